@@ -0,0 +1,107 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// ACMEUser retrieves the ACME account that is used to obtain TLS
+// certificates. Only administrators are allowed to retrieve it.
+func (c Client) ACMEUser() (u ACMEUser, err error) {
+	return c.ACMEUserContext(context.Background())
+}
+
+// ACMEUserContext provides the same functionality as ACMEUser with Context.
+func (c Client) ACMEUserContext(ctx context.Context) (u ACMEUser, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/acme/user", nil, nil, "", &u)
+	return
+}
+
+// RolloverACMEUserKey replaces the ACME account key with a newly generated
+// one. Only administrators are allowed to do it.
+func (c Client) RolloverACMEUserKey() (u ACMEUser, err error) {
+	return c.RolloverACMEUserKeyContext(context.Background())
+}
+
+// RolloverACMEUserKeyContext provides the same functionality as
+// RolloverACMEUserKey with Context.
+func (c Client) RolloverACMEUserKeyContext(ctx context.Context) (u ACMEUser, err error) {
+	_, err = c.jsonContext(ctx, "POST", "/acme/user/key-rollover", nil, nil, "", &u)
+	return
+}
+
+// DNSProviders retrieves names of DNS providers that can be selected for
+// domains to obtain TLS certificates with dns-01 challenges.
+func (c Client) DNSProviders() (p DNSProviders, err error) {
+	return c.DNSProvidersContext(context.Background())
+}
+
+// DNSProvidersContext provides the same functionality as DNSProviders
+// with Context.
+func (c Client) DNSProvidersContext(ctx context.Context) (p DNSProviders, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/acme/dns-providers", nil, nil, "", &p)
+	return
+}
+
+// DomainACMESettings retrieves ACME settings of a domain referenced
+// by its ID or fully qualified domain name.
+func (c Client) DomainACMESettings(ref string) (s ACMESettings, err error) {
+	return c.DomainACMESettingsContext(context.Background(), ref)
+}
+
+// DomainACMESettingsContext provides the same functionality as
+// DomainACMESettings with Context.
+func (c Client) DomainACMESettingsContext(ctx context.Context, ref string) (s ACMESettings, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/domains/"+ref+"/acme-settings", nil, nil, "", &s)
+	return
+}
+
+// UpdateDomainACMESettings changes ACME settings of a domain referenced
+// by its ID or fully qualified domain name. Only domain owners are
+// allowed to change them.
+func (c Client) UpdateDomainACMESettings(ref string, o *ACMESettingsOptions) (s ACMESettings, err error) {
+	return c.UpdateDomainACMESettingsContext(context.Background(), ref, o)
+}
+
+// UpdateDomainACMESettingsContext provides the same functionality as
+// UpdateDomainACMESettings with Context.
+func (c Client) UpdateDomainACMESettingsContext(ctx context.Context, ref string, o *ACMESettingsOptions) (s ACMESettings, err error) {
+	body, err := json.Marshal(o)
+	if err != nil {
+		return
+	}
+	_, err = c.jsonContext(ctx, "POST", "/domains/"+ref+"/acme-settings", nil, body, "", &s)
+	return
+}
+
+// DomainCertificate retrieves information about the TLS certificate of a
+// domain referenced by its ID or fully qualified domain name.
+func (c Client) DomainCertificate(ref string) (crt Certificate, err error) {
+	return c.DomainCertificateContext(context.Background(), ref)
+}
+
+// DomainCertificateContext provides the same functionality as
+// DomainCertificate with Context.
+func (c Client) DomainCertificateContext(ctx context.Context, ref string) (crt Certificate, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/domains/"+ref+"/certificate", nil, nil, "", &crt)
+	return
+}
+
+// RenewDomainCertificate queues the TLS certificate of a domain referenced
+// by its ID or fully qualified domain name for renewal, regardless of its
+// expiration time. Only domain owners are allowed to renew it.
+func (c Client) RenewDomainCertificate(ref string) (crt Certificate, err error) {
+	return c.RenewDomainCertificateContext(context.Background(), ref)
+}
+
+// RenewDomainCertificateContext provides the same functionality as
+// RenewDomainCertificate with Context.
+func (c Client) RenewDomainCertificateContext(ctx context.Context, ref string) (crt Certificate, err error) {
+	_, err = c.jsonContext(ctx, "POST", "/domains/"+ref+"/certificate/renew", nil, nil, "", &crt)
+	return
+}
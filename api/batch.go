@@ -0,0 +1,72 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+
+	apiClient "resenje.org/web/client/api"
+)
+
+// Batch applies all operations atomically. Either all of them succeed
+// and results are returned in the same order, or none of them is applied
+// and *BatchError is returned with the index of the failed operation.
+// Errors that are not related to a single operation are returned as is.
+func (c Client) Batch(operations []BatchOperation) (results []BatchResult, err error) {
+	return c.BatchContext(context.Background(), operations)
+}
+
+// BatchContext provides the same functionality as Batch with Context.
+func (c Client) BatchContext(ctx context.Context, operations []BatchOperation) (results []BatchResult, err error) {
+	body, err := json.Marshal(BatchRequest{
+		Operations: operations,
+	})
+	if err != nil {
+		return
+	}
+	c.ErrorRegistry = batchErrorRegistry{ErrorRegistry: errorRegistry}
+	response := BatchResponse{}
+	if _, err = c.jsonContext(ctx, "POST", "/batch", nil, body, "", &response); err != nil {
+		return
+	}
+	return response.Results, nil
+}
+
+// batchErrorRegistry wraps errors from the error response of the batch
+// endpoint in BatchError if the response contains the index of the
+// failed operation.
+type batchErrorRegistry struct {
+	apiClient.ErrorRegistry
+}
+
+func (r batchErrorRegistry) Error(code int) error {
+	return nil
+}
+
+func (r batchErrorRegistry) Handler(code int) func(body []byte) error {
+	return func(body []byte) error {
+		message := struct {
+			Message   string `json:"message"`
+			Operation *int   `json:"operation"`
+		}{}
+		json.Unmarshal(body, &message)
+		err := r.ErrorRegistry.Error(code)
+		if err == nil {
+			err = &apiClient.Error{
+				Message: message.Message,
+				Code:    code,
+			}
+		}
+		if message.Operation == nil {
+			return err
+		}
+		return &BatchError{
+			Operation: *message.Operation,
+			Err:       err,
+		}
+	}
+}
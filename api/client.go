@@ -46,10 +46,33 @@ To use GopherPit installation on-premises:
 
     c := api.NewClientWithEndpoint("https://go.example.com/api/v1", "TOKEN")
 
+Every method has a variant with Context suffix that accepts
+context.Context for cancellation and deadlines. Paginated listings
+can be retrieved completely with AllDomains, AllDomainPackages and
+AllSearchPackages methods.
+
+Requests rejected because of rate limiting or maintenance are not
+retried by default. Retries with exponential backoff that respect
+Retry-After response header can be enabled with Retry field:
+
+    c.Retry = api.DefaultRetryOptions
+
 */
 package api
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
 	apiClient "resenje.org/web/client/api"
 )
 
@@ -63,6 +86,63 @@ var (
 // against GopherPit server.
 type Client struct {
 	apiClient.Client
+
+	// Retry configures retrying of requests that are rejected with
+	// ErrTooManyRequests or ErrMaintenance. Retries are disabled
+	// by default.
+	Retry RetryOptions
+}
+
+// RetryOptions holds parameters for retrying requests that are rejected
+// with ErrTooManyRequests or ErrMaintenance errors. Rejected requests
+// are not processed by the server, so it is safe to retry them.
+type RetryOptions struct {
+	// MaxRetries is the maximal number of retries after the first
+	// attempt. Zero value disables retries.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry if the server
+	// does not specify it with Retry-After header. It is doubled for
+	// every subsequent retry. Zero value means MinBackoff of
+	// DefaultRetryOptions.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximal delay between retries. If the server
+	// requires a longer delay with Retry-After header, the error is
+	// returned without retrying. Zero value means no limit.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryOptions are recommended retry options for clients
+// that perform a larger number of requests.
+var DefaultRetryOptions = RetryOptions{
+	MaxRetries: 5,
+	MinBackoff: time.Second,
+	MaxBackoff: 5 * time.Minute,
+}
+
+// backoff returns the delay before a retry and false if the request
+// should not be retried. Value of the Retry-After header, if it is not
+// zero, is used instead of the exponential backoff.
+func (o RetryOptions) backoff(retry int, retryAfter time.Duration) (delay time.Duration, ok bool) {
+	if retryAfter > 0 {
+		if o.MaxBackoff > 0 && retryAfter > o.MaxBackoff {
+			return 0, false
+		}
+		return retryAfter, true
+	}
+	delay = o.MinBackoff
+	if delay <= 0 {
+		delay = DefaultRetryOptions.MinBackoff
+	}
+	for i := 0; i < retry; i++ {
+		delay *= 2
+		if o.MaxBackoff > 0 && delay > o.MaxBackoff {
+			break
+		}
+	}
+	if o.MaxBackoff > 0 && delay > o.MaxBackoff {
+		delay = o.MaxBackoff
+	}
+	return delay, true
 }
 
 // NewClient creates a new Client object with
@@ -91,3 +171,134 @@ func newClientWithAPIClient(c apiClient.Client) *Client {
 	c.ErrorRegistry = errorRegistry
 	return &Client{Client: c}
 }
+
+// jsonContext makes a HTTP request that expects application/json response
+// and decodes it into response if it is not nil. If-Match request header
+// is set to ifMatch value, if it is not blank. Requests rejected with
+// ErrTooManyRequests or ErrMaintenance are retried according to Retry
+// options. It returns the value of ETag response header.
+func (c Client) jsonContext(ctx context.Context, method, path string, query url.Values, body []byte, ifMatch string, response interface{}) (etag string, err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if ifMatch != "" {
+		headers := map[string]string{}
+		for key, value := range c.Headers {
+			headers[key] = value
+		}
+		headers["If-Match"] = ifMatch
+		c.Headers = headers
+	}
+	var recorder *retryAfterRecorder
+	if c.Retry.MaxRetries > 0 {
+		// Client from resenje.org/web/client/api does not return
+		// the response on errors, so the Retry-After header is
+		// recorded by the transport.
+		httpClient := http.DefaultClient
+		if c.HTTPClient != nil {
+			httpClient = c.HTTPClient
+		}
+		hc := *httpClient
+		recorder = &retryAfterRecorder{transport: hc.Transport}
+		hc.Transport = recorder
+		c.HTTPClient = &hc
+	}
+	for retry := 0; ; retry++ {
+		var r io.Reader
+		if body != nil {
+			r = bytes.NewReader(body)
+		}
+		etag, err = c.json(ctx, method, path, query, r, response)
+		if err != ErrTooManyRequests && err != ErrMaintenance {
+			return
+		}
+		if retry >= c.Retry.MaxRetries {
+			return
+		}
+		delay, ok := c.Retry.backoff(retry, recorder.retryAfter)
+		if !ok {
+			return
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return "", ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// json makes a single HTTP request that expects application/json response
+// and decodes it into response if it is not nil. It returns the value of
+// ETag response header.
+func (c Client) json(ctx context.Context, method, path string, query url.Values, body io.Reader, response interface{}) (etag string, err error) {
+	resp, err := c.RequestContext(ctx, method, path, query, body, []string{"application/json"})
+	if err != nil {
+		return
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	etag = resp.Header.Get("ETag")
+	if response == nil {
+		return
+	}
+	if resp.ContentLength == 0 {
+		return "", errors.New("empty response body")
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if !strings.Contains(contentType, "application/json") {
+		return "", fmt.Errorf("unsupported content type: %s", contentType)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+	if err = apiClient.JSONUnmarshal(data, response); err != nil {
+		return "", err
+	}
+	return
+}
+
+// retryAfterRecorder is a http.RoundTripper that records the delay from
+// Retry-After header of the last response.
+type retryAfterRecorder struct {
+	transport  http.RoundTripper
+	retryAfter time.Duration
+}
+
+func (t *retryAfterRecorder) RoundTrip(r *http.Request) (*http.Response, error) {
+	transport := t.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(r)
+	t.retryAfter = 0
+	if resp != nil {
+		t.retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	}
+	return resp, err
+}
+
+// parseRetryAfter returns the delay specified by the Retry-After header
+// value, either in seconds or as HTTP date. Zero is returned for invalid
+// or past values.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	t, err := http.ParseTime(v)
+	if err != nil || !t.After(now) {
+		return 0
+	}
+	return t.Sub(now)
+}
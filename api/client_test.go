@@ -0,0 +1,267 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestClient(handler http.HandlerFunc) (c *Client, close func()) {
+	ts := httptest.NewServer(handler)
+	return NewClientWithEndpoint(ts.URL+"/api/v1", "KEY"), ts.Close
+}
+
+func respondJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestClientRetry(t *testing.T) {
+	tooManyRequests := map[string]interface{}{
+		"message": "Too Many Requests",
+		"code":    429,
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		var count int
+		c, close := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+			count++
+			respondJSON(w, http.StatusTooManyRequests, tooManyRequests)
+		})
+		defer close()
+
+		_, err := c.Domain("example.com")
+		if err != ErrTooManyRequests {
+			t.Errorf("expected error %v, got %v", ErrTooManyRequests, err)
+		}
+		if count != 1 {
+			t.Errorf("expected 1 request, got %d", count)
+		}
+	})
+
+	t.Run("too many requests", func(t *testing.T) {
+		var mu sync.Mutex
+		bodies := []string{}
+		c, close := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			mu.Lock()
+			bodies = append(bodies, string(body))
+			count := len(bodies)
+			mu.Unlock()
+			if count < 3 {
+				w.Header().Set("Retry-After", "0")
+				respondJSON(w, http.StatusTooManyRequests, tooManyRequests)
+				return
+			}
+			respondJSON(w, http.StatusOK, Domain{ID: "1", FQDN: "example.com"})
+		})
+		defer close()
+		c.Retry = RetryOptions{
+			MaxRetries: 3,
+			MinBackoff: time.Millisecond,
+		}
+
+		fqdn := "example.com"
+		d, err := c.AddDomain(&DomainOptions{FQDN: &fqdn})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.ID != "1" {
+			t.Errorf("expected domain id %q, got %q", "1", d.ID)
+		}
+		if len(bodies) != 3 {
+			t.Fatalf("expected 3 requests, got %d", len(bodies))
+		}
+		for i, body := range bodies {
+			if body != bodies[0] {
+				t.Errorf("request %d: expected body %q, got %q", i, bodies[0], body)
+			}
+		}
+	})
+
+	t.Run("maintenance", func(t *testing.T) {
+		var count int
+		c, close := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+			count++
+			respondJSON(w, http.StatusServiceUnavailable, map[string]interface{}{
+				"message": "Maintenance",
+				"code":    503,
+			})
+		})
+		defer close()
+		c.Retry = RetryOptions{
+			MaxRetries: 2,
+			MinBackoff: time.Millisecond,
+		}
+
+		_, err := c.Domains("", 0)
+		if err != ErrMaintenance {
+			t.Errorf("expected error %v, got %v", ErrMaintenance, err)
+		}
+		if count != 3 {
+			t.Errorf("expected 3 requests, got %d", count)
+		}
+	})
+
+	t.Run("retry after too long", func(t *testing.T) {
+		var count int
+		c, close := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+			count++
+			w.Header().Set("Retry-After", "3600")
+			respondJSON(w, http.StatusTooManyRequests, tooManyRequests)
+		})
+		defer close()
+		c.Retry = RetryOptions{
+			MaxRetries: 3,
+			MinBackoff: time.Millisecond,
+			MaxBackoff: time.Minute,
+		}
+
+		_, err := c.Domain("example.com")
+		if err != ErrTooManyRequests {
+			t.Errorf("expected error %v, got %v", ErrTooManyRequests, err)
+		}
+		if count != 1 {
+			t.Errorf("expected 1 request, got %d", count)
+		}
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		c, close := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "60")
+			respondJSON(w, http.StatusTooManyRequests, tooManyRequests)
+		})
+		defer close()
+		c.Retry = DefaultRetryOptions
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := c.DomainContext(ctx, "example.com")
+		if err != context.DeadlineExceeded {
+			t.Errorf("expected error %v, got %v", context.DeadlineExceeded, err)
+		}
+	})
+
+	t.Run("not retried", func(t *testing.T) {
+		var count int
+		c, close := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+			count++
+			respondJSON(w, http.StatusBadRequest, map[string]interface{}{
+				"message": "Domain Not Found",
+				"code":    ErrDomainNotFound.Code,
+			})
+		})
+		defer close()
+		c.Retry = DefaultRetryOptions
+
+		_, err := c.Domain("example.com")
+		if err != ErrDomainNotFound {
+			t.Errorf("expected error %v, got %v", ErrDomainNotFound, err)
+		}
+		if count != 1 {
+			t.Errorf("expected 1 request, got %d", count)
+		}
+	})
+}
+
+func TestRetryOptionsBackoff(t *testing.T) {
+	o := RetryOptions{
+		MinBackoff: time.Second,
+		MaxBackoff: 5 * time.Second,
+	}
+	for _, tc := range []struct {
+		retry      int
+		retryAfter time.Duration
+		delay      time.Duration
+		ok         bool
+	}{
+		{retry: 0, delay: time.Second, ok: true},
+		{retry: 1, delay: 2 * time.Second, ok: true},
+		{retry: 2, delay: 4 * time.Second, ok: true},
+		{retry: 3, delay: 5 * time.Second, ok: true},
+		{retry: 100, delay: 5 * time.Second, ok: true},
+		{retry: 0, retryAfter: 3 * time.Second, delay: 3 * time.Second, ok: true},
+		{retry: 0, retryAfter: 10 * time.Second, ok: false},
+	} {
+		delay, ok := o.backoff(tc.retry, tc.retryAfter)
+		if delay != tc.delay || ok != tc.ok {
+			t.Errorf("retry %d, retry after %s: expected %s %v, got %s %v", tc.retry, tc.retryAfter, tc.delay, tc.ok, delay, ok)
+		}
+	}
+
+	o.MinBackoff = 0
+	if delay, ok := o.backoff(1, 0); delay != 2*DefaultRetryOptions.MinBackoff || !ok {
+		t.Errorf("zero min backoff: expected %s true, got %s %v", 2*DefaultRetryOptions.MinBackoff, delay, ok)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		value string
+		delay time.Duration
+	}{
+		{"", 0},
+		{"invalid", 0},
+		{"-1", 0},
+		{"0", 0},
+		{"120", 2 * time.Minute},
+		{now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second},
+		{now.Add(-30 * time.Second).Format(http.TimeFormat), 0},
+	} {
+		if delay := parseRetryAfter(tc.value, now); delay != tc.delay {
+			t.Errorf("%q: expected %s, got %s", tc.value, tc.delay, delay)
+		}
+	}
+}
+
+func TestClientAllDomains(t *testing.T) {
+	var count int
+	c, close := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if r.URL.Query().Get("limit") != strconv.Itoa(MaxLimit) {
+			t.Errorf("expected limit %d, got %q", MaxLimit, r.URL.Query().Get("limit"))
+		}
+		start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+		page := DomainsPage{}
+		for i := start; i < start+2 && i < 5; i++ {
+			page.Domains = append(page.Domains, Domain{ID: strconv.Itoa(i)})
+		}
+		page.Count = len(page.Domains)
+		if start+2 < 5 {
+			page.Next = strconv.Itoa(start + 2)
+		}
+		respondJSON(w, http.StatusOK, page)
+	})
+	defer close()
+
+	domains, err := c.AllDomains(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("expected 3 requests, got %d", count)
+	}
+	if len(domains) != 5 {
+		t.Fatalf("expected 5 domains, got %d", len(domains))
+	}
+	for i, d := range domains {
+		if d.ID != fmt.Sprint(i) {
+			t.Errorf("expected domain %d id %q, got %q", i, fmt.Sprint(i), d.ID)
+		}
+	}
+}
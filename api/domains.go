@@ -6,7 +6,7 @@
 package api
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -14,33 +14,82 @@ import (
 
 // Domain retrieves a Domain instance.
 func (c Client) Domain(ref string) (d Domain, err error) {
-	err = c.JSON("GET", "/domains/"+ref, nil, nil, &d)
+	return c.DomainContext(context.Background(), ref)
+}
+
+// DomainContext provides the same functionality as Domain with Context.
+func (c Client) DomainContext(ctx context.Context, ref string) (d Domain, err error) {
+	d.ETag, err = c.jsonContext(ctx, "GET", "/domains/"+ref, nil, nil, "", &d)
 	return
 }
 
 // AddDomain creates a new Domain.
 func (c Client) AddDomain(o *DomainOptions) (d Domain, err error) {
+	return c.AddDomainContext(context.Background(), o)
+}
+
+// AddDomainContext provides the same functionality as AddDomain with Context.
+func (c Client) AddDomainContext(ctx context.Context, o *DomainOptions) (d Domain, err error) {
 	body, err := json.Marshal(o)
 	if err != nil {
 		return
 	}
-	err = c.JSON("POST", "/domains", nil, bytes.NewReader(body), &d)
+	d.ETag, err = c.jsonContext(ctx, "POST", "/domains", nil, body, "", &d)
 	return
 }
 
 // UpdateDomain updates fields of an existing Domain.
 func (c Client) UpdateDomain(ref string, o *DomainOptions) (d Domain, err error) {
+	return c.UpdateDomainIfMatchContext(context.Background(), ref, "", o)
+}
+
+// UpdateDomainContext provides the same functionality as UpdateDomain
+// with Context.
+func (c Client) UpdateDomainContext(ctx context.Context, ref string, o *DomainOptions) (d Domain, err error) {
+	return c.UpdateDomainIfMatchContext(ctx, ref, "", o)
+}
+
+// UpdateDomainIfMatch updates fields of an existing Domain only if
+// its current entity tag is equal to the etag argument. If entity tags
+// do not match, ErrPreconditionFailed is returned. Blank etag disables
+// the check.
+func (c Client) UpdateDomainIfMatch(ref, etag string, o *DomainOptions) (d Domain, err error) {
+	return c.UpdateDomainIfMatchContext(context.Background(), ref, etag, o)
+}
+
+// UpdateDomainIfMatchContext provides the same functionality as
+// UpdateDomainIfMatch with Context.
+func (c Client) UpdateDomainIfMatchContext(ctx context.Context, ref, etag string, o *DomainOptions) (d Domain, err error) {
 	body, err := json.Marshal(o)
 	if err != nil {
 		return
 	}
-	err = c.JSON("POST", "/domains/"+ref, nil, bytes.NewReader(body), &d)
+	d.ETag, err = c.jsonContext(ctx, "POST", "/domains/"+ref, nil, body, etag, &d)
 	return
 }
 
 // DeleteDomain removes a Domain.
 func (c Client) DeleteDomain(ref string) (d Domain, err error) {
-	err = c.JSON("DELETE", "/domains/"+ref, nil, nil, &d)
+	return c.DeleteDomainIfMatchContext(context.Background(), ref, "")
+}
+
+// DeleteDomainContext provides the same functionality as DeleteDomain
+// with Context.
+func (c Client) DeleteDomainContext(ctx context.Context, ref string) (d Domain, err error) {
+	return c.DeleteDomainIfMatchContext(ctx, ref, "")
+}
+
+// DeleteDomainIfMatch removes a Domain only if its current entity tag
+// is equal to the etag argument. If entity tags do not match,
+// ErrPreconditionFailed is returned. Blank etag disables the check.
+func (c Client) DeleteDomainIfMatch(ref, etag string) (d Domain, err error) {
+	return c.DeleteDomainIfMatchContext(context.Background(), ref, etag)
+}
+
+// DeleteDomainIfMatchContext provides the same functionality as
+// DeleteDomainIfMatch with Context.
+func (c Client) DeleteDomainIfMatchContext(ctx context.Context, ref, etag string) (d Domain, err error) {
+	_, err = c.jsonContext(ctx, "DELETE", "/domains/"+ref, nil, nil, etag, &d)
 	return
 }
 
@@ -48,6 +97,11 @@ func (c Client) DeleteDomain(ref string) (d Domain, err error) {
 // Values from the previous and next fields in returned page can be provided as
 // startRef argument to get a previous or next page in the listing.
 func (c Client) Domains(startRef string, limit int) (page DomainsPage, err error) {
+	return c.DomainsContext(context.Background(), startRef, limit)
+}
+
+// DomainsContext provides the same functionality as Domains with Context.
+func (c Client) DomainsContext(ctx context.Context, startRef string, limit int) (page DomainsPage, err error) {
 	query := url.Values{}
 	if startRef != "" {
 		query.Set("start", startRef)
@@ -55,29 +109,180 @@ func (c Client) Domains(startRef string, limit int) (page DomainsPage, err error
 	if limit > 0 {
 		query.Set("limit", strconv.Itoa(limit))
 	}
-	err = c.JSON("GET", "/domains", query, nil, &page)
+	_, err = c.jsonContext(ctx, "GET", "/domains", query, nil, "", &page)
 	return
 }
 
+// AllDomains retrieves all Domains by requesting pages of the listing
+// until the last one.
+func (c Client) AllDomains(ctx context.Context) (domains []Domain, err error) {
+	var start string
+	for {
+		page, err := c.DomainsContext(ctx, start, MaxLimit)
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, page.Domains...)
+		if page.Next == "" {
+			return domains, nil
+		}
+		start = page.Next
+	}
+}
+
 // DomainTokens retrieves a list of validation tokens for domain.
 func (c Client) DomainTokens(fqdn string) (tokens DomainTokens, err error) {
-	err = c.JSON("GET", "/domains/"+fqdn+"/tokens", nil, nil, &tokens)
+	return c.DomainTokensContext(context.Background(), fqdn)
+}
+
+// DomainTokensContext provides the same functionality as DomainTokens
+// with Context.
+func (c Client) DomainTokensContext(ctx context.Context, fqdn string) (tokens DomainTokens, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/domains/"+fqdn+"/tokens", nil, nil, "", &tokens)
 	return
 }
 
 // DomainUsers retrieves a list of user IDs that have write access to
 // domain packages and domain owner user ID.
 func (c Client) DomainUsers(ref string) (users DomainUsers, err error) {
-	err = c.JSON("GET", "/domains/"+ref+"/users", nil, nil, &users)
+	return c.DomainUsersContext(context.Background(), ref)
+}
+
+// DomainUsersContext provides the same functionality as DomainUsers
+// with Context.
+func (c Client) DomainUsersContext(ctx context.Context, ref string) (users DomainUsers, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/domains/"+ref+"/users", nil, nil, "", &users)
 	return
 }
 
 // GrantDomainUser gives write access to domain packages for a user.
 func (c Client) GrantDomainUser(ref, user string) error {
-	return c.JSON("POST", "/domains/"+ref+"/users/"+user, nil, nil, nil)
+	return c.GrantDomainUserContext(context.Background(), ref, user)
+}
+
+// GrantDomainUserContext provides the same functionality as
+// GrantDomainUser with Context.
+func (c Client) GrantDomainUserContext(ctx context.Context, ref, user string) (err error) {
+	_, err = c.jsonContext(ctx, "POST", "/domains/"+ref+"/users/"+user, nil, nil, "", nil)
+	return
+}
+
+// GrantDomainUserWithRole gives access to a domain for a user with
+// a specific role.
+func (c Client) GrantDomainUserWithRole(ref, user string, role DomainRole) error {
+	return c.GrantDomainUserWithRoleContext(context.Background(), ref, user, role)
+}
+
+// GrantDomainUserWithRoleContext provides the same functionality as
+// GrantDomainUserWithRole with Context.
+func (c Client) GrantDomainUserWithRoleContext(ctx context.Context, ref, user string, role DomainRole) (err error) {
+	body, err := json.Marshal(DomainUserOptions{
+		Role: role,
+	})
+	if err != nil {
+		return
+	}
+	_, err = c.jsonContext(ctx, "POST", "/domains/"+ref+"/users/"+user, nil, body, "", nil)
+	return
+}
+
+// UpdateDomainUserRole changes the role of a user that has access to
+// a domain.
+func (c Client) UpdateDomainUserRole(ref, user string, role DomainRole) error {
+	return c.UpdateDomainUserRoleContext(context.Background(), ref, user, role)
+}
+
+// UpdateDomainUserRoleContext provides the same functionality as
+// UpdateDomainUserRole with Context.
+func (c Client) UpdateDomainUserRoleContext(ctx context.Context, ref, user string, role DomainRole) (err error) {
+	body, err := json.Marshal(DomainUserOptions{
+		Role: role,
+	})
+	if err != nil {
+		return
+	}
+	_, err = c.jsonContext(ctx, "POST", "/domains/"+ref+"/users/"+user+"/role", nil, body, "", nil)
+	return
 }
 
 // RevokeDomainUser removes write access to domain packages for a user.
 func (c Client) RevokeDomainUser(ref, user string) error {
-	return c.JSON("DELETE", "/domains/"+ref+"/users/"+user, nil, nil, nil)
+	return c.RevokeDomainUserContext(context.Background(), ref, user)
+}
+
+// RevokeDomainUserContext provides the same functionality as
+// RevokeDomainUser with Context.
+func (c Client) RevokeDomainUserContext(ctx context.Context, ref, user string) (err error) {
+	_, err = c.jsonContext(ctx, "DELETE", "/domains/"+ref+"/users/"+user, nil, nil, "", nil)
+	return
+}
+
+// DomainTransfer retrieves a pending transfer of the Domain to a new owner.
+func (c Client) DomainTransfer(ref string) (t DomainTransfer, err error) {
+	return c.DomainTransferContext(context.Background(), ref)
+}
+
+// DomainTransferContext provides the same functionality as
+// DomainTransfer with Context.
+func (c Client) DomainTransferContext(ctx context.Context, ref string) (t DomainTransfer, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/domains/"+ref+"/transfer", nil, nil, "", &t)
+	return
+}
+
+// RequestDomainTransfer requests a transfer of the Domain to a new owner.
+// Domain owner is not changed until the user accepts the transfer.
+func (c Client) RequestDomainTransfer(ref, user string) (t DomainTransfer, err error) {
+	return c.RequestDomainTransferContext(context.Background(), ref, user)
+}
+
+// RequestDomainTransferContext provides the same functionality as
+// RequestDomainTransfer with Context.
+func (c Client) RequestDomainTransferContext(ctx context.Context, ref, user string) (t DomainTransfer, err error) {
+	body, err := json.Marshal(DomainTransferOptions{
+		ToUser: user,
+	})
+	if err != nil {
+		return
+	}
+	_, err = c.jsonContext(ctx, "POST", "/domains/"+ref+"/transfer", nil, body, "", &t)
+	return
+}
+
+// AcceptDomainTransfer makes the authenticated user the owner of the Domain
+// that is requested to be transferred to that user.
+func (c Client) AcceptDomainTransfer(ref string) (d Domain, err error) {
+	return c.AcceptDomainTransferContext(context.Background(), ref)
+}
+
+// AcceptDomainTransferContext provides the same functionality as
+// AcceptDomainTransfer with Context.
+func (c Client) AcceptDomainTransferContext(ctx context.Context, ref string) (d Domain, err error) {
+	d.ETag, err = c.jsonContext(ctx, "POST", "/domains/"+ref+"/transfer/accept", nil, nil, "", &d)
+	return
+}
+
+// CancelDomainTransfer removes a pending transfer of the Domain. Domain
+// owner can cancel the transfer and the new owner can decline it.
+func (c Client) CancelDomainTransfer(ref string) (t DomainTransfer, err error) {
+	return c.CancelDomainTransferContext(context.Background(), ref)
+}
+
+// CancelDomainTransferContext provides the same functionality as
+// CancelDomainTransfer with Context.
+func (c Client) CancelDomainTransferContext(ctx context.Context, ref string) (t DomainTransfer, err error) {
+	_, err = c.jsonContext(ctx, "DELETE", "/domains/"+ref+"/transfer", nil, nil, "", &t)
+	return
+}
+
+// DomainTransfers retrieves all pending transfers of domains that are
+// requested by or for the authenticated user.
+func (c Client) DomainTransfers() (transfers DomainTransfers, err error) {
+	return c.DomainTransfersContext(context.Background())
+}
+
+// DomainTransfersContext provides the same functionality as
+// DomainTransfers with Context.
+func (c Client) DomainTransfersContext(ctx context.Context) (transfers DomainTransfers, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/domain-transfers", nil, nil, "", &transfers)
+	return
 }
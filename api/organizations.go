@@ -0,0 +1,146 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// Organizations retrieves all Organizations that the user is a member of.
+func (c Client) Organizations() (orgs Organizations, err error) {
+	return c.OrganizationsContext(context.Background())
+}
+
+// OrganizationsContext provides the same functionality as Organizations
+// with Context.
+func (c Client) OrganizationsContext(ctx context.Context) (orgs Organizations, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/organizations", nil, nil, "", &orgs)
+	return
+}
+
+// Organization retrieves an Organization by its ID or name.
+func (c Client) Organization(ref string) (o Organization, err error) {
+	return c.OrganizationContext(context.Background(), ref)
+}
+
+// OrganizationContext provides the same functionality as Organization
+// with Context.
+func (c Client) OrganizationContext(ctx context.Context, ref string) (o Organization, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/organizations/"+ref, nil, nil, "", &o)
+	return
+}
+
+// AddOrganization creates a new Organization with the user as its owner.
+func (c Client) AddOrganization(o *OrganizationOptions) (org Organization, err error) {
+	return c.AddOrganizationContext(context.Background(), o)
+}
+
+// AddOrganizationContext provides the same functionality as
+// AddOrganization with Context.
+func (c Client) AddOrganizationContext(ctx context.Context, o *OrganizationOptions) (org Organization, err error) {
+	body, err := json.Marshal(o)
+	if err != nil {
+		return
+	}
+	_, err = c.jsonContext(ctx, "POST", "/organizations", nil, body, "", &org)
+	return
+}
+
+// UpdateOrganization updates fields of an existing Organization.
+func (c Client) UpdateOrganization(ref string, o *OrganizationOptions) (org Organization, err error) {
+	return c.UpdateOrganizationContext(context.Background(), ref, o)
+}
+
+// UpdateOrganizationContext provides the same functionality as
+// UpdateOrganization with Context.
+func (c Client) UpdateOrganizationContext(ctx context.Context, ref string, o *OrganizationOptions) (org Organization, err error) {
+	body, err := json.Marshal(o)
+	if err != nil {
+		return
+	}
+	_, err = c.jsonContext(ctx, "POST", "/organizations/"+ref, nil, body, "", &org)
+	return
+}
+
+// DeleteOrganization deletes an Organization that does not own any
+// Domains.
+func (c Client) DeleteOrganization(ref string) (org Organization, err error) {
+	return c.DeleteOrganizationContext(context.Background(), ref)
+}
+
+// DeleteOrganizationContext provides the same functionality as
+// DeleteOrganization with Context.
+func (c Client) DeleteOrganizationContext(ctx context.Context, ref string) (org Organization, err error) {
+	_, err = c.jsonContext(ctx, "DELETE", "/organizations/"+ref, nil, nil, "", &org)
+	return
+}
+
+// OrganizationMembers retrieves members of an Organization and their
+// roles.
+func (c Client) OrganizationMembers(ref string) (members OrganizationMembers, err error) {
+	return c.OrganizationMembersContext(context.Background(), ref)
+}
+
+// OrganizationMembersContext provides the same functionality as
+// OrganizationMembers with Context.
+func (c Client) OrganizationMembersContext(ctx context.Context, ref string) (members OrganizationMembers, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/organizations/"+ref+"/members", nil, nil, "", &members)
+	return
+}
+
+// SetOrganizationMember adds a user to an Organization or changes the
+// role of an existing member.
+func (c Client) SetOrganizationMember(ref, user string, role OrganizationRole) error {
+	return c.SetOrganizationMemberContext(context.Background(), ref, user, role)
+}
+
+// SetOrganizationMemberContext provides the same functionality as
+// SetOrganizationMember with Context.
+func (c Client) SetOrganizationMemberContext(ctx context.Context, ref, user string, role OrganizationRole) (err error) {
+	body, err := json.Marshal(OrganizationMemberOptions{
+		Role: role,
+	})
+	if err != nil {
+		return
+	}
+	_, err = c.jsonContext(ctx, "POST", "/organizations/"+ref+"/members/"+user, nil, body, "", nil)
+	return
+}
+
+// RemoveOrganizationMember removes a user from an Organization.
+func (c Client) RemoveOrganizationMember(ref, user string) error {
+	return c.RemoveOrganizationMemberContext(context.Background(), ref, user)
+}
+
+// RemoveOrganizationMemberContext provides the same functionality as
+// RemoveOrganizationMember with Context.
+func (c Client) RemoveOrganizationMemberContext(ctx context.Context, ref, user string) (err error) {
+	_, err = c.jsonContext(ctx, "DELETE", "/organizations/"+ref+"/members/"+user, nil, nil, "", nil)
+	return
+}
+
+// OrganizationDomains retrieves a paginated list of Domains that are
+// owned by an Organization.
+func (c Client) OrganizationDomains(ref, startRef string, limit int) (page DomainsPage, err error) {
+	return c.OrganizationDomainsContext(context.Background(), ref, startRef, limit)
+}
+
+// OrganizationDomainsContext provides the same functionality as
+// OrganizationDomains with Context.
+func (c Client) OrganizationDomainsContext(ctx context.Context, ref, startRef string, limit int) (page DomainsPage, err error) {
+	query := url.Values{}
+	if startRef != "" {
+		query.Set("start", startRef)
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	_, err = c.jsonContext(ctx, "GET", "/organizations/"+ref+"/domains", query, nil, "", &page)
+	return
+}
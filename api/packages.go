@@ -6,7 +6,7 @@
 package api
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -14,33 +14,83 @@ import (
 
 // Package retrieves a Package instance.
 func (c Client) Package(id string) (p Package, err error) {
-	err = c.JSON("GET", "/packages/"+id, nil, nil, &p)
+	return c.PackageContext(context.Background(), id)
+}
+
+// PackageContext provides the same functionality as Package with Context.
+func (c Client) PackageContext(ctx context.Context, id string) (p Package, err error) {
+	p.ETag, err = c.jsonContext(ctx, "GET", "/packages/"+id, nil, nil, "", &p)
 	return
 }
 
 // AddPackage creates a new Package.
 func (c Client) AddPackage(o *PackageOptions) (p Package, err error) {
+	return c.AddPackageContext(context.Background(), o)
+}
+
+// AddPackageContext provides the same functionality as AddPackage
+// with Context.
+func (c Client) AddPackageContext(ctx context.Context, o *PackageOptions) (p Package, err error) {
 	body, err := json.Marshal(o)
 	if err != nil {
 		return
 	}
-	err = c.JSON("POST", "/packages", nil, bytes.NewReader(body), &p)
+	p.ETag, err = c.jsonContext(ctx, "POST", "/packages", nil, body, "", &p)
 	return
 }
 
 // UpdatePackage updates fields of an existing Package.
 func (c Client) UpdatePackage(id string, o *PackageOptions) (p Package, err error) {
+	return c.UpdatePackageIfMatchContext(context.Background(), id, "", o)
+}
+
+// UpdatePackageContext provides the same functionality as UpdatePackage
+// with Context.
+func (c Client) UpdatePackageContext(ctx context.Context, id string, o *PackageOptions) (p Package, err error) {
+	return c.UpdatePackageIfMatchContext(ctx, id, "", o)
+}
+
+// UpdatePackageIfMatch updates fields of an existing Package only if
+// its current entity tag is equal to the etag argument. If entity tags
+// do not match, ErrPreconditionFailed is returned. Blank etag disables
+// the check.
+func (c Client) UpdatePackageIfMatch(id, etag string, o *PackageOptions) (p Package, err error) {
+	return c.UpdatePackageIfMatchContext(context.Background(), id, etag, o)
+}
+
+// UpdatePackageIfMatchContext provides the same functionality as
+// UpdatePackageIfMatch with Context.
+func (c Client) UpdatePackageIfMatchContext(ctx context.Context, id, etag string, o *PackageOptions) (p Package, err error) {
 	body, err := json.Marshal(o)
 	if err != nil {
 		return
 	}
-	err = c.JSON("POST", "/packages/"+id, nil, bytes.NewReader(body), &p)
+	p.ETag, err = c.jsonContext(ctx, "POST", "/packages/"+id, nil, body, etag, &p)
 	return
 }
 
 // DeletePackage removes a Package.
 func (c Client) DeletePackage(id string) (p Package, err error) {
-	err = c.JSON("DELETE", "/packages/"+id, nil, nil, &p)
+	return c.DeletePackageIfMatchContext(context.Background(), id, "")
+}
+
+// DeletePackageContext provides the same functionality as DeletePackage
+// with Context.
+func (c Client) DeletePackageContext(ctx context.Context, id string) (p Package, err error) {
+	return c.DeletePackageIfMatchContext(ctx, id, "")
+}
+
+// DeletePackageIfMatch removes a Package only if its current entity tag
+// is equal to the etag argument. If entity tags do not match,
+// ErrPreconditionFailed is returned. Blank etag disables the check.
+func (c Client) DeletePackageIfMatch(id, etag string) (p Package, err error) {
+	return c.DeletePackageIfMatchContext(context.Background(), id, etag)
+}
+
+// DeletePackageIfMatchContext provides the same functionality as
+// DeletePackageIfMatch with Context.
+func (c Client) DeletePackageIfMatchContext(ctx context.Context, id, etag string) (p Package, err error) {
+	_, err = c.jsonContext(ctx, "DELETE", "/packages/"+id, nil, nil, etag, &p)
 	return
 }
 
@@ -48,6 +98,25 @@ func (c Client) DeletePackage(id string) (p Package, err error) {
 // Values from the previous and next fields in returned page can be provided as
 // startRef argument to get a previous or next page in the listing.
 func (c Client) DomainPackages(domainRef, start string, limit int) (page PackagesPage, err error) {
+	return c.DomainPackagesFilteredContext(context.Background(), domainRef, start, limit, nil)
+}
+
+// DomainPackagesContext provides the same functionality as DomainPackages
+// with Context.
+func (c Client) DomainPackagesContext(ctx context.Context, domainRef, start string, limit int) (page PackagesPage, err error) {
+	return c.DomainPackagesFilteredContext(ctx, domainRef, start, limit, nil)
+}
+
+// DomainPackagesFiltered retrieves a paginated list of packages under
+// a domain that match the filter. Start and limit are the same as
+// for DomainPackages.
+func (c Client) DomainPackagesFiltered(domainRef, start string, limit int, filter *PackagesFilter) (page PackagesPage, err error) {
+	return c.DomainPackagesFilteredContext(context.Background(), domainRef, start, limit, filter)
+}
+
+// DomainPackagesFilteredContext provides the same functionality as
+// DomainPackagesFiltered with Context.
+func (c Client) DomainPackagesFilteredContext(ctx context.Context, domainRef, start string, limit int, filter *PackagesFilter) (page PackagesPage, err error) {
 	query := url.Values{}
 	if start != "" {
 		query.Set("start", start)
@@ -55,6 +124,133 @@ func (c Client) DomainPackages(domainRef, start string, limit int) (page Package
 	if limit > 0 {
 		query.Set("limit", strconv.Itoa(limit))
 	}
-	err = c.JSON("GET", "/domains/"+domainRef+"/packages", query, nil, &page)
+	if filter != nil {
+		if filter.VCS != "" {
+			query.Set("vcs", string(filter.VCS))
+		}
+		if filter.RefType != "" {
+			query.Set("ref_type", string(filter.RefType))
+		}
+		if filter.RepoRootHost != "" {
+			query.Set("repo_root_host", filter.RepoRootHost)
+		}
+		if filter.Disabled != nil {
+			query.Set("disabled", strconv.FormatBool(*filter.Disabled))
+		}
+		if filter.Query != "" {
+			query.Set("query", filter.Query)
+		}
+	}
+	_, err = c.jsonContext(ctx, "GET", "/domains/"+domainRef+"/packages", query, nil, "", &page)
+	return
+}
+
+// AllDomainPackages retrieves all packages under a domain that match
+// the filter by requesting pages of the listing until the last one.
+// Filter can be nil.
+func (c Client) AllDomainPackages(ctx context.Context, domainRef string, filter *PackagesFilter) (packages []Package, err error) {
+	var start string
+	for {
+		page, err := c.DomainPackagesFilteredContext(ctx, domainRef, start, MaxLimit, filter)
+		if err != nil {
+			return nil, err
+		}
+		packages = append(packages, page.Packages...)
+		if page.Next == "" {
+			return packages, nil
+		}
+		start = page.Next
+	}
+}
+
+// SearchPackages retrieves a paginated list of packages under all domains
+// that the user has access to, with import prefix, repository root or
+// reference name that contain the query, case insensitive. Packages are
+// ordered by domain and path. Value from the next field in returned page can
+// be provided as start argument to get the next page in the listing.
+func (c Client) SearchPackages(query, start string, limit int) (page PackagesPage, err error) {
+	return c.SearchPackagesContext(context.Background(), query, start, limit)
+}
+
+// SearchPackagesContext provides the same functionality as SearchPackages
+// with Context.
+func (c Client) SearchPackagesContext(ctx context.Context, query, start string, limit int) (page PackagesPage, err error) {
+	q := url.Values{}
+	if query != "" {
+		q.Set("query", query)
+	}
+	if start != "" {
+		q.Set("start", start)
+	}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+	_, err = c.jsonContext(ctx, "GET", "/packages", q, nil, "", &page)
+	return
+}
+
+// AllSearchPackages retrieves all packages that match the query in the
+// same way as SearchPackages by requesting pages of the listing until
+// the last one.
+func (c Client) AllSearchPackages(ctx context.Context, query string) (packages []Package, err error) {
+	var start string
+	for {
+		page, err := c.SearchPackagesContext(ctx, query, start, MaxLimit)
+		if err != nil {
+			return nil, err
+		}
+		packages = append(packages, page.Packages...)
+		if page.Next == "" {
+			return packages, nil
+		}
+		start = page.Next
+	}
+}
+
+// SyncDomainPackages makes packages under a domain match the manifest.
+// Packages that are in the manifest are added or updated, and packages
+// that are not in the manifest are deleted, all in a single transaction.
+// If dryRun is true, changes are not applied and only the plan is returned.
+func (c Client) SyncDomainPackages(domainRef string, m *PackagesManifest, dryRun bool) (plan PackagesPlan, err error) {
+	return c.SyncDomainPackagesContext(context.Background(), domainRef, m, dryRun)
+}
+
+// SyncDomainPackagesContext provides the same functionality as
+// SyncDomainPackages with Context.
+func (c Client) SyncDomainPackagesContext(ctx context.Context, domainRef string, m *PackagesManifest, dryRun bool) (plan PackagesPlan, err error) {
+	body, err := json.Marshal(m)
+	if err != nil {
+		return
+	}
+	query := url.Values{}
+	if dryRun {
+		query.Set("dry_run", "true")
+	}
+	_, err = c.jsonContext(ctx, "POST", "/domains/"+domainRef+"/packages/sync", query, body, "", &plan)
+	return
+}
+
+// ApplyDomainPackageDefaults sets fields of all packages under a domain
+// to the domain package defaults, in a single transaction. If no fields
+// are provided, all fields with default values are applied. If dryRun
+// is true, changes are not applied and only the plan is returned.
+func (c Client) ApplyDomainPackageDefaults(domainRef string, fields []PackageDefaultsField, dryRun bool) (plan PackagesPlan, err error) {
+	return c.ApplyDomainPackageDefaultsContext(context.Background(), domainRef, fields, dryRun)
+}
+
+// ApplyDomainPackageDefaultsContext provides the same functionality as
+// ApplyDomainPackageDefaults with Context.
+func (c Client) ApplyDomainPackageDefaultsContext(ctx context.Context, domainRef string, fields []PackageDefaultsField, dryRun bool) (plan PackagesPlan, err error) {
+	body, err := json.Marshal(PackageDefaultsApplication{
+		Fields: fields,
+	})
+	if err != nil {
+		return
+	}
+	query := url.Values{}
+	if dryRun {
+		query.Set("dry_run", "true")
+	}
+	_, err = c.jsonContext(ctx, "POST", "/domains/"+domainRef+"/packages/defaults", query, body, "", &plan)
 	return
 }
@@ -0,0 +1,72 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Quota retrieves domain and package quota of a user. User can be
+// referenced by ID, username or email. Only administrators can retrieve
+// quotas of other users.
+func (c Client) Quota(userRef string) (q Quota, err error) {
+	return c.QuotaContext(context.Background(), userRef)
+}
+
+// QuotaContext provides the same functionality as Quota with Context.
+func (c Client) QuotaContext(ctx context.Context, userRef string) (q Quota, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/users/"+userRef+"/quota", nil, nil, "", &q)
+	return
+}
+
+// UpdateQuota changes domain and package quota overrides of a user.
+// Only administrators are allowed to change quotas.
+func (c Client) UpdateQuota(userRef string, o *QuotaOptions) (q Quota, err error) {
+	return c.UpdateQuotaContext(context.Background(), userRef, o)
+}
+
+// UpdateQuotaContext provides the same functionality as UpdateQuota
+// with Context.
+func (c Client) UpdateQuotaContext(ctx context.Context, userRef string, o *QuotaOptions) (q Quota, err error) {
+	body, err := json.Marshal(o)
+	if err != nil {
+		return
+	}
+	_, err = c.jsonContext(ctx, "POST", "/users/"+userRef+"/quota", nil, body, "", &q)
+	return
+}
+
+// OrganizationQuota retrieves domain and package quota of an organization.
+// Organization can be referenced by ID or name. Only organization members
+// and administrators can retrieve it.
+func (c Client) OrganizationQuota(ref string) (q Quota, err error) {
+	return c.OrganizationQuotaContext(context.Background(), ref)
+}
+
+// OrganizationQuotaContext provides the same functionality as
+// OrganizationQuota with Context.
+func (c Client) OrganizationQuotaContext(ctx context.Context, ref string) (q Quota, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/organizations/"+ref+"/quota", nil, nil, "", &q)
+	return
+}
+
+// UpdateOrganizationQuota changes domain and package quota overrides of
+// an organization. Only administrators are allowed to change quotas.
+func (c Client) UpdateOrganizationQuota(ref string, o *QuotaOptions) (q Quota, err error) {
+	return c.UpdateOrganizationQuotaContext(context.Background(), ref, o)
+}
+
+// UpdateOrganizationQuotaContext provides the same functionality as
+// UpdateOrganizationQuota with Context.
+func (c Client) UpdateOrganizationQuotaContext(ctx context.Context, ref string, o *QuotaOptions) (q Quota, err error) {
+	body, err := json.Marshal(o)
+	if err != nil {
+		return
+	}
+	_, err = c.jsonContext(ctx, "POST", "/organizations/"+ref+"/quota", nil, body, "", &q)
+	return
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// RateLimits retrieves API rate limits of a user. User can be referenced
+// by ID, username or email. Only administrators can retrieve rate limits
+// of other users.
+func (c Client) RateLimits(userRef string) (l RateLimits, err error) {
+	return c.RateLimitsContext(context.Background(), userRef)
+}
+
+// RateLimitsContext provides the same functionality as RateLimits
+// with Context.
+func (c Client) RateLimitsContext(ctx context.Context, userRef string) (l RateLimits, err error) {
+	_, err = c.jsonContext(ctx, "GET", "/users/"+userRef+"/rate-limits", nil, nil, "", &l)
+	return
+}
+
+// UpdateRateLimits changes API rate limit overrides of a user.
+// Only administrators are allowed to change rate limits.
+func (c Client) UpdateRateLimits(userRef string, o *RateLimitsOptions) (l RateLimits, err error) {
+	return c.UpdateRateLimitsContext(context.Background(), userRef, o)
+}
+
+// UpdateRateLimitsContext provides the same functionality as
+// UpdateRateLimits with Context.
+func (c Client) UpdateRateLimitsContext(ctx context.Context, userRef string, o *RateLimitsOptions) (l RateLimits, err error) {
+	body, err := json.Marshal(o)
+	if err != nil {
+		return
+	}
+	_, err = c.jsonContext(ctx, "POST", "/users/"+userRef+"/rate-limits", nil, body, "", &l)
+	return
+}
@@ -5,26 +5,56 @@
 
 package api // import "gopherpit.com/gopherpit/api"
 
-import apiClient "resenje.org/web/client/api"
+import (
+	"fmt"
+	"time"
+
+	apiClient "resenje.org/web/client/api"
+)
 
 // MaxLimit is a default maximum number of elements for paged responses.
 const MaxLimit = 100
 
 // Domain holds information about GopherPit domain instance.
+// Domain is owned by a user with OwnerUserID or by an organization
+// with OrganizationID.
 type Domain struct {
 	ID                string `json:"id"`
 	FQDN              string `json:"fqdn"`
 	OwnerUserID       string `json:"owner_user_id"`
+	OrganizationID    string `json:"organization_id,omitempty"`
 	CertificateIgnore bool   `json:"certificate_ignore,omitempty"`
 	Disabled          bool   `json:"disabled,omitempty"`
+
+	PackageDefaults *PackageDefaults `json:"package_defaults,omitempty"`
+
+	// Aliases are additional fully qualified domain names that serve
+	// the same packages. If AliasRedirect is true, requests to aliases
+	// are redirected to the Domain FQDN.
+	Aliases       []string `json:"aliases,omitempty"`
+	AliasRedirect bool     `json:"alias_redirect,omitempty"`
+
+	// ETag is the entity tag of the Domain revision returned in
+	// HTTP response headers. It can be used with UpdateDomainIfMatch and
+	// DeleteDomainIfMatch methods to prevent overwriting concurrent changes.
+	ETag string `json:"-"`
 }
 
-// DomainOptions defines Domain fields that can be changed.
+// DomainOptions defines Domain fields that can be changed. Setting
+// OrganizationID gives the Domain to the organization and setting
+// OwnerUserID gives it back to a user.
 type DomainOptions struct {
 	FQDN              *string `json:"fqdn,omitempty"`
 	OwnerUserID       *string `json:"owner_user_id,omitempty"`
+	OrganizationID    *string `json:"organization_id,omitempty"`
 	CertificateIgnore *bool   `json:"certificate_ignore,omitempty"`
 	Disabled          *bool   `json:"disabled,omitempty"`
+	// PackageDefaults replaces package defaults of the Domain.
+	// Empty PackageDefaults removes them.
+	PackageDefaults *PackageDefaults `json:"package_defaults,omitempty"`
+	// Aliases replaces all aliases of the Domain.
+	Aliases       *[]string `json:"aliases,omitempty"`
+	AliasRedirect *bool     `json:"alias_redirect,omitempty"`
 }
 
 // DomainsPage is a paginated list of Domain instances.
@@ -35,10 +65,23 @@ type DomainsPage struct {
 	Next     string   `json:"next,omitempty"`
 }
 
+// DomainVerificationMethod defines a method of domain ownership verification.
+type DomainVerificationMethod string
+
+// Possible domain verification methods.
+var (
+	DomainVerificationDNSTXT   DomainVerificationMethod = "dns-txt"
+	DomainVerificationHTTPFile DomainVerificationMethod = "http-file"
+)
+
 // DomainToken holds information about validation token for a specific fully qualified domain name.
+// For DNS TXT verification method, FQDN is the name of the TXT record. For HTTP
+// file verification method, FQDN is the domain that serves the file on the URL.
 type DomainToken struct {
-	FQDN  string `json:"fqdn"`
-	Token string `json:"token"`
+	Method DomainVerificationMethod `json:"method"`
+	FQDN   string                   `json:"fqdn"`
+	Token  string                   `json:"token"`
+	URL    string                   `json:"url,omitempty"`
 }
 
 // DomainTokens is an API response with a list of domain tokens.
@@ -47,9 +90,96 @@ type DomainTokens struct {
 }
 
 // DomainUsers holds information with User IDs who have access to a Domain.
+// Roles holds a DomainRole for every user in UserIDs. Members of the
+// organization that owns the Domain are not listed.
 type DomainUsers struct {
-	OwnerUserID string   `json:"owner_user_id"`
-	UserIDs     []string `json:"user_ids,omitempty"`
+	OwnerUserID    string                `json:"owner_user_id"`
+	OrganizationID string                `json:"organization_id,omitempty"`
+	UserIDs        []string              `json:"user_ids,omitempty"`
+	Roles          map[string]DomainRole `json:"roles,omitempty"`
+}
+
+// DomainRole is a type that defines what a user is allowed to do with
+// a Domain.
+type DomainRole string
+
+// Possible DomainRole values. Owner can change Domain settings and
+// manage its users, maintainers can add, update and delete packages
+// and viewers can only read Domain and its packages.
+var (
+	DomainRoleOwner      DomainRole = "owner"
+	DomainRoleMaintainer DomainRole = "maintainer"
+	DomainRoleViewer     DomainRole = "viewer"
+)
+
+// DomainUserOptions defines the role of a user that is granted
+// access to a Domain.
+type DomainUserOptions struct {
+	Role DomainRole `json:"role"`
+}
+
+// DomainTransfer holds information about a pending change of the Domain
+// owner. The transfer must be accepted by the user with ToUserID before
+// the ExpirationTime.
+type DomainTransfer struct {
+	DomainID       string    `json:"domain_id"`
+	FQDN           string    `json:"fqdn"`
+	FromUserID     string    `json:"from_user_id"`
+	ToUserID       string    `json:"to_user_id"`
+	Time           time.Time `json:"time"`
+	ExpirationTime time.Time `json:"expiration_time"`
+}
+
+// DomainTransferOptions defines the user that the Domain is transferred
+// to, referenced by its ID, username or e-mail address.
+type DomainTransferOptions struct {
+	ToUser string `json:"to_user"`
+}
+
+// DomainTransfers is an API response with a list of domain transfers.
+type DomainTransfers struct {
+	Transfers []DomainTransfer `json:"transfers"`
+}
+
+// Organization holds information about a group of users that owns
+// Domains.
+type Organization struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// OrganizationOptions defines Organization fields that can be changed.
+type OrganizationOptions struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// Organizations is an API response with a list of organizations.
+type Organizations struct {
+	Organizations []Organization `json:"organizations"`
+}
+
+// OrganizationMembers holds IDs of Users that are members of an
+// Organization and their roles.
+type OrganizationMembers struct {
+	UserIDs []string                    `json:"user_ids"`
+	Roles   map[string]OrganizationRole `json:"roles"`
+}
+
+// OrganizationRole is a type that defines what a member is allowed to
+// do with an Organization.
+type OrganizationRole string
+
+// Possible OrganizationRole values. Owners manage the Organization, its
+// members and Domains and they have the owner role on all Organization
+// Domains. Members have the maintainer role on them.
+var (
+	OrganizationRoleOwner  OrganizationRole = "owner"
+	OrganizationRoleMember OrganizationRole = "member"
+)
+
+// OrganizationMemberOptions defines the role of an Organization member.
+type OrganizationMemberOptions struct {
+	Role OrganizationRole `json:"role"`
 }
 
 // VCS is a type that defines possible VCS values for the Package.
@@ -87,6 +217,46 @@ type Package struct {
 	GoSource    string  `json:"go_source,omitempty"`
 	RedirectURL string  `json:"redirect_url,omitempty"`
 	Disabled    bool    `json:"disabled,omitempty"`
+
+	// ETag is the entity tag of the Package revision returned in
+	// HTTP response headers. It can be used with UpdatePackageIfMatch and
+	// DeletePackageIfMatch methods to prevent overwriting concurrent changes.
+	ETag string `json:"-"`
+}
+
+// PackageDefaults holds values that new packages under a Domain inherit
+// when they are not provided. RepoRoot, GoSource and RedirectURL are
+// templates where {domain} is replaced with the Domain FQDN and {path}
+// with the package path without the leading slash, for example
+// https://git.example.com/{path}.git.
+type PackageDefaults struct {
+	VCS         VCS     `json:"vcs,omitempty"`
+	RepoRoot    string  `json:"repo_root,omitempty"`
+	RefType     RefType `json:"ref_type,omitempty"`
+	RefName     string  `json:"ref_name,omitempty"`
+	GoSource    string  `json:"go_source,omitempty"`
+	RedirectURL string  `json:"redirect_url,omitempty"`
+}
+
+// PackageDefaultsField is a name of a PackageDefaults field that can be
+// applied to existing packages.
+type PackageDefaultsField string
+
+// Package defaults fields. PackageDefaultsFieldRef applies both reference
+// type and reference name.
+var (
+	PackageDefaultsFieldVCS         PackageDefaultsField = "vcs"
+	PackageDefaultsFieldRepoRoot    PackageDefaultsField = "repo_root"
+	PackageDefaultsFieldRef         PackageDefaultsField = "ref"
+	PackageDefaultsFieldGoSource    PackageDefaultsField = "go_source"
+	PackageDefaultsFieldRedirectURL PackageDefaultsField = "redirect_url"
+)
+
+// PackageDefaultsApplication selects package defaults fields that are
+// applied to all existing packages under a Domain. If no fields are
+// selected, all fields with default values are applied.
+type PackageDefaultsApplication struct {
+	Fields []PackageDefaultsField `json:"fields,omitempty"`
 }
 
 // PackageOptions defines Package fields that can be changed.
@@ -110,39 +280,299 @@ type PackagesPage struct {
 	Next     string    `json:"next,omitempty"`
 }
 
-var errorRegistry = apiClient.NewMapErrorRegistry(nil, nil)
+// PackagesFilter holds criteria for listing packages under a domain.
+// Only packages that match all non-zero fields are listed.
+type PackagesFilter struct {
+	VCS          VCS     `json:"vcs,omitempty"`
+	RefType      RefType `json:"ref_type,omitempty"`
+	RepoRootHost string  `json:"repo_root_host,omitempty"`
+	Disabled     *bool   `json:"disabled,omitempty"`
+	// Query is a case insensitive substring of the package path.
+	Query string `json:"query,omitempty"`
+}
+
+// PackagesManifest is a declarative description of all packages under
+// a domain. Packages are identified by their paths and fields that are
+// not set are reset to their default values on synchronization.
+type PackagesManifest struct {
+	Packages []PackageOptions `json:"packages"`
+}
+
+// PackageAction is a type that defines possible actions on a package
+// in PackagesPlan.
+type PackageAction string
+
+// Possible package actions.
+var (
+	PackageActionAdd    PackageAction = "add"
+	PackageActionUpdate PackageAction = "update"
+	PackageActionDelete PackageAction = "delete"
+)
+
+// FieldChange holds the previous and the new value of a changed field.
+type FieldChange struct {
+	Field string  `json:"field"`
+	From  *string `json:"from,omitempty"`
+	To    *string `json:"to,omitempty"`
+}
+
+// PackageChange describes an action on a package and changed fields.
+type PackageChange struct {
+	Action  PackageAction `json:"action"`
+	Package Package       `json:"package"`
+	Changes []FieldChange `json:"changes,omitempty"`
+}
+
+// PackagesPlan is a list of changes that are needed for packages
+// under a domain to match a PackagesManifest.
+type PackagesPlan struct {
+	Changes []PackageChange `json:"changes"`
+	Applied bool            `json:"applied"`
+}
+
+// BatchAction is a type that defines possible operations in a
+// BatchRequest.
+type BatchAction string
+
+// Possible batch actions.
+var (
+	BatchActionAddDomain        BatchAction = "add_domain"
+	BatchActionUpdateDomain     BatchAction = "update_domain"
+	BatchActionDeleteDomain     BatchAction = "delete_domain"
+	BatchActionGrantDomainUser  BatchAction = "grant_domain_user"
+	BatchActionRevokeDomainUser BatchAction = "revoke_domain_user"
+	BatchActionUpdateDomainUser BatchAction = "update_domain_user"
+	BatchActionAddPackage       BatchAction = "add_package"
+	BatchActionUpdatePackage    BatchAction = "update_package"
+	BatchActionDeletePackage    BatchAction = "delete_package"
+)
+
+// MaxBatchOperations is the maximum number of operations in a
+// BatchRequest.
+const MaxBatchOperations = 100
+
+// BatchOperation describes a single operation in a BatchRequest.
+// Ref is a domain ID or FQDN for domain actions and a package ID for
+// update and delete package actions. User is a user ID, username or
+// email for grant, update and revoke user actions, and Role is the role
+// of the granted or updated user. IfMatch is an optional entity tag for
+// update and delete actions.
+type BatchOperation struct {
+	Action  BatchAction     `json:"action"`
+	Ref     string          `json:"ref,omitempty"`
+	User    string          `json:"user,omitempty"`
+	Role    DomainRole      `json:"role,omitempty"`
+	Domain  *DomainOptions  `json:"domain,omitempty"`
+	Package *PackageOptions `json:"package,omitempty"`
+	IfMatch string          `json:"if_match,omitempty"`
+}
+
+// BatchRequest is a list of operations that are applied atomically.
+type BatchRequest struct {
+	Operations []BatchOperation `json:"operations"`
+}
+
+// BatchResult holds the result of a single operation in
+// a BatchRequest. ETag is the entity tag of the resulting Domain or
+// Package revision.
+type BatchResult struct {
+	Action  BatchAction `json:"action"`
+	Domain  *Domain     `json:"domain,omitempty"`
+	Package *Package    `json:"package,omitempty"`
+	ETag    string      `json:"etag,omitempty"`
+}
+
+// BatchResponse holds results of all operations in a BatchRequest,
+// in the same order as operations.
+type BatchResponse struct {
+	Results []BatchResult `json:"results"`
+}
+
+// BatchError is returned by Client.Batch when an operation fails.
+// None of the operations are applied in that case.
+type BatchError struct {
+	// Operation is the index of the failed operation.
+	Operation int
+	// Err is the error returned by the failed operation.
+	Err error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("batch operation %d: %s", e.Operation, e.Err)
+}
+
+// RateLimits holds hourly API rate limits of a user. HourlyLimit applies
+// to all requests except GET requests, which are limited by
+// HourlyReadLimit. Zero limit means that requests are not limited.
+// Overrides are set by administrators and replace default limits.
+// Zero override keeps the default limit and -1 disables the limit.
+type RateLimits struct {
+	HourlyLimit             int `json:"hourly_limit"`
+	HourlyReadLimit         int `json:"hourly_read_limit"`
+	HourlyLimitOverride     int `json:"hourly_limit_override,omitempty"`
+	HourlyReadLimitOverride int `json:"hourly_read_limit_override,omitempty"`
+}
+
+// RateLimitsOptions defines rate limit overrides that can be changed.
+type RateLimitsOptions struct {
+	HourlyLimitOverride     *int `json:"hourly_limit_override,omitempty"`
+	HourlyReadLimitOverride *int `json:"hourly_read_limit_override,omitempty"`
+}
+
+// Quota holds limits of the number of domains and packages that a user or
+// an organization can own, together with the number of domains and
+// packages that it owns. Zero limit means that the number is not limited.
+// Overrides are set by administrators and replace default limits.
+// Zero override keeps the default limit and -1 disables the limit.
+type Quota struct {
+	DomainLimit          int `json:"domain_limit"`
+	PackageLimit         int `json:"package_limit"`
+	DomainLimitOverride  int `json:"domain_limit_override,omitempty"`
+	PackageLimitOverride int `json:"package_limit_override,omitempty"`
+	Domains              int `json:"domains"`
+	Packages             int `json:"packages"`
+}
+
+// QuotaOptions defines quota overrides that can be changed.
+type QuotaOptions struct {
+	DomainLimitOverride  *int `json:"domain_limit_override,omitempty"`
+	PackageLimitOverride *int `json:"package_limit_override,omitempty"`
+}
+
+// ACMEUser holds information about the ACME account that is used to
+// obtain TLS certificates.
+type ACMEUser struct {
+	ID                   int    `json:"id,omitempty"`
+	Email                string `json:"email,omitempty"`
+	URL                  string `json:"url"`
+	DirectoryURL         string `json:"directory_url"`
+	ExternalAccountKeyID string `json:"external_account_key_id,omitempty"`
+}
+
+// KeyType is a type that defines possible certificate private key types.
+type KeyType string
+
+// Possible key types. KeyTypeDual is an ECDSA P-256 certificate with an
+// alternative RSA-2048 certificate for clients that do not support ECDSA.
+var (
+	KeyTypeP256    KeyType = "p256"
+	KeyTypeP384    KeyType = "p384"
+	KeyTypeRSA2048 KeyType = "rsa2048"
+	KeyTypeRSA4096 KeyType = "rsa4096"
+	KeyTypeDual    KeyType = "dual"
+)
+
+// ACMESettings holds domain parameters for obtaining TLS certificates.
+// If DNSProvider is set, certificates are validated with dns-01 challenges
+// using the named DNS provider, otherwise with http-01 challenges. If
+// KeyType is empty, the default key type configured on the service is
+// used.
+type ACMESettings struct {
+	DNSProvider string  `json:"dns_provider,omitempty"`
+	KeyType     KeyType `json:"key_type,omitempty"`
+}
+
+// ACMESettingsOptions is used to update domain ACME settings. Empty
+// DNSProvider selects http-01 challenges and empty KeyType selects the
+// default key type.
+type ACMESettingsOptions struct {
+	DNSProvider *string  `json:"dns_provider,omitempty"`
+	KeyType     *KeyType `json:"key_type,omitempty"`
+}
+
+// Certificate holds information about a TLS certificate of a domain.
+// RenewalAttemptTime and RenewalError describe the last renewal attempt,
+// and RenewalFailures is the number of consecutive failed renewals.
+type Certificate struct {
+	FQDN               string     `json:"fqdn"`
+	ExpirationTime     *time.Time `json:"expiration_time,omitempty"`
+	KeyType            KeyType    `json:"key_type,omitempty"`
+	RenewalAttemptTime *time.Time `json:"renewal_attempt_time,omitempty"`
+	RenewalError       string     `json:"renewal_error,omitempty"`
+	RenewalFailures    int        `json:"renewal_failures,omitempty"`
+}
+
+// DNSProviders holds names of DNS providers that can be selected for
+// dns-01 challenges.
+type DNSProviders struct {
+	Names []string `json:"names"`
+}
+
+var (
+	errorRegistry = apiClient.NewMapErrorRegistry(nil, nil)
+	errorList     []*apiClient.Error
+)
+
+func newError(code int, message string) *apiClient.Error {
+	err := errorRegistry.MustAddMessageError(code, message)
+	errorList = append(errorList, err)
+	return err
+}
+
+// Errors returns all errors that the API can return,
+// in order of their definition.
+func Errors() []*apiClient.Error {
+	errs := make([]*apiClient.Error, len(errorList))
+	copy(errs, errorList)
+	return errs
+}
 
 // Errors that the API can return.
 var (
-	ErrBadRequest                    = errorRegistry.MustAddMessageError(400, "Bad Request")
-	ErrUnauthorized                  = errorRegistry.MustAddMessageError(401, "Unauthorized")
-	ErrForbidden                     = errorRegistry.MustAddMessageError(403, "Forbidden")
-	ErrNotFound                      = errorRegistry.MustAddMessageError(404, "Not Found")
-	ErrTooManyRequests               = errorRegistry.MustAddMessageError(429, "Too Many Requests")
-	ErrInternalServerError           = errorRegistry.MustAddMessageError(500, "Internal Server Error")
-	ErrMaintenance                   = errorRegistry.MustAddMessageError(503, "Maintenance")
-	ErrDomainNotFound                = errorRegistry.MustAddMessageError(1000, "Domain Not Found")
-	ErrDomainAlreadyExists           = errorRegistry.MustAddMessageError(1001, "Domain Already Exists")
-	ErrDomainFQDNRequired            = errorRegistry.MustAddMessageError(1010, "Domain FQDN Required")
-	ErrDomainFQDNInvalid             = errorRegistry.MustAddMessageError(1011, "Domain FQDN Invalid")
-	ErrDomainNotAvailable            = errorRegistry.MustAddMessageError(1012, "Domain Not Available")
-	ErrDomainWithTooManySubdomains   = errorRegistry.MustAddMessageError(1013, "Domain With Too Many Subdomains")
-	ErrDomainNeedsVerification       = errorRegistry.MustAddMessageError(1014, "Domain Needs Verification")
-	ErrUserDoesNotExist              = errorRegistry.MustAddMessageError(1100, "User Does Not Exist")
-	ErrUserAlreadyGranted            = errorRegistry.MustAddMessageError(1101, "User Already Granted")
-	ErrUserNotGranted                = errorRegistry.MustAddMessageError(1102, "User Not Granted")
-	ErrPackageNotFound               = errorRegistry.MustAddMessageError(2000, "Package Not Found")
-	ErrPackageAlreadyExists          = errorRegistry.MustAddMessageError(2001, "Package Already Exists")
-	ErrPackageDomainRequired         = errorRegistry.MustAddMessageError(2010, "Package Domain Required")
-	ErrPackagePathRequired           = errorRegistry.MustAddMessageError(2020, "Package Path Required")
-	ErrPackageVCSRequired            = errorRegistry.MustAddMessageError(2030, "Package VCS Required")
-	ErrPackageRepoRootRequired       = errorRegistry.MustAddMessageError(2040, "Package Repository Root Required")
-	ErrPackageRepoRootInvalid        = errorRegistry.MustAddMessageError(2041, "Package Repository Root Invalid")
-	ErrPackageRepoRootSchemeRequired = errorRegistry.MustAddMessageError(2042, "Package Repository Root Scheme Required")
-	ErrPackageRepoRootSchemeInvalid  = errorRegistry.MustAddMessageError(2043, "Package Repository Root Scheme Invalid")
-	ErrPackageRepoRootHostInvalid    = errorRegistry.MustAddMessageError(2044, "Package Repository Root Host Invalid")
-	ErrPackageRefTypeInvalid         = errorRegistry.MustAddMessageError(2050, "Package Reference Type Invalid")
-	ErrPackageRefNameRequired        = errorRegistry.MustAddMessageError(2060, "Package Reference Name Required")
-	ErrPackageRefChangeRejected      = errorRegistry.MustAddMessageError(2070, "Package Reference Change Rejected")
-	ErrPackageRedirectURLInvalid     = errorRegistry.MustAddMessageError(2080, "Package Redirect URL Invalid")
+	ErrBadRequest                    = newError(400, "Bad Request")
+	ErrUnauthorized                  = newError(401, "Unauthorized")
+	ErrForbidden                     = newError(403, "Forbidden")
+	ErrNotFound                      = newError(404, "Not Found")
+	ErrPreconditionFailed            = newError(412, "Precondition Failed")
+	ErrTooManyRequests               = newError(429, "Too Many Requests")
+	ErrInternalServerError           = newError(500, "Internal Server Error")
+	ErrMaintenance                   = newError(503, "Maintenance")
+	ErrDomainNotFound                = newError(1000, "Domain Not Found")
+	ErrDomainAlreadyExists           = newError(1001, "Domain Already Exists")
+	ErrDomainFQDNRequired            = newError(1010, "Domain FQDN Required")
+	ErrDomainFQDNInvalid             = newError(1011, "Domain FQDN Invalid")
+	ErrDomainNotAvailable            = newError(1012, "Domain Not Available")
+	ErrDomainWithTooManySubdomains   = newError(1013, "Domain With Too Many Subdomains")
+	ErrDomainNeedsVerification       = newError(1014, "Domain Needs Verification")
+	ErrDomainAliasInvalid            = newError(1015, "Domain Alias Invalid")
+	ErrDomainAliasAlreadyExists      = newError(1016, "Domain Alias Already Exists")
+	ErrDomainQuotaExceeded           = newError(1017, "Domain Quota Exceeded")
+	ErrDomainTransferNotFound        = newError(1020, "Domain Transfer Not Found")
+	ErrDomainTransferAlreadyExists   = newError(1021, "Domain Transfer Already Exists")
+	ErrDomainTransferToOwner         = newError(1022, "Domain Transfer To Owner")
+	ErrDomainTransferExpired         = newError(1023, "Domain Transfer Expired")
+	ErrDomainTransferRequired        = newError(1024, "Domain Transfer Required")
+	ErrUserDoesNotExist              = newError(1100, "User Does Not Exist")
+	ErrUserAlreadyGranted            = newError(1101, "User Already Granted")
+	ErrUserNotGranted                = newError(1102, "User Not Granted")
+	ErrDomainRoleInvalid             = newError(1104, "Domain Role Invalid")
+	ErrOrganizationNotFound          = newError(1200, "Organization Not Found")
+	ErrOrganizationAlreadyExists     = newError(1201, "Organization Already Exists")
+	ErrOrganizationNameRequired      = newError(1210, "Organization Name Required")
+	ErrOrganizationNameInvalid       = newError(1211, "Organization Name Invalid")
+	ErrOrganizationRoleInvalid       = newError(1220, "Organization Role Invalid")
+	ErrOrganizationLastOwner         = newError(1221, "Organization Last Owner")
+	ErrOrganizationMemberNotFound    = newError(1222, "Organization Member Not Found")
+	ErrOrganizationHasDomains        = newError(1230, "Organization Has Domains")
+	ErrPackageNotFound               = newError(2000, "Package Not Found")
+	ErrPackageAlreadyExists          = newError(2001, "Package Already Exists")
+	ErrPackageDomainRequired         = newError(2010, "Package Domain Required")
+	ErrPackagePathRequired           = newError(2020, "Package Path Required")
+	ErrPackageVCSRequired            = newError(2030, "Package VCS Required")
+	ErrPackageRepoRootRequired       = newError(2040, "Package Repository Root Required")
+	ErrPackageRepoRootInvalid        = newError(2041, "Package Repository Root Invalid")
+	ErrPackageRepoRootSchemeRequired = newError(2042, "Package Repository Root Scheme Required")
+	ErrPackageRepoRootSchemeInvalid  = newError(2043, "Package Repository Root Scheme Invalid")
+	ErrPackageRepoRootHostInvalid    = newError(2044, "Package Repository Root Host Invalid")
+	ErrPackageRefTypeInvalid         = newError(2050, "Package Reference Type Invalid")
+	ErrPackageRefNameRequired        = newError(2060, "Package Reference Name Required")
+	ErrPackageRefChangeRejected      = newError(2070, "Package Reference Change Rejected")
+	ErrPackageRedirectURLInvalid     = newError(2080, "Package Redirect URL Invalid")
+	ErrPackageDefaultsFieldInvalid   = newError(2100, "Package Defaults Field Invalid")
+	ErrPackageQuotaExceeded          = newError(2110, "Package Quota Exceeded")
+	ErrBatchActionInvalid            = newError(3000, "Batch Action Invalid")
+	ErrACMEUserNotFound              = newError(4000, "ACME User Not Found")
+	ErrDNSProviderNotFound           = newError(4010, "DNS Provider Not Found")
+	ErrKeyTypeInvalid                = newError(4020, "Key Type Invalid")
+	ErrCertificateNotFound           = newError(4030, "Certificate Not Found")
 )
@@ -0,0 +1,300 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v2"
+
+	"gopherpit.com/gopherpit/api"
+)
+
+var clientUsage = `USAGE
+
+  %s client [options...] RESOURCE ACTION [action options...] [arguments...]
+
+  Manage domains and packages using the GopherPit API.
+
+  API endpoint and Personal Access Token are selected with command line
+  options, GOPHERPIT_ENDPOINT and GOPHERPIT_TOKEN environment variables or
+  with a configuration file, in that order of precedence. Configuration file
+  is a YAML file with the following fields:
+
+    endpoint: https://go.example.com/api/v1
+    key: 0036CHARACTERLONGPERSONALACCESSTOKEN
+    format: table
+
+  Options can be specified before the resource or after the action.
+
+RESOURCES AND ACTIONS
+
+%s
+OPTIONS
+
+`
+
+// errClientUsage is returned by client commands when arguments are not valid.
+var errClientUsage = errors.New("invalid arguments")
+
+// clientCommand defines a single action on an API resource.
+type clientCommand struct {
+	Action      string
+	Args        string
+	Description string
+	// Help is an optional additional description that is printed
+	// in the command usage.
+	Help string
+	Run  func(ctx *clientContext, args []string) error
+}
+
+// clientResources holds all client commands grouped by resource name.
+// They are registered in init functions of client_*.go files.
+var clientResources = map[string][]clientCommand{}
+
+// clientOptions holds options that are common to all client commands.
+type clientOptions struct {
+	Endpoint string `yaml:"endpoint"`
+	Key      string `yaml:"key"`
+	Format   string `yaml:"format"`
+
+	config string
+}
+
+func (o *clientOptions) register(f *flag.FlagSet) {
+	f.StringVar(&o.Endpoint, "endpoint", o.Endpoint, "GopherPit API endpoint. Default: https://gopherpit.com/api/v1.")
+	f.StringVar(&o.Key, "key", o.Key, "Personal Access Token.")
+	f.StringVar(&o.Format, "format", o.Format, "Output format: table or json. Default: table.")
+	f.StringVar(&o.config, "config", o.config, "Configuration file. Default: GOPHERPIT_CLIENT_CONFIG environment variable or ~/.gopherpit/client.yaml.")
+}
+
+// load sets options that are not set by command line flags from
+// environment variables and the configuration file.
+func (o *clientOptions) load() error {
+	if o.Endpoint == "" {
+		o.Endpoint = os.Getenv("GOPHERPIT_ENDPOINT")
+	}
+	if o.Key == "" {
+		o.Key = os.Getenv("GOPHERPIT_TOKEN")
+	}
+	filename := o.config
+	if filename == "" {
+		filename = os.Getenv("GOPHERPIT_CLIENT_CONFIG")
+	}
+	if filename == "" {
+		filename = filepath.Join(os.Getenv("HOME"), ".gopherpit", "client.yaml")
+	}
+	data, err := ioutil.ReadFile(filename)
+	switch {
+	case os.IsNotExist(err) && o.config == "":
+		// Default configuration file is optional.
+	case err != nil:
+		return fmt.Errorf("read config: %s", err)
+	default:
+		c := clientOptions{}
+		if err := yaml.Unmarshal(data, &c); err != nil {
+			return fmt.Errorf("config %s: %s", filename, err)
+		}
+		if o.Endpoint == "" {
+			o.Endpoint = c.Endpoint
+		}
+		if o.Key == "" {
+			o.Key = c.Key
+		}
+		if o.Format == "" {
+			o.Format = c.Format
+		}
+	}
+	switch o.Format {
+	case "":
+		o.Format = "table"
+	case "table", "json":
+	default:
+		return fmt.Errorf("unknown output format %q", o.Format)
+	}
+	return nil
+}
+
+// clientContext is passed to every client command to parse its arguments,
+// construct API client and print results.
+type clientContext struct {
+	resource string
+	command  *clientCommand
+	options  *clientOptions
+	flags    *flag.FlagSet
+}
+
+// flagSet creates a new flag.FlagSet for the command with registered
+// common client options.
+func (ctx *clientContext) flagSet() *flag.FlagSet {
+	name := ctx.resource + " " + ctx.command.Action
+	f := flag.NewFlagSet(name, flag.ExitOnError)
+	f.Usage = func() {
+		fmt.Fprintf(os.Stderr, "USAGE\n\n  %s client %s [options...] %s\n\n  %s\n\n", os.Args[0], name, ctx.command.Args, ctx.command.Description)
+		if ctx.command.Help != "" {
+			fmt.Fprintf(os.Stderr, "  %s\n\n", ctx.command.Help)
+		}
+		fmt.Fprint(os.Stderr, "OPTIONS\n\n")
+		f.PrintDefaults()
+	}
+	ctx.options.register(f)
+	ctx.flags = f
+	return f
+}
+
+// client returns API client configured with common client options.
+func (ctx *clientContext) client() (*api.Client, error) {
+	if err := ctx.options.load(); err != nil {
+		return nil, err
+	}
+	if ctx.options.Key == "" {
+		return nil, errors.New("personal access token is not set")
+	}
+	var c *api.Client
+	if ctx.options.Endpoint == "" {
+		c = api.NewClient(ctx.options.Key)
+	} else {
+		c = api.NewClientWithEndpoint(ctx.options.Endpoint, ctx.options.Key)
+	}
+	// Batch and listing commands may perform many requests, so they
+	// should wait for rate limits to reset instead of failing.
+	c.Retry = api.DefaultRetryOptions
+	return c, nil
+}
+
+// print writes v to stdout in JSON format, or a table with provided
+// header and rows, depending on the format option.
+func (ctx *clientContext) print(v interface{}, header []string, rows [][]string) error {
+	if ctx.options.Format == "json" {
+		data, err := json.MarshalIndent(v, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	if header != nil {
+		fmt.Fprintln(w, strings.Join(header, "\t"))
+	}
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// printETag writes the entity tag of a resource to stderr, so that
+// it can be used in if-match option of update and delete commands.
+func (ctx *clientContext) printETag(etag string) {
+	if etag != "" {
+		fmt.Fprintln(os.Stderr, "ETag:", etag)
+	}
+}
+
+// isFlagSet returns true if the flag with the provided name
+// is specified on the command line.
+func isFlagSet(f *flag.FlagSet, name string) (set bool) {
+	f.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return
+}
+
+func clientCmd(args []string) {
+	options := &clientOptions{}
+	f := flag.NewFlagSet("client", flag.ExitOnError)
+	f.Usage = func() {
+		names := []string{}
+		for name := range clientResources {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		commands := ""
+		for _, name := range names {
+			commands += "  " + name + "\n"
+			for _, c := range clientResources[name] {
+				commands += fmt.Sprintf("    %s\n      %s\n", strings.TrimSpace(c.Action+" "+c.Args), c.Description)
+			}
+			commands += "\n"
+		}
+		fmt.Fprintf(os.Stderr, clientUsage, os.Args[0], commands)
+		f.PrintDefaults()
+	}
+	options.register(f)
+	f.Parse(args)
+
+	resource, action := f.Arg(0), f.Arg(1)
+	var command *clientCommand
+	for _, c := range clientResources[resource] {
+		if c.Action == action {
+			c := c
+			command = &c
+			break
+		}
+	}
+	if command == nil {
+		if resource != "" {
+			fmt.Fprintf(os.Stderr, "unknown client command: %s %s\n", resource, action)
+		}
+		f.Usage()
+		os.Exit(2)
+	}
+
+	ctx := &clientContext{
+		resource: resource,
+		command:  command,
+		options:  options,
+	}
+	if err := command.Run(ctx, f.Args()[2:]); err != nil {
+		if err == errClientUsage && ctx.flags != nil {
+			ctx.flags.Usage()
+			os.Exit(2)
+		}
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+// clientPaging holds pagination options for listing commands.
+type clientPaging struct {
+	start string
+	limit int
+}
+
+func (p *clientPaging) register(f *flag.FlagSet) {
+	f.StringVar(&p.start, "start", "", "Return a single page starting from this reference.")
+	f.IntVar(&p.limit, "limit", 0, fmt.Sprintf("Return a single page with at most this number of elements, up to %d.", api.MaxLimit))
+}
+
+// all returns true if all pages should be retrieved.
+func (p clientPaging) all() bool {
+	return p.start == "" && p.limit == 0
+}
+
+func boolString(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"gopherpit.com/gopherpit/api"
+)
+
+func init() {
+	clientResources["acme-user"] = []clientCommand{
+		{
+			Action:      "get",
+			Description: "Show the ACME account that is used to obtain TLS certificates. Only administrators can get it.",
+			Run:         clientACMEUserGet,
+		},
+		{
+			Action:      "rollover",
+			Description: "Replace the ACME account key with a newly generated one. Only administrators can do it.",
+			Run:         clientACMEUserRollover,
+		},
+	}
+	clientResources["acme-settings"] = []clientCommand{
+		{
+			Action:      "get",
+			Args:        "DOMAIN",
+			Description: "Show parameters for obtaining TLS certificates for a domain.",
+			Run:         clientACMESettingsGet,
+		},
+		{
+			Action:      "update",
+			Args:        "DOMAIN",
+			Description: "Select a DNS provider for dns-01 challenges, or http-01 challenges with an empty DNS provider.",
+			Run:         clientACMESettingsUpdate,
+		},
+		{
+			Action:      "dns-providers",
+			Description: "List DNS providers that can be selected for domains.",
+			Run:         clientACMESettingsDNSProviders,
+		},
+	}
+	clientResources["certificate"] = []clientCommand{
+		{
+			Action:      "get",
+			Args:        "DOMAIN",
+			Description: "Show the expiration time and the last renewal attempt of a domain TLS certificate.",
+			Run:         clientCertificateGet,
+		},
+		{
+			Action:      "renew",
+			Args:        "DOMAIN",
+			Description: "Queue a domain TLS certificate for renewal, regardless of its expiration time.",
+			Run:         clientCertificateRenew,
+		},
+	}
+}
+
+func clientACMEUserRows(u api.ACMEUser) [][]string {
+	return [][]string{
+		{"url", u.URL},
+		{"directory", u.DirectoryURL},
+		{"email", u.Email},
+		{"external account", u.ExternalAccountKeyID},
+	}
+}
+
+func clientACMEUserGet(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 0 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+	u, err := c.ACMEUser()
+	if err != nil {
+		return err
+	}
+	return ctx.print(u, nil, clientACMEUserRows(u))
+}
+
+func clientACMEUserRollover(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 0 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+	u, err := c.RolloverACMEUserKey()
+	if err != nil {
+		return err
+	}
+	return ctx.print(u, nil, clientACMEUserRows(u))
+}
+
+func clientACMESettingsRows(s api.ACMESettings) [][]string {
+	return [][]string{
+		{"dns provider", s.DNSProvider},
+		{"key type", string(s.KeyType)},
+	}
+}
+
+func clientACMESettingsGet(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+	s, err := c.DomainACMESettings(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	return ctx.print(s, nil, clientACMESettingsRows(s))
+}
+
+func clientACMESettingsUpdate(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	dnsProvider := f.String("dns-provider", "", "Name of the DNS provider for dns-01 challenges. Empty value selects http-01 challenges.")
+	keyType := f.String("key-type", "", "Certificate key type: p256, p384, rsa2048, rsa4096 or dual. Empty value selects the default key type.")
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+	o := &api.ACMESettingsOptions{}
+	if isFlagSet(f, "dns-provider") {
+		o.DNSProvider = dnsProvider
+	}
+	if isFlagSet(f, "key-type") {
+		t := api.KeyType(*keyType)
+		o.KeyType = &t
+	}
+	s, err := c.UpdateDomainACMESettings(f.Arg(0), o)
+	if err != nil {
+		return err
+	}
+	return ctx.print(s, nil, clientACMESettingsRows(s))
+}
+
+func clientACMESettingsDNSProviders(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 0 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+	p, err := c.DNSProviders()
+	if err != nil {
+		return err
+	}
+	rows := [][]string{}
+	for _, name := range p.Names {
+		rows = append(rows, []string{name})
+	}
+	return ctx.print(p, []string{"NAME"}, rows)
+}
+
+func clientCertificateRows(crt api.Certificate) [][]string {
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+	return [][]string{
+		{"fqdn", crt.FQDN},
+		{"expiration time", formatTime(crt.ExpirationTime)},
+		{"key type", string(crt.KeyType)},
+		{"renewal attempt time", formatTime(crt.RenewalAttemptTime)},
+		{"renewal error", crt.RenewalError},
+		{"renewal failures", strconv.Itoa(crt.RenewalFailures)},
+	}
+}
+
+func clientCertificateGet(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+	crt, err := c.DomainCertificate(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	return ctx.print(crt, nil, clientCertificateRows(crt))
+}
+
+func clientCertificateRenew(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+	crt, err := c.RenewDomainCertificate(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	return ctx.print(crt, nil, clientCertificateRows(crt))
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"gopkg.in/yaml.v2"
+
+	"gopherpit.com/gopherpit/api"
+)
+
+func init() {
+	clientResources["batch"] = []clientCommand{
+		{
+			Action:      "apply",
+			Args:        "FILE",
+			Description: "Apply domain and package operations from a file atomically.",
+			Help: `Operations are applied in order and if any of them fails, none of them
+  are applied. File can be in YAML or JSON format, for example:
+
+    operations:
+      - action: add_package
+        package:
+          domain: project.example.com
+          path: /application
+          vcs: git
+          repo_root: https://github.com/me/application.git
+      - action: grant_domain_user
+        ref: project.example.com
+        user: alice`,
+			Run: clientBatchApply,
+		},
+	}
+}
+
+func clientBatchApply(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	filename := f.Arg(0)
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("read batch: %s", err)
+	}
+	request, err := parseBatchRequest(data)
+	if err != nil {
+		return fmt.Errorf("parse batch %s: %s", filename, err)
+	}
+
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	results, err := c.Batch(request.Operations)
+	if e, ok := err.(*api.BatchError); ok && e.Operation < len(request.Operations) {
+		return fmt.Errorf("operation %d (%s): %s: nothing is applied", e.Operation, request.Operations[e.Operation].Action, e.Err)
+	}
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{}
+	for _, r := range results {
+		switch {
+		case r.Package != nil:
+			rows = append(rows, []string{string(r.Action), r.Package.ID, r.Package.FQDN + r.Package.Path, r.ETag})
+		case r.Domain != nil:
+			rows = append(rows, []string{string(r.Action), r.Domain.ID, r.Domain.FQDN, r.ETag})
+		default:
+			rows = append(rows, []string{string(r.Action), "", "", r.ETag})
+		}
+	}
+	if err := ctx.print(api.BatchResponse{Results: results}, []string{"ACTION", "ID", "NAME", "ETAG"}, rows); err != nil {
+		return err
+	}
+	if ctx.options.Format == "table" {
+		fmt.Fprintf(os.Stderr, "Applied %d operations.\n", len(results))
+	}
+	return nil
+}
+
+// parseBatchRequest decodes batch operations from YAML or JSON data in the
+// same way as parseManifest.
+func parseBatchRequest(data []byte) (request *api.BatchRequest, err error) {
+	var v interface{}
+	if err = yaml.Unmarshal(data, &v); err != nil {
+		return
+	}
+	if v == nil {
+		return nil, errors.New("no operations")
+	}
+	data, err = json.Marshal(jsonValue(v))
+	if err != nil {
+		return
+	}
+	request = &api.BatchRequest{}
+	if err = json.Unmarshal(data, request); err != nil {
+		return
+	}
+	if len(request.Operations) == 0 {
+		return nil, errors.New("no operations")
+	}
+	return
+}
@@ -0,0 +1,467 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopherpit.com/gopherpit/api"
+)
+
+func init() {
+	clientResources["domains"] = []clientCommand{
+		{
+			Action:      "list",
+			Description: "List domains that the user has access to.",
+			Run:         clientDomainsList,
+		},
+		{
+			Action:      "get",
+			Args:        "DOMAIN",
+			Description: "Show a domain by its ID or FQDN.",
+			Run:         clientDomainsGet,
+		},
+		{
+			Action:      "add",
+			Args:        "FQDN",
+			Description: "Add a new domain.",
+			Run:         clientDomainsAdd,
+		},
+		{
+			Action:      "update",
+			Args:        "DOMAIN",
+			Description: "Update fields of a domain that are specified as options.",
+			Run:         clientDomainsUpdate,
+		},
+		{
+			Action:      "delete",
+			Args:        "DOMAIN",
+			Description: "Delete a domain and all of its packages.",
+			Run:         clientDomainsDelete,
+		},
+		{
+			Action:      "tokens",
+			Args:        "FQDN",
+			Description: "List TXT DNS records or HTTPS files needed to verify the domain ownership.",
+			Run:         clientDomainsTokens,
+		},
+		{
+			Action:      "users",
+			Args:        "DOMAIN",
+			Description: "List users that have access to a domain.",
+			Run:         clientDomainsUsers,
+		},
+		{
+			Action:      "grant",
+			Args:        "DOMAIN USER",
+			Description: "Grant a user access to a domain. User can be referenced by ID, username or email.",
+			Run:         clientDomainsGrant,
+		},
+		{
+			Action:      "role",
+			Args:        "DOMAIN USER ROLE",
+			Description: "Change the role of a user that has access to a domain. Role can be maintainer or viewer.",
+			Run:         clientDomainsRole,
+		},
+		{
+			Action:      "revoke",
+			Args:        "DOMAIN USER",
+			Description: "Revoke a user access to a domain. User can be referenced by ID, username or email.",
+			Run:         clientDomainsRevoke,
+		},
+		{
+			Action:      "transfer",
+			Args:        "DOMAIN USER",
+			Description: "Request a transfer of a domain ownership to a user. User can be referenced by ID, username or email.",
+			Run:         clientDomainsTransfer,
+		},
+		{
+			Action:      "accept-transfer",
+			Args:        "DOMAIN",
+			Description: "Accept a transfer of a domain ownership.",
+			Run:         clientDomainsAcceptTransfer,
+		},
+		{
+			Action:      "cancel-transfer",
+			Args:        "DOMAIN",
+			Description: "Cancel or decline a transfer of a domain ownership.",
+			Run:         clientDomainsCancelTransfer,
+		},
+		{
+			Action:      "transfers",
+			Description: "List pending domain transfers from or to the user.",
+			Run:         clientDomainsTransfers,
+		},
+	}
+}
+
+var clientDomainsHeader = []string{"ID", "FQDN", "OWNER USER ID", "ORGANIZATION ID", "CERTIFICATE IGNORE", "DISABLED"}
+
+func clientDomainRow(d api.Domain) []string {
+	return []string{d.ID, d.FQDN, d.OwnerUserID, d.OrganizationID, boolString(d.CertificateIgnore), boolString(d.Disabled)}
+}
+
+var clientDomainTransfersHeader = []string{"DOMAIN ID", "FQDN", "FROM USER ID", "TO USER ID", "EXPIRATION TIME"}
+
+func clientDomainTransferRow(t api.DomainTransfer) []string {
+	return []string{t.DomainID, t.FQDN, t.FromUserID, t.ToUserID, t.ExpirationTime.Format(time.RFC3339)}
+}
+
+func clientDomainsList(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	paging := &clientPaging{}
+	paging.register(f)
+	f.Parse(args)
+	if f.NArg() != 0 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	page, err := c.Domains(paging.start, paging.limit)
+	if err != nil {
+		return err
+	}
+	for paging.all() && page.Next != "" {
+		next, err := c.Domains(page.Next, api.MaxLimit)
+		if err != nil {
+			return err
+		}
+		page.Domains = append(page.Domains, next.Domains...)
+		page.Count += next.Count
+		page.Next = next.Next
+	}
+
+	rows := [][]string{}
+	for _, d := range page.Domains {
+		rows = append(rows, clientDomainRow(d))
+	}
+	if err := ctx.print(page, clientDomainsHeader, rows); err != nil {
+		return err
+	}
+	if ctx.options.Format == "table" && page.Next != "" {
+		fmt.Fprintln(os.Stderr, "Next page start:", page.Next)
+	}
+	return nil
+}
+
+func clientDomainsGet(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	d, err := c.Domain(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	ctx.printETag(d.ETag)
+	return ctx.print(d, clientDomainsHeader, [][]string{clientDomainRow(d)})
+}
+
+// clientDomainOptions registers flags for domain fields and returns
+// a function that constructs api.DomainOptions only from flags that
+// are specified on the command line.
+func clientDomainOptions(f *flag.FlagSet) func() *api.DomainOptions {
+	fqdn := f.String("fqdn", "", "Fully qualified domain name.")
+	ownerUserID := f.String("owner-user-id", "", "ID of the user that owns the domain. Owner of an existing domain is changed only by a domain transfer.")
+	organizationID := f.String("organization-id", "", "ID or name of the organization that owns the domain.")
+	certificateIgnore := f.Bool("certificate-ignore", false, "Do not obtain TLS certificate for the domain.")
+	disabled := f.Bool("disabled", false, "Disable the domain.")
+	aliases := f.String("aliases", "", "Comma separated alias domain names that replace all domain aliases.")
+	aliasRedirect := f.Bool("alias-redirect", false, "Redirect requests to aliases to the domain.")
+	defaults := api.PackageDefaults{}
+	f.StringVar((*string)(&defaults.VCS), "default-vcs", "", "Package defaults VCS. Any of the default options replaces all package defaults.")
+	f.StringVar(&defaults.RepoRoot, "default-repo-root", "", "Package defaults repository root template, for example https://github.com/me/{path}.git.")
+	f.StringVar((*string)(&defaults.RefType), "default-ref-type", "", "Package defaults reference type.")
+	f.StringVar(&defaults.RefName, "default-ref-name", "", "Package defaults reference name.")
+	f.StringVar(&defaults.GoSource, "default-go-source", "", "Package defaults go-source meta tag template.")
+	f.StringVar(&defaults.RedirectURL, "default-redirect-url", "", "Package defaults redirect URL template.")
+	return func() *api.DomainOptions {
+		o := &api.DomainOptions{}
+		for _, name := range []string{"default-vcs", "default-repo-root", "default-ref-type", "default-ref-name", "default-go-source", "default-redirect-url"} {
+			if isFlagSet(f, name) {
+				o.PackageDefaults = &defaults
+				break
+			}
+		}
+		if isFlagSet(f, "fqdn") {
+			o.FQDN = fqdn
+		}
+		if isFlagSet(f, "owner-user-id") {
+			o.OwnerUserID = ownerUserID
+		}
+		if isFlagSet(f, "organization-id") {
+			o.OrganizationID = organizationID
+		}
+		if isFlagSet(f, "certificate-ignore") {
+			o.CertificateIgnore = certificateIgnore
+		}
+		if isFlagSet(f, "disabled") {
+			o.Disabled = disabled
+		}
+		if isFlagSet(f, "aliases") {
+			a := []string{}
+			for _, alias := range strings.Split(*aliases, ",") {
+				if alias = strings.TrimSpace(alias); alias != "" {
+					a = append(a, alias)
+				}
+			}
+			o.Aliases = &a
+		}
+		if isFlagSet(f, "alias-redirect") {
+			o.AliasRedirect = aliasRedirect
+		}
+		return o
+	}
+}
+
+func clientDomainsAdd(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	options := clientDomainOptions(f)
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	o := options()
+	fqdn := f.Arg(0)
+	o.FQDN = &fqdn
+	d, err := c.AddDomain(o)
+	if err == api.ErrDomainNeedsVerification {
+		return fmt.Errorf("%s: list required DNS records with: %s client domains tokens %s", err, os.Args[0], fqdn)
+	}
+	if err != nil {
+		return err
+	}
+	ctx.printETag(d.ETag)
+	return ctx.print(d, clientDomainsHeader, [][]string{clientDomainRow(d)})
+}
+
+func clientDomainsUpdate(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	options := clientDomainOptions(f)
+	etag := f.String("if-match", "", "Update only if the domain entity tag is equal to this value.")
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	d, err := c.UpdateDomainIfMatch(f.Arg(0), *etag, options())
+	if err != nil {
+		return err
+	}
+	ctx.printETag(d.ETag)
+	return ctx.print(d, clientDomainsHeader, [][]string{clientDomainRow(d)})
+}
+
+func clientDomainsDelete(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	etag := f.String("if-match", "", "Delete only if the domain entity tag is equal to this value.")
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	d, err := c.DeleteDomainIfMatch(f.Arg(0), *etag)
+	if err != nil {
+		return err
+	}
+	return ctx.print(d, clientDomainsHeader, [][]string{clientDomainRow(d)})
+}
+
+func clientDomainsTokens(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	tokens, err := c.DomainTokens(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	rows := [][]string{}
+	for _, t := range tokens.Tokens {
+		location := t.FQDN
+		if t.Method == api.DomainVerificationHTTPFile {
+			location = t.URL
+		}
+		rows = append(rows, []string{string(t.Method), location, t.Token})
+	}
+	return ctx.print(tokens, []string{"METHOD", "LOCATION", "TOKEN"}, rows)
+}
+
+func clientDomainsUsers(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	users, err := c.DomainUsers(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	rows := [][]string{
+		{users.OwnerUserID, string(api.DomainRoleOwner)},
+	}
+	for _, id := range users.UserIDs {
+		rows = append(rows, []string{id, string(users.Roles[id])})
+	}
+	return ctx.print(users, []string{"USER ID", "ROLE"}, rows)
+}
+
+func clientDomainsGrant(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	role := f.String("role", string(api.DomainRoleMaintainer), "Role of the user, maintainer or viewer.")
+	f.Parse(args)
+	if f.NArg() != 2 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	return c.GrantDomainUserWithRole(f.Arg(0), f.Arg(1), api.DomainRole(*role))
+}
+
+func clientDomainsRole(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 3 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	return c.UpdateDomainUserRole(f.Arg(0), f.Arg(1), api.DomainRole(f.Arg(2)))
+}
+
+func clientDomainsRevoke(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 2 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	return c.RevokeDomainUser(f.Arg(0), f.Arg(1))
+}
+
+func clientDomainsTransfer(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 2 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	t, err := c.RequestDomainTransfer(f.Arg(0), f.Arg(1))
+	if err != nil {
+		return err
+	}
+	return ctx.print(t, clientDomainTransfersHeader, [][]string{clientDomainTransferRow(t)})
+}
+
+func clientDomainsAcceptTransfer(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	d, err := c.AcceptDomainTransfer(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	ctx.printETag(d.ETag)
+	return ctx.print(d, clientDomainsHeader, [][]string{clientDomainRow(d)})
+}
+
+func clientDomainsCancelTransfer(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	t, err := c.CancelDomainTransfer(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	return ctx.print(t, clientDomainTransfersHeader, [][]string{clientDomainTransferRow(t)})
+}
+
+func clientDomainsTransfers(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 0 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	transfers, err := c.DomainTransfers()
+	if err != nil {
+		return err
+	}
+	rows := [][]string{}
+	for _, t := range transfers.Transfers {
+		rows = append(rows, clientDomainTransferRow(t))
+	}
+	return ctx.print(transfers, clientDomainTransfersHeader, rows)
+}
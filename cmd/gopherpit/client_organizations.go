@@ -0,0 +1,268 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"gopherpit.com/gopherpit/api"
+)
+
+func init() {
+	clientResources["organizations"] = []clientCommand{
+		{
+			Action:      "list",
+			Description: "List organizations that the user is a member of.",
+			Run:         clientOrganizationsList,
+		},
+		{
+			Action:      "get",
+			Args:        "ORGANIZATION",
+			Description: "Show an organization by its ID or name.",
+			Run:         clientOrganizationsGet,
+		},
+		{
+			Action:      "add",
+			Args:        "NAME",
+			Description: "Add a new organization with the user as its owner.",
+			Run:         clientOrganizationsAdd,
+		},
+		{
+			Action:      "rename",
+			Args:        "ORGANIZATION NAME",
+			Description: "Change the name of an organization.",
+			Run:         clientOrganizationsRename,
+		},
+		{
+			Action:      "delete",
+			Args:        "ORGANIZATION",
+			Description: "Delete an organization that does not own any domains.",
+			Run:         clientOrganizationsDelete,
+		},
+		{
+			Action:      "members",
+			Args:        "ORGANIZATION",
+			Description: "List members of an organization and their roles.",
+			Run:         clientOrganizationsMembers,
+		},
+		{
+			Action:      "set-member",
+			Args:        "ORGANIZATION USER",
+			Description: "Add a member to an organization or change the role of a member.",
+			Run:         clientOrganizationsSetMember,
+		},
+		{
+			Action:      "remove-member",
+			Args:        "ORGANIZATION USER",
+			Description: "Remove a member from an organization.",
+			Run:         clientOrganizationsRemoveMember,
+		},
+		{
+			Action:      "domains",
+			Args:        "ORGANIZATION",
+			Description: "List domains owned by an organization.",
+			Run:         clientOrganizationsDomains,
+		},
+	}
+}
+
+var clientOrganizationsHeader = []string{"ID", "NAME"}
+
+func clientOrganizationRow(o api.Organization) []string {
+	return []string{o.ID, o.Name}
+}
+
+func clientOrganizationsList(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 0 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	orgs, err := c.Organizations()
+	if err != nil {
+		return err
+	}
+	rows := [][]string{}
+	for _, o := range orgs.Organizations {
+		rows = append(rows, clientOrganizationRow(o))
+	}
+	return ctx.print(orgs, clientOrganizationsHeader, rows)
+}
+
+func clientOrganizationsGet(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	o, err := c.Organization(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	return ctx.print(o, clientOrganizationsHeader, [][]string{clientOrganizationRow(o)})
+}
+
+func clientOrganizationsAdd(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	name := f.Arg(0)
+	o, err := c.AddOrganization(&api.OrganizationOptions{
+		Name: &name,
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.print(o, clientOrganizationsHeader, [][]string{clientOrganizationRow(o)})
+}
+
+func clientOrganizationsRename(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 2 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	name := f.Arg(1)
+	o, err := c.UpdateOrganization(f.Arg(0), &api.OrganizationOptions{
+		Name: &name,
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.print(o, clientOrganizationsHeader, [][]string{clientOrganizationRow(o)})
+}
+
+func clientOrganizationsDelete(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	o, err := c.DeleteOrganization(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	return ctx.print(o, clientOrganizationsHeader, [][]string{clientOrganizationRow(o)})
+}
+
+func clientOrganizationsMembers(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	members, err := c.OrganizationMembers(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	rows := [][]string{}
+	for _, id := range members.UserIDs {
+		rows = append(rows, []string{id, string(members.Roles[id])})
+	}
+	return ctx.print(members, []string{"USER ID", "ROLE"}, rows)
+}
+
+func clientOrganizationsSetMember(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	role := f.String("role", string(api.OrganizationRoleMember), "Role of the member, owner or member.")
+	f.Parse(args)
+	if f.NArg() != 2 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	return c.SetOrganizationMember(f.Arg(0), f.Arg(1), api.OrganizationRole(*role))
+}
+
+func clientOrganizationsRemoveMember(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 2 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	return c.RemoveOrganizationMember(f.Arg(0), f.Arg(1))
+}
+
+func clientOrganizationsDomains(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	paging := &clientPaging{}
+	paging.register(f)
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	page, err := c.OrganizationDomains(f.Arg(0), paging.start, paging.limit)
+	if err != nil {
+		return err
+	}
+	for paging.all() && page.Next != "" {
+		next, err := c.OrganizationDomains(f.Arg(0), page.Next, api.MaxLimit)
+		if err != nil {
+			return err
+		}
+		page.Domains = append(page.Domains, next.Domains...)
+		page.Count += next.Count
+		page.Next = next.Next
+	}
+
+	rows := [][]string{}
+	for _, d := range page.Domains {
+		rows = append(rows, clientDomainRow(d))
+	}
+	if err := ctx.print(page, clientDomainsHeader, rows); err != nil {
+		return err
+	}
+	if ctx.options.Format == "table" && page.Next != "" {
+		fmt.Fprintln(os.Stderr, "Next page start:", page.Next)
+	}
+	return nil
+}
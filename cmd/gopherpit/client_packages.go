@@ -0,0 +1,462 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"gopkg.in/yaml.v2"
+
+	"gopherpit.com/gopherpit/api"
+)
+
+func init() {
+	clientResources["packages"] = []clientCommand{
+		{
+			Action:      "list",
+			Args:        "DOMAIN",
+			Description: "List packages under a domain.",
+			Run:         clientPackagesList,
+		},
+		{
+			Action:      "search",
+			Args:        "[QUERY]",
+			Description: "Search packages under all accessible domains by import path, repository root or reference name.",
+			Run:         clientPackagesSearch,
+		},
+		{
+			Action:      "get",
+			Args:        "ID",
+			Description: "Show a package.",
+			Run:         clientPackagesGet,
+		},
+		{
+			Action:      "add",
+			Args:        "DOMAIN PATH",
+			Description: "Add a new package under a domain.",
+			Run:         clientPackagesAdd,
+		},
+		{
+			Action:      "update",
+			Args:        "ID",
+			Description: "Update fields of a package that are specified as options.",
+			Run:         clientPackagesUpdate,
+		},
+		{
+			Action:      "delete",
+			Args:        "ID",
+			Description: "Delete a package.",
+			Run:         clientPackagesDelete,
+		},
+		{
+			Action:      "sync",
+			Args:        "DOMAIN MANIFEST",
+			Description: "Add, update and delete packages under a domain to match a manifest file.",
+			Help: `Packages under the DOMAIN are changed to match the packages described in
+  the MANIFEST file. Packages are identified by their paths. Manifest file can
+  be in YAML or JSON format, for example:
+
+    packages:
+      - path: /application
+        vcs: git
+        repo_root: https://github.com/me/application.git
+      - path: /library
+        vcs: git
+        repo_root: https://github.com/me/library.git
+        ref_type: tag
+        ref_name: v1.0.0
+
+  Domain packages that are not in the manifest are deleted.`,
+			Run: clientPackagesSync,
+		},
+		{
+			Action:      "apply-defaults",
+			Args:        "DOMAIN [FIELD...]",
+			Description: "Apply package defaults of a domain to all of its existing packages.",
+			Help: `Fields can be vcs, repo_root, ref, go_source and redirect_url. If no fields
+  are specified, all fields that have default values are applied. Package
+  defaults are set with domain update command options.`,
+			Run: clientPackagesApplyDefaults,
+		},
+	}
+}
+
+var clientPackagesHeader = []string{"ID", "IMPORT PREFIX", "VCS", "REPOSITORY ROOT", "REFERENCE", "DISABLED"}
+
+func clientPackageRow(p api.Package) []string {
+	ref := ""
+	if p.RefType != "" {
+		ref = string(p.RefType) + " " + p.RefName
+	}
+	return []string{p.ID, p.FQDN + p.Path, string(p.VCS), p.RepoRoot, ref, boolString(p.Disabled)}
+}
+
+func clientPackagesList(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	paging := &clientPaging{}
+	paging.register(f)
+	filter := clientPackagesFilter(f)
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	domain := f.Arg(0)
+	page, err := c.DomainPackagesFiltered(domain, paging.start, paging.limit, filter())
+	if err != nil {
+		return err
+	}
+	for paging.all() && page.Next != "" {
+		next, err := c.DomainPackagesFiltered(domain, page.Next, api.MaxLimit, filter())
+		if err != nil {
+			return err
+		}
+		page.Packages = append(page.Packages, next.Packages...)
+		page.Count += next.Count
+		page.Next = next.Next
+	}
+
+	rows := [][]string{}
+	for _, p := range page.Packages {
+		rows = append(rows, clientPackageRow(p))
+	}
+	if err := ctx.print(page, clientPackagesHeader, rows); err != nil {
+		return err
+	}
+	if ctx.options.Format == "table" && page.Next != "" {
+		fmt.Fprintln(os.Stderr, "Next page start:", page.Next)
+	}
+	return nil
+}
+
+func clientPackagesSearch(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	paging := &clientPaging{}
+	paging.register(f)
+	f.Parse(args)
+	if f.NArg() > 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	query := f.Arg(0)
+	page, err := c.SearchPackages(query, paging.start, paging.limit)
+	if err != nil {
+		return err
+	}
+	for paging.all() && page.Next != "" {
+		next, err := c.SearchPackages(query, page.Next, api.MaxLimit)
+		if err != nil {
+			return err
+		}
+		page.Packages = append(page.Packages, next.Packages...)
+		page.Count += next.Count
+		page.Next = next.Next
+	}
+
+	rows := [][]string{}
+	for _, p := range page.Packages {
+		rows = append(rows, clientPackageRow(p))
+	}
+	if err := ctx.print(page, clientPackagesHeader, rows); err != nil {
+		return err
+	}
+	if ctx.options.Format == "table" && page.Next != "" {
+		fmt.Fprintln(os.Stderr, "Next page start:", page.Next)
+	}
+	return nil
+}
+
+// clientPackagesFilter registers flags for filtering package listings and
+// returns a function that constructs api.PackagesFilter from them.
+func clientPackagesFilter(f *flag.FlagSet) func() *api.PackagesFilter {
+	vcs := f.String("vcs", "", "List only packages with this version control system.")
+	refType := f.String("ref-type", "", "List only packages with this reference type: branch or tag.")
+	repoRootHost := f.String("repo-root-host", "", "List only packages with repository root on this host.")
+	disabled := f.Bool("disabled", false, "List only disabled packages, or only enabled with -disabled=false.")
+	query := f.String("query", "", "List only packages with paths that contain this substring.")
+	return func() *api.PackagesFilter {
+		filter := &api.PackagesFilter{
+			VCS:          api.VCS(*vcs),
+			RefType:      api.RefType(*refType),
+			RepoRootHost: *repoRootHost,
+			Query:        *query,
+		}
+		if isFlagSet(f, "disabled") {
+			filter.Disabled = disabled
+		}
+		return filter
+	}
+}
+
+func clientPackagesGet(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	p, err := c.Package(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	ctx.printETag(p.ETag)
+	return ctx.print(p, clientPackagesHeader, [][]string{clientPackageRow(p)})
+}
+
+// clientPackageOptions registers flags for package fields and returns
+// a function that constructs api.PackageOptions only from flags that
+// are specified on the command line.
+func clientPackageOptions(f *flag.FlagSet) func() *api.PackageOptions {
+	domain := f.String("domain", "", "Domain ID or FQDN.")
+	path := f.String("path", "", "Package path under the domain.")
+	vcs := f.String("vcs", "", "Version control system: git, hg, bzr or svn.")
+	repoRoot := f.String("repo-root", "", "Repository root URL.")
+	refType := f.String("ref-type", "", "Reference type: branch or tag.")
+	refName := f.String("ref-name", "", "Reference name.")
+	goSource := f.String("go-source", "", "Value of go-source meta tag.")
+	redirectURL := f.String("redirect-url", "", "URL to redirect browsers to.")
+	disabled := f.Bool("disabled", false, "Disable the package.")
+	return func() *api.PackageOptions {
+		o := &api.PackageOptions{}
+		if isFlagSet(f, "domain") {
+			o.Domain = domain
+		}
+		if isFlagSet(f, "path") {
+			o.Path = path
+		}
+		if isFlagSet(f, "vcs") {
+			v := api.VCS(*vcs)
+			o.VCS = &v
+		}
+		if isFlagSet(f, "repo-root") {
+			o.RepoRoot = repoRoot
+		}
+		if isFlagSet(f, "ref-type") {
+			v := api.RefType(*refType)
+			o.RefType = &v
+		}
+		if isFlagSet(f, "ref-name") {
+			o.RefName = refName
+		}
+		if isFlagSet(f, "go-source") {
+			o.GoSource = goSource
+		}
+		if isFlagSet(f, "redirect-url") {
+			o.RedirectURL = redirectURL
+		}
+		if isFlagSet(f, "disabled") {
+			o.Disabled = disabled
+		}
+		return o
+	}
+}
+
+func clientPackagesAdd(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	options := clientPackageOptions(f)
+	f.Parse(args)
+	if f.NArg() != 2 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	o := options()
+	domain := f.Arg(0)
+	path := f.Arg(1)
+	o.Domain = &domain
+	o.Path = &path
+	p, err := c.AddPackage(o)
+	if err != nil {
+		return err
+	}
+	ctx.printETag(p.ETag)
+	return ctx.print(p, clientPackagesHeader, [][]string{clientPackageRow(p)})
+}
+
+func clientPackagesUpdate(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	options := clientPackageOptions(f)
+	etag := f.String("if-match", "", "Update only if the package entity tag is equal to this value.")
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	p, err := c.UpdatePackageIfMatch(f.Arg(0), *etag, options())
+	if err != nil {
+		return err
+	}
+	ctx.printETag(p.ETag)
+	return ctx.print(p, clientPackagesHeader, [][]string{clientPackageRow(p)})
+}
+
+func clientPackagesDelete(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	etag := f.String("if-match", "", "Delete only if the package entity tag is equal to this value.")
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	p, err := c.DeletePackageIfMatch(f.Arg(0), *etag)
+	if err != nil {
+		return err
+	}
+	return ctx.print(p, clientPackagesHeader, [][]string{clientPackageRow(p)})
+}
+
+func clientPackagesSync(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	dryRun := f.Bool("dry-run", false, "Only print changes without applying them.")
+	f.Parse(args)
+	if f.NArg() != 2 {
+		return errClientUsage
+	}
+	domain := f.Arg(0)
+	filename := f.Arg(1)
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("read manifest: %s", err)
+	}
+	manifest, err := parseManifest(data)
+	if err != nil {
+		return fmt.Errorf("parse manifest %s: %s", filename, err)
+	}
+
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	plan, err := c.SyncDomainPackages(domain, manifest, *dryRun)
+	if err != nil {
+		return err
+	}
+	return clientPrintPackagesPlan(ctx, plan)
+}
+
+func clientPackagesApplyDefaults(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	dryRun := f.Bool("dry-run", false, "Only print changes without applying them.")
+	f.Parse(args)
+	if f.NArg() < 1 {
+		return errClientUsage
+	}
+	domain := f.Arg(0)
+	fields := []api.PackageDefaultsField{}
+	for _, field := range f.Args()[1:] {
+		fields = append(fields, api.PackageDefaultsField(field))
+	}
+
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	plan, err := c.ApplyDomainPackageDefaults(domain, fields, *dryRun)
+	if err != nil {
+		return err
+	}
+	return clientPrintPackagesPlan(ctx, plan)
+}
+
+// clientPrintPackagesPlan prints changes of the plan and whether they
+// are applied.
+func clientPrintPackagesPlan(ctx *clientContext, plan api.PackagesPlan) error {
+	if ctx.options.Format == "json" {
+		return ctx.print(plan, nil, nil)
+	}
+	rows := [][]string{}
+	for _, c := range plan.Changes {
+		rows = append(rows, []string{string(c.Action), c.Package.FQDN + c.Package.Path, "", "", ""})
+		if c.Action != api.PackageActionUpdate {
+			continue
+		}
+		for _, f := range c.Changes {
+			rows = append(rows, []string{"", "", f.Field, stringValue(f.From), stringValue(f.To)})
+		}
+	}
+	if err := ctx.print(plan, []string{"ACTION", "IMPORT PREFIX", "FIELD", "FROM", "TO"}, rows); err != nil {
+		return err
+	}
+	switch {
+	case len(plan.Changes) == 0:
+		fmt.Fprintln(os.Stderr, "No changes.")
+	case plan.Applied:
+		fmt.Fprintf(os.Stderr, "Applied %d changes.\n", len(plan.Changes))
+	default:
+		fmt.Fprintf(os.Stderr, "Dry run: %d changes not applied.\n", len(plan.Changes))
+	}
+	return nil
+}
+
+// parseManifest decodes manifest from YAML or JSON data. YAML is converted to
+// JSON first, so that both formats have the same field names as the API.
+func parseManifest(data []byte) (manifest *api.PackagesManifest, err error) {
+	var v interface{}
+	if err = yaml.Unmarshal(data, &v); err != nil {
+		return
+	}
+	if v == nil {
+		// Protect from deleting all packages by an empty file.
+		return nil, errors.New("empty manifest")
+	}
+	data, err = json.Marshal(jsonValue(v))
+	if err != nil {
+		return
+	}
+	manifest = &api.PackagesManifest{}
+	err = json.Unmarshal(data, manifest)
+	return
+}
+
+// jsonValue converts maps with interface{} keys that YAML decoder produces
+// to maps with string keys that can be encoded to JSON.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := map[string]interface{}{}
+		for key, value := range v {
+			m[fmt.Sprint(key)] = jsonValue(value)
+		}
+		return m
+	case []interface{}:
+		for i, value := range v {
+			v[i] = jsonValue(value)
+		}
+		return v
+	}
+	return v
+}
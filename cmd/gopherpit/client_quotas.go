@@ -0,0 +1,117 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strconv"
+
+	"gopherpit.com/gopherpit/api"
+)
+
+func init() {
+	clientResources["quota"] = []clientCommand{
+		{
+			Action:      "get",
+			Args:        "USER",
+			Description: "Show domain and package quota of a user or an organization. User can be referenced by ID, username or email.",
+			Help:        `With -organization flag, the argument is an organization ID or name.`,
+			Run:         clientQuotaGet,
+		},
+		{
+			Action:      "set",
+			Args:        "USER",
+			Description: "Override domain and package quota of a user or an organization. Only administrators can change quotas.",
+			Help: `Limit overrides replace default quotas. Value 0 resets the limit to the
+  default and -1 disables the limit. With -organization flag, the argument
+  is an organization ID or name.`,
+			Run: clientQuotaSet,
+		},
+	}
+}
+
+func clientQuotaRows(q api.Quota) [][]string {
+	limit := func(v int) string {
+		if v == 0 {
+			return "unlimited"
+		}
+		return strconv.Itoa(v)
+	}
+	override := func(v int) string {
+		switch v {
+		case 0:
+			return "default"
+		case -1:
+			return "unlimited"
+		}
+		return strconv.Itoa(v)
+	}
+	return [][]string{
+		{"domains", strconv.Itoa(q.Domains), limit(q.DomainLimit), override(q.DomainLimitOverride)},
+		{"packages", strconv.Itoa(q.Packages), limit(q.PackageLimit), override(q.PackageLimitOverride)},
+	}
+}
+
+var clientQuotaHeader = []string{"RESOURCE", "USED", "LIMIT", "OVERRIDE"}
+
+func clientQuotaGet(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	organization := f.Bool("organization", false, "Reference an organization instead of a user.")
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	var q api.Quota
+	if *organization {
+		q, err = c.OrganizationQuota(f.Arg(0))
+	} else {
+		q, err = c.Quota(f.Arg(0))
+	}
+	if err != nil {
+		return err
+	}
+	return ctx.print(q, clientQuotaHeader, clientQuotaRows(q))
+}
+
+func clientQuotaSet(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	organization := f.Bool("organization", false, "Reference an organization instead of a user.")
+	domains := f.Int("domains", 0, "Maximal number of domains.")
+	packages := f.Int("packages", 0, "Maximal number of packages on all domains.")
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	o := &api.QuotaOptions{}
+	if isFlagSet(f, "domains") {
+		o.DomainLimitOverride = domains
+	}
+	if isFlagSet(f, "packages") {
+		o.PackageLimitOverride = packages
+	}
+	if o.DomainLimitOverride == nil && o.PackageLimitOverride == nil {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	var q api.Quota
+	if *organization {
+		q, err = c.UpdateOrganizationQuota(f.Arg(0), o)
+	} else {
+		q, err = c.UpdateQuota(f.Arg(0), o)
+	}
+	if err != nil {
+		return err
+	}
+	return ctx.print(q, clientQuotaHeader, clientQuotaRows(q))
+}
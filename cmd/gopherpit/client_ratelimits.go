@@ -0,0 +1,97 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strconv"
+
+	"gopherpit.com/gopherpit/api"
+)
+
+func init() {
+	clientResources["rate-limits"] = []clientCommand{
+		{
+			Action:      "get",
+			Args:        "USER",
+			Description: "Show API rate limits of a user. User can be referenced by ID, username or email.",
+			Run:         clientRateLimitsGet,
+		},
+		{
+			Action:      "set",
+			Args:        "USER",
+			Description: "Override API rate limits of a user. Only administrators can change rate limits.",
+			Help: `Limit overrides replace default limits for all API requests of the user. Value 0 resets
+  the limit to the default and -1 disables the limit.`,
+			Run: clientRateLimitsSet,
+		},
+	}
+}
+
+func clientRateLimitsRows(l api.RateLimits) [][]string {
+	override := func(v int) string {
+		switch v {
+		case 0:
+			return "default"
+		case -1:
+			return "unlimited"
+		}
+		return strconv.Itoa(v)
+	}
+	return [][]string{
+		{"write", strconv.Itoa(l.HourlyLimit), override(l.HourlyLimitOverride)},
+		{"read", strconv.Itoa(l.HourlyReadLimit), override(l.HourlyReadLimitOverride)},
+	}
+}
+
+var clientRateLimitsHeader = []string{"REQUESTS", "HOURLY LIMIT", "OVERRIDE"}
+
+func clientRateLimitsGet(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	l, err := c.RateLimits(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	return ctx.print(l, clientRateLimitsHeader, clientRateLimitsRows(l))
+}
+
+func clientRateLimitsSet(ctx *clientContext, args []string) error {
+	f := ctx.flagSet()
+	limit := f.Int("limit", 0, "Hourly limit for requests other than GET.")
+	readLimit := f.Int("read-limit", 0, "Hourly limit for GET requests.")
+	f.Parse(args)
+	if f.NArg() != 1 {
+		return errClientUsage
+	}
+	o := &api.RateLimitsOptions{}
+	if isFlagSet(f, "limit") {
+		o.HourlyLimitOverride = limit
+	}
+	if isFlagSet(f, "read-limit") {
+		o.HourlyReadLimitOverride = readLimit
+	}
+	if o.HourlyLimitOverride == nil && o.HourlyReadLimitOverride == nil {
+		return errClientUsage
+	}
+	c, err := ctx.client()
+	if err != nil {
+		return err
+	}
+
+	l, err := c.UpdateRateLimits(f.Arg(0), o)
+	if err != nil {
+		return err
+	}
+	return ctx.print(l, clientRateLimitsHeader, clientRateLimitsRows(l))
+}
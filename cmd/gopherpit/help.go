@@ -36,6 +36,14 @@ COMMANDS
   debug-dump
     Send to a running process USR1 signal to log debug information in the log.
 
+  client
+    Manage domains and packages using the GopherPit API.
+    Execute "client -h" for more information.
+
+  sync
+    Synchronize packages under a domain with a manifest file using the API.
+    It is a shortcut for "client packages sync" command.
+
   version
     Print version to Stdout.
 
@@ -45,6 +45,15 @@ func main() {
 		return
 	}
 
+	switch cmd {
+	case "client":
+		clientCmd(cli.Args()[1:])
+		return
+	case "sync":
+		clientCmd(append([]string{"packages", "sync"}, cli.Args()[1:]...))
+		return
+	}
+
 	updateConfig()
 
 	switch cmd {
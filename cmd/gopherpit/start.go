@@ -24,6 +24,7 @@ import (
 	"resenje.org/web/maintenance"
 	"resenje.org/x/application"
 
+	"gopherpit.com/gopherpit/pkg/resolver"
 	"gopherpit.com/gopherpit/server"
 	"gopherpit.com/gopherpit/server/config"
 	"gopherpit.com/gopherpit/services/certificate"
@@ -206,7 +207,7 @@ func startCmd(daemon bool) {
 			os.Exit(2)
 		}
 		userService = &boltUser.Service{
-			DB: db,
+			DB:                    db,
 			PasswordNoReuseMonths: userOptions.PasswordNoReuseMonths,
 			Logger:                logger,
 		}
@@ -282,11 +283,23 @@ func startCmd(daemon bool) {
 			fmt.Fprintln(os.Stderr, "certificate service bolt database:", err)
 			os.Exit(2)
 		}
+		dnsProviders, err := certificateOptions.NewDNSProviders()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "certificate service:", err)
+			os.Exit(2)
+		}
 		certificateService = &boltCertificate.Service{
-			DB: db,
+			DB:                      db,
 			DefaultACMEDirectoryURL: certificateOptions.DirectoryURL,
+			UserAgent:               config.UserAgent,
+			DNSProviders:            dnsProviders,
+			ChallengeType:           certificateOptions.ChallengeType,
+			KeyType:                 certificateOptions.KeyType,
 			RenewPeriod:             certificateOptions.RenewPeriod.Duration(),
 			RenewCheckPeriod:        certificateOptions.RenewCheckPeriod.Duration(),
+			RenewWorkers:            certificateOptions.RenewWorkers,
+			RenewBackoff:            certificateOptions.RenewBackoff.Duration(),
+			RenewMaxBackoff:         certificateOptions.RenewMaxBackoff.Duration(),
 			RecoveryService:         *recoveryService,
 			Logger:                  logger,
 		}
@@ -314,9 +327,11 @@ func startCmd(daemon bool) {
 			os.Exit(2)
 		}
 		packagesService = &boltPackages.Service{
-			DB:        db,
-			Changelog: changelog,
-			Logger:    logger,
+			DB:           db,
+			Changelog:    changelog,
+			Logger:       logger,
+			DomainQuota:  options.DomainQuota,
+			PackageQuota: options.PackageQuota,
 		}
 	}
 	var keyService key.Service
@@ -360,6 +375,12 @@ func startCmd(daemon bool) {
 		}
 	}
 
+	verificationResolver, err := resolver.New(options.VerificationResolvers, options.VerificationTimeout.Duration())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "verification resolver:", err)
+		os.Exit(2)
+	}
+
 	// Initialize server.
 	s, err := server.New(
 		server.Options{
@@ -386,13 +407,26 @@ func startCmd(daemon bool) {
 			ContactRecipientEmail:   options.ContactRecipientEmail,
 			ACMEDirectoryURL:        certificateOptions.DirectoryURL,
 			ACMEDirectoryURLStaging: certificateOptions.DirectoryURLStaging,
+			ACMEEABKeyID:            certificateOptions.EABKeyID,
+			ACMEEABHMACKey:          certificateOptions.EABHMACKey,
 			SkipDomainVerification:  options.SkipDomainVerification,
 			VerificationSubdomain:   options.VerificationSubdomain,
+			VerificationResolver:    verificationResolver,
+			VerificationNSLookup:    options.VerificationNSLookup,
+			ReverifyPeriod:          options.ReverifyPeriod.Duration(),
+			ReverifyGracePeriod:     options.ReverifyGracePeriod.Duration(),
+			CertExpiryNotifyPeriod:  certificateOptions.ExpiryNotificationPeriod.Duration(),
+			CertExpiryCheckPeriod:   certificateOptions.ExpiryCheckPeriod.Duration(),
+			CertExpiryRepeatPeriod:  certificateOptions.ExpiryRepeatPeriod.Duration(),
+			NotifyAddresses:         emailOptions.NotifyAddresses,
+			DomainTransferPeriod:    options.DomainTransferPeriod.Duration(),
 			TrustedDomains:          options.TrustedDomains,
 			ForbiddenDomains:        options.ForbiddenDomains,
 			APITrustedProxyCIDRs:    apiOptions.TrustedProxyCIDRs,
 			APIProxyRealIPHeader:    apiOptions.ProxyRealIPHeader,
 			APIHourlyRateLimit:      apiOptions.HourlyRateLimit,
+			APIHourlyReadRateLimit:  apiOptions.HourlyReadRateLimit,
+			APIHourlyWriteRateLimit: apiOptions.HourlyWriteRateLimit,
 			APIEnabled:              !apiOptions.Disabled,
 
 			Logger:              logger,
@@ -421,6 +455,8 @@ func startCmd(daemon bool) {
 	// All functions must be non-blocking or short-lived.
 	// They will be executed in the same goroutine in the same order.
 	app.Functions = append(app.Functions, s.Serve)
+	// Start periodic verification of domain ownership.
+	app.Functions = append(app.Functions, s.PeriodicReverifyDomains)
 	if service, ok := sessionService.(*boltSession.Service); ok {
 		// Start session cleanup.
 		app.Functions = append(app.Functions, func() error {
@@ -445,6 +481,10 @@ func startCmd(daemon bool) {
 			app.Functions = append(app.Functions, service.PeriodicRenew)
 		}
 	}
+	if options.ListenTLS != "" {
+		// Start notifications about certificates that failed to be renewed.
+		app.Functions = append(app.Functions, s.PeriodicCertificateExpiryNotifications)
+	}
 
 	app.ShutdownFunc = func() error {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
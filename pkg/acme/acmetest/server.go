@@ -0,0 +1,1052 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package acmetest provides a local ACME v2 server for testing
+// certificate issuance without external certificate authorities.
+package acmetest
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// idPeACMEIdentifier is the object identifier of the tls-alpn-01
+// challenge certificate extension.
+var idPeACMEIdentifier = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 31}
+
+// HTTP01Validator returns the content served for the http-01 challenge
+// token on the domain.
+type HTTP01Validator func(domain, token string) (keyAuthorization string, err error)
+
+// DNS01Validator returns the values of TXT records for the dns-01
+// challenge record name of the domain.
+type DNS01Validator func(name string) (values []string, err error)
+
+// TLSALPN01Validator returns the certificate presented in the TLS
+// handshake with the domain that negotiated acme-tls/1 protocol.
+type TLSALPN01Validator func(domain string) (cert *x509.Certificate, err error)
+
+// Server is an ACME server that listens on a random local port. It
+// implements account registration with optional external account binding,
+// account key rollover, orders with http-01, dns-01 and tls-alpn-01
+// challenges and
+// certificate issuance from a generated certificate authority. Wildcard
+// identifiers are offered only dns-01 challenges.
+type Server struct {
+	server *httptest.Server
+
+	caKey  *ecdsa.PrivateKey
+	caCert *x509.Certificate
+
+	mu                      sync.Mutex
+	externalAccountRequired bool
+	externalAccounts        map[string][]byte
+	validator               HTTP01Validator
+	dns01Validator          DNS01Validator
+	tlsALPN01Validator      TLSALPN01Validator
+	asyncIssuance           bool
+	nonces                  map[string]struct{}
+	accounts                map[string]*account
+	orders                  map[string]*order
+	authorizations          map[string]*authorization
+	challenges              map[string]*authorization
+	certificates            map[string][]byte
+	serial                  int64
+}
+
+type account struct {
+	url     string
+	key     crypto.PublicKey
+	contact []string
+	eabKID  string
+}
+
+type order struct {
+	id             string
+	accountURL     string
+	status         string
+	names          []string
+	authorizations []string
+	certificate    string
+	problem        *problem
+}
+
+type authorization struct {
+	id         string
+	accountURL string
+	name       string
+	status     string
+	token      string
+	wildcard   bool
+	validated  string
+	problem    *problem
+}
+
+type problem struct {
+	Type   string `json:"type"`
+	Detail string `json:"detail"`
+	Status int    `json:"status"`
+}
+
+// NewServer starts a new Server. If validator is nil, all http-01
+// challenges are considered valid.
+func NewServer(validator HTTP01Validator) (s *Server, err error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "acmetest root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, caKey.Public(), caKey)
+	if err != nil {
+		return
+	}
+	caCert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return
+	}
+	s = &Server{
+		caKey:            caKey,
+		caCert:           caCert,
+		externalAccounts: map[string][]byte{},
+		validator:        validator,
+		nonces:           map[string]struct{}{},
+		accounts:         map[string]*account{},
+		orders:           map[string]*order{},
+		authorizations:   map[string]*authorization{},
+		challenges:       map[string]*authorization{},
+		certificates:     map[string][]byte{},
+		serial:           1,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s, nil
+}
+
+// SetDNS01Validator sets the function that resolves dns-01 challenge
+// records. If it is not set, all dns-01 challenges are considered valid.
+func (s *Server) SetDNS01Validator(validator DNS01Validator) {
+	s.mu.Lock()
+	s.dns01Validator = validator
+	s.mu.Unlock()
+}
+
+// SetTLSALPN01Validator sets the function that performs TLS handshakes
+// for tls-alpn-01 challenges. If it is not set, all tls-alpn-01 challenges
+// are considered valid.
+func (s *Server) SetTLSALPN01Validator(validator TLSALPN01Validator) {
+	s.mu.Lock()
+	s.tlsALPN01Validator = validator
+	s.mu.Unlock()
+}
+
+// SetAsyncIssuance sets whether certificates are issued asynchronously, as
+// Pebble does. Finalized orders are then returned with processing status
+// and without the Location header, and they are valid when they are
+// requested again.
+func (s *Server) SetAsyncIssuance(async bool) {
+	s.mu.Lock()
+	s.asyncIssuance = async
+	s.mu.Unlock()
+}
+
+// DirectoryURL returns the URL of the ACME directory.
+func (s *Server) DirectoryURL() string {
+	return s.server.URL + "/directory"
+}
+
+// Close stops the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// CACertificate returns the certificate of the authority that signs
+// issued certificates.
+func (s *Server) CACertificate() *x509.Certificate {
+	return s.caCert
+}
+
+// RequireExternalAccount requires external account binding for new
+// accounts and adds a key identifier with its MAC key that can be used
+// for binding.
+func (s *Server) RequireExternalAccount(kid string, hmacKey []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.externalAccountRequired = true
+	s.externalAccounts[kid] = hmacKey
+}
+
+// AddAccount registers an account for a public key without the ACME
+// request, as if it was created with an earlier version of the protocol.
+// It returns the account URL.
+func (s *Server) AddAccount(key crypto.PublicKey, contact ...string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addAccount(key, contact, "").url
+}
+
+// AccountKey returns the current public key of an account or nil if the
+// account does not exist.
+func (s *Server) AccountKey(url string) crypto.PublicKey {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a, ok := s.accounts[url]
+	if !ok {
+		return nil
+	}
+	return a.key
+}
+
+// AccountExternalKeyID returns the key identifier of the external account
+// that is bound to the account.
+func (s *Server) AccountExternalKeyID(url string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a, ok := s.accounts[url]
+	if !ok {
+		return ""
+	}
+	return a.eabKID
+}
+
+// InvalidateNonces makes all issued nonces invalid, so that the next
+// request is rejected with badNonce error.
+func (s *Server) InvalidateNonces() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nonces = map[string]struct{}{}
+}
+
+func (s *Server) url(path string) string {
+	return s.server.URL + path
+}
+
+func (s *Server) addAccount(key crypto.PublicKey, contact []string, eabKID string) *account {
+	a := &account{
+		url:     s.url("/acme/acct/" + strconv.Itoa(len(s.accounts)+1)),
+		key:     key,
+		contact: contact,
+		eabKID:  eabKID,
+	}
+	s.accounts[a.url] = a
+	return a
+}
+
+func (s *Server) accountByKey(key crypto.PublicKey) *account {
+	for _, a := range s.accounts {
+		if publicKeyEqual(a.key, key) {
+			return a
+		}
+	}
+	return nil
+}
+
+func (s *Server) newNonce() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	nonce := base64.RawURLEncoding.EncodeToString(b)
+	s.nonces[nonce] = struct{}{}
+	return nonce
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Replay-Nonce", s.newNonce())
+	w.Header().Set("Cache-Control", "no-store")
+
+	switch {
+	case r.URL.Path == "/directory":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"newNonce":   s.url("/acme/new-nonce"),
+			"newAccount": s.url("/acme/new-account"),
+			"newOrder":   s.url("/acme/new-order"),
+			"revokeCert": s.url("/acme/revoke-cert"),
+			"keyChange":  s.url("/acme/key-change"),
+			"meta": map[string]interface{}{
+				"termsOfService":          s.url("/terms"),
+				"externalAccountRequired": s.externalAccountRequired,
+			},
+		})
+		return
+	case r.URL.Path == "/acme/new-nonce":
+		w.WriteHeader(http.StatusOK)
+		return
+	case r.Method != "POST":
+		writeProblem(w, http.StatusMethodNotAllowed, "malformed", "method not allowed")
+		return
+	}
+
+	msg, err := parseJWS(r)
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed", err.Error())
+		return
+	}
+	if _, ok := s.nonces[msg.header.Nonce]; !ok {
+		writeProblem(w, http.StatusBadRequest, "badNonce", "invalid nonce")
+		return
+	}
+	delete(s.nonces, msg.header.Nonce)
+	if msg.header.URL != s.url(r.URL.Path) {
+		writeProblem(w, http.StatusUnauthorized, "unauthorized", "url header mismatch")
+		return
+	}
+
+	if r.URL.Path == "/acme/new-account" {
+		s.newAccount(w, msg)
+		return
+	}
+
+	if msg.header.KeyID == "" {
+		writeProblem(w, http.StatusBadRequest, "malformed", "kid required")
+		return
+	}
+	a, ok := s.accounts[msg.header.KeyID]
+	if !ok {
+		writeProblem(w, http.StatusBadRequest, "accountDoesNotExist", "account does not exist")
+		return
+	}
+	if err := msg.verify(a.key); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed", err.Error())
+		return
+	}
+
+	path := r.URL.Path
+	switch {
+	case path == "/acme/key-change":
+		s.keyChange(w, a, msg)
+	case path == "/acme/new-order":
+		s.newOrder(w, a, msg)
+	case strings.HasPrefix(path, "/acme/order/"):
+		o, ok := s.orders[strings.TrimPrefix(path, "/acme/order/")]
+		if !ok || o.accountURL != a.url {
+			writeProblem(w, http.StatusNotFound, "malformed", "order not found")
+			return
+		}
+		s.writeOrder(w, http.StatusOK, o)
+	case strings.HasPrefix(path, "/acme/finalize/"):
+		o, ok := s.orders[strings.TrimPrefix(path, "/acme/finalize/")]
+		if !ok || o.accountURL != a.url {
+			writeProblem(w, http.StatusNotFound, "malformed", "order not found")
+			return
+		}
+		s.finalize(w, o, msg)
+	case strings.HasPrefix(path, "/acme/authz/"):
+		z, ok := s.authorizations[strings.TrimPrefix(path, "/acme/authz/")]
+		if !ok || z.accountURL != a.url {
+			writeProblem(w, http.StatusNotFound, "malformed", "authorization not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, s.authorizationJSON(z))
+	case strings.HasPrefix(path, "/acme/chall/"):
+		id, challengeType := path[len("/acme/chall/"):], "http-01"
+		if i := strings.IndexRune(id, '/'); i >= 0 {
+			id, challengeType = id[:i], id[i+1:]
+		}
+		z, ok := s.challenges[id]
+		if !ok || z.accountURL != a.url || !z.offers(challengeType) {
+			writeProblem(w, http.StatusNotFound, "malformed", "challenge not found")
+			return
+		}
+		s.validate(a, z, challengeType)
+		writeJSON(w, http.StatusOK, s.challengeJSON(z, challengeType))
+	case strings.HasPrefix(path, "/acme/cert/"):
+		cert, ok := s.certificates[strings.TrimPrefix(path, "/acme/cert/")]
+		if !ok {
+			writeProblem(w, http.StatusNotFound, "malformed", "certificate not found")
+			return
+		}
+		w.Header().Set("Content-Type", "application/pem-certificate-chain")
+		w.WriteHeader(http.StatusOK)
+		w.Write(cert)
+	default:
+		writeProblem(w, http.StatusNotFound, "malformed", "not found")
+	}
+}
+
+func (s *Server) newAccount(w http.ResponseWriter, msg *jwsMessage) {
+	if msg.key == nil {
+		writeProblem(w, http.StatusBadRequest, "malformed", "jwk required")
+		return
+	}
+	if err := msg.verify(msg.key); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed", err.Error())
+		return
+	}
+	var req struct {
+		Contact                []string         `json:"contact"`
+		TermsOfServiceAgreed   bool             `json:"termsOfServiceAgreed"`
+		OnlyReturnExisting     bool             `json:"onlyReturnExisting"`
+		ExternalAccountBinding *json.RawMessage `json:"externalAccountBinding"`
+	}
+	if err := json.Unmarshal(msg.payload, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed", err.Error())
+		return
+	}
+	if a := s.accountByKey(msg.key); a != nil {
+		w.Header().Set("Location", a.url)
+		writeJSON(w, http.StatusOK, accountJSON(a))
+		return
+	}
+	if req.OnlyReturnExisting {
+		writeProblem(w, http.StatusBadRequest, "accountDoesNotExist", "account does not exist")
+		return
+	}
+	if !req.TermsOfServiceAgreed {
+		writeProblem(w, http.StatusForbidden, "userActionRequired", "terms of service must be agreed")
+		return
+	}
+	var eabKID string
+	if req.ExternalAccountBinding != nil {
+		kid, err := s.verifyExternalAccountBinding(*req.ExternalAccountBinding, msg.key)
+		if err != nil {
+			writeProblem(w, http.StatusUnauthorized, "unauthorized", err.Error())
+			return
+		}
+		eabKID = kid
+	} else if s.externalAccountRequired {
+		writeProblem(w, http.StatusUnauthorized, "externalAccountRequired", "external account binding required")
+		return
+	}
+	a := s.addAccount(msg.key, req.Contact, eabKID)
+	w.Header().Set("Location", a.url)
+	writeJSON(w, http.StatusCreated, accountJSON(a))
+}
+
+func (s *Server) verifyExternalAccountBinding(data []byte, key crypto.PublicKey) (kid string, err error) {
+	var m struct {
+		Protected string `json:"protected"`
+		Payload   string `json:"payload"`
+		Signature string `json:"signature"`
+	}
+	if err = json.Unmarshal(data, &m); err != nil {
+		return
+	}
+	header, err := base64.RawURLEncoding.DecodeString(m.Protected)
+	if err != nil {
+		return
+	}
+	var h jwsHeader
+	if err = json.Unmarshal(header, &h); err != nil {
+		return
+	}
+	if h.Algorithm != "HS256" || h.URL != s.url("/acme/new-account") {
+		return "", errors.New("invalid external account binding header")
+	}
+	hmacKey, ok := s.externalAccounts[h.KeyID]
+	if !ok {
+		return "", errors.New("unknown external account")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(m.Signature)
+	if err != nil {
+		return
+	}
+	mac := hmac.New(sha256.New, hmacKey)
+	mac.Write([]byte(m.Protected + "." + m.Payload))
+	if !hmac.Equal(mac.Sum(nil), signature) {
+		return "", errors.New("invalid external account binding signature")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(m.Payload)
+	if err != nil {
+		return
+	}
+	bound, err := parseJWK(payload)
+	if err != nil {
+		return
+	}
+	if !publicKeyEqual(bound, key) {
+		return "", errors.New("external account binding key mismatch")
+	}
+	return h.KeyID, nil
+}
+
+func (s *Server) keyChange(w http.ResponseWriter, a *account, msg *jwsMessage) {
+	inner, err := parseJWSData(msg.payload)
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed", err.Error())
+		return
+	}
+	if inner.key == nil || inner.header.URL != msg.header.URL {
+		writeProblem(w, http.StatusBadRequest, "malformed", "invalid inner jws header")
+		return
+	}
+	if err := inner.verify(inner.key); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed", err.Error())
+		return
+	}
+	var req struct {
+		Account string          `json:"account"`
+		OldKey  json.RawMessage `json:"oldKey"`
+	}
+	if err := json.Unmarshal(inner.payload, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed", err.Error())
+		return
+	}
+	oldKey, err := parseJWK(req.OldKey)
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed", err.Error())
+		return
+	}
+	if req.Account != a.url || !publicKeyEqual(oldKey, a.key) {
+		writeProblem(w, http.StatusBadRequest, "malformed", "account or old key mismatch")
+		return
+	}
+	if other := s.accountByKey(inner.key); other != nil {
+		w.Header().Set("Location", other.url)
+		writeProblem(w, http.StatusConflict, "malformed", "key in use")
+		return
+	}
+	a.key = inner.key
+	writeJSON(w, http.StatusOK, accountJSON(a))
+}
+
+func (s *Server) newOrder(w http.ResponseWriter, a *account, msg *jwsMessage) {
+	var req struct {
+		Identifiers []struct {
+			Type  string `json:"type"`
+			Value string `json:"value"`
+		} `json:"identifiers"`
+	}
+	if err := json.Unmarshal(msg.payload, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed", err.Error())
+		return
+	}
+	if len(req.Identifiers) == 0 {
+		writeProblem(w, http.StatusBadRequest, "malformed", "no identifiers")
+		return
+	}
+	o := &order{
+		id:         strconv.Itoa(len(s.orders) + 1),
+		accountURL: a.url,
+		status:     "pending",
+	}
+	for _, i := range req.Identifiers {
+		if i.Type != "dns" {
+			writeProblem(w, http.StatusBadRequest, "unsupportedIdentifier", "unsupported identifier "+i.Type)
+			return
+		}
+		z := &authorization{
+			id:         strconv.Itoa(len(s.authorizations) + 1),
+			accountURL: a.url,
+			name:       strings.TrimPrefix(i.Value, "*."),
+			status:     "pending",
+			token:      s.newNonce(),
+			wildcard:   strings.HasPrefix(i.Value, "*."),
+		}
+		s.authorizations[z.id] = z
+		s.challenges[z.id] = z
+		o.names = append(o.names, i.Value)
+		o.authorizations = append(o.authorizations, z.id)
+	}
+	s.orders[o.id] = o
+	w.Header().Set("Location", s.url("/acme/order/"+o.id))
+	s.writeOrder(w, http.StatusCreated, o)
+}
+
+// offers returns true if the challenge type is offered for the
+// authorization.
+func (z *authorization) offers(challengeType string) bool {
+	switch challengeType {
+	case "http-01", "tls-alpn-01":
+		return !z.wildcard
+	case "dns-01":
+		return true
+	}
+	return false
+}
+
+// challengeTypes returns types of challenges offered for the authorization.
+func (z *authorization) challengeTypes() (types []string) {
+	for _, t := range []string{"http-01", "dns-01", "tls-alpn-01"} {
+		if z.offers(t) {
+			types = append(types, t)
+		}
+	}
+	return
+}
+
+func (s *Server) validate(a *account, z *authorization, challengeType string) {
+	if z.status != "pending" {
+		return
+	}
+	z.status = "valid"
+	z.validated = challengeType
+	thumbprint, err := jwkThumbprint(a.key)
+	if err != nil {
+		z.status = "invalid"
+		z.problem = &problem{Type: "urn:ietf:params:acme:error:serverInternal", Detail: err.Error(), Status: http.StatusInternalServerError}
+		return
+	}
+	keyAuthorization := z.token + "." + thumbprint
+	switch challengeType {
+	case "http-01":
+		if s.validator == nil {
+			return
+		}
+		v, err := s.validator(z.name, z.token)
+		if err != nil {
+			z.status = "invalid"
+			z.problem = &problem{Type: "urn:ietf:params:acme:error:connection", Detail: err.Error(), Status: http.StatusBadRequest}
+			return
+		}
+		if v != keyAuthorization {
+			z.status = "invalid"
+			z.problem = &problem{Type: "urn:ietf:params:acme:error:unauthorized", Detail: "key authorization mismatch", Status: http.StatusForbidden}
+		}
+	case "dns-01":
+		if s.dns01Validator == nil {
+			return
+		}
+		values, err := s.dns01Validator("_acme-challenge." + z.name)
+		if err != nil {
+			z.status = "invalid"
+			z.problem = &problem{Type: "urn:ietf:params:acme:error:dns", Detail: err.Error(), Status: http.StatusBadRequest}
+			return
+		}
+		h := sha256.Sum256([]byte(keyAuthorization))
+		expected := base64.RawURLEncoding.EncodeToString(h[:])
+		for _, v := range values {
+			if v == expected {
+				return
+			}
+		}
+		z.status = "invalid"
+		z.problem = &problem{Type: "urn:ietf:params:acme:error:unauthorized", Detail: "no matching txt record found", Status: http.StatusForbidden}
+	case "tls-alpn-01":
+		if s.tlsALPN01Validator == nil {
+			return
+		}
+		cert, err := s.tlsALPN01Validator(z.name)
+		if err != nil {
+			z.status = "invalid"
+			z.problem = &problem{Type: "urn:ietf:params:acme:error:tls", Detail: err.Error(), Status: http.StatusBadRequest}
+			return
+		}
+		if err := verifyTLSALPN01Certificate(cert, z.name, keyAuthorization); err != nil {
+			z.status = "invalid"
+			z.problem = &problem{Type: "urn:ietf:params:acme:error:unauthorized", Detail: err.Error(), Status: http.StatusForbidden}
+		}
+	}
+}
+
+// verifyTLSALPN01Certificate checks that the certificate is issued only
+// for the domain and that it holds the digest of the key authorization.
+func verifyTLSALPN01Certificate(cert *x509.Certificate, domain, keyAuthorization string) error {
+	if len(cert.DNSNames) != 1 || !strings.EqualFold(cert.DNSNames[0], domain) {
+		return fmt.Errorf("certificate names %v, expected %s", cert.DNSNames, domain)
+	}
+	for _, e := range cert.Extensions {
+		if !e.Id.Equal(idPeACMEIdentifier) {
+			continue
+		}
+		if !e.Critical {
+			return errors.New("acmeIdentifier extension not critical")
+		}
+		var digest []byte
+		if _, err := asn1.Unmarshal(e.Value, &digest); err != nil {
+			return fmt.Errorf("acmeIdentifier extension: %s", err)
+		}
+		h := sha256.Sum256([]byte(keyAuthorization))
+		if !hmac.Equal(digest, h[:]) {
+			return errors.New("key authorization mismatch")
+		}
+		return nil
+	}
+	return errors.New("acmeIdentifier extension not found")
+}
+
+func (s *Server) orderStatus(o *order) string {
+	if o.status != "pending" {
+		return o.status
+	}
+	for _, id := range o.authorizations {
+		switch s.authorizations[id].status {
+		case "invalid":
+			o.status = "invalid"
+			o.problem = s.authorizations[id].problem
+			return o.status
+		case "pending":
+			return o.status
+		}
+	}
+	o.status = "ready"
+	return o.status
+}
+
+func (s *Server) finalize(w http.ResponseWriter, o *order, msg *jwsMessage) {
+	if s.orderStatus(o) != "ready" {
+		writeProblem(w, http.StatusForbidden, "orderNotReady", "order is "+o.status)
+		return
+	}
+	var req struct {
+		CSR string `json:"csr"`
+	}
+	if err := json.Unmarshal(msg.payload, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed", err.Error())
+		return
+	}
+	der, err := base64.RawURLEncoding.DecodeString(req.CSR)
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, "badCSR", err.Error())
+		return
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, "badCSR", err.Error())
+		return
+	}
+	if err := csr.CheckSignature(); err != nil {
+		writeProblem(w, http.StatusBadRequest, "badCSR", err.Error())
+		return
+	}
+	if strings.Join(csr.DNSNames, ",") != strings.Join(o.names, ",") {
+		writeProblem(w, http.StatusBadRequest, "badCSR", "csr names do not match order identifiers")
+		return
+	}
+	s.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(s.serial),
+		Subject:      pkix.Name{CommonName: o.names[0]},
+		DNSNames:     o.names,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, s.caCert, csr.PublicKey, s.caKey)
+	if err != nil {
+		writeProblem(w, http.StatusInternalServerError, "serverInternal", err.Error())
+		return
+	}
+	chain := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})
+	chain = append(chain, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.caCert.Raw})...)
+	s.certificates[o.id] = chain
+	if s.asyncIssuance {
+		o.status = "processing"
+		s.writeOrder(w, http.StatusOK, o)
+		o.status = "valid"
+		o.certificate = s.url("/acme/cert/" + o.id)
+		return
+	}
+	o.status = "valid"
+	o.certificate = s.url("/acme/cert/" + o.id)
+	w.Header().Set("Location", s.url("/acme/order/"+o.id))
+	s.writeOrder(w, http.StatusOK, o)
+}
+
+func (s *Server) writeOrder(w http.ResponseWriter, status int, o *order) {
+	v := map[string]interface{}{
+		"status":   s.orderStatus(o),
+		"finalize": s.url("/acme/finalize/" + o.id),
+	}
+	var identifiers []map[string]string
+	var authorizations []string
+	for i, name := range o.names {
+		identifiers = append(identifiers, map[string]string{"type": "dns", "value": name})
+		authorizations = append(authorizations, s.url("/acme/authz/"+o.authorizations[i]))
+	}
+	v["identifiers"] = identifiers
+	v["authorizations"] = authorizations
+	if o.certificate != "" {
+		v["certificate"] = o.certificate
+	}
+	if o.problem != nil {
+		v["error"] = o.problem
+	}
+	writeJSON(w, status, v)
+}
+
+func (s *Server) challengeJSON(z *authorization, challengeType string) map[string]interface{} {
+	path := "/acme/chall/" + z.id
+	if challengeType != "http-01" {
+		path += "/" + challengeType
+	}
+	status := "pending"
+	if z.status != "pending" && (z.validated == "" || z.validated == challengeType) {
+		status = z.status
+	}
+	v := map[string]interface{}{
+		"type":   challengeType,
+		"url":    s.url(path),
+		"status": status,
+		"token":  z.token,
+	}
+	if z.problem != nil && z.validated == challengeType {
+		v["error"] = z.problem
+	}
+	return v
+}
+
+func (s *Server) authorizationJSON(z *authorization) map[string]interface{} {
+	challenges := []interface{}{}
+	for _, t := range z.challengeTypes() {
+		challenges = append(challenges, s.challengeJSON(z, t))
+	}
+	v := map[string]interface{}{
+		"status":     z.status,
+		"identifier": map[string]string{"type": "dns", "value": z.name},
+		"challenges": challenges,
+	}
+	if z.wildcard {
+		v["wildcard"] = true
+	}
+	return v
+}
+
+func accountJSON(a *account) map[string]interface{} {
+	return map[string]interface{}{
+		"status":  "valid",
+		"contact": a.contact,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeProblem(w http.ResponseWriter, status int, acmeType, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(problem{
+		Type:   "urn:ietf:params:acme:error:" + acmeType,
+		Detail: detail,
+		Status: status,
+	})
+}
+
+type jwsHeader struct {
+	Algorithm string          `json:"alg"`
+	KeyID     string          `json:"kid"`
+	JWK       json.RawMessage `json:"jwk"`
+	Nonce     string          `json:"nonce"`
+	URL       string          `json:"url"`
+}
+
+type jwsMessage struct {
+	header    jwsHeader
+	key       crypto.PublicKey
+	payload   []byte
+	signed    []byte
+	signature []byte
+}
+
+func parseJWS(r *http.Request) (*jwsMessage, error) {
+	if r.Header.Get("Content-Type") != "application/jose+json" {
+		return nil, errors.New("invalid content type")
+	}
+	var data json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+	return parseJWSData(data)
+}
+
+func parseJWSData(data []byte) (*jwsMessage, error) {
+	var m struct {
+		Protected string `json:"protected"`
+		Payload   string `json:"payload"`
+		Signature string `json:"signature"`
+	}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	header, err := base64.RawURLEncoding.DecodeString(m.Protected)
+	if err != nil {
+		return nil, err
+	}
+	msg := &jwsMessage{
+		signed: []byte(m.Protected + "." + m.Payload),
+	}
+	if err := json.Unmarshal(header, &msg.header); err != nil {
+		return nil, err
+	}
+	if msg.payload, err = base64.RawURLEncoding.DecodeString(m.Payload); err != nil {
+		return nil, err
+	}
+	if msg.signature, err = base64.RawURLEncoding.DecodeString(m.Signature); err != nil {
+		return nil, err
+	}
+	if len(msg.header.JWK) > 0 {
+		if msg.header.KeyID != "" {
+			return nil, errors.New("both jwk and kid are set")
+		}
+		if msg.key, err = parseJWK(msg.header.JWK); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+func (m *jwsMessage) verify(key crypto.PublicKey) error {
+	var hash crypto.Hash
+	switch m.header.Algorithm {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "ES384":
+		hash = crypto.SHA384
+	case "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported algorithm %s", m.header.Algorithm)
+	}
+	var digest []byte
+	switch hash {
+	case crypto.SHA256:
+		sum := sha256.Sum256(m.signed)
+		digest = sum[:]
+	case crypto.SHA384:
+		sum := sha512.Sum384(m.signed)
+		digest = sum[:]
+	default:
+		sum := sha512.Sum512(m.signed)
+		digest = sum[:]
+	}
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if m.header.Algorithm != "RS256" {
+			return errors.New("algorithm key mismatch")
+		}
+		return rsa.VerifyPKCS1v15(key, hash, digest, m.signature)
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if !strings.HasPrefix(m.header.Algorithm, "ES") || len(m.signature) != 2*size {
+			return errors.New("invalid ecdsa signature")
+		}
+		r := new(big.Int).SetBytes(m.signature[:size])
+		s := new(big.Int).SetBytes(m.signature[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return errors.New("invalid ecdsa signature")
+		}
+		return nil
+	}
+	return errors.New("unsupported key")
+}
+
+type jwk struct {
+	Curve    string `json:"crv,omitempty"`
+	Exponent string `json:"e,omitempty"`
+	KeyType  string `json:"kty"`
+	Modulus  string `json:"n,omitempty"`
+	X        string `json:"x,omitempty"`
+	Y        string `json:"y,omitempty"`
+}
+
+func parseJWK(data []byte) (crypto.PublicKey, error) {
+	var k jwk
+	if err := json.Unmarshal(data, &k); err != nil {
+		return nil, err
+	}
+	decode := func(s string) (*big.Int, error) {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetBytes(b), nil
+	}
+	switch k.KeyType {
+	case "RSA":
+		n, err := decode(k.Modulus)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.Exponent)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Curve)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %s", k.KeyType)
+}
+
+func jwkThumbprint(key crypto.PublicKey) (string, error) {
+	var k jwk
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		k = jwk{
+			KeyType:  "RSA",
+			Exponent: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			Modulus:  base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		k = jwk{
+			KeyType: "EC",
+			Curve:   key.Curve.Params().Name,
+			X:       base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, size))),
+			Y:       base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, size))),
+		}
+	default:
+		return "", errors.New("unsupported key")
+	}
+	data, err := json.Marshal(k)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+func publicKeyEqual(a, b crypto.PublicKey) bool {
+	switch a := a.(type) {
+	case *rsa.PublicKey:
+		b, ok := b.(*rsa.PublicKey)
+		return ok && a.E == b.E && a.N.Cmp(b.N) == 0
+	case *ecdsa.PublicKey:
+		b, ok := b.(*ecdsa.PublicKey)
+		return ok && a.Curve == b.Curve && a.X.Cmp(b.X) == 0 && a.Y.Cmp(b.Y) == 0
+	}
+	return false
+}
@@ -0,0 +1,310 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acmetest
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"sync"
+	"testing"
+
+	"golang.org/x/crypto/acme"
+)
+
+// challenges is a storage of http-01 challenge responses that is used
+// by the test server for validation.
+type challenges struct {
+	mu   sync.Mutex
+	data map[string]string
+}
+
+func (c *challenges) set(domain, token, keyAuthorization string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data[domain+"/"+token] = keyAuthorization
+}
+
+func (c *challenges) validate(domain, token string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.data[domain+"/"+token]
+	if !ok {
+		return "", errors.New("challenge response not found")
+	}
+	return v, nil
+}
+
+func newTestServer(t *testing.T) (*Server, *challenges) {
+	c := &challenges{data: map[string]string{}}
+	s, err := NewServer(c.validate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, c
+}
+
+func newECDSAKey(t *testing.T) crypto.Signer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func newRSAKey(t *testing.T) crypto.Signer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func isProblem(err error, acmeType string) bool {
+	e, ok := err.(*acme.Error)
+	return ok && e.ProblemType == "urn:ietf:params:acme:error:"+acmeType
+}
+
+// obtain issues a certificate for domains, responding to http-01
+// challenges.
+func obtain(ctx context.Context, t *testing.T, client *acme.Client, c *challenges, domains ...string) (*x509.Certificate, error) {
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(domains...))
+	if err != nil {
+		return nil, err
+	}
+	for _, url := range order.AuthzURLs {
+		authz, err := client.GetAuthorization(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+		if authz.Status == acme.StatusValid {
+			continue
+		}
+		var challenge *acme.Challenge
+		for _, ch := range authz.Challenges {
+			if ch.Type == "http-01" {
+				challenge = ch
+				break
+			}
+		}
+		if challenge == nil {
+			t.Fatalf("no http-01 challenge for %s", authz.Identifier.Value)
+		}
+		keyAuthorization, err := client.HTTP01ChallengeResponse(challenge.Token)
+		if err != nil {
+			return nil, err
+		}
+		c.set(authz.Identifier.Value, challenge.Token, keyAuthorization)
+		if _, err := client.Accept(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if _, err := client.WaitAuthorization(ctx, url); err != nil {
+			return nil, err
+		}
+	}
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: domains[0]},
+		DNSNames: domains,
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der[0])
+}
+
+func TestServer(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		key  func(*testing.T) crypto.Signer
+	}{
+		{name: "ecdsa", key: newECDSAKey},
+		{name: "rsa", key: newRSAKey},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s, c := newTestServer(t)
+			defer s.Close()
+			ctx := context.Background()
+
+			client := &acme.Client{
+				DirectoryURL: s.DirectoryURL(),
+				Key:          tc.key(t),
+			}
+
+			if _, err := client.GetReg(ctx, ""); err != acme.ErrNoAccount {
+				t.Fatalf("got lookup account error %v, expected %v", err, acme.ErrNoAccount)
+			}
+
+			account, err := client.Register(ctx, &acme.Account{
+				Contact: []string{"mailto:admin@example.com"},
+			}, acme.AcceptTOS)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if account.URI == "" {
+				t.Fatal("account url not set")
+			}
+
+			client2 := &acme.Client{
+				DirectoryURL: s.DirectoryURL(),
+				Key:          client.Key,
+			}
+			found, err := client2.GetReg(ctx, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if found.URI != account.URI {
+				t.Errorf("got account url %q, expected %q", found.URI, account.URI)
+			}
+
+			cert, err := obtain(ctx, t, client, c, "example.com", "www.example.com")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := cert.VerifyHostname("www.example.com"); err != nil {
+				t.Error(err)
+			}
+			if err := cert.CheckSignatureFrom(s.CACertificate()); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestServerInvalidChallenge(t *testing.T) {
+	s, err := NewServer(func(domain, token string) (string, error) {
+		return "invalid", nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	ctx := context.Background()
+
+	client := &acme.Client{
+		DirectoryURL: s.DirectoryURL(),
+		Key:          newECDSAKey(t),
+	}
+	if _, err := client.Register(ctx, &acme.Account{}, acme.AcceptTOS); err != nil {
+		t.Fatal(err)
+	}
+	_, err = obtain(ctx, t, client, &challenges{data: map[string]string{}}, "example.com")
+	e, ok := err.(*acme.AuthorizationError)
+	if !ok || len(e.Errors) == 0 || !isProblem(e.Errors[0], "unauthorized") {
+		t.Fatalf("got error %v, expected unauthorized authorization error", err)
+	}
+}
+
+func TestServerExternalAccountBinding(t *testing.T) {
+	s, _ := newTestServer(t)
+	defer s.Close()
+	ctx := context.Background()
+
+	hmacKey := []byte("secret")
+	s.RequireExternalAccount("kid-1", hmacKey)
+
+	client := &acme.Client{
+		DirectoryURL: s.DirectoryURL(),
+		Key:          newECDSAKey(t),
+	}
+	if _, err := client.Register(ctx, &acme.Account{}, acme.AcceptTOS); !isProblem(err, "externalAccountRequired") {
+		t.Fatalf("got error %v, expected externalAccountRequired problem", err)
+	}
+
+	_, err := client.Register(ctx, &acme.Account{
+		ExternalAccountBinding: &acme.ExternalAccountBinding{
+			KID: "kid-1",
+			Key: []byte("wrong"),
+		},
+	}, acme.AcceptTOS)
+	if !isProblem(err, "unauthorized") {
+		t.Fatalf("got error %v, expected unauthorized problem", err)
+	}
+
+	account, err := client.Register(ctx, &acme.Account{
+		ExternalAccountBinding: &acme.ExternalAccountBinding{
+			KID: "kid-1",
+			Key: hmacKey,
+		},
+	}, acme.AcceptTOS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kid := s.AccountExternalKeyID(account.URI); kid != "kid-1" {
+		t.Errorf("got external account key id %q, expected %q", kid, "kid-1")
+	}
+}
+
+func TestServerKeyRollover(t *testing.T) {
+	s, c := newTestServer(t)
+	defer s.Close()
+	ctx := context.Background()
+
+	oldKey := newRSAKey(t)
+	client := &acme.Client{
+		DirectoryURL: s.DirectoryURL(),
+		Key:          oldKey,
+	}
+	account, err := client.Register(ctx, &acme.Account{}, acme.AcceptTOS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newKey := newECDSAKey(t)
+	if err := client.AccountKeyRollover(ctx, newKey); err != nil {
+		t.Fatal(err)
+	}
+	if key, ok := s.AccountKey(account.URI).(*ecdsa.PublicKey); !ok || key.X.Cmp(newKey.Public().(*ecdsa.PublicKey).X) != 0 {
+		t.Error("account key is not replaced on server")
+	}
+
+	if _, err := obtain(ctx, t, client, c, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	old := &acme.Client{
+		DirectoryURL: s.DirectoryURL(),
+		Key:          oldKey,
+	}
+	if _, err := old.GetReg(ctx, ""); err != acme.ErrNoAccount {
+		t.Errorf("got lookup account error %v, expected %v", err, acme.ErrNoAccount)
+	}
+}
+
+func TestServerBadNonce(t *testing.T) {
+	s, _ := newTestServer(t)
+	defer s.Close()
+	ctx := context.Background()
+
+	client := &acme.Client{
+		DirectoryURL: s.DirectoryURL(),
+		Key:          newECDSAKey(t),
+	}
+	if _, err := client.Register(ctx, &acme.Account{}, acme.AcceptTOS); err != nil {
+		t.Fatal(err)
+	}
+	s.InvalidateNonces()
+	if _, err := client.AuthorizeOrder(ctx, acme.DomainIDs("example.com")); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package dnsprovider provides DNS providers that create and remove TXT
+// records for ACME dns-01 challenges.
+package dnsprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Provider creates and removes TXT records. Name is a fully qualified
+// record name, such as _acme-challenge.example.com, and value is the
+// content of the TXT record.
+type Provider interface {
+	Present(ctx context.Context, name, value string) error
+	CleanUp(ctx context.Context, name, value string) error
+}
+
+// Types of providers that can be created with New.
+const (
+	TypeRFC2136 = "rfc2136"
+	TypeExec    = "exec"
+)
+
+// Options holds configuration for any of the supported provider types.
+// Only fields that are relevant to the Type are used. RFC 2136 fields
+// are documented on RFC2136 type and exec hook fields on Exec type.
+type Options struct {
+	Type string
+
+	// RFC 2136 dynamic updates.
+	Nameserver    string
+	Zone          string
+	TSIGKey       string
+	TSIGAlgorithm string
+	TSIGSecret    string
+	TTL           int
+
+	// Exec hook.
+	Command string
+	Args    []string
+
+	// PropagationDelay is the duration to wait after the record is
+	// created before the ACME provider is asked to validate it.
+	PropagationDelay time.Duration
+	// Timeout limits the duration of a single update or command.
+	Timeout time.Duration
+}
+
+// New creates a new Provider from Options.
+func New(o Options) (p Provider, err error) {
+	switch strings.ToLower(o.Type) {
+	case TypeRFC2136:
+		if o.Nameserver == "" {
+			return nil, fmt.Errorf("%s: nameserver not set", TypeRFC2136)
+		}
+		p = &RFC2136{
+			Nameserver:    o.Nameserver,
+			Zone:          o.Zone,
+			TSIGKey:       o.TSIGKey,
+			TSIGAlgorithm: o.TSIGAlgorithm,
+			TSIGSecret:    o.TSIGSecret,
+			TTL:           o.TTL,
+			Timeout:       o.Timeout,
+		}
+	case TypeExec:
+		if o.Command == "" {
+			return nil, fmt.Errorf("%s: command not set", TypeExec)
+		}
+		p = &Exec{
+			Command: o.Command,
+			Args:    o.Args,
+			Timeout: o.Timeout,
+		}
+	default:
+		return nil, fmt.Errorf("unknown provider type %q", o.Type)
+	}
+	if o.PropagationDelay > 0 {
+		p = &delayed{Provider: p, delay: o.PropagationDelay}
+	}
+	return
+}
+
+// delayed waits after the record is presented by the Provider.
+type delayed struct {
+	Provider
+	delay time.Duration
+}
+
+func (p *delayed) Present(ctx context.Context, name, value string) error {
+	if err := p.Provider.Present(ctx, name, value); err != nil {
+		return err
+	}
+	select {
+	case <-time.After(p.delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
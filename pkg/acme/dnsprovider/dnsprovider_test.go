@@ -0,0 +1,176 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dnsprovider
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopherpit.com/gopherpit/pkg/resolver/resolvertest"
+)
+
+const testTSIGSecret = "c2VjcmV0LXNlY3JldC1zZWNyZXQ="
+
+func TestRFC2136(t *testing.T) {
+	server, err := resolvertest.NewTSIGServer(map[string]string{"gopherpit.": testTSIGSecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	server.SetSOA("example.com")
+
+	ctx := context.Background()
+	name := "_acme-challenge.www.example.com"
+
+	for _, tc := range []struct {
+		name     string
+		provider *RFC2136
+		err      string
+	}{
+		{
+			name: "zone lookup",
+			provider: &RFC2136{
+				Nameserver: server.Addr(),
+				TSIGKey:    "gopherpit",
+				TSIGSecret: testTSIGSecret,
+			},
+		},
+		{
+			name: "zone",
+			provider: &RFC2136{
+				Nameserver:    server.Addr(),
+				Zone:          "example.com",
+				TSIGKey:       "gopherpit",
+				TSIGAlgorithm: "hmac-sha256",
+				TSIGSecret:    testTSIGSecret,
+			},
+		},
+		{
+			name: "without tsig",
+			provider: &RFC2136{
+				Nameserver: server.Addr(),
+				Zone:       "example.com",
+			},
+			err: "REFUSED",
+		},
+		{
+			name: "unknown zone",
+			provider: &RFC2136{
+				Nameserver: server.Addr(),
+				Zone:       "example.org",
+				TSIGKey:    "gopherpit",
+				TSIGSecret: testTSIGSecret,
+			},
+			err: "REFUSED",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.provider.Present(ctx, name, "value-1")
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("got error %v, expected %s", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tc.provider.Present(ctx, name, "value-2"); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := server.TXT(name), []string{"value-1", "value-2"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("got txt records %v, expected %v", got, want)
+			}
+			if err := tc.provider.CleanUp(ctx, name, "value-1"); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := server.TXT(name), []string{"value-2"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("got txt records %v, expected %v", got, want)
+			}
+			if err := tc.provider.CleanUp(ctx, name, "value-2"); err != nil {
+				t.Fatal(err)
+			}
+			if got := server.TXT(name); len(got) != 0 {
+				t.Errorf("got txt records %v, expected none", got)
+			}
+		})
+	}
+}
+
+func TestExec(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gopherpit-dnsprovider-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	script := filepath.Join(dir, "hook.sh")
+	log := filepath.Join(dir, "hook.log")
+	if err := ioutil.WriteFile(script, []byte(`#!/bin/sh
+if [ "$3" = "fail" ]; then
+	echo "no such zone" >&2
+	exit 1
+fi
+echo "$@" >> "$1"
+`), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := New(Options{
+		Type:    TypeExec,
+		Command: script,
+		Args:    []string{log},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err := p.Present(ctx, "_acme-challenge.example.com.", "value"); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.CleanUp(ctx, "_acme-challenge.example.com", "value"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := log + " present _acme-challenge.example.com value\n" + log + " cleanup _acme-challenge.example.com value\n"
+	if string(data) != want {
+		t.Errorf("got hook calls %q, expected %q", string(data), want)
+	}
+
+	err = p.Present(ctx, "fail", "value")
+	if err == nil || !strings.Contains(err.Error(), "no such zone") {
+		t.Errorf("got error %v, expected command output", err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		options Options
+		err     bool
+	}{
+		{name: "rfc2136", options: Options{Type: "rfc2136", Nameserver: "127.0.0.1"}},
+		{name: "rfc2136 without nameserver", options: Options{Type: "rfc2136"}, err: true},
+		{name: "exec", options: Options{Type: "exec", Command: "/bin/true"}},
+		{name: "exec without command", options: Options{Type: "exec"}, err: true},
+		{name: "unknown", options: Options{Type: "route53"}, err: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := New(tc.options)
+			if (err != nil) != tc.err {
+				t.Errorf("got error %v, expected error %v", err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dnsprovider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Exec creates and removes TXT records by executing a command. The
+// command is called with Args, followed by the action ("present" or
+// "cleanup"), the record name and its value. It should exit after the
+// record is created or removed, with non-zero status if it failed.
+type Exec struct {
+	Command string
+	Args    []string
+	// Timeout limits the command execution.
+	Timeout time.Duration
+}
+
+// Present executes the command with "present" action.
+func (p *Exec) Present(ctx context.Context, name, value string) error {
+	return p.run(ctx, "present", name, value)
+}
+
+// CleanUp executes the command with "cleanup" action.
+func (p *Exec) CleanUp(ctx context.Context, name, value string) error {
+	return p.run(ctx, "cleanup", name, value)
+}
+
+func (p *Exec) run(ctx context.Context, action, name, value string) error {
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	args := append(append([]string{}, p.Args...), action, strings.TrimSuffix(name, "."), value)
+	cmd := exec.CommandContext(ctx, p.Command, args...)
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Run(); err != nil {
+		if o := strings.TrimSpace(output.String()); o != "" {
+			return fmt.Errorf("%s %s: %s: %s", p.Command, action, err, o)
+		}
+		return fmt.Errorf("%s %s: %s", p.Command, action, err)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dnsprovider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// Defaults for RFC2136 provider.
+const (
+	DefaultTTL     = 60
+	DefaultTimeout = 10 * time.Second
+)
+
+// RFC2136 creates and removes TXT records with DNS UPDATE messages, as
+// specified in RFC 2136, optionally authenticated with TSIG (RFC 2845).
+type RFC2136 struct {
+	// Nameserver is the address of the primary name server, with
+	// optional port.
+	Nameserver string
+	// Zone that contains records. If it is empty, the zone is found
+	// by SOA queries to the Nameserver.
+	Zone string
+	// TSIGKey is the name of the TSIG key. If it is empty, messages
+	// are not signed.
+	TSIGKey string
+	// TSIGAlgorithm is one of hmac-md5, hmac-sha1, hmac-sha256 or
+	// hmac-sha512. Default is hmac-sha256.
+	TSIGAlgorithm string
+	// TSIGSecret is base64 encoded TSIG key secret.
+	TSIGSecret string
+	// TTL of created records in seconds.
+	TTL int
+	// Timeout of a single DNS message exchange.
+	Timeout time.Duration
+}
+
+// Present adds the TXT record.
+func (p *RFC2136) Present(ctx context.Context, name, value string) error {
+	return p.update(ctx, name, value, true)
+}
+
+// CleanUp removes the TXT record.
+func (p *RFC2136) CleanUp(ctx context.Context, name, value string) error {
+	return p.update(ctx, name, value, false)
+}
+
+func (p *RFC2136) update(ctx context.Context, name, value string, insert bool) error {
+	name = dns.Fqdn(name)
+	zone := p.Zone
+	if zone == "" {
+		var err error
+		zone, err = p.findZone(ctx, name)
+		if err != nil {
+			return fmt.Errorf("%s: find zone: %s", name, err)
+		}
+	}
+	ttl := p.TTL
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	rr := &dns.TXT{
+		Hdr: dns.RR_Header{
+			Name:   name,
+			Rrtype: dns.TypeTXT,
+			Class:  dns.ClassINET,
+			Ttl:    uint32(ttl),
+		},
+		Txt: []string{value},
+	}
+	m := &dns.Msg{}
+	m.SetUpdate(dns.Fqdn(zone))
+	if insert {
+		m.Insert([]dns.RR{rr})
+	} else {
+		m.Remove([]dns.RR{rr})
+	}
+	r, err := p.exchange(ctx, m)
+	if err != nil {
+		return fmt.Errorf("%s: update: %s", name, err)
+	}
+	if r.Rcode != dns.RcodeSuccess {
+		return fmt.Errorf("%s: update: %s", name, dns.RcodeToString[r.Rcode])
+	}
+	return nil
+}
+
+// findZone queries SOA records for the name and its parent domains until
+// the name server responds with the SOA record of a zone.
+func (p *RFC2136) findZone(ctx context.Context, name string) (zone string, err error) {
+	labels := dns.SplitDomainName(name)
+	for i := range labels {
+		candidate := dns.Fqdn(strings.Join(labels[i:], "."))
+		m := &dns.Msg{}
+		m.SetQuestion(candidate, dns.TypeSOA)
+		r, err := p.exchange(ctx, m)
+		if err != nil {
+			return "", err
+		}
+		for _, rr := range r.Answer {
+			if soa, ok := rr.(*dns.SOA); ok && strings.EqualFold(soa.Hdr.Name, candidate) {
+				return candidate, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no soa record")
+}
+
+func (p *RFC2136) exchange(ctx context.Context, m *dns.Msg) (r *dns.Msg, err error) {
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	c := &dns.Client{
+		Net:     "tcp",
+		Timeout: timeout,
+	}
+	if p.TSIGKey != "" {
+		key := dns.Fqdn(p.TSIGKey)
+		c.TsigSecret = map[string]string{key: p.TSIGSecret}
+		m.SetTsig(key, tsigAlgorithm(p.TSIGAlgorithm), 300, time.Now().Unix())
+	}
+	r, _, err = c.ExchangeContext(ctx, m, nameserverAddr(p.Nameserver))
+	return
+}
+
+func tsigAlgorithm(a string) string {
+	switch strings.TrimSuffix(strings.ToLower(a), ".") {
+	case "hmac-md5", "hmac-md5.sig-alg.reg.int":
+		return dns.HmacMD5
+	case "hmac-sha1":
+		return dns.HmacSHA1
+	case "hmac-sha512":
+		return dns.HmacSHA512
+	}
+	return dns.HmacSHA256
+}
+
+func nameserverAddr(a string) string {
+	if _, _, err := net.SplitHostPort(a); err != nil {
+		return net.JoinHostPort(a, "53")
+	}
+	return a
+}
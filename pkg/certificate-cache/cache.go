@@ -20,6 +20,9 @@ var ErrCertificateNotFound = errors.New("certificate not found")
 
 type tlsCertificate struct {
 	*tls.Certificate
+	// alt is an alternative certificate for clients that do not support
+	// the primary one.
+	alt *tls.Certificate
 	TTL time.Time
 }
 
@@ -46,6 +49,25 @@ func NewCache(certificateGetter certificate.Getter, ttl, ttlNoCert time.Duration
 // Certificate returns a tls.Certificate from cache or fetches it from
 // certificate service.
 func (cc Cache) Certificate(name string) (c *tls.Certificate, err error) {
+	c, _, err = cc.certificates(name)
+	return
+}
+
+// ClientCertificate returns a tls.Certificate like Certificate, but if
+// the certificate has an alternative certificate, the alternative is
+// returned to clients that do not support the primary certificate.
+func (cc Cache) ClientCertificate(hello *tls.ClientHelloInfo, name string) (c *tls.Certificate, err error) {
+	c, alt, err := cc.certificates(name)
+	if err != nil {
+		return
+	}
+	if alt != nil && hello != nil && hello.SupportsCertificate(c) != nil {
+		c = alt
+	}
+	return
+}
+
+func (cc Cache) certificates(name string) (c, alt *tls.Certificate, err error) {
 	var found bool
 	cc.mu.RLock()
 	cert, found := cc.nameToCertificate[name]
@@ -53,7 +75,7 @@ func (cc Cache) Certificate(name string) (c *tls.Certificate, err error) {
 
 	// Certificate found in cache
 	if found {
-		c = cert.Certificate
+		c, alt = cert.Certificate, cert.alt
 		switch {
 		case time.Now().After(cert.TTL):
 			// Is certificate cache expired
@@ -96,9 +118,18 @@ func (cc Cache) Certificate(name string) (c *tls.Certificate, err error) {
 		err = fmt.Errorf("tls X509KeyPair: %s: %s", name, err)
 		return
 	}
+	if crt.AltCert != "" {
+		alt = &tls.Certificate{}
+		*alt, err = tls.X509KeyPair([]byte(crt.AltCert), []byte(crt.AltKey))
+		if err != nil {
+			err = fmt.Errorf("tls X509KeyPair: %s: alternative certificate: %s", name, err)
+			return nil, nil, err
+		}
+	}
 
 	cc.nameToCertificate[name] = tlsCertificate{
 		Certificate: c,
+		alt:         alt,
 		TTL:         time.Now().Add(cc.ttl),
 	}
 	return
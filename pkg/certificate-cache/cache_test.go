@@ -6,7 +6,16 @@
 package certificateCache
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
+	"math/big"
 	"testing"
 	"time"
 
@@ -182,3 +191,99 @@ func TestCertificateCacheInvalidation(t *testing.T) {
 		t.Errorf("cache for certificate invalidation made %d requests to certificate.Getter instead of 2", getter.getCount)
 	}
 }
+
+// dualCertificateGetter returns certificates with ECDSA primary and RSA
+// alternative certificates.
+type dualCertificateGetter struct {
+	CertificateGetter
+	t *testing.T
+}
+
+func (g *dualCertificateGetter) Certificate(fqdn string) (c *certificate.Certificate, err error) {
+	c, err = g.CertificateGetter.Certificate(fqdn)
+	if err != nil || c == nil {
+		return
+	}
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		g.t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: fqdn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{fqdn},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, ecdsaKey.Public(), ecdsaKey)
+	if err != nil {
+		g.t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(ecdsaKey)
+	if err != nil {
+		g.t.Fatal(err)
+	}
+	return &certificate.Certificate{
+		FQDN:    fqdn,
+		Cert:    string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		Key:     string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+		KeyType: certificate.KeyTypeDual,
+		AltCert: c.Cert,
+		AltKey:  c.Key,
+	}, nil
+}
+
+func TestClientCertificate(t *testing.T) {
+	cache := NewCache(&dualCertificateGetter{t: t}, time.Minute, time.Second)
+
+	for _, tc := range []struct {
+		name  string
+		hello *tls.ClientHelloInfo
+		want  string
+	}{
+		{
+			name:  "no client hello",
+			hello: nil,
+			want:  "ecdsa",
+		},
+		{
+			name: "ecdsa",
+			hello: &tls.ClientHelloInfo{
+				CipherSuites:      []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+				SupportedCurves:   []tls.CurveID{tls.CurveP256},
+				SupportedPoints:   []uint8{0},
+				SignatureSchemes:  []tls.SignatureScheme{tls.ECDSAWithP256AndSHA256, tls.PKCS1WithSHA256},
+				SupportedVersions: []uint16{tls.VersionTLS12},
+			},
+			want: "ecdsa",
+		},
+		{
+			name: "rsa",
+			hello: &tls.ClientHelloInfo{
+				CipherSuites:      []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+				SupportedCurves:   []tls.CurveID{tls.CurveP256},
+				SupportedPoints:   []uint8{0},
+				SignatureSchemes:  []tls.SignatureScheme{tls.PKCS1WithSHA256},
+				SupportedVersions: []uint16{tls.VersionTLS12},
+			},
+			want: "rsa",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := cache.ClientCertificate(tc.hello, "dual.gopherpit.com")
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got string
+			switch c.PrivateKey.(type) {
+			case *ecdsa.PrivateKey:
+				got = "ecdsa"
+			case *rsa.PrivateKey:
+				got = "rsa"
+			}
+			if got != tc.want {
+				t.Errorf("got %s certificate, expected %s", got, tc.want)
+			}
+		})
+	}
+}
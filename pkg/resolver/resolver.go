@@ -0,0 +1,313 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package resolver provides DNS resolvers with configurable upstream
+// servers that are queried over UDP with TCP fallback, or over HTTPS
+// (DNS-over-HTTPS, RFC 8484).
+package resolver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// DefaultTimeout is the timeout for a single query to an upstream server
+// if it is not configured.
+const DefaultTimeout = 5 * time.Second
+
+// ErrNoServers is returned when there are no upstream servers to query.
+var ErrNoServers = errors.New("no servers")
+
+// Resolver exchanges DNS messages with upstream servers.
+type Resolver interface {
+	Exchange(ctx context.Context, m *dns.Msg) (*dns.Msg, error)
+}
+
+// New creates a new Resolver that queries upstreams in order, until
+// one of them responds. Upstreams with https:// scheme are DNS-over-HTTPS
+// endpoints, all other values are DNS server addresses with optional port.
+// If no upstreams are provided, servers from /etc/resolv.conf are used.
+func New(upstreams []string, timeout time.Duration) (Resolver, error) {
+	if len(upstreams) == 0 {
+		return DNS{Timeout: timeout}, nil
+	}
+	resolvers := Chain{}
+	for _, u := range upstreams {
+		switch {
+		case strings.HasPrefix(u, "https://"):
+			resolvers = append(resolvers, DoH{
+				URL:     u,
+				Timeout: timeout,
+			})
+		case strings.Contains(u, "://"):
+			return nil, fmt.Errorf("unsupported upstream %s", u)
+		default:
+			resolvers = append(resolvers, DNS{
+				Servers: []string{u},
+				Timeout: timeout,
+			})
+		}
+	}
+	return resolvers, nil
+}
+
+// Chain is a Resolver that exchanges messages with resolvers in order
+// and returns the first successful response.
+type Chain []Resolver
+
+// Exchange implements Resolver interface.
+func (c Chain) Exchange(ctx context.Context, m *dns.Msg) (in *dns.Msg, err error) {
+	err = ErrNoServers
+	for _, r := range c {
+		in, err = r.Exchange(ctx, m)
+		if err == nil {
+			return
+		}
+	}
+	return
+}
+
+// DNS is a Resolver that sends queries to DNS servers over UDP and
+// retries them over TCP if the response is truncated or UDP query fails.
+type DNS struct {
+	// Servers are addresses of DNS servers, with optional port that
+	// defaults to 53. If no servers are provided, servers from
+	// /etc/resolv.conf are used.
+	Servers []string
+	// Timeout is the timeout for a single query. If it is zero,
+	// DefaultTimeout is used.
+	Timeout time.Duration
+}
+
+// Exchange implements Resolver interface.
+func (r DNS) Exchange(ctx context.Context, m *dns.Msg) (in *dns.Msg, err error) {
+	servers := r.Servers
+	if len(servers) == 0 {
+		conf, err := dns.ClientConfigFromFile("/etc/resolv.conf")
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range conf.Servers {
+			servers = append(servers, net.JoinHostPort(s, conf.Port))
+		}
+	}
+	err = ErrNoServers
+	for _, s := range servers {
+		in, err = r.exchange(ctx, m, serverAddress(s))
+		if err == nil {
+			return
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+	return
+}
+
+func (r DNS) exchange(ctx context.Context, m *dns.Msg, addr string) (in *dns.Msg, err error) {
+	c := &dns.Client{
+		Timeout: r.Timeout,
+	}
+	if c.Timeout == 0 {
+		c.Timeout = DefaultTimeout
+	}
+	in, _, err = c.ExchangeContext(ctx, m, addr)
+	if err == nil && !in.Truncated {
+		return in, nil
+	}
+	c.Net = "tcp"
+	in, _, err = c.ExchangeContext(ctx, m, addr)
+	return
+}
+
+// serverAddress appends the default DNS port to the server address
+// if it does not contain one.
+func serverAddress(s string) string {
+	if _, _, err := net.SplitHostPort(s); err == nil {
+		return s
+	}
+	return net.JoinHostPort(strings.Trim(s, "[]"), "53")
+}
+
+// DoH is a Resolver that sends queries to a DNS-over-HTTPS endpoint
+// with POST requests in DNS wire format.
+type DoH struct {
+	// URL of the DNS-over-HTTPS endpoint, for example
+	// https://cloudflare-dns.com/dns-query.
+	URL string
+	// Timeout is the timeout for a single query. If it is zero,
+	// DefaultTimeout is used.
+	Timeout time.Duration
+	// Client is used for HTTP requests. If it is nil,
+	// http.DefaultClient is used.
+	Client *http.Client
+}
+
+// Exchange implements Resolver interface.
+func (r DoH) Exchange(ctx context.Context, m *dns.Msg) (in *dns.Msg, err error) {
+	// Message ID should be 0 for better HTTP caching.
+	q := m.Copy()
+	q.Id = 0
+	data, err := q.Pack()
+	if err != nil {
+		return
+	}
+
+	timeout := r.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequest("POST", r.URL, bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/dns-message")
+	req.Header.Set("Accept", "application/dns-message")
+
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: http status %d", r.URL, resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/dns-message" {
+		return nil, fmt.Errorf("%s: unsupported content type %s", r.URL, ct)
+	}
+	data, err = ioutil.ReadAll(io.LimitReader(resp.Body, dns.MaxMsgSize))
+	if err != nil {
+		return
+	}
+	in = &dns.Msg{}
+	if err = in.Unpack(data); err != nil {
+		return nil, err
+	}
+	in.Id = m.Id
+	return in, nil
+}
+
+// Authoritative is a Resolver that finds the authoritative name servers
+// for the closest zone of the queried name and sends the query directly
+// to them. It avoids caching of responses by recursive resolvers, which
+// is important when the record has just been created.
+type Authoritative struct {
+	// Resolver is used to find name servers and their addresses.
+	Resolver Resolver
+	// Port of authoritative name servers. If it is blank, port 53 is used.
+	Port string
+	// Timeout is the timeout for a single query. If it is zero,
+	// DefaultTimeout is used.
+	Timeout time.Duration
+}
+
+// Exchange implements Resolver interface.
+func (r Authoritative) Exchange(ctx context.Context, m *dns.Msg) (in *dns.Msg, err error) {
+	if len(m.Question) == 0 {
+		return nil, errors.New("no question")
+	}
+	port := r.Port
+	if port == "" {
+		port = "53"
+	}
+	name := m.Question[0].Name
+	for {
+		nss, err := lookup(ctx, r.Resolver, name, dns.TypeNS)
+		if err != nil {
+			return nil, err
+		}
+		if len(nss) == 0 {
+			index := strings.Index(name, ".")
+			if index < 0 || index+1 >= len(name) {
+				return nil, fmt.Errorf("%s: no name servers", m.Question[0].Name)
+			}
+			name = name[index+1:]
+			continue
+		}
+		servers := []string{}
+		for _, rr := range nss {
+			ns, ok := rr.(*dns.NS)
+			if !ok {
+				continue
+			}
+			addrs, err := lookup(ctx, r.Resolver, ns.Ns, dns.TypeA)
+			if err != nil {
+				return nil, err
+			}
+			for _, rr := range addrs {
+				if a, ok := rr.(*dns.A); ok {
+					servers = append(servers, net.JoinHostPort(a.A.String(), port))
+				}
+			}
+		}
+		if len(servers) == 0 {
+			return nil, fmt.Errorf("%s: no name server addresses", name)
+		}
+		return DNS{
+			Servers: servers,
+			Timeout: r.Timeout,
+		}.Exchange(ctx, m)
+	}
+}
+
+// LookupTXT returns values of all TXT records for the name.
+func LookupTXT(ctx context.Context, r Resolver, name string) (txts []string, err error) {
+	rrs, err := lookup(ctx, r, name, dns.TypeTXT)
+	if err != nil {
+		return
+	}
+	for _, rr := range rrs {
+		if txt, ok := rr.(*dns.TXT); ok {
+			txts = append(txts, strings.Join(txt.Txt, ""))
+		}
+	}
+	return
+}
+
+// lookup returns answer records of type t for the name. Records of other
+// types, like CNAME, are not included in the result. A non-existent name
+// is not an error.
+func lookup(ctx context.Context, r Resolver, name string, t uint16) (rrs []dns.RR, err error) {
+	m := &dns.Msg{}
+	m.SetQuestion(dns.Fqdn(name), t)
+	m.SetEdns0(4096, false)
+	in, err := r.Exchange(ctx, m)
+	if err != nil {
+		return
+	}
+	switch in.Rcode {
+	case dns.RcodeSuccess, dns.RcodeNameError:
+	default:
+		return nil, fmt.Errorf("%s: %s", name, dns.RcodeToString[in.Rcode])
+	}
+	for _, rr := range in.Answer {
+		if rr.Header().Rrtype == t {
+			rrs = append(rrs, rr)
+		}
+	}
+	return
+}
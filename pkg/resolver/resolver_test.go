@@ -0,0 +1,225 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package resolver
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"gopherpit.com/gopherpit/pkg/resolver/resolvertest"
+)
+
+func newTestDNSServer(t *testing.T) *resolvertest.Server {
+	s, err := resolvertest.NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestDNS(t *testing.T) {
+	s := newTestDNSServer(t)
+	defer s.Close()
+
+	s.SetTXT("_gopherpit.example.com", "token1", "token2")
+
+	r := DNS{Servers: []string{s.Addr()}}
+
+	t.Run("udp", func(t *testing.T) {
+		txts, err := LookupTXT(context.Background(), r, "_gopherpit.example.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(txts, ",") != "token1,token2" {
+			t.Errorf("expected %q, got %q", "token1,token2", txts)
+		}
+		if udp, tcp := s.Queries(); udp != 1 || tcp != 0 {
+			t.Errorf("expected 1 udp and 0 tcp queries, got %d and %d", udp, tcp)
+		}
+	})
+
+	t.Run("tcp fallback", func(t *testing.T) {
+		s.TruncateUDP(true)
+		defer s.TruncateUDP(false)
+
+		txts, err := LookupTXT(context.Background(), r, "_gopherpit.example.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(txts, ",") != "token1,token2" {
+			t.Errorf("expected %q, got %q", "token1,token2", txts)
+		}
+		if udp, tcp := s.Queries(); udp != 2 || tcp != 1 {
+			t.Errorf("expected 2 udp and 1 tcp queries, got %d and %d", udp, tcp)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		txts, err := LookupTXT(context.Background(), r, "missing.example.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(txts) != 0 {
+			t.Errorf("expected no records, got %q", txts)
+		}
+	})
+
+	t.Run("next server", func(t *testing.T) {
+		unavailable := newTestDNSServer(t)
+		addr := unavailable.Addr()
+		unavailable.Close()
+
+		r := DNS{
+			Servers: []string{addr, s.Addr()},
+			Timeout: 100 * time.Millisecond,
+		}
+		txts, err := LookupTXT(context.Background(), r, "_gopherpit.example.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(txts) != 2 {
+			t.Errorf("expected 2 records, got %q", txts)
+		}
+	})
+}
+
+func TestDoH(t *testing.T) {
+	s := newTestDNSServer(t)
+	defer s.Close()
+
+	s.SetTXT("_gopherpit.example.com", "token")
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/dns-query" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method != "POST" || r.Header.Get("Content-Type") != "application/dns-message" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		m := &dns.Msg{}
+		if err := m.Unpack(data); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if m.Id != 0 {
+			http.Error(w, "message id is not 0", http.StatusBadRequest)
+			return
+		}
+		in, err := DNS{Servers: []string{s.Addr()}}.Exchange(r.Context(), m)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		data, err = in.Pack()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/dns-message")
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	r := DoH{
+		URL:    ts.URL + "/dns-query",
+		Client: ts.Client(),
+	}
+	txts, err := LookupTXT(context.Background(), r, "_gopherpit.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(txts, ",") != "token" {
+		t.Errorf("expected %q, got %q", "token", txts)
+	}
+
+	r.URL = ts.URL + "/missing"
+	if _, err := LookupTXT(context.Background(), r, "_gopherpit.example.com"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestAuthoritative(t *testing.T) {
+	// Recursive resolver knows only about name servers, and the
+	// authoritative server has the record.
+	recursive := newTestDNSServer(t)
+	defer recursive.Close()
+	authoritative := newTestDNSServer(t)
+	defer authoritative.Close()
+
+	recursive.SetNS("example.com", "ns1.example.com")
+	recursive.SetA("ns1.example.com", "127.0.0.1")
+	authoritative.SetTXT("_gopherpit.sub.example.com", "token")
+
+	r := Authoritative{
+		Resolver: DNS{Servers: []string{recursive.Addr()}},
+		Port:     authoritative.Port(),
+	}
+	txts, err := LookupTXT(context.Background(), r, "_gopherpit.sub.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(txts, ",") != "token" {
+		t.Errorf("expected %q, got %q", "token", txts)
+	}
+
+	if _, err := LookupTXT(context.Background(), r, "_gopherpit.example.org"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestNew(t *testing.T) {
+	s := newTestDNSServer(t)
+	defer s.Close()
+
+	s.SetTXT("_gopherpit.example.com", "token")
+
+	r, err := New([]string{"127.0.0.1:1", s.Addr()}, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txts, err := LookupTXT(context.Background(), r, "_gopherpit.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(txts, ",") != "token" {
+		t.Errorf("expected %q, got %q", "token", txts)
+	}
+
+	if _, err := New([]string{"tls://1.1.1.1"}, 0); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestServerAddress(t *testing.T) {
+	for _, tc := range []struct {
+		in, out string
+	}{
+		{"1.1.1.1", "1.1.1.1:53"},
+		{"1.1.1.1:5353", "1.1.1.1:5353"},
+		{"::1", "[::1]:53"},
+		{"[::1]", "[::1]:53"},
+		{"[::1]:5353", "[::1]:5353"},
+		{"dns.example.com", "dns.example.com:53"},
+	} {
+		if got := serverAddress(tc.in); got != tc.out {
+			t.Errorf("%q: expected %q, got %q", tc.in, tc.out, got)
+		}
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package resolvertest provides a local DNS server for testing
+// DNS lookups without external name servers.
+package resolvertest
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// Server is a DNS server that listens on a random local port for both
+// UDP and TCP queries and responds with records that are set on it.
+// Records in zones that are set with SetSOA can be changed with dynamic
+// updates (RFC 2136).
+type Server struct {
+	addr string
+	udp  *dns.Server
+	tcp  *dns.Server
+
+	mu          sync.RWMutex
+	records     map[string][]dns.RR
+	truncateUDP bool
+	udpQueries  int
+	tcpQueries  int
+	updates     int
+	tsig        map[string]string
+}
+
+// NewServer starts a new Server.
+func NewServer() (s *Server, err error) {
+	return NewTSIGServer(nil)
+}
+
+// NewTSIGServer starts a new Server that accepts only dynamic updates
+// signed with one of TSIG keys. Keys are mapping of fully qualified key
+// names to base64 encoded secrets.
+func NewTSIGServer(keys map[string]string) (s *Server, err error) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		return
+	}
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	if err != nil {
+		pc.Close()
+		return
+	}
+	s = &Server{
+		addr:    pc.LocalAddr().String(),
+		records: map[string][]dns.RR{},
+		tsig:    keys,
+	}
+	s.udp = &dns.Server{PacketConn: pc, Handler: dns.HandlerFunc(s.serveDNS), TsigSecret: keys}
+	s.tcp = &dns.Server{Listener: l, Handler: dns.HandlerFunc(s.serveDNS), TsigSecret: keys}
+
+	var wg sync.WaitGroup
+	for _, srv := range []*dns.Server{s.udp, s.tcp} {
+		wg.Add(1)
+		srv.NotifyStartedFunc = wg.Done
+		go srv.ActivateAndServe()
+	}
+	wg.Wait()
+	return s, nil
+}
+
+// Addr returns the address that the server listens on for both
+// UDP and TCP queries.
+func (s *Server) Addr() string {
+	return s.addr
+}
+
+// Port returns the port that the server listens on.
+func (s *Server) Port() string {
+	_, port, _ := net.SplitHostPort(s.addr)
+	return port
+}
+
+// Close stops the server.
+func (s *Server) Close() error {
+	err := s.udp.Shutdown()
+	if e := s.tcp.Shutdown(); err == nil {
+		err = e
+	}
+	return err
+}
+
+// SetTXT replaces TXT records for the name with provided values.
+func (s *Server) SetTXT(name string, values ...string) {
+	rrs := []dns.RR{}
+	for _, v := range values {
+		rrs = append(rrs, &dns.TXT{
+			Hdr: header(name, dns.TypeTXT),
+			Txt: []string{v},
+		})
+	}
+	s.set(name, dns.TypeTXT, rrs)
+}
+
+// SetNS replaces NS records for the name with provided name servers.
+func (s *Server) SetNS(name string, nss ...string) {
+	rrs := []dns.RR{}
+	for _, ns := range nss {
+		rrs = append(rrs, &dns.NS{
+			Hdr: header(name, dns.TypeNS),
+			Ns:  dns.Fqdn(ns),
+		})
+	}
+	s.set(name, dns.TypeNS, rrs)
+}
+
+// SetA replaces A records for the name with provided IPv4 addresses.
+func (s *Server) SetA(name string, ips ...string) {
+	rrs := []dns.RR{}
+	for _, ip := range ips {
+		rrs = append(rrs, &dns.A{
+			Hdr: header(name, dns.TypeA),
+			A:   net.ParseIP(ip),
+		})
+	}
+	s.set(name, dns.TypeA, rrs)
+}
+
+// SetSOA sets the SOA record for the zone, which allows dynamic updates
+// of records in it.
+func (s *Server) SetSOA(zone string) {
+	s.set(zone, dns.TypeSOA, []dns.RR{&dns.SOA{
+		Hdr:     header(zone, dns.TypeSOA),
+		Ns:      dns.Fqdn("ns." + zone),
+		Mbox:    dns.Fqdn("hostmaster." + zone),
+		Serial:  1,
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  60,
+	}})
+}
+
+// TXT returns values of TXT records for the name.
+func (s *Server) TXT(name string) (values []string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, rr := range s.records[key(name, dns.TypeTXT)] {
+		if txt, ok := rr.(*dns.TXT); ok {
+			values = append(values, strings.Join(txt.Txt, ""))
+		}
+	}
+	return
+}
+
+// Updates returns the number of applied dynamic updates.
+func (s *Server) Updates() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.updates
+}
+
+// TruncateUDP sets whether responses to UDP queries are truncated and
+// without answers, requiring clients to repeat queries over TCP.
+func (s *Server) TruncateUDP(truncate bool) {
+	s.mu.Lock()
+	s.truncateUDP = truncate
+	s.mu.Unlock()
+}
+
+// Queries returns the number of received UDP and TCP queries.
+func (s *Server) Queries() (udp, tcp int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.udpQueries, s.tcpQueries
+}
+
+func (s *Server) set(name string, t uint16, rrs []dns.RR) {
+	s.mu.Lock()
+	s.records[key(name, t)] = rrs
+	s.mu.Unlock()
+}
+
+func (s *Server) serveDNS(w dns.ResponseWriter, r *dns.Msg) {
+	m := &dns.Msg{}
+	m.SetReply(r)
+	m.Authoritative = true
+
+	_, udp := w.RemoteAddr().(*net.UDPAddr)
+
+	s.mu.Lock()
+	if udp {
+		s.udpQueries++
+	} else {
+		s.tcpQueries++
+	}
+	truncate := udp && s.truncateUDP
+	s.mu.Unlock()
+
+	if truncate {
+		m.Truncated = true
+		w.WriteMsg(m)
+		return
+	}
+
+	if r.Opcode == dns.OpcodeUpdate {
+		m.Rcode = s.update(w, r)
+		if t := r.IsTsig(); t != nil && w.TsigStatus() == nil {
+			m.SetTsig(t.Hdr.Name, t.Algorithm, 300, time.Now().Unix())
+		}
+		w.WriteMsg(m)
+		return
+	}
+
+	s.mu.RLock()
+	for _, q := range r.Question {
+		m.Answer = append(m.Answer, s.records[key(q.Name, q.Qtype)]...)
+	}
+	if len(m.Answer) == 0 && len(r.Question) > 0 && !s.exists(r.Question[0].Name) {
+		m.Rcode = dns.RcodeNameError
+	}
+	s.mu.RUnlock()
+
+	w.WriteMsg(m)
+}
+
+// update applies the prerequisite-free dynamic update message and returns
+// the response code.
+func (s *Server) update(w dns.ResponseWriter, r *dns.Msg) int {
+	if s.tsig != nil && (r.IsTsig() == nil || w.TsigStatus() != nil) {
+		return dns.RcodeRefused
+	}
+	if len(r.Question) != 1 {
+		return dns.RcodeFormatError
+	}
+	zone := r.Question[0].Name
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.records[key(zone, dns.TypeSOA)]) == 0 {
+		return dns.RcodeRefused
+	}
+	for _, rr := range r.Ns {
+		if !dns.IsSubDomain(zone, rr.Header().Name) {
+			return dns.RcodeNotZone
+		}
+	}
+	for _, rr := range r.Ns {
+		h := rr.Header()
+		k := key(h.Name, h.Rrtype)
+		switch h.Class {
+		case dns.ClassANY:
+			if h.Rrtype == dns.TypeANY {
+				prefix := strings.ToLower(dns.Fqdn(h.Name)) + " "
+				for k := range s.records {
+					if strings.HasPrefix(k, prefix) {
+						delete(s.records, k)
+					}
+				}
+				continue
+			}
+			delete(s.records, k)
+		case dns.ClassNONE:
+			rrs := []dns.RR{}
+			for _, e := range s.records[k] {
+				if !equalRData(e, rr) {
+					rrs = append(rrs, e)
+				}
+			}
+			s.records[k] = rrs
+		default:
+			exists := false
+			for _, e := range s.records[k] {
+				if equalRData(e, rr) {
+					exists = true
+					break
+				}
+			}
+			if !exists {
+				s.records[k] = append(s.records[k], dns.Copy(rr))
+			}
+		}
+	}
+	s.updates++
+	return dns.RcodeSuccess
+}
+
+// equalRData returns true if records have the same name, type and data,
+// regardless of their class and TTL.
+func equalRData(a, b dns.RR) bool {
+	a, b = dns.Copy(a), dns.Copy(b)
+	for _, rr := range []dns.RR{a, b} {
+		rr.Header().Class = dns.ClassINET
+		rr.Header().Ttl = 0
+		rr.Header().Name = strings.ToLower(rr.Header().Name)
+	}
+	return a.String() == b.String()
+}
+
+// exists returns true if there are any records for the name.
+// It must be called with read lock held.
+func (s *Server) exists(name string) bool {
+	prefix := strings.ToLower(dns.Fqdn(name)) + " "
+	for k, rrs := range s.records {
+		if strings.HasPrefix(k, prefix) && len(rrs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func key(name string, t uint16) string {
+	return strings.ToLower(dns.Fqdn(name)) + " " + dns.TypeToString[t]
+}
+
+func header(name string, t uint16) dns.RR_Header {
+	return dns.RR_Header{
+		Name:   dns.Fqdn(name),
+		Rrtype: t,
+		Class:  dns.ClassINET,
+		Ttl:    60,
+	}
+}
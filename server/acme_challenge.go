@@ -0,0 +1,67 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"time"
+)
+
+// idPeACMEIdentifier is the object identifier of the acmeIdentifier
+// certificate extension that holds the digest of the key authorization,
+// as specified in RFC 8737.
+var idPeACMEIdentifier = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 31}
+
+// tlsALPN01ChallengeCertificate returns a self-signed certificate for the
+// domain that responds to the tls-alpn-01 challenge with the key
+// authorization. Challenge certificates are constructed from the stored
+// key authorization, as the ACME account key is available only to the
+// certificate service.
+func tlsALPN01ChallengeCertificate(domain, keyAuthorization string) (cert tls.Certificate, err error) {
+	h := sha256.Sum256([]byte(keyAuthorization))
+	value, err := asn1.Marshal(h[:])
+	if err != nil {
+		return
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "ACME TLS-ALPN-01 challenge"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		DNSNames:     []string{domain},
+		ExtraExtensions: []pkix.Extension{
+			{
+				Id:       idPeACMEIdentifier,
+				Critical: true,
+				Value:    value,
+			},
+		},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return
+	}
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}, nil
+}
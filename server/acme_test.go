@@ -0,0 +1,850 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	"resenje.org/logging"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/pkg/acme/acmetest"
+	"gopherpit.com/gopherpit/pkg/acme/dnsprovider"
+	"gopherpit.com/gopherpit/pkg/resolver/resolvertest"
+	"gopherpit.com/gopherpit/services/certificate"
+	boltCertificate "gopherpit.com/gopherpit/services/certificate/bolt"
+	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+func newTestCertificateService(t *testing.T, directoryURL string) *boltCertificate.Service {
+	logger, err := logging.GetLogger("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "gopherpit-test-certificate-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := boltCertificate.NewDB(filepath.Join(dir, "certificate.db"), 0666, nil)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return &boltCertificate.Service{
+		DB:                      db,
+		DefaultACMEDirectoryURL: directoryURL,
+		Logger:                  logger,
+	}
+}
+
+func removeTestCertificateService(s *boltCertificate.Service) {
+	s.DB.Close()
+	os.RemoveAll(filepath.Dir(s.DB.Path()))
+}
+
+func TestACME(t *testing.T) {
+	// ACME server validates http-01 challenges by requesting them from
+	// the test server with the domain in the Host header.
+	var httpAddr string
+	acmeServer, err := acmetest.NewServer(func(domain, token string) (string, error) {
+		r, err := http.NewRequest("GET", "http://"+httpAddr+"/.well-known/acme-challenge/"+token, nil)
+		if err != nil {
+			return "", err
+		}
+		r.Host = domain
+		resp, err := http.DefaultClient.Do(r)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("challenge response status %s", resp.Status)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		return strings.TrimSpace(string(body)), err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer acmeServer.Close()
+
+	certificateService := newTestCertificateService(t, acmeServer.DirectoryURL())
+	defer removeTestCertificateService(certificateService)
+
+	s, err := newTestServer(map[string]interface{}{
+		"CertificateService": certificateService,
+		"ACMEDirectoryURL":   acmeServer.DirectoryURL(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+	httpAddr = s.servers.Addr("HTTP").String()
+
+	_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+	if err != nil {
+		t.Fatalf("parse IPv4 net: %s", err)
+	}
+	clients := map[string]*api.Client{}
+	for _, username := range []string{"alice", "admin"} {
+		email := username + "@localhost.loc"
+		admin := username == "admin"
+		u, err := s.UserService.CreateUser(&user.Options{
+			Email:    &email,
+			Username: &username,
+			Admin:    &admin,
+		})
+		if err != nil {
+			t.Fatalf("create user: %s", err)
+		}
+		k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+			AuthorizedNetworks: &[]net.IPNet{*ipV4Net},
+		})
+		if err != nil {
+			t.Fatalf("create key: %s", err)
+		}
+		clients[username] = api.NewClientWithEndpoint("localhost:"+strconv.Itoa(s.servers.Addr("HTTP").Port)+"/api/v1", k.Secret)
+	}
+	c := clients["admin"]
+
+	t.Run("acme user not found", func(t *testing.T) {
+		if _, err := c.ACMEUser(); err != api.ErrACMEUserNotFound {
+			t.Fatalf("got error %v, expected %v", err, api.ErrACMEUserNotFound)
+		}
+	})
+
+	// ACME user registered with ACME v1 has PKCS #1 encoded RSA key and
+	// the same account at the ACME v2 provider.
+	v1Key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accountURL := acmeServer.AddAccount(v1Key.Public(), "mailto:admin@localhost.loc")
+	v1Record, err := json.Marshal(map[string]interface{}{
+		"id":            7,
+		"email":         "admin@localhost.loc",
+		"private-key":   x509.MarshalPKCS1PrivateKey(v1Key),
+		"url":           "https://acme.localhost/acme/reg/7",
+		"new-authz-url": "https://acme.localhost/acme/new-authz",
+		"directory-url": acmeServer.DirectoryURL(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	acmeUserFilename := filepath.Join(filepath.Dir(certificateService.DB.Path()), "acme-user.json")
+	if err := ioutil.WriteFile(acmeUserFilename, v1Record, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("acme v1 user", func(t *testing.T) {
+		u, err := c.ACMEUser()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.ID != 7 || u.URL != "https://acme.localhost/acme/reg/7" {
+			t.Errorf("got acme user %#v, expected not migrated user", u)
+		}
+	})
+
+	t.Run("obtain certificate with migration", func(t *testing.T) {
+		crt, err := certificateService.ObtainCertificate("acme.trusted.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if crt.ExpirationTime == nil {
+			t.Error("certificate expiration time not set")
+		}
+		if crt.ACMEAccount != accountURL {
+			t.Errorf("got certificate acme account %q, expected %q", crt.ACMEAccount, accountURL)
+		}
+		if _, err := certificateService.ACMEChallenge("acme.trusted.com"); err != certificate.ErrACMEChallengeNotFound {
+			t.Errorf("got acme challenge error %v, expected %v", err, certificate.ErrACMEChallengeNotFound)
+		}
+
+		u, err := c.ACMEUser()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.URL != accountURL {
+			t.Errorf("got acme user url %q, expected %q", u.URL, accountURL)
+		}
+		if _, err := os.Stat(acmeUserFilename + ".v1"); err != nil {
+			t.Errorf("acme v1 user backup: %s", err)
+		}
+	})
+
+	t.Run("key rollover", func(t *testing.T) {
+		if _, err := clients["alice"].RolloverACMEUserKey(); err != api.ErrForbidden {
+			t.Fatalf("got error %v, expected %v", err, api.ErrForbidden)
+		}
+
+		u, err := c.RolloverACMEUserKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.URL != accountURL {
+			t.Errorf("got acme user url %q, expected %q", u.URL, accountURL)
+		}
+		if _, ok := acmeServer.AccountKey(accountURL).(*ecdsa.PublicKey); !ok {
+			t.Errorf("got account key %T, expected ecdsa key", acmeServer.AccountKey(accountURL))
+		}
+
+		crt, err := certificateService.ObtainCertificate("acme.trusted.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if crt.ACMEAccount != accountURL {
+			t.Errorf("got certificate acme account %q, expected %q", crt.ACMEAccount, accountURL)
+		}
+	})
+
+	t.Run("obtain certificate with async issuance", func(t *testing.T) {
+		acmeServer.SetAsyncIssuance(true)
+		defer acmeServer.SetAsyncIssuance(false)
+
+		crt, err := certificateService.ObtainCertificate("async.trusted.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if crt.ExpirationTime == nil {
+			t.Error("certificate expiration time not set")
+		}
+		if crt.ACMEURL == "" {
+			t.Error("certificate acme url not set")
+		}
+	})
+}
+
+func TestACMERegisterUser(t *testing.T) {
+	acmeServer, err := acmetest.NewServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer acmeServer.Close()
+	acmeServer.RequireExternalAccount("kid-1", []byte("secret"))
+
+	certificateService := newTestCertificateService(t, acmeServer.DirectoryURL())
+	defer removeTestCertificateService(certificateService)
+
+	for _, tc := range []struct {
+		name string
+		eab  *certificate.ExternalAccountBinding
+		err  error
+	}{
+		{
+			name: "eab required",
+			err:  certificate.ErrACMEUserEABRequired,
+		},
+		{
+			name: "eab invalid encoding",
+			eab:  &certificate.ExternalAccountBinding{KeyID: "kid-1", HMACKey: "!"},
+			err:  certificate.ErrACMEUserEABInvalid,
+		},
+		{
+			name: "eab invalid key",
+			eab:  &certificate.ExternalAccountBinding{KeyID: "kid-1", HMACKey: "c2VjcmV0Mg"},
+			err:  certificate.ErrACMEUserEABInvalid,
+		},
+		{
+			name: "eab",
+			eab:  &certificate.ExternalAccountBinding{KeyID: "kid-1", HMACKey: "c2VjcmV0"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			u, err := certificateService.RegisterACMEUser("", "admin@localhost.loc", tc.eab)
+			if err != tc.err {
+				t.Fatalf("got error %v, expected %v", err, tc.err)
+			}
+			if err != nil {
+				return
+			}
+			if u.ExternalAccountKeyID != "kid-1" {
+				t.Errorf("got external account key id %q, expected %q", u.ExternalAccountKeyID, "kid-1")
+			}
+			if kid := acmeServer.AccountExternalKeyID(u.URL); kid != "kid-1" {
+				t.Errorf("got server external account key id %q, expected %q", kid, "kid-1")
+			}
+			if u.DirectoryURL != acmeServer.DirectoryURL() {
+				t.Errorf("got directory url %q, expected %q", u.DirectoryURL, acmeServer.DirectoryURL())
+			}
+		})
+	}
+}
+
+func TestACMEDNS01(t *testing.T) {
+	dnsServer, err := resolvertest.NewTSIGServer(map[string]string{"gopherpit.": "c2VjcmV0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dnsServer.Close()
+	dnsServer.SetSOA("trusted.com")
+
+	acmeServer, err := acmetest.NewServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer acmeServer.Close()
+	acmeServer.SetDNS01Validator(func(name string) ([]string, error) {
+		return dnsServer.TXT(name), nil
+	})
+
+	certificateService := newTestCertificateService(t, acmeServer.DirectoryURL())
+	defer removeTestCertificateService(certificateService)
+	certificateService.DNSProviders = map[string]dnsprovider.Provider{
+		"ns1": &dnsprovider.RFC2136{
+			Nameserver: dnsServer.Addr(),
+			TSIGKey:    "gopherpit",
+			TSIGSecret: "c2VjcmV0",
+		},
+	}
+	if _, err := certificateService.RegisterACMEUser("", "admin@localhost.loc", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := newTestServer(map[string]interface{}{
+		"CertificateService": certificateService,
+		"ACMEDirectoryURL":   acmeServer.DirectoryURL(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+	if err != nil {
+		t.Fatalf("parse IPv4 net: %s", err)
+	}
+	clients := map[string]*api.Client{}
+	for _, username := range []string{"alice", "bob"} {
+		email := username + "@localhost.loc"
+		u, err := s.UserService.CreateUser(&user.Options{
+			Email:    &email,
+			Username: &username,
+		})
+		if err != nil {
+			t.Fatalf("create user: %s", err)
+		}
+		k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+			AuthorizedNetworks: &[]net.IPNet{*ipV4Net},
+		})
+		if err != nil {
+			t.Fatalf("create key: %s", err)
+		}
+		clients[username] = api.NewClientWithEndpoint("localhost:"+strconv.Itoa(s.servers.Addr("HTTP").Port)+"/api/v1", k.Secret)
+	}
+	c := clients["alice"]
+
+	fqdn := "*.teams.trusted.com"
+	if _, err := c.AddDomain(&api.DomainOptions{FQDN: &fqdn}); err != nil {
+		t.Fatalf("add domain: %s", err)
+	}
+
+	t.Run("dns providers", func(t *testing.T) {
+		providers, err := c.DNSProviders()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(providers.Names) != 1 || providers.Names[0] != "ns1" {
+			t.Errorf("got dns providers %v, expected [ns1]", providers.Names)
+		}
+	})
+
+	t.Run("wildcard without dns provider", func(t *testing.T) {
+		if _, err := certificateService.ObtainCertificate(fqdn); err != certificate.ErrDNSProviderRequired {
+			t.Fatalf("got error %v, expected %v", err, certificate.ErrDNSProviderRequired)
+		}
+	})
+
+	tlsConfig, err := newTLSConfig(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host := "team.teams.trusted.com"
+	s.ListenTLS = "127.0.0.1:443"
+
+	t.Run("wildcard host without dns provider", func(t *testing.T) {
+		if _, err := tlsConfig.GetCertificate(&tls.ClientHelloInfo{ServerName: host}); err == nil {
+			t.Error("got certificate for wildcard host without wildcard certificate")
+		}
+		for _, name := range []string{host, fqdn} {
+			if _, err := certificateService.Certificate(name); err != certificate.ErrCertificateNotFound {
+				t.Errorf("%s: got error %v, expected %v", name, err, certificate.ErrCertificateNotFound)
+			}
+		}
+	})
+
+	t.Run("acme settings", func(t *testing.T) {
+		settings, err := c.DomainACMESettings(fqdn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if settings.DNSProvider != "" {
+			t.Errorf("got dns provider %q, expected none", settings.DNSProvider)
+		}
+
+		provider := "ns1"
+		if _, err := clients["bob"].UpdateDomainACMESettings(fqdn, &api.ACMESettingsOptions{DNSProvider: &provider}); err != api.ErrForbidden {
+			t.Errorf("got error %v, expected %v", err, api.ErrForbidden)
+		}
+		unknown := "ns2"
+		if _, err := c.UpdateDomainACMESettings(fqdn, &api.ACMESettingsOptions{DNSProvider: &unknown}); err != api.ErrDNSProviderNotFound {
+			t.Errorf("got error %v, expected %v", err, api.ErrDNSProviderNotFound)
+		}
+		settings, err = c.UpdateDomainACMESettings(fqdn, &api.ACMESettingsOptions{DNSProvider: &provider})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if settings.DNSProvider != provider {
+			t.Errorf("got dns provider %q, expected %q", settings.DNSProvider, provider)
+		}
+	})
+
+	t.Run("obtain wildcard certificate", func(t *testing.T) {
+		crt, err := certificateService.ObtainCertificate(fqdn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if crt.ExpirationTime == nil {
+			t.Error("certificate expiration time not set")
+		}
+		if dnsServer.Updates() == 0 {
+			t.Error("dns records not updated")
+		}
+		if got := dnsServer.TXT("_acme-challenge.teams.trusted.com"); len(got) != 0 {
+			t.Errorf("got txt records %v after clean up, expected none", got)
+		}
+	})
+
+	t.Run("wildcard host", func(t *testing.T) {
+		s.certificateCache.InvalidateCertificate(fqdn)
+		crt, err := tlsConfig.GetCertificate(&tls.ClientHelloInfo{ServerName: host})
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaf, err := x509.ParseCertificate(crt.Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := leaf.VerifyHostname(host); err != nil {
+			t.Error(err)
+		}
+		if len(leaf.DNSNames) != 1 || leaf.DNSNames[0] != fqdn {
+			t.Errorf("got certificate dns names %v, expected [%s]", leaf.DNSNames, fqdn)
+		}
+		if _, err := certificateService.Certificate(host); err != certificate.ErrCertificateNotFound {
+			t.Errorf("got error %v, expected %v", err, certificate.ErrCertificateNotFound)
+		}
+	})
+}
+
+func TestACMETLSALPN01(t *testing.T) {
+	// ACME server validates tls-alpn-01 challenges with TLS handshakes
+	// on the test server TLS listener.
+	var tlsAddr string
+	acmeServer, err := acmetest.NewServer(func(domain, token string) (string, error) {
+		return "", errors.New("http-01 challenge not expected")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer acmeServer.Close()
+	acmeServer.SetTLSALPN01Validator(func(domain string) (*x509.Certificate, error) {
+		conn, err := tls.Dial("tcp", tlsAddr, &tls.Config{
+			ServerName:         domain,
+			NextProtos:         []string{acme.ALPNProto},
+			InsecureSkipVerify: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		if p := conn.ConnectionState().NegotiatedProtocol; p != acme.ALPNProto {
+			return nil, fmt.Errorf("negotiated protocol %q", p)
+		}
+		return conn.ConnectionState().PeerCertificates[0], nil
+	})
+
+	certificateService := newTestCertificateService(t, acmeServer.DirectoryURL())
+	defer removeTestCertificateService(certificateService)
+	certificateService.ChallengeType = certificate.ChallengeTypeTLSALPN01
+	if _, err := certificateService.RegisterACMEUser("", "admin@localhost.loc", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := newTestServer(map[string]interface{}{
+		"ListenTLS":          "127.0.0.1:",
+		"CertificateService": certificateService,
+		"ACMEDirectoryURL":   acmeServer.DirectoryURL(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+	deadline := time.Now().Add(5 * time.Second)
+	for s.servers.Addr("TLS HTTP") == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout waiting for TLS HTTP server address")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	tlsAddr = s.servers.Addr("TLS HTTP").String()
+
+	crt, err := certificateService.ObtainCertificate("acme.trusted.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crt.ExpirationTime == nil {
+		t.Error("certificate expiration time not set")
+	}
+	if _, err := certificateService.ACMEChallenge("acme.trusted.com"); err != certificate.ErrACMEChallengeNotFound {
+		t.Errorf("got acme challenge error %v, expected %v", err, certificate.ErrACMEChallengeNotFound)
+	}
+	if _, err := tls.Dial("tcp", tlsAddr, &tls.Config{
+		ServerName:         "acme.trusted.com",
+		NextProtos:         []string{acme.ALPNProto},
+		InsecureSkipVerify: true,
+	}); err == nil {
+		t.Error("tls-alpn-01 handshake succeeded without acme challenge")
+	}
+}
+
+func TestACMEKeyTypes(t *testing.T) {
+	acmeServer, err := acmetest.NewServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer acmeServer.Close()
+
+	certificateService := newTestCertificateService(t, acmeServer.DirectoryURL())
+	defer removeTestCertificateService(certificateService)
+	certificateService.KeyType = certificate.KeyTypeP384
+	if _, err := certificateService.RegisterACMEUser("", "admin@localhost.loc", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := newTestServer(map[string]interface{}{
+		"CertificateService": certificateService,
+		"ACMEDirectoryURL":   acmeServer.DirectoryURL(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+	if err != nil {
+		t.Fatalf("parse IPv4 net: %s", err)
+	}
+	username := "alice"
+	email := "alice@localhost.loc"
+	u, err := s.UserService.CreateUser(&user.Options{
+		Email:    &email,
+		Username: &username,
+	})
+	if err != nil {
+		t.Fatalf("create user: %s", err)
+	}
+	k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+		AuthorizedNetworks: &[]net.IPNet{*ipV4Net},
+	})
+	if err != nil {
+		t.Fatalf("create key: %s", err)
+	}
+	c := api.NewClientWithEndpoint("localhost:"+strconv.Itoa(s.servers.Addr("HTTP").Port)+"/api/v1", k.Secret)
+
+	fqdn := "keys.trusted.com"
+	if _, err := c.AddDomain(&api.DomainOptions{FQDN: &fqdn}); err != nil {
+		t.Fatalf("add domain: %s", err)
+	}
+
+	t.Run("invalid key type", func(t *testing.T) {
+		keyType := api.KeyType("dsa")
+		if _, err := c.UpdateDomainACMESettings(fqdn, &api.ACMESettingsOptions{KeyType: &keyType}); err != api.ErrKeyTypeInvalid {
+			t.Errorf("got error %v, expected %v", err, api.ErrKeyTypeInvalid)
+		}
+	})
+
+	for _, tc := range []struct {
+		keyType api.KeyType
+		want    string
+		alt     bool
+	}{
+		{keyType: "", want: "ecdsa P-384"},
+		{keyType: api.KeyTypeP256, want: "ecdsa P-256"},
+		{keyType: api.KeyTypeRSA2048, want: "rsa 2048"},
+		{keyType: api.KeyTypeRSA4096, want: "rsa 4096"},
+		{keyType: api.KeyTypeDual, want: "ecdsa P-256", alt: true},
+	} {
+		t.Run("key type "+string(tc.keyType), func(t *testing.T) {
+			keyType := tc.keyType
+			settings, err := c.UpdateDomainACMESettings(fqdn, &api.ACMESettingsOptions{KeyType: &keyType})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if settings.KeyType != tc.keyType {
+				t.Errorf("got key type %q, expected %q", settings.KeyType, tc.keyType)
+			}
+
+			crt, err := certificateService.ObtainCertificate(fqdn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cert, err := tls.X509KeyPair([]byte(crt.Cert), []byte(crt.Key))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := testKeyDescription(cert.PrivateKey); got != tc.want {
+				t.Errorf("got key %s, expected %s", got, tc.want)
+			}
+			if !tc.alt {
+				if crt.AltCert != "" {
+					t.Error("got alternative certificate")
+				}
+				return
+			}
+			alt, err := tls.X509KeyPair([]byte(crt.AltCert), []byte(crt.AltKey))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := testKeyDescription(alt.PrivateKey); got != "rsa 2048" {
+				t.Errorf("got alternative key %s, expected rsa 2048", got)
+			}
+		})
+	}
+}
+
+func TestACMERenewal(t *testing.T) {
+	acmeServer, err := acmetest.NewServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer acmeServer.Close()
+
+	certificateService := newTestCertificateService(t, acmeServer.DirectoryURL())
+	defer removeTestCertificateService(certificateService)
+	if _, err := certificateService.RegisterACMEUser("", "admin@localhost.loc", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := newTestServer(map[string]interface{}{
+		"CertificateService": certificateService,
+		"ACMEDirectoryURL":   acmeServer.DirectoryURL(),
+		"ListenTLS":          "127.0.0.1:",
+		"TrustedDomains":     []string{"example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+	if err != nil {
+		t.Fatalf("parse IPv4 net: %s", err)
+	}
+	username := "alice"
+	email := "alice@localhost.loc"
+	u, err := s.UserService.CreateUser(&user.Options{
+		Email:    &email,
+		Username: &username,
+	})
+	if err != nil {
+		t.Fatalf("create user: %s", err)
+	}
+	k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+		AuthorizedNetworks: &[]net.IPNet{*ipV4Net},
+	})
+	if err != nil {
+		t.Fatalf("create key: %s", err)
+	}
+	c := api.NewClientWithEndpoint("localhost:"+strconv.Itoa(s.servers.Addr("HTTP").Port)+"/api/v1", k.Secret)
+
+	// Subdomains of the example domain do not resolve, so that every
+	// renewal fails on the dns lookup.
+	fqdn := "renewal.example.com"
+	if _, err := c.AddDomain(&api.DomainOptions{FQDN: &fqdn}); err != nil {
+		t.Fatalf("add domain: %s", err)
+	}
+
+	t.Run("certificate not found", func(t *testing.T) {
+		if _, err := c.DomainCertificate(fqdn); err != api.ErrCertificateNotFound {
+			t.Errorf("got error %v, expected %v", err, api.ErrCertificateNotFound)
+		}
+		if _, err := c.RenewDomainCertificate(fqdn); err != api.ErrCertificateNotFound {
+			t.Errorf("got error %v, expected %v", err, api.ErrCertificateNotFound)
+		}
+	})
+
+	obtained, err := certificateService.ObtainCertificate(fqdn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crt, err := c.DomainCertificate(fqdn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crt.ExpirationTime == nil || !crt.ExpirationTime.Equal(*obtained.ExpirationTime) {
+		t.Errorf("got expiration time %v, expected %v", crt.ExpirationTime, obtained.ExpirationTime)
+	}
+	if crt.RenewalAttemptTime != nil {
+		t.Errorf("got renewal attempt time %v, expected none", crt.RenewalAttemptTime)
+	}
+
+	// Forced renewals are not delayed by the backoff after failures.
+	for failures := 1; failures <= 2; failures++ {
+		if _, err := c.RenewDomainCertificate(fqdn); err != nil {
+			t.Fatal(err)
+		}
+		deadline := time.Now().Add(10 * time.Second)
+		for {
+			crt, err = c.DomainCertificate(fqdn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if crt.RenewalFailures == failures {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("got renewal failures %v, expected %v", crt.RenewalFailures, failures)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		if crt.RenewalAttemptTime == nil {
+			t.Error("got no renewal attempt time")
+		}
+		if !strings.HasPrefix(crt.RenewalError, "lookup dns host: ") {
+			t.Errorf("got renewal error %q, expected dns lookup error", crt.RenewalError)
+		}
+		if !crt.ExpirationTime.Equal(*obtained.ExpirationTime) {
+			t.Errorf("got expiration time %v, expected %v", crt.ExpirationTime, obtained.ExpirationTime)
+		}
+	}
+}
+
+func testKeyDescription(key crypto.PrivateKey) string {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		return "ecdsa " + k.Curve.Params().Name
+	case *rsa.PrivateKey:
+		return "rsa " + strconv.Itoa(k.N.BitLen())
+	}
+	return fmt.Sprintf("%T", key)
+}
+
+// TestACMEPebble issues certificates from a Pebble instance
+// (https://github.com/letsencrypt/pebble) if its directory URL is set in
+// ACME_PEBBLE_DIRECTORY_URL environment variable. Pebble root certificate
+// is trusted only by the ACME client if its PEM file is set in ACME_PEBBLE_CA.
+// Challenges are not served, so Pebble must run with
+// PEBBLE_VA_ALWAYS_VALID=1, for example:
+//
+//	PEBBLE_VA_ALWAYS_VALID=1 PEBBLE_VA_NOSLEEP=1 pebble -config test/config/pebble-config.json
+//	ACME_PEBBLE_CA=test/certs/pebble.minica.pem ACME_PEBBLE_DIRECTORY_URL=https://localhost:14000/dir go test -run Pebble
+//
+// If Pebble requires external account binding, key identifier and MAC key
+// can be set in ACME_PEBBLE_EAB_KID and ACME_PEBBLE_EAB_HMAC_KEY.
+func TestACMEPebble(t *testing.T) {
+	directoryURL := os.Getenv("ACME_PEBBLE_DIRECTORY_URL")
+	if directoryURL == "" {
+		t.Skip("ACME_PEBBLE_DIRECTORY_URL is not set")
+	}
+
+	certificateService := newTestCertificateService(t, directoryURL)
+	defer removeTestCertificateService(certificateService)
+
+	if caFile := os.Getenv("ACME_PEBBLE_CA"); caFile != "" {
+		ca, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			t.Fatalf("no certificates in %s", caFile)
+		}
+		certificateService.HTTPClient = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					RootCAs: pool,
+				},
+			},
+		}
+	}
+
+	var eab *certificate.ExternalAccountBinding
+	if kid := os.Getenv("ACME_PEBBLE_EAB_KID"); kid != "" {
+		eab = &certificate.ExternalAccountBinding{
+			KeyID:   kid,
+			HMACKey: os.Getenv("ACME_PEBBLE_EAB_HMAC_KEY"),
+		}
+	}
+	u, err := certificateService.RegisterACMEUser("", "admin@gopherpit.example.com", eab)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, keyType := range []string{certificate.KeyTypeDual, certificate.KeyTypeRSA2048} {
+		t.Run("obtain certificate "+keyType, func(t *testing.T) {
+			certificateService.KeyType = keyType
+			crt, err := certificateService.ObtainCertificate("pebble.gopherpit.example.com")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if crt.ACMEAccount != u.URL {
+				t.Errorf("got certificate acme account %q, expected %q", crt.ACMEAccount, u.URL)
+			}
+			cert, err := tls.X509KeyPair([]byte(crt.Cert), []byte(crt.Key))
+			if err != nil {
+				t.Fatal(err)
+			}
+			leaf, err := x509.ParseCertificate(cert.Certificate[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := leaf.VerifyHostname("pebble.gopherpit.example.com"); err != nil {
+				t.Error(err)
+			}
+			if (keyType == certificate.KeyTypeDual) != (crt.AltCert != "") {
+				t.Errorf("got alternative certificate %v for key type %s", crt.AltCert != "", keyType)
+			}
+		})
+	}
+
+	t.Run("key rollover", func(t *testing.T) {
+		ru, err := certificateService.RolloverACMEUserKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ru.URL != u.URL {
+			t.Errorf("got acme user url %q, expected %q", ru.URL, u.URL)
+		}
+		crt, err := certificateService.ObtainCertificate("pebble.gopherpit.example.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if crt.ACMEAccount != u.URL {
+			t.Errorf("got certificate acme account %q, expected %q", crt.ACMEAccount, u.URL)
+		}
+	})
+}
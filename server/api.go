@@ -9,7 +9,10 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	throttled "gopkg.in/throttled/throttled.v2"
 	"resenje.org/jsonresponse"
 
 	"gopherpit.com/gopherpit/api"
@@ -22,11 +25,83 @@ func packagesDomainToAPIDomain(d packages.Domain) api.Domain {
 		ID:                d.ID,
 		FQDN:              d.FQDN,
 		OwnerUserID:       d.OwnerUserID,
+		OrganizationID:    d.OrganizationID,
 		CertificateIgnore: d.CertificateIgnore,
 		Disabled:          d.Disabled,
+		PackageDefaults:   packagesPackageDefaultsToAPIPackageDefaults(d.PackageDefaults),
+		Aliases:           d.Aliases,
+		AliasRedirect:     d.AliasRedirect,
 	}
 }
 
+func packagesPackageDefaultsToAPIPackageDefaults(d *packages.PackageDefaults) *api.PackageDefaults {
+	if d == nil {
+		return nil
+	}
+	return &api.PackageDefaults{
+		VCS:         api.VCS(d.VCS),
+		RepoRoot:    d.RepoRoot,
+		RefType:     api.RefType(d.RefType),
+		RefName:     d.RefName,
+		GoSource:    d.GoSource,
+		RedirectURL: d.RedirectURL,
+	}
+}
+
+// apiPackageDefaultsFields maps API package defaults field names to
+// packages service field names.
+var apiPackageDefaultsFields = map[api.PackageDefaultsField]packages.PackageDefaultsField{
+	api.PackageDefaultsFieldVCS:         packages.PackageDefaultsFieldVCS,
+	api.PackageDefaultsFieldRepoRoot:    packages.PackageDefaultsFieldRepoRoot,
+	api.PackageDefaultsFieldRef:         packages.PackageDefaultsFieldRef,
+	api.PackageDefaultsFieldGoSource:    packages.PackageDefaultsFieldGoSource,
+	api.PackageDefaultsFieldRedirectURL: packages.PackageDefaultsFieldRedirectURL,
+}
+
+func packagesDomainUsersToAPIDomainUsers(u packages.DomainUsers) api.DomainUsers {
+	users := api.DomainUsers{
+		OwnerUserID:    u.OwnerUserID,
+		OrganizationID: u.OrganizationID,
+		UserIDs:        u.UserIDs,
+	}
+	if u.Roles != nil {
+		users.Roles = map[string]api.DomainRole{}
+		for id, role := range u.Roles {
+			users.Roles[id] = api.DomainRole(role)
+		}
+	}
+	return users
+}
+
+func packagesDomainTransferToAPIDomainTransfer(t packages.DomainTransfer) api.DomainTransfer {
+	return api.DomainTransfer{
+		DomainID:       t.DomainID,
+		FQDN:           t.FQDN,
+		FromUserID:     t.FromUserID,
+		ToUserID:       t.ToUserID,
+		Time:           t.Time,
+		ExpirationTime: t.ExpirationTime,
+	}
+}
+
+func packagesOrganizationToAPIOrganization(o packages.Organization) api.Organization {
+	return api.Organization{
+		ID:   o.ID,
+		Name: o.Name,
+	}
+}
+
+func packagesOrganizationMembersToAPIOrganizationMembers(m packages.OrganizationMembers) api.OrganizationMembers {
+	members := api.OrganizationMembers{
+		UserIDs: m.UserIDs,
+		Roles:   map[string]api.OrganizationRole{},
+	}
+	for id, role := range m.Roles {
+		members.Roles[id] = api.OrganizationRole(role)
+	}
+	return members
+}
+
 func packagesPackageToAPIPackage(p packages.Package, d *packages.Domain) api.Package {
 	if d == nil {
 		d = p.Domain
@@ -46,37 +121,209 @@ func packagesPackageToAPIPackage(p packages.Package, d *packages.Domain) api.Pac
 	}
 }
 
+// packagesPlanToAPIPackagesPlan converts packages synchronization plan to
+// the API response, where actions and field names are in the API format.
+func packagesPlanToAPIPackagesPlan(plan packages.PackagesPlan) api.PackagesPlan {
+	actions := map[packages.Action]api.PackageAction{
+		packages.ActionAddPackage:    api.PackageActionAdd,
+		packages.ActionUpdatePackage: api.PackageActionUpdate,
+		packages.ActionDeletePackage: api.PackageActionDelete,
+	}
+	response := api.PackagesPlan{
+		Changes: []api.PackageChange{},
+		Applied: plan.Applied,
+	}
+	for _, c := range plan.Changes {
+		change := api.PackageChange{
+			Action:  actions[c.Action],
+			Package: packagesPackageToAPIPackage(c.Package, plan.Domain),
+		}
+		for _, fc := range c.Changes {
+			change.Changes = append(change.Changes, api.FieldChange{
+				Field: strings.Replace(fc.Field, "-", "_", -1),
+				From:  fc.From,
+				To:    fc.To,
+			})
+		}
+		response.Changes = append(response.Changes, change)
+	}
+	return response
+}
+
+// revisionETag returns a strong entity tag that represents
+// a revision of a domain or a package.
+func revisionETag(revision uint64) string {
+	return `"` + strconv.FormatUint(revision, 10) + `"`
+}
+
+// ifMatchRevision returns a revision from the If-Match HTTP request header.
+// Returned revision is nil if the header is not set or if its value is "*".
+// Only a single entity tag is supported and if the header value is not
+// a valid revision entity tag, ok is false.
+func ifMatchRevision(r *http.Request) (revision *uint64, ok bool) {
+	return parseRevisionETag(r.Header.Get("If-Match"))
+}
+
+// parseRevisionETag returns a revision from an entity tag constructed by
+// revisionETag. Returned revision is nil if the value is blank or "*".
+func parseRevisionETag(v string) (revision *uint64, ok bool) {
+	v = strings.TrimSpace(v)
+	if v == "" || v == "*" {
+		return nil, true
+	}
+	if len(v) < 2 || v[0] != '"' || v[len(v)-1] != '"' {
+		return nil, false
+	}
+	rev, err := strconv.ParseUint(v[1:len(v)-1], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &rev, true
+}
+
+// apiRateLimits returns hourly rate limits for write and read API
+// requests of a user. Limit overrides configured for the user take
+// precedence over default limits. Zero limit means that requests are
+// not limited.
+func (s *Server) apiRateLimits(userID string) (limit, readLimit int, err error) {
+	limit, readLimit = s.APIHourlyWriteRateLimit, s.APIHourlyReadRateLimit
+	l, err := s.KeyService.RateLimits(userID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if l.HourlyLimit != 0 {
+		limit = l.HourlyLimit
+	}
+	if l.HourlyReadLimit != 0 {
+		readLimit = l.HourlyReadLimit
+	}
+	if limit < 0 {
+		limit = 0
+	}
+	if readLimit < 0 {
+		readLimit = 0
+	}
+	return
+}
+
+// apiRateLimiter returns a rate limiter that allows limit requests per
+// hour. Rate limiters are created on first use and shared between
+// users with the same limit.
+func (s *Server) apiRateLimiter(limit int) (*throttled.GCRARateLimiter, error) {
+	s.apiRateLimitersMu.Lock()
+	defer s.apiRateLimitersMu.Unlock()
+
+	if l, ok := s.apiRateLimiters[limit]; ok {
+		return l, nil
+	}
+	l, err := throttled.NewGCRARateLimiter(
+		s.GCRAStoreService,
+		throttled.RateQuota{
+			MaxRate:  throttled.PerHour(limit),
+			MaxBurst: limit - 1,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.apiRateLimiters[limit] = l
+	return l, nil
+}
+
+// jsonAPIRateLimiterHandler limits the number of API requests per user.
+// GET requests are limited separately from all other requests. Rate
+// limit headers are set on every response of a limited request.
 func (s *Server) jsonAPIRateLimiterHandler(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if s.APIHourlyRateLimit > 0 {
-			var u *user.User
-			var err error
-			u, r, err = s.getRequestUser(r)
-			if err != nil {
-				panic(err)
-			}
-			limited, result, err := s.apiRateLimiter.RateLimit(fmt.Sprintf("userID:%s", u.ID), 1)
-			if err != nil {
-				s.Logger.Errorf("api rate limiter: rate limit: %s", err)
-				jsonresponse.InternalServerError(w, nil)
-				return
-			}
-			if result.Limit > 0 {
-				w.Header().Set("X-Ratelimit-Limit", strconv.Itoa(result.Limit))
-				w.Header().Set("X-Ratelimit-Remaining", strconv.Itoa(result.Remaining))
-				if result.ResetAfter > 0 {
-					w.Header().Set("X-Ratelimit-Reset", fmt.Sprintf("%f", result.ResetAfter.Seconds()))
-				}
-				if result.RetryAfter > 0 {
-					w.Header().Set("X-Ratelimit-Retry", fmt.Sprintf("%f", result.RetryAfter.Seconds()))
-				}
-			}
-			if limited {
-				s.Logger.Warningf("api rate limiter: blocked %s: retry after %s", u.ID, result.RetryAfter)
-				jsonresponse.BadRequest(w, api.ErrTooManyRequests)
-				return
-			}
+		var u *user.User
+		var err error
+		u, r, err = s.getRequestUser(r)
+		if err != nil {
+			panic(err)
+		}
+		if u == nil {
+			h.ServeHTTP(w, r)
+			return
+		}
+		limit, readLimit, err := s.apiRateLimits(u.ID)
+		if err != nil {
+			s.Logger.Errorf("api rate limiter: user %s: rate limits: %s", u.ID, err)
+			jsonresponse.InternalServerError(w, nil)
+			return
+		}
+		rateLimiterKey := "write:userID:" + u.ID
+		if r.Method == "GET" || r.Method == "HEAD" {
+			limit = readLimit
+			rateLimiterKey = "read:userID:" + u.ID
+		}
+		if !s.apiRateLimit(w, u.ID, rateLimiterKey, limit) {
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// jsonAPIDomainRateLimiterHandler limits the number of requests per user
+// that add or update domains and batch requests with APIHourlyRateLimit,
+// in addition to limits of all API requests.
+func (s *Server) jsonAPIDomainRateLimiterHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var u *user.User
+		var err error
+		u, r, err = s.getRequestUser(r)
+		if err != nil {
+			panic(err)
+		}
+		if u != nil && !s.apiRateLimit(w, u.ID, "userID:"+u.ID, s.APIHourlyRateLimit) {
+			return
 		}
 		h.ServeHTTP(w, r)
 	})
 }
+
+// apiRateLimit counts the request under the rate limiter key with the
+// hourly limit and sets rate limit headers. If the request is not allowed,
+// the Too Many Requests response is written and false is returned. Zero
+// limit means that requests are not limited.
+func (s *Server) apiRateLimit(w http.ResponseWriter, userID, rateLimiterKey string, limit int) bool {
+	if limit <= 0 {
+		return true
+	}
+	rateLimiter, err := s.apiRateLimiter(limit)
+	if err != nil {
+		s.Logger.Errorf("api rate limiter: user %s: %s", userID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return false
+	}
+	limited, result, err := rateLimiter.RateLimit(rateLimiterKey, 1)
+	if err != nil {
+		s.Logger.Errorf("api rate limiter: rate limit: %s", err)
+		jsonresponse.InternalServerError(w, nil)
+		return false
+	}
+	if result.Limit > 0 {
+		w.Header().Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+		w.Header().Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		w.Header().Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(result.ResetAfter)))
+		w.Header().Set("X-Ratelimit-Limit", strconv.Itoa(result.Limit))
+		w.Header().Set("X-Ratelimit-Remaining", strconv.Itoa(result.Remaining))
+		if result.ResetAfter > 0 {
+			w.Header().Set("X-Ratelimit-Reset", fmt.Sprintf("%f", result.ResetAfter.Seconds()))
+		}
+		if result.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter)))
+			w.Header().Set("X-Ratelimit-Retry", fmt.Sprintf("%f", result.RetryAfter.Seconds()))
+		}
+	}
+	if limited {
+		s.Logger.Warningf("api rate limiter: blocked %s: retry after %s", userID, result.RetryAfter)
+		jsonresponse.TooManyRequests(w, api.ErrTooManyRequests)
+		return false
+	}
+	return true
+}
+
+// ceilSeconds returns the duration in whole seconds, rounded up.
+func ceilSeconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
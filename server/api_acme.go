@@ -0,0 +1,382 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"resenje.org/jsonresponse"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/certificate"
+	"gopherpit.com/gopherpit/services/packages"
+)
+
+func certificateACMEUserToAPIACMEUser(u certificate.ACMEUser) api.ACMEUser {
+	return api.ACMEUser{
+		ID:                   u.ID,
+		Email:                u.Email,
+		URL:                  u.URL,
+		DirectoryURL:         u.DirectoryURL,
+		ExternalAccountKeyID: u.ExternalAccountKeyID,
+	}
+}
+
+func (s *Server) acmeUserAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	if !u.Admin {
+		s.Logger.Warningf("acme user api: user %s: not an admin", u.ID)
+		jsonresponse.Forbidden(w, nil)
+		return
+	}
+
+	au, err := s.CertificateService.ACMEUser()
+	if err != nil {
+		if err == certificate.ErrACMEUserNotFound {
+			s.Logger.Warningf("acme user api: user %s: acme user: %s", u.ID, err)
+			jsonresponse.BadRequest(w, api.ErrACMEUserNotFound)
+			return
+		}
+		s.Logger.Errorf("acme user api: user %s: acme user: %s", u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	jsonresponse.OK(w, certificateACMEUserToAPIACMEUser(*au))
+}
+
+func (s *Server) rolloverACMEUserKeyAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	if !u.Admin {
+		s.Logger.Warningf("acme user key rollover api: user %s: not an admin", u.ID)
+		jsonresponse.Forbidden(w, nil)
+		return
+	}
+
+	au, err := s.CertificateService.RolloverACMEUserKey()
+	if err != nil {
+		if err == certificate.ErrACMEUserNotFound {
+			s.Logger.Warningf("acme user key rollover api: user %s: rollover acme user key: %s", u.ID, err)
+			jsonresponse.BadRequest(w, api.ErrACMEUserNotFound)
+			return
+		}
+		s.Logger.Errorf("acme user key rollover api: user %s: rollover acme user key: %s", u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	s.auditf(r, nil, "acme user key rollover", "%d: %s", au.ID, au.URL)
+
+	jsonresponse.OK(w, certificateACMEUserToAPIACMEUser(*au))
+}
+
+func (s *Server) dnsProvidersAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	names, err := s.CertificateService.ACMEDNSProviders()
+	if err != nil {
+		s.Logger.Errorf("dns providers api: user %s: dns providers: %s", u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	jsonresponse.OK(w, api.DNSProviders{
+		Names: names,
+	})
+}
+
+// domainACMESettingsDomain returns the domain from the request path if
+// the user has the required role.
+func (s *Server) domainACMESettingsDomain(w http.ResponseWriter, r *http.Request, userID string, ownerOnly bool) (domain *packages.Domain, ok bool) {
+	id := mux.Vars(r)["id"]
+
+	domain, err := s.PackagesService.Domain(id)
+	if err != nil {
+		if err == packages.ErrDomainNotFound {
+			s.Logger.Warningf("domain acme settings api: domain %s: user %s: %s", id, userID, err)
+			jsonresponse.BadRequest(w, api.ErrDomainNotFound)
+			return
+		}
+		s.Logger.Errorf("domain acme settings api: domain %s: user %s: %s", id, userID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	role, err := s.PackagesService.DomainUserRole(domain.ID, userID)
+	if err != nil {
+		if err == packages.ErrDomainNotFound {
+			s.Logger.Warningf("domain acme settings api: domain user role %s: user %s: %s", id, userID, err)
+			jsonresponse.BadRequest(w, api.ErrDomainNotFound)
+			return
+		}
+		s.Logger.Errorf("domain acme settings api: domain user role %s: user %s: %s", id, userID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+	if role == "" || (ownerOnly && role != packages.DomainRoleOwner) {
+		s.Logger.Warningf("domain acme settings api: domain %s: user %s: role %q", id, userID, role)
+		jsonresponse.Forbidden(w, nil)
+		return
+	}
+	return domain, true
+}
+
+func (s *Server) domainACMESettingsAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	domain, ok := s.domainACMESettingsDomain(w, r, u.ID, false)
+	if !ok {
+		return
+	}
+
+	settings, err := s.CertificateService.ACMESettings(domain.FQDN)
+	switch err {
+	case nil:
+	case certificate.ErrACMESettingsNotFound:
+		settings = &certificate.ACMESettings{FQDN: domain.FQDN}
+	default:
+		s.Logger.Errorf("domain acme settings api: domain %s: user %s: acme settings: %s", domain.FQDN, u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	jsonresponse.OK(w, api.ACMESettings{
+		DNSProvider: settings.DNSProvider,
+		KeyType:     api.KeyType(settings.KeyType),
+	})
+}
+
+func (s *Server) updateDomainACMESettingsAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	request := api.ACMESettingsOptions{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.Logger.Warningf("update domain acme settings api: user %s: request decode: %s", u.ID, err)
+		jsonresponse.BadRequest(w, nil)
+		return
+	}
+
+	domain, ok := s.domainACMESettingsDomain(w, r, u.ID, true)
+	if !ok {
+		return
+	}
+
+	previous, err := s.CertificateService.ACMESettings(domain.FQDN)
+	switch err {
+	case nil:
+	case certificate.ErrACMESettingsNotFound:
+		previous = &certificate.ACMESettings{FQDN: domain.FQDN}
+	default:
+		s.Logger.Errorf("update domain acme settings api: domain %s: user %s: acme settings: %s", domain.FQDN, u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	o := &certificate.ACMESettingsOptions{
+		DNSProvider: request.DNSProvider,
+	}
+	if request.KeyType != nil {
+		keyType := string(*request.KeyType)
+		o.KeyType = &keyType
+	}
+	settings, err := s.CertificateService.UpdateACMESettings(domain.FQDN, o)
+	if err != nil {
+		switch err {
+		case certificate.ErrDNSProviderNotFound:
+			s.Logger.Warningf("update domain acme settings api: domain %s: user %s: %s", domain.FQDN, u.ID, err)
+			jsonresponse.BadRequest(w, api.ErrDNSProviderNotFound)
+			return
+		case certificate.ErrKeyTypeInvalid:
+			s.Logger.Warningf("update domain acme settings api: domain %s: user %s: %s", domain.FQDN, u.ID, err)
+			jsonresponse.BadRequest(w, api.ErrKeyTypeInvalid)
+			return
+		}
+		s.Logger.Errorf("update domain acme settings api: domain %s: user %s: %s", domain.FQDN, u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	s.auditf(r, request, "domain acme settings update", "%s: %s: dns provider %q: key type %q", domain.ID, domain.FQDN, settings.DNSProvider, settings.KeyType)
+
+	s.obtainWildcardDomainCertificate(domain, settings, u.ID)
+	if settings.KeyType != previous.KeyType {
+		s.reissueDomainCertificate(domain, u.ID)
+	}
+
+	jsonresponse.OK(w, api.ACMESettings{
+		DNSProvider: settings.DNSProvider,
+		KeyType:     api.KeyType(settings.KeyType),
+	})
+}
+
+func certificateToAPICertificate(c certificate.Certificate) api.Certificate {
+	return api.Certificate{
+		FQDN:               c.FQDN,
+		ExpirationTime:     c.ExpirationTime,
+		KeyType:            api.KeyType(c.KeyType),
+		RenewalAttemptTime: c.RenewalAttemptTime,
+		RenewalError:       c.RenewalError,
+		RenewalFailures:    c.RenewalFailures,
+	}
+}
+
+func (s *Server) domainCertificateAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	domain, ok := s.domainACMESettingsDomain(w, r, u.ID, false)
+	if !ok {
+		return
+	}
+
+	c, err := s.CertificateService.Certificate(domain.FQDN)
+	if err != nil {
+		if err == certificate.ErrCertificateNotFound {
+			s.Logger.Warningf("domain certificate api: domain %s: user %s: %s", domain.FQDN, u.ID, err)
+			jsonresponse.BadRequest(w, api.ErrCertificateNotFound)
+			return
+		}
+		s.Logger.Errorf("domain certificate api: domain %s: user %s: certificate: %s", domain.FQDN, u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	jsonresponse.OK(w, certificateToAPICertificate(*c))
+}
+
+func (s *Server) renewDomainCertificateAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	domain, ok := s.domainACMESettingsDomain(w, r, u.ID, true)
+	if !ok {
+		return
+	}
+
+	if !s.tlsEnabled || domain.CertificateIgnore {
+		s.Logger.Warningf("renew domain certificate api: domain %s: user %s: certificate ignored", domain.FQDN, u.ID)
+		jsonresponse.BadRequest(w, api.ErrCertificateNotFound)
+		return
+	}
+
+	if err := s.CertificateService.RenewCertificate(domain.FQDN); err != nil {
+		if err == certificate.ErrCertificateNotFound {
+			s.Logger.Warningf("renew domain certificate api: domain %s: user %s: %s", domain.FQDN, u.ID, err)
+			jsonresponse.BadRequest(w, api.ErrCertificateNotFound)
+			return
+		}
+		s.Logger.Errorf("renew domain certificate api: domain %s: user %s: renew certificate: %s", domain.FQDN, u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	s.auditf(r, nil, "domain certificate renew", "%s: %s", domain.ID, domain.FQDN)
+
+	c, err := s.CertificateService.Certificate(domain.FQDN)
+	if err != nil {
+		s.Logger.Errorf("renew domain certificate api: domain %s: user %s: certificate: %s", domain.FQDN, u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	jsonresponse.OK(w, certificateToAPICertificate(*c))
+}
+
+// obtainWildcardDomainCertificate obtains a wildcard certificate in the
+// background for a wildcard domain that has a DNS provider selected and
+// does not have the certificate.
+func (s *Server) obtainWildcardDomainCertificate(domain *packages.Domain, settings *certificate.ACMESettings, userID string) {
+	if !s.tlsEnabled || !domain.IsWildcard() || domain.CertificateIgnore || settings.DNSProvider == "" {
+		return
+	}
+	go func() {
+		defer s.RecoveryService.Recover()
+		if _, err := s.CertificateService.Certificate(domain.FQDN); err != certificate.ErrCertificateNotFound {
+			if err != nil {
+				s.Logger.Errorf("obtain wildcard domain certificate: user %s: %s: %s", userID, domain.FQDN, err)
+			}
+			return
+		}
+		c, err := s.CertificateService.ObtainCertificate(domain.FQDN)
+		if err != nil {
+			s.Logger.Errorf("obtain wildcard domain certificate: user %s: %s: %s", userID, domain.FQDN, err)
+			return
+		}
+		s.Logger.Infof("obtain wildcard domain certificate: user %s: success for %s: expiration time: %s", userID, c.FQDN, c.ExpirationTime)
+	}()
+}
+
+// reissueDomainCertificate obtains a new certificate in the background for
+// a domain that already has one, so that a changed key type is applied
+// without waiting for the renewal.
+func (s *Server) reissueDomainCertificate(domain *packages.Domain, userID string) {
+	if !s.tlsEnabled || domain.CertificateIgnore {
+		return
+	}
+	go func() {
+		defer s.RecoveryService.Recover()
+		if _, err := s.CertificateService.Certificate(domain.FQDN); err != nil {
+			if err != certificate.ErrCertificateNotFound {
+				s.Logger.Errorf("reissue domain certificate: user %s: %s: %s", userID, domain.FQDN, err)
+			}
+			return
+		}
+		c, err := s.CertificateService.ObtainCertificate(domain.FQDN)
+		if err != nil {
+			s.Logger.Errorf("reissue domain certificate: user %s: %s: %s", userID, domain.FQDN, err)
+			return
+		}
+		s.certificateCache.InvalidateCertificate(c.FQDN)
+		s.Logger.Infof("reissue domain certificate: user %s: success for %s: key type %s: expiration time: %s", userID, c.FQDN, c.KeyType, c.ExpirationTime)
+	}()
+}
+
+// moveDomainACMESettings keeps ACME settings of a domain when its FQDN is
+// changed, and removes them when the domain is deleted with empty newFQDN.
+func (s *Server) moveDomainACMESettings(oldFQDN, newFQDN string) {
+	if oldFQDN == newFQDN || s.CertificateService == nil {
+		return
+	}
+	settings, err := s.CertificateService.DeleteACMESettings(oldFQDN)
+	if err != nil {
+		if err != certificate.ErrACMESettingsNotFound {
+			s.Logger.Errorf("move domain acme settings: %s: delete acme settings: %s", oldFQDN, err)
+		}
+		return
+	}
+	if newFQDN == "" {
+		return
+	}
+	if _, err := s.CertificateService.UpdateACMESettings(newFQDN, &certificate.ACMESettingsOptions{
+		DNSProvider: &settings.DNSProvider,
+		KeyType:     &settings.KeyType,
+	}); err != nil {
+		s.Logger.Errorf("move domain acme settings: %s: update acme settings %s: %s", oldFQDN, newFQDN, err)
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"resenje.org/jsonresponse"
+	"resenje.org/web/client/api"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+// batchErrorResponse is the response body of the batch API endpoint
+// when an operation fails. Operation is the index of the failed
+// operation in the request.
+type batchErrorResponse struct {
+	Message   string `json:"message"`
+	Code      int    `json:"code"`
+	Operation int    `json:"operation"`
+}
+
+func (s *Server) batchAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	warningf := func(format string, a ...interface{}) {
+		s.Logger.Warningf("batch api: user %s: %s", u.ID, fmt.Sprintf(format, a...))
+	}
+	errorf := func(format string, a ...interface{}) {
+		s.Logger.Errorf("batch api: user %s: %s", u.ID, fmt.Sprintf(format, a...))
+	}
+
+	request := api.BatchRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		warningf("request decode: %s", err)
+		jsonresponse.BadRequest(w, nil)
+		return
+	}
+
+	if len(request.Operations) == 0 || len(request.Operations) > api.MaxBatchOperations {
+		warningf("request: invalid number of operations %d", len(request.Operations))
+		jsonresponse.BadRequest(w, api.ErrBadRequest)
+		return
+	}
+
+	organization := getRequestOrganization(r)
+
+	operations := make([]packages.BatchOperation, 0, len(request.Operations))
+	for i, o := range request.Operations {
+		if organization != nil && o.Action == api.BatchActionAddDomain {
+			warningf("operation %d: add domain: organization api key", i)
+			jsonresponse.Respond(w, http.StatusForbidden, batchErrorResponse{
+				Message:   api.ErrForbidden.Message,
+				Code:      api.ErrForbidden.Code,
+				Operation: i,
+			})
+			return
+		}
+		operation, apiErr, err := s.batchOperation(o, u.ID)
+		if err != nil {
+			if apiErr == nil {
+				errorf("operation %d: %s", i, err)
+				jsonresponse.InternalServerError(w, nil)
+				return
+			}
+			warningf("operation %d: %s", i, err)
+			status := http.StatusBadRequest
+			if apiErr == api.ErrPreconditionFailed {
+				status = http.StatusPreconditionFailed
+			}
+			jsonresponse.Respond(w, status, batchErrorResponse{
+				Message:   apiErr.Message,
+				Code:      apiErr.Code,
+				Operation: i,
+			})
+			return
+		}
+		operations = append(operations, *operation)
+	}
+
+	results, err := s.PackagesService.Batch(operations, u.ID)
+	if err != nil {
+		e, ok := err.(*packages.BatchError)
+		if !ok {
+			errorf("batch: %s", err)
+			jsonresponse.InternalServerError(w, nil)
+			return
+		}
+		status, apiErr := batchServiceError(e.Err)
+		if apiErr == nil {
+			errorf("batch: %s", err)
+			jsonresponse.InternalServerError(w, nil)
+			return
+		}
+		warningf("batch: %s", err)
+		jsonresponse.Respond(w, status, batchErrorResponse{
+			Message:   apiErr.Message,
+			Code:      apiErr.Code,
+			Operation: e.Operation,
+		})
+		return
+	}
+
+	response := api.BatchResponse{
+		Results: make([]api.BatchResult, 0, len(results)),
+	}
+	for i, br := range results {
+		result := api.BatchResult{
+			Action: request.Operations[i].Action,
+		}
+		if br.Domain != nil {
+			d := packagesDomainToAPIDomain(*br.Domain)
+			result.Domain = &d
+			result.ETag = revisionETag(br.Domain.Revision)
+			if br.Action == packages.ActionAddDomain {
+				s.obtainDomainCertificate(br.Domain, u.ID)
+			}
+		}
+		if br.Package != nil {
+			p := packagesPackageToAPIPackage(*br.Package, nil)
+			result.Package = &p
+			result.ETag = revisionETag(br.Package.Revision)
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	s.auditf(r, request, "batch", "%d operations", len(operations))
+
+	jsonresponse.OK(w, response)
+}
+
+// batchOperation validates a single operation from the batch API request
+// and converts it to the packages service batch operation. If err is not
+// nil, apiErr should be returned in the response, or if apiErr is nil,
+// the error is internal.
+func (s *Server) batchOperation(o api.BatchOperation, userID string) (operation *packages.BatchOperation, apiErr *apiClient.Error, err error) {
+	ifRevision, ok := parseRevisionETag(o.IfMatch)
+	if !ok {
+		return nil, api.ErrPreconditionFailed, fmt.Errorf("invalid if_match %q", o.IfMatch)
+	}
+
+	operation = &packages.BatchOperation{
+		Ref:        o.Ref,
+		IfRevision: ifRevision,
+	}
+
+	switch o.Action {
+	case api.BatchActionAddDomain, api.BatchActionUpdateDomain:
+		if o.Domain == nil {
+			return nil, api.ErrBadRequest, errors.New("domain options absent")
+		}
+		var domain *packages.Domain
+		if o.Action == api.BatchActionAddDomain {
+			operation.Action = packages.ActionAddDomain
+		} else {
+			if o.Ref == "" {
+				return nil, api.ErrDomainNotFound, errors.New("domain ref absent")
+			}
+			operation.Action = packages.ActionUpdateDomain
+			domain, err = s.PackagesService.Domain(o.Ref)
+			switch err {
+			case packages.ErrDomainNotFound:
+				// Domain may be added by a previous operation.
+				domain = &packages.Domain{}
+			case nil:
+			default:
+				return nil, nil, fmt.Errorf("get domain %s: %s", o.Ref, err)
+			}
+		}
+		operation.DomainOptions, apiErr, err = s.apiDomainOptions(*o.Domain, domain, userID)
+		if err != nil {
+			return nil, apiErr, err
+		}
+		operation.DomainOptions.IfRevision = ifRevision
+	case api.BatchActionDeleteDomain:
+		operation.Action = packages.ActionDeleteDomain
+	case api.BatchActionGrantDomainUser, api.BatchActionUpdateDomainUser, api.BatchActionRevokeDomainUser:
+		switch o.Action {
+		case api.BatchActionGrantDomainUser:
+			operation.Action = packages.ActionDomainAddUser
+		case api.BatchActionUpdateDomainUser:
+			operation.Action = packages.ActionDomainUpdateUser
+		default:
+			operation.Action = packages.ActionDomainRemoveUser
+		}
+		operation.Role = packages.DomainRole(o.Role)
+		u, err := s.UserService.User(o.User)
+		if err != nil {
+			if err == user.ErrUserNotFound {
+				return nil, api.ErrUserDoesNotExist, fmt.Errorf("get user %s: %s", o.User, err)
+			}
+			return nil, nil, fmt.Errorf("get user %s: %s", o.User, err)
+		}
+		operation.UserID = u.ID
+	case api.BatchActionAddPackage, api.BatchActionUpdatePackage:
+		if o.Package == nil {
+			return nil, api.ErrBadRequest, errors.New("package options absent")
+		}
+		add := o.Action == api.BatchActionAddPackage
+		if add {
+			operation.Action = packages.ActionAddPackage
+			if err := s.applyAPIPackageDefaults(o.Package); err != nil {
+				return nil, nil, err
+			}
+		} else {
+			operation.Action = packages.ActionUpdatePackage
+		}
+		operation.PackageOptions, apiErr, err = apiPackageOptions(*o.Package, add)
+		if err != nil {
+			return nil, apiErr, err
+		}
+		operation.PackageOptions.IfRevision = ifRevision
+	case api.BatchActionDeletePackage:
+		operation.Action = packages.ActionDeletePackage
+	default:
+		return nil, api.ErrBatchActionInvalid, fmt.Errorf("invalid action %q", o.Action)
+	}
+	return operation, nil, nil
+}
+
+// batchServiceError returns HTTP status code and API error for
+// an error returned by a packages service batch operation. Returned
+// API error is nil if the error is not expected.
+func batchServiceError(err error) (status int, apiErr *apiClient.Error) {
+	switch err {
+	case packages.ErrForbidden:
+		return http.StatusForbidden, api.ErrForbidden
+	case packages.ErrDomainRevisionMismatch, packages.ErrPackageRevisionMismatch:
+		return http.StatusPreconditionFailed, api.ErrPreconditionFailed
+	case packages.ErrBatchActionInvalid:
+		return http.StatusBadRequest, api.ErrBatchActionInvalid
+	case packages.ErrDomainNotFound:
+		return http.StatusBadRequest, api.ErrDomainNotFound
+	case packages.ErrDomainAlreadyExists:
+		return http.StatusBadRequest, api.ErrDomainAlreadyExists
+	case packages.ErrDomainAliasInvalid:
+		return http.StatusBadRequest, api.ErrDomainAliasInvalid
+	case packages.ErrDomainAliasAlreadyExists:
+		return http.StatusBadRequest, api.ErrDomainAliasAlreadyExists
+	case packages.ErrDomainQuotaExceeded:
+		return http.StatusBadRequest, api.ErrDomainQuotaExceeded
+	case packages.ErrPackageQuotaExceeded:
+		return http.StatusBadRequest, api.ErrPackageQuotaExceeded
+	case packages.ErrDomainFQDNRequired:
+		return http.StatusBadRequest, api.ErrDomainFQDNRequired
+	case packages.ErrOrganizationNotFound:
+		return http.StatusBadRequest, api.ErrOrganizationNotFound
+	case packages.ErrUserExists:
+		return http.StatusBadRequest, api.ErrUserAlreadyGranted
+	case packages.ErrUserDoesNotExist:
+		return http.StatusBadRequest, api.ErrUserNotGranted
+	case packages.ErrDomainRoleInvalid:
+		return http.StatusBadRequest, api.ErrDomainRoleInvalid
+	case packages.ErrPackageNotFound:
+		return http.StatusBadRequest, api.ErrPackageNotFound
+	case packages.ErrPackageAlreadyExists:
+		return http.StatusBadRequest, api.ErrPackageAlreadyExists
+	case packages.ErrPackageDomainRequired:
+		return http.StatusBadRequest, api.ErrPackageDomainRequired
+	case packages.ErrPackagePathRequired:
+		return http.StatusBadRequest, api.ErrPackagePathRequired
+	case packages.ErrPackageVCSRequired:
+		return http.StatusBadRequest, api.ErrPackageVCSRequired
+	case packages.ErrPackageRepoRootRequired:
+		return http.StatusBadRequest, api.ErrPackageRepoRootRequired
+	case packages.ErrPackageRepoRootInvalid:
+		return http.StatusBadRequest, api.ErrPackageRepoRootInvalid
+	case packages.ErrPackageRepoRootSchemeRequired:
+		return http.StatusBadRequest, api.ErrPackageRepoRootSchemeRequired
+	case packages.ErrPackageRepoRootSchemeInvalid:
+		return http.StatusBadRequest, api.ErrPackageRepoRootSchemeInvalid
+	case packages.ErrPackageRepoRootHostInvalid:
+		return http.StatusBadRequest, api.ErrPackageRepoRootHostInvalid
+	case packages.ErrPackageRefChangeRejected:
+		return http.StatusBadRequest, api.ErrPackageRefChangeRejected
+	}
+	return 0, nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+func TestDomainRolesAPI(t *testing.T) {
+	s, err := newTestServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	httpClients := map[string]*api.Client{}
+	users := map[string]*user.User{}
+	for _, username := range []string{"alice", "bob", "chuck"} {
+		t.Run(fmt.Sprintf("create account %s", username), func(t *testing.T) {
+			email := username + "@localhost.loc"
+			name := strings.ToUpper(username)
+			u, err := s.UserService.CreateUser(&user.Options{
+				Email:    &email,
+				Username: &username,
+				Name:     &name,
+			})
+			if err != nil {
+				t.Fatalf("create user: %s", err)
+			}
+
+			users[username] = u
+
+			_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+			if err != nil {
+				t.Fatalf("parse IPv4 net: %s", err)
+			}
+			_, ipV6Net, err := net.ParseCIDR("::/0")
+			if err != nil {
+				t.Fatalf("parse IPv6 net: %s", err)
+			}
+
+			k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+				AuthorizedNetworks: &[]net.IPNet{
+					*ipV4Net,
+					*ipV6Net,
+				},
+			})
+			if err != nil {
+				t.Fatalf("create key: %s", err)
+			}
+
+			httpClients[username] = api.NewClientWithEndpoint(
+				"localhost:"+strconv.Itoa(s.servers.Addr("HTTP").Port)+"/api/v1",
+				k.Secret,
+			)
+			httpClients[username].UserAgent = username + "-gopherpit-test-client"
+		})
+	}
+
+	fqdn := "roles.localhost"
+	if _, err := s.PackagesService.AddDomain(&packages.DomainOptions{
+		FQDN:        &fqdn,
+		OwnerUserID: &users["alice"].ID,
+	}, users["alice"].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	addPackage := func(username, path string) error {
+		vcs := api.VCSGit
+		repoRoot := "https://github.com/gopherpit/gopherpit.git"
+		_, err := httpClients[username].AddPackage(&api.PackageOptions{
+			Domain:   &fqdn,
+			Path:     &path,
+			VCS:      &vcs,
+			RepoRoot: &repoRoot,
+		})
+		return err
+	}
+
+	t.Run("grant with role", func(t *testing.T) {
+		t.Run("invalid role", func(t *testing.T) {
+			for _, role := range []api.DomainRole{api.DomainRoleOwner, "unknown"} {
+				err := httpClients["alice"].GrantDomainUserWithRole(fqdn, "bob", role)
+				if err != api.ErrDomainRoleInvalid {
+					t.Errorf("%s: expected error %v, got %v", role, api.ErrDomainRoleInvalid, err)
+				}
+			}
+		})
+		t.Run("viewer", func(t *testing.T) {
+			if err := httpClients["alice"].GrantDomainUserWithRole(fqdn, "bob", api.DomainRoleViewer); err != nil {
+				t.Fatal(err)
+			}
+		})
+		t.Run("default maintainer", func(t *testing.T) {
+			if err := httpClients["alice"].GrantDomainUser(fqdn, "chuck"); err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+
+	t.Run("domain users roles", func(t *testing.T) {
+		domainUsers, err := httpClients["alice"].DomainUsers(fqdn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for id, role := range map[string]api.DomainRole{
+			users["bob"].ID:   api.DomainRoleViewer,
+			users["chuck"].ID: api.DomainRoleMaintainer,
+		} {
+			if domainUsers.Roles[id] != role {
+				t.Errorf("expected role %q for user %s, got %q", role, id, domainUsers.Roles[id])
+			}
+		}
+	})
+
+	t.Run("viewer", func(t *testing.T) {
+		t.Run("read", func(t *testing.T) {
+			if _, err := httpClients["bob"].Domain(fqdn); err != nil {
+				t.Error(err)
+			}
+			if _, err := httpClients["bob"].DomainPackages(fqdn, "", 0); err != nil {
+				t.Error(err)
+			}
+		})
+		t.Run("add package", func(t *testing.T) {
+			if err := addPackage("bob", "/viewer"); err != api.ErrForbidden {
+				t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+			}
+		})
+	})
+
+	t.Run("maintainer", func(t *testing.T) {
+		t.Run("add package", func(t *testing.T) {
+			if err := addPackage("chuck", "/maintainer"); err != nil {
+				t.Error(err)
+			}
+		})
+		t.Run("update domain", func(t *testing.T) {
+			disabled := true
+			_, err := httpClients["chuck"].UpdateDomain(fqdn, &api.DomainOptions{
+				Disabled: &disabled,
+			})
+			if err != api.ErrForbidden {
+				t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+			}
+		})
+		t.Run("grant user", func(t *testing.T) {
+			err := httpClients["chuck"].GrantDomainUserWithRole(fqdn, "bob", api.DomainRoleMaintainer)
+			if err != api.ErrForbidden {
+				t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+			}
+		})
+		t.Run("update role", func(t *testing.T) {
+			err := httpClients["chuck"].UpdateDomainUserRole(fqdn, "bob", api.DomainRoleMaintainer)
+			if err != api.ErrForbidden {
+				t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+			}
+		})
+	})
+
+	t.Run("update role", func(t *testing.T) {
+		t.Run("invalid role", func(t *testing.T) {
+			err := httpClients["alice"].UpdateDomainUserRole(fqdn, "bob", api.DomainRoleOwner)
+			if err != api.ErrDomainRoleInvalid {
+				t.Errorf("expected error %v, got %v", api.ErrDomainRoleInvalid, err)
+			}
+		})
+		t.Run("viewer to maintainer", func(t *testing.T) {
+			if err := httpClients["alice"].UpdateDomainUserRole(fqdn, "bob", api.DomainRoleMaintainer); err != nil {
+				t.Fatal(err)
+			}
+			if err := addPackage("bob", "/viewer"); err != nil {
+				t.Error(err)
+			}
+		})
+		t.Run("maintainer to viewer", func(t *testing.T) {
+			if err := httpClients["alice"].UpdateDomainUserRole(fqdn, "chuck", api.DomainRoleViewer); err != nil {
+				t.Fatal(err)
+			}
+			if err := addPackage("chuck", "/viewer2"); err != api.ErrForbidden {
+				t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+			}
+		})
+	})
+}
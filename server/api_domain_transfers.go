@@ -0,0 +1,220 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"resenje.org/jsonresponse"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+func (s *Server) domainTransferAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	transfer, err := s.PackagesService.DomainTransfer(id)
+	switch err {
+	case packages.ErrDomainNotFound:
+		s.Logger.Warningf("domain transfer api: domain %s: %s", id, err)
+		jsonresponse.BadRequest(w, api.ErrDomainNotFound)
+		return
+	case packages.ErrDomainTransferNotFound:
+		s.Logger.Warningf("domain transfer api: domain %s: %s", id, err)
+		jsonresponse.BadRequest(w, api.ErrDomainTransferNotFound)
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("domain transfer api: domain %s: %s", id, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	if transfer.FromUserID != u.ID && transfer.ToUserID != u.ID {
+		s.Logger.Warningf("domain transfer api: domain %s: user %s: not a party of the transfer", id, u.ID)
+		jsonresponse.Forbidden(w, nil)
+		return
+	}
+
+	jsonresponse.OK(w, packagesDomainTransferToAPIDomainTransfer(*transfer))
+}
+
+func (s *Server) requestDomainTransferAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	request := api.DomainTransferOptions{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.Logger.Warningf("request domain transfer api: request decode: %s", err)
+		jsonresponse.BadRequest(w, api.ErrBadRequest)
+		return
+	}
+	request.ToUser = strings.TrimSpace(request.ToUser)
+	if request.ToUser == "" {
+		s.Logger.Warningf("request domain transfer api: domain %s: user empty", id)
+		jsonresponse.BadRequest(w, api.ErrUserDoesNotExist)
+		return
+	}
+
+	to, err := s.UserService.User(request.ToUser)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			s.Logger.Warningf("request domain transfer api: domain %s: get user %s: %s", id, request.ToUser, err)
+			jsonresponse.BadRequest(w, api.ErrUserDoesNotExist)
+			return
+		}
+		s.Logger.Errorf("request domain transfer api: domain %s: get user %s: %s", id, request.ToUser, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	transfer, err := s.requestDomainTransfer(r, id, to, u)
+	switch err {
+	case packages.ErrDomainNotFound:
+		s.Logger.Warningf("request domain transfer api: domain %s: %s", id, err)
+		jsonresponse.BadRequest(w, api.ErrDomainNotFound)
+		return
+	case packages.ErrForbidden:
+		s.Logger.Warningf("request domain transfer api: domain %s: user %s: %s", id, u.ID, err)
+		jsonresponse.Forbidden(w, api.ErrForbidden)
+		return
+	case packages.ErrDomainTransferToOwner:
+		s.Logger.Warningf("request domain transfer api: domain %s: %s", id, err)
+		jsonresponse.BadRequest(w, api.ErrDomainTransferToOwner)
+		return
+	case packages.ErrDomainTransferAlreadyExists:
+		s.Logger.Warningf("request domain transfer api: domain %s: %s", id, err)
+		jsonresponse.BadRequest(w, api.ErrDomainTransferAlreadyExists)
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("request domain transfer api: domain %s: %s", id, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	s.auditf(r, request, "domain transfer request", "%s: %s to %s", transfer.DomainID, transfer.FQDN, transfer.ToUserID)
+
+	jsonresponse.OK(w, packagesDomainTransferToAPIDomainTransfer(*transfer))
+}
+
+func (s *Server) acceptDomainTransferAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	domain, err := s.PackagesService.AcceptDomainTransfer(id, u.ID)
+	switch err {
+	case packages.ErrDomainNotFound:
+		s.Logger.Warningf("accept domain transfer api: domain %s: %s", id, err)
+		jsonresponse.BadRequest(w, api.ErrDomainNotFound)
+		return
+	case packages.ErrDomainTransferNotFound:
+		s.Logger.Warningf("accept domain transfer api: domain %s: %s", id, err)
+		jsonresponse.BadRequest(w, api.ErrDomainTransferNotFound)
+		return
+	case packages.ErrDomainTransferExpired:
+		s.Logger.Warningf("accept domain transfer api: domain %s: %s", id, err)
+		jsonresponse.BadRequest(w, api.ErrDomainTransferExpired)
+		return
+	case packages.ErrDomainQuotaExceeded:
+		s.Logger.Warningf("accept domain transfer api: domain %s: %s", id, err)
+		jsonresponse.BadRequest(w, api.ErrDomainQuotaExceeded)
+		return
+	case packages.ErrPackageQuotaExceeded:
+		s.Logger.Warningf("accept domain transfer api: domain %s: %s", id, err)
+		jsonresponse.BadRequest(w, api.ErrPackageQuotaExceeded)
+		return
+	case packages.ErrForbidden:
+		s.Logger.Warningf("accept domain transfer api: domain %s: user %s: %s", id, u.ID, err)
+		jsonresponse.Forbidden(w, api.ErrForbidden)
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("accept domain transfer api: domain %s: %s", id, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	s.auditf(r, nil, "domain transfer accept", "%s: %s", domain.ID, domain.FQDN)
+
+	w.Header().Set("ETag", revisionETag(domain.Revision))
+	jsonresponse.OK(w, packagesDomainToAPIDomain(*domain))
+}
+
+func (s *Server) cancelDomainTransferAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	transfer, err := s.PackagesService.CancelDomainTransfer(id, u.ID)
+	switch err {
+	case packages.ErrDomainNotFound:
+		s.Logger.Warningf("cancel domain transfer api: domain %s: %s", id, err)
+		jsonresponse.BadRequest(w, api.ErrDomainNotFound)
+		return
+	case packages.ErrDomainTransferNotFound:
+		s.Logger.Warningf("cancel domain transfer api: domain %s: %s", id, err)
+		jsonresponse.BadRequest(w, api.ErrDomainTransferNotFound)
+		return
+	case packages.ErrForbidden:
+		s.Logger.Warningf("cancel domain transfer api: domain %s: user %s: %s", id, u.ID, err)
+		jsonresponse.Forbidden(w, api.ErrForbidden)
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("cancel domain transfer api: domain %s: %s", id, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	s.auditf(r, nil, "domain transfer cancel", "%s: %s to %s", transfer.DomainID, transfer.FQDN, transfer.ToUserID)
+
+	jsonresponse.OK(w, packagesDomainTransferToAPIDomainTransfer(*transfer))
+}
+
+func (s *Server) domainTransfersAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	transfers, err := s.PackagesService.DomainTransfersByUser(u.ID)
+	if err != nil {
+		s.Logger.Errorf("domain transfers api: user %s: %s", u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	response := api.DomainTransfers{
+		Transfers: []api.DomainTransfer{},
+	}
+	for _, t := range transfers {
+		response.Transfers = append(response.Transfers, packagesDomainTransferToAPIDomainTransfer(t))
+	}
+
+	jsonresponse.OK(w, response)
+}
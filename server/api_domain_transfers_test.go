@@ -0,0 +1,341 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+func TestDomainTransfersAPI(t *testing.T) {
+	notificationService := &testNotificationService{}
+	s, err := newTestServer(map[string]interface{}{
+		"NotificationService": notificationService,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	httpClients := map[string]*api.Client{}
+	users := map[string]*user.User{}
+	for _, username := range []string{"alice", "bob", "chuck"} {
+		t.Run(fmt.Sprintf("create account %s", username), func(t *testing.T) {
+			email := username + "@localhost.loc"
+			name := strings.ToUpper(username)
+			u, err := s.UserService.CreateUser(&user.Options{
+				Email:    &email,
+				Username: &username,
+				Name:     &name,
+			})
+			if err != nil {
+				t.Fatalf("create user: %s", err)
+			}
+
+			users[username] = u
+
+			_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+			if err != nil {
+				t.Fatalf("parse IPv4 net: %s", err)
+			}
+			_, ipV6Net, err := net.ParseCIDR("::/0")
+			if err != nil {
+				t.Fatalf("parse IPv6 net: %s", err)
+			}
+
+			k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+				AuthorizedNetworks: &[]net.IPNet{
+					*ipV4Net,
+					*ipV6Net,
+				},
+			})
+			if err != nil {
+				t.Fatalf("create key: %s", err)
+			}
+
+			httpClients[username] = api.NewClientWithEndpoint(
+				"localhost:"+strconv.Itoa(s.servers.Addr("HTTP").Port)+"/api/v1",
+				k.Secret,
+			)
+			httpClients[username].UserAgent = username + "-gopherpit-test-client"
+		})
+	}
+
+	fqdn := "transfer.localhost"
+	domain, err := s.PackagesService.AddDomain(&packages.DomainOptions{
+		FQDN:        &fqdn,
+		OwnerUserID: &users["alice"].ID,
+	}, users["alice"].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("get domain transfer not found", func(t *testing.T) {
+		_, err := httpClients["alice"].DomainTransfer(fqdn)
+		if err != api.ErrDomainTransferNotFound {
+			t.Errorf("expected error %v, got %v", api.ErrDomainTransferNotFound, err)
+		}
+	})
+
+	// checkOwner fails the test if the owner of the domain is not alice.
+	checkOwner := func(t *testing.T) {
+		d, err := s.PackagesService.Domain(fqdn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.OwnerUserID != users["alice"].ID {
+			t.Errorf("expected owner %s, got %s", users["alice"].ID, d.OwnerUserID)
+		}
+	}
+
+	t.Run("update domain owner", func(t *testing.T) {
+		_, err := httpClients["alice"].UpdateDomain(fqdn, &api.DomainOptions{
+			OwnerUserID: &users["bob"].ID,
+		})
+		if err != api.ErrDomainTransferRequired {
+			t.Errorf("expected error %v, got %v", api.ErrDomainTransferRequired, err)
+		}
+		checkOwner(t)
+
+		_, err = httpClients["alice"].Batch([]api.BatchOperation{
+			{
+				Action: api.BatchActionUpdateDomain,
+				Ref:    fqdn,
+				Domain: &api.DomainOptions{
+					OwnerUserID: &users["bob"].Username,
+				},
+			},
+		})
+		e, ok := err.(*api.BatchError)
+		if !ok || e.Err != api.ErrDomainTransferRequired {
+			t.Errorf("expected batch error %v, got %v", api.ErrDomainTransferRequired, err)
+		}
+		checkOwner(t)
+
+		if _, err := httpClients["alice"].UpdateDomain(fqdn, &api.DomainOptions{
+			OwnerUserID: &users["alice"].Email,
+		}); err != nil {
+			t.Errorf("update domain with the current owner: %s", err)
+		}
+		checkOwner(t)
+	})
+
+	t.Run("request domain transfer", func(t *testing.T) {
+		t.Run("forbidden", func(t *testing.T) {
+			_, err := httpClients["bob"].RequestDomainTransfer(fqdn, "chuck")
+			if err != api.ErrForbidden {
+				t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+			}
+		})
+		t.Run("domain not found", func(t *testing.T) {
+			_, err := httpClients["alice"].RequestDomainTransfer("missing.localhost", "bob")
+			if err != api.ErrDomainNotFound {
+				t.Errorf("expected error %v, got %v", api.ErrDomainNotFound, err)
+			}
+		})
+		t.Run("user does not exist", func(t *testing.T) {
+			_, err := httpClients["alice"].RequestDomainTransfer(fqdn, "missing")
+			if err != api.ErrUserDoesNotExist {
+				t.Errorf("expected error %v, got %v", api.ErrUserDoesNotExist, err)
+			}
+		})
+		t.Run("to owner", func(t *testing.T) {
+			_, err := httpClients["alice"].RequestDomainTransfer(fqdn, "alice")
+			if err != api.ErrDomainTransferToOwner {
+				t.Errorf("expected error %v, got %v", api.ErrDomainTransferToOwner, err)
+			}
+		})
+		t.Run("to bob", func(t *testing.T) {
+			transfer, err := httpClients["alice"].RequestDomainTransfer(fqdn, "bob")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if transfer.DomainID != domain.ID || transfer.FQDN != fqdn {
+				t.Errorf("expected transfer of domain %s %s, got %s %s", domain.ID, fqdn, transfer.DomainID, transfer.FQDN)
+			}
+			if transfer.FromUserID != users["alice"].ID || transfer.ToUserID != users["bob"].ID {
+				t.Errorf("expected transfer from %s to %s, got from %s to %s", users["alice"].ID, users["bob"].ID, transfer.FromUserID, transfer.ToUserID)
+			}
+			if !transfer.ExpirationTime.After(time.Now()) {
+				t.Errorf("expected expiration time in the future, got %s", transfer.ExpirationTime)
+			}
+
+			d, err := s.PackagesService.Domain(fqdn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.OwnerUserID != users["alice"].ID {
+				t.Errorf("expected owner not to be changed, got %s", d.OwnerUserID)
+			}
+
+			emails := notificationService.Emails()
+			if len(emails) != 1 {
+				t.Fatalf("expected 1 email, got %d", len(emails))
+			}
+			if emails[0].To[0] != users["bob"].Email {
+				t.Errorf("expected email to %s, got %s", users["bob"].Email, emails[0].To[0])
+			}
+			if !strings.Contains(emails[0].Body, "/domain-transfer/"+domain.ID) {
+				t.Errorf("expected transfer link in email body, got %q", emails[0].Body)
+			}
+		})
+		t.Run("already exists", func(t *testing.T) {
+			_, err := httpClients["alice"].RequestDomainTransfer(fqdn, "chuck")
+			if err != api.ErrDomainTransferAlreadyExists {
+				t.Errorf("expected error %v, got %v", api.ErrDomainTransferAlreadyExists, err)
+			}
+		})
+	})
+
+	t.Run("get domain transfer", func(t *testing.T) {
+		for _, username := range []string{"alice", "bob"} {
+			transfer, err := httpClients[username].DomainTransfer(fqdn)
+			if err != nil {
+				t.Fatalf("%s: %s", username, err)
+			}
+			if transfer.ToUserID != users["bob"].ID {
+				t.Errorf("%s: expected transfer to %s, got %s", username, users["bob"].ID, transfer.ToUserID)
+			}
+		}
+		t.Run("forbidden", func(t *testing.T) {
+			_, err := httpClients["chuck"].DomainTransfer(fqdn)
+			if err != api.ErrForbidden {
+				t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+			}
+		})
+	})
+
+	t.Run("list domain transfers", func(t *testing.T) {
+		for username, count := range map[string]int{"alice": 1, "bob": 1, "chuck": 0} {
+			transfers, err := httpClients[username].DomainTransfers()
+			if err != nil {
+				t.Fatalf("%s: %s", username, err)
+			}
+			if len(transfers.Transfers) != count {
+				t.Errorf("%s: expected %d transfers, got %d", username, count, len(transfers.Transfers))
+			}
+		}
+	})
+
+	t.Run("update domain owner with pending transfer", func(t *testing.T) {
+		for _, username := range []string{"alice", "bob"} {
+			_, err := httpClients[username].UpdateDomain(fqdn, &api.DomainOptions{
+				OwnerUserID: &users["bob"].ID,
+			})
+			if err == nil {
+				t.Errorf("%s: expected error", username)
+			}
+		}
+		checkOwner(t)
+	})
+
+	t.Run("accept domain transfer forbidden", func(t *testing.T) {
+		for _, username := range []string{"alice", "chuck"} {
+			_, err := httpClients[username].AcceptDomainTransfer(fqdn)
+			if err != api.ErrForbidden {
+				t.Errorf("%s: expected error %v, got %v", username, api.ErrForbidden, err)
+			}
+		}
+	})
+
+	t.Run("cancel domain transfer", func(t *testing.T) {
+		t.Run("forbidden", func(t *testing.T) {
+			_, err := httpClients["chuck"].CancelDomainTransfer(fqdn)
+			if err != api.ErrForbidden {
+				t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+			}
+		})
+		t.Run("by recipient", func(t *testing.T) {
+			transfer, err := httpClients["bob"].CancelDomainTransfer(fqdn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if transfer.ToUserID != users["bob"].ID {
+				t.Errorf("expected transfer to %s, got %s", users["bob"].ID, transfer.ToUserID)
+			}
+			if _, err := httpClients["alice"].DomainTransfer(fqdn); err != api.ErrDomainTransferNotFound {
+				t.Errorf("expected error %v, got %v", api.ErrDomainTransferNotFound, err)
+			}
+		})
+		t.Run("by owner", func(t *testing.T) {
+			if _, err := httpClients["alice"].RequestDomainTransfer(fqdn, "bob"); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := httpClients["alice"].CancelDomainTransfer(fqdn); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := httpClients["bob"].AcceptDomainTransfer(fqdn); err != api.ErrDomainTransferNotFound {
+				t.Errorf("expected error %v, got %v", api.ErrDomainTransferNotFound, err)
+			}
+		})
+	})
+
+	t.Run("accept expired domain transfer", func(t *testing.T) {
+		if _, err := s.PackagesService.RequestDomainTransfer(domain.ID, users["chuck"].ID, time.Now().Add(-time.Minute), users["alice"].ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := httpClients["chuck"].AcceptDomainTransfer(fqdn); err != api.ErrDomainTransferExpired {
+			t.Errorf("expected error %v, got %v", api.ErrDomainTransferExpired, err)
+		}
+	})
+
+	t.Run("accept domain transfer", func(t *testing.T) {
+		// Expired transfer is replaced by a new request.
+		if _, err := httpClients["alice"].RequestDomainTransfer(fqdn, "bob"); err != nil {
+			t.Fatal(err)
+		}
+		d, err := httpClients["bob"].AcceptDomainTransfer(fqdn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.OwnerUserID != users["bob"].ID {
+			t.Errorf("expected owner %s, got %s", users["bob"].ID, d.OwnerUserID)
+		}
+		if d.ETag == "" {
+			t.Error("expected etag")
+		}
+		if _, err := httpClients["bob"].DomainTransfer(fqdn); err != api.ErrDomainTransferNotFound {
+			t.Errorf("expected error %v, got %v", api.ErrDomainTransferNotFound, err)
+		}
+
+		domainUsers, err := httpClients["bob"].DomainUsers(fqdn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(domainUsers.UserIDs) != 1 || domainUsers.UserIDs[0] != users["alice"].ID {
+			t.Errorf("expected previous owner as domain user, got %v", domainUsers.UserIDs)
+		}
+	})
+
+	t.Run("changelog", func(t *testing.T) {
+		changelog, err := s.PackagesService.ChangelogForDomain(domain.ID, "", 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts := map[packages.Action]int{}
+		for _, r := range changelog.Records {
+			counts[r.Action]++
+		}
+		for action, count := range map[packages.Action]int{
+			packages.ActionRequestDomainTransfer: 4,
+			packages.ActionCancelDomainTransfer:  2,
+			packages.ActionAcceptDomainTransfer:  1,
+		} {
+			if counts[action] != count {
+				t.Errorf("expected %d %s changelog records, got %d", count, action, counts[action])
+			}
+		}
+	})
+}
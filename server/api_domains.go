@@ -6,10 +6,10 @@
 package server
 
 import (
-	"crypto/sha1"
-	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -19,8 +19,10 @@ import (
 
 	"github.com/gorilla/mux"
 	"resenje.org/jsonresponse"
+	"resenje.org/web/client/api"
 
 	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/certificate"
 	"gopherpit.com/gopherpit/services/packages"
 	"gopherpit.com/gopherpit/services/user"
 )
@@ -49,33 +51,25 @@ func (s *Server) domainAPIHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	found := domain.OwnerUserID == u.ID
-	if !found {
-		domainUsers, err := s.PackagesService.DomainUsers(id)
-		if err != nil {
-			if err == packages.ErrDomainNotFound {
-				s.Logger.Warningf("domain api: domain users %s: %s", id, err)
-				jsonresponse.BadRequest(w, api.ErrDomainNotFound)
-				return
-			}
-			s.Logger.Errorf("domain api: domain users %s: %s", id, err)
-			jsonresponse.InternalServerError(w, nil)
+	role, err := s.PackagesService.DomainUserRole(domain.ID, u.ID)
+	if err != nil {
+		if err == packages.ErrDomainNotFound {
+			s.Logger.Warningf("domain api: domain user role %s: %s", id, err)
+			jsonresponse.BadRequest(w, api.ErrDomainNotFound)
 			return
 		}
-		for _, uid := range domainUsers.UserIDs {
-			if u.ID == uid {
-				found = true
-				break
-			}
-		}
+		s.Logger.Errorf("domain api: domain user role %s: %s", id, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
 	}
 
-	if !found {
+	if role == "" {
 		s.Logger.Errorf("domain api: domain %s: does not belong to user %s", id, u.ID)
 		jsonresponse.Forbidden(w, nil)
 		return
 	}
 
+	w.Header().Set("ETag", revisionETag(domain.Revision))
 	jsonresponse.OK(w, packagesDomainToAPIDomain(*domain))
 }
 
@@ -86,7 +80,10 @@ func (s *Server) domainTokensAPIHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	vars := mux.Vars(r)
-	fqdn := vars["id"]
+	id := vars["id"]
+	// Wildcard domains are verified by their parent domain, only with
+	// DNS TXT records.
+	fqdn := strings.TrimPrefix(id, "*.")
 
 	publicSuffix, icann := publicsuffix.PublicSuffix(fqdn)
 	if !icann {
@@ -110,20 +107,10 @@ func (s *Server) domainTokensAPIHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	d := publicSuffix
-	var token, verificationDomain string
-	var x [20]byte
 	for i := startIndex; i >= 0; i-- {
 		d = fmt.Sprintf("%s.%s", domainParts[i], d)
 
-		x = sha1.Sum(append(s.salt, []byte(u.ID+d)...))
-		token = base64.URLEncoding.EncodeToString(x[:])
-
-		verificationDomain = s.VerificationSubdomain + "." + d
-
-		tokens = append(tokens, api.DomainToken{
-			FQDN:  verificationDomain,
-			Token: token,
-		})
+		tokens = append(tokens, s.domainVerificationTokens(d, s.domainVerificationToken(u.ID, d), d == id)...)
 	}
 
 	jsonresponse.OK(w, api.DomainTokens{
@@ -147,6 +134,13 @@ func (s *Server) updateDomainAPIHandler(w http.ResponseWriter, r *http.Request)
 		s.Logger.Errorf("update domain api: %q: user %s: %s", id, u.ID, fmt.Sprintf(format, a...))
 	}
 
+	ifRevision, ok := ifMatchRevision(r)
+	if !ok {
+		warningf("request: invalid if-match header %q", r.Header.Get("If-Match"))
+		jsonresponse.PreconditionFailed(w, api.ErrPreconditionFailed)
+		return
+	}
+
 	request := api.DomainOptions{}
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		warningf("request decode: %s", err)
@@ -154,23 +148,9 @@ func (s *Server) updateDomainAPIHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var fqdn string
-	if request.FQDN != nil {
-		fqdn = strings.TrimSpace(*request.FQDN)
-		if fqdn == "" {
-			warningf("request: fqdn empty")
-			jsonresponse.BadRequest(w, api.ErrDomainFQDNRequired)
-			return
-		}
-
-		if !fqdnRegex.MatchString(fqdn) && fqdn != s.Domain {
-			warningf("request: fqdn invalid")
-			jsonresponse.BadRequest(w, api.ErrDomainFQDNInvalid)
-			return
-		}
-	} else if id == "" {
-		warningf("request: fqdn absent")
-		jsonresponse.BadRequest(w, api.ErrDomainFQDNRequired)
+	if id == "" && getRequestOrganization(r) != nil {
+		warningf("add domain: organization api key")
+		jsonresponse.Forbidden(w, api.ErrForbidden)
 		return
 	}
 
@@ -192,6 +172,217 @@ func (s *Server) updateDomainAPIHandler(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
+	o, apiErr, err := s.apiDomainOptions(request, domain, u.ID)
+	if err != nil {
+		if apiErr == nil {
+			errorf("%s", err)
+			jsonresponse.InternalServerError(w, nil)
+			return
+		}
+		warningf("%s", err)
+		jsonresponse.BadRequest(w, apiErr)
+		return
+	}
+	var fqdn string
+	if o.FQDN != nil {
+		fqdn = *o.FQDN
+	} else if domain != nil {
+		fqdn = domain.FQDN
+	}
+
+	var editedDomain *packages.Domain
+	if id == "" {
+		editedDomain, err = s.PackagesService.AddDomain(o, u.ID)
+	} else {
+		o.IfRevision = ifRevision
+		editedDomain, err = s.PackagesService.UpdateDomain(id, o, u.ID)
+	}
+	if err != nil {
+		switch err {
+		case packages.ErrDomainFQDNRequired:
+			warningf("add/update domain: %s: %s", fqdn, err)
+			jsonresponse.BadRequest(w, api.ErrDomainFQDNRequired)
+			return
+		case packages.ErrDomainNotFound:
+			warningf("add/update domain: %s: %s", fqdn, err)
+			jsonresponse.BadRequest(w, api.ErrDomainNotFound)
+			return
+		case packages.ErrForbidden:
+			warningf("add/update domain: %s: %s", fqdn, err)
+			jsonresponse.Forbidden(w, api.ErrForbidden)
+			return
+		case packages.ErrDomainAlreadyExists:
+			warningf("add/update domain: %s: %s", fqdn, err)
+			jsonresponse.BadRequest(w, api.ErrDomainAlreadyExists)
+			return
+		case packages.ErrDomainAliasInvalid:
+			warningf("add/update domain: %s: %s", fqdn, err)
+			jsonresponse.BadRequest(w, api.ErrDomainAliasInvalid)
+			return
+		case packages.ErrDomainAliasAlreadyExists:
+			warningf("add/update domain: %s: %s", fqdn, err)
+			jsonresponse.BadRequest(w, api.ErrDomainAliasAlreadyExists)
+			return
+		case packages.ErrDomainQuotaExceeded:
+			warningf("add/update domain: %s: %s", fqdn, err)
+			jsonresponse.BadRequest(w, api.ErrDomainQuotaExceeded)
+			return
+		case packages.ErrPackageQuotaExceeded:
+			warningf("add/update domain: %s: %s", fqdn, err)
+			jsonresponse.BadRequest(w, api.ErrPackageQuotaExceeded)
+			return
+		case packages.ErrDomainRevisionMismatch:
+			warningf("add/update domain: %s: %s", fqdn, err)
+			jsonresponse.PreconditionFailed(w, api.ErrPreconditionFailed)
+			return
+		case packages.ErrOrganizationNotFound:
+			warningf("add/update domain: %s: %s", fqdn, err)
+			jsonresponse.BadRequest(w, api.ErrOrganizationNotFound)
+			return
+		case nil:
+		default:
+			errorf("add/update domain: %s: %s", fqdn, err)
+			jsonresponse.InternalServerError(w, nil)
+			return
+		}
+	}
+
+	if domain != nil && editedDomain != nil {
+		s.moveDomainACMESettings(domain.FQDN, editedDomain.FQDN)
+	}
+
+	// Obtain certificate only if:
+	// - it is the new domain (id == "")
+	// - the domain as actually created (editedDomain != nil)
+	if id == "" && editedDomain != nil {
+		s.obtainDomainCertificate(editedDomain, u.ID)
+	}
+	// Obtain certificates for new aliases of an existing domain.
+	if id != "" && editedDomain != nil && !editedDomain.CertificateIgnore {
+		aliases := []string{}
+		for _, a := range editedDomain.Aliases {
+			if !domain.HasAlias(a) {
+				aliases = append(aliases, a)
+			}
+		}
+		s.obtainDomainAliasCertificates(aliases, u.ID)
+	}
+
+	action := "domain update"
+	if id == "" {
+		action = "domain add"
+	}
+	s.auditf(r, request, action, "%s: %s", editedDomain.ID, editedDomain.FQDN)
+
+	w.Header().Set("ETag", revisionETag(editedDomain.Revision))
+	jsonresponse.OK(w, packagesDomainToAPIDomain(*editedDomain))
+}
+
+// apiDomainOptions validates domain options from the API request,
+// verifies the ownership of a new or changed fully qualified domain
+// name and new aliases and converts options to packages service options. Domain is
+// the current state of the domain that is updated, or nil if a new
+// domain is added. If err is not nil, apiErr should be returned in the
+// response, or if apiErr is nil, the error is internal.
+func (s *Server) apiDomainOptions(request api.DomainOptions, domain *packages.Domain, userID string) (o *packages.DomainOptions, apiErr *apiClient.Error, err error) {
+	var fqdn string
+	if request.FQDN != nil {
+		fqdn = strings.TrimSpace(*request.FQDN)
+		if fqdn == "" {
+			return nil, api.ErrDomainFQDNRequired, errors.New("request: fqdn empty")
+		}
+
+		if !s.isValidFQDN(fqdn) {
+			return nil, api.ErrDomainFQDNInvalid, errors.New("request: fqdn invalid")
+		}
+	} else if domain == nil {
+		return nil, api.ErrDomainFQDNRequired, errors.New("request: fqdn absent")
+	}
+
+	if fqdn != "" {
+		if apiErr, err = s.checkDomainFQDN(fqdn, domain == nil || domain.FQDN != fqdn, userID); err != nil {
+			return nil, apiErr, err
+		}
+	}
+
+	var aliases *[]string
+	if request.Aliases != nil {
+		aliases = &[]string{}
+		for _, a := range *request.Aliases {
+			a = strings.ToLower(strings.TrimSpace(a))
+			if a == "" {
+				continue
+			}
+			if !s.isValidFQDN(a) || packages.IsWildcardFQDN(a) || a == fqdn || (fqdn == "" && domain != nil && a == domain.FQDN) {
+				return nil, api.ErrDomainAliasInvalid, fmt.Errorf("request: alias invalid: %s", a)
+			}
+			if apiErr, err = s.checkDomainFQDN(a, domain == nil || !domain.HasAlias(a), userID); err != nil {
+				return nil, apiErr, err
+			}
+			*aliases = append(*aliases, a)
+		}
+	}
+
+	o = &packages.DomainOptions{
+		FQDN:              request.FQDN,
+		CertificateIgnore: request.CertificateIgnore,
+		Disabled:          request.Disabled,
+		Aliases:           aliases,
+		AliasRedirect:     request.AliasRedirect,
+	}
+
+	if request.PackageDefaults != nil {
+		defaultsFQDN := fqdn
+		if defaultsFQDN == "" && domain != nil {
+			defaultsFQDN = domain.FQDN
+		}
+		o.PackageDefaults, apiErr, err = apiPackageDefaults(*request.PackageDefaults, defaultsFQDN)
+		if err != nil {
+			return nil, apiErr, err
+		}
+	}
+
+	if request.OwnerUserID != nil {
+		owner, err := s.UserService.User(*request.OwnerUserID)
+		if err != nil {
+			if err == user.ErrUserNotFound {
+				return nil, api.ErrUserDoesNotExist, fmt.Errorf("get owner user: %s: %s", *request.OwnerUserID, err)
+			}
+			return nil, nil, fmt.Errorf("get owner user: %s: %s", *request.OwnerUserID, err)
+		}
+		// Owner of an existing domain is changed only by an accepted
+		// domain transfer. Organization owners can take the ownership of
+		// organization domains, as they already have the owner role.
+		if domain != nil && owner.ID != domain.OwnerUserID && (domain.OrganizationID == "" || owner.ID != userID) {
+			return nil, api.ErrDomainTransferRequired, fmt.Errorf("owner change to %s requires domain transfer", owner.ID)
+		}
+		o.OwnerUserID = &owner.ID
+	} else if request.OrganizationID == nil && domain == nil {
+		o.OwnerUserID = &userID
+	}
+
+	if request.OrganizationID != nil && *request.OrganizationID != "" {
+		org, err := s.PackagesService.Organization(*request.OrganizationID)
+		if err != nil {
+			if err == packages.ErrOrganizationNotFound {
+				return nil, api.ErrOrganizationNotFound, fmt.Errorf("get organization: %s: %s", *request.OrganizationID, err)
+			}
+			return nil, nil, fmt.Errorf("get organization: %s: %s", *request.OrganizationID, err)
+		}
+		o.OrganizationID = &org.ID
+	}
+
+	if domain == nil && !packages.IsWildcardFQDN(fqdn) {
+		t := true
+		o.CertificateIgnoreMissing = &t
+	}
+	return o, nil, nil
+}
+
+// checkDomainFQDN returns an error if the fully qualified domain name
+// of a domain or its alias is not available and, if verify is true,
+// if the user has not verified its ownership.
+func (s *Server) checkDomainFQDN(fqdn string, verify bool, userID string) (apiErr *apiClient.Error, err error) {
 	skipDomainVerification := s.SkipDomainVerification
 	if !skipDomainVerification {
 		for _, d := range s.TrustedDomains {
@@ -204,184 +395,129 @@ func (s *Server) updateDomainAPIHandler(w http.ResponseWriter, r *http.Request)
 
 	for _, d := range s.ForbiddenDomains {
 		if d == fqdn || strings.HasSuffix(fqdn, "."+d) {
-			warningf("domain not available: %s", fqdn)
-			jsonresponse.BadRequest(w, api.ErrDomainNotAvailable)
-			return
+			return api.ErrDomainNotAvailable, fmt.Errorf("domain not available: %s", fqdn)
 		}
 	}
+	if s.isServiceWildcardFQDN(fqdn) {
+		return api.ErrDomainNotAvailable, fmt.Errorf("domain not available: %s", fqdn)
+	}
 
-	// New or changed domain fqdn verification.
-	if fqdn != "" && (domain == nil || domain.FQDN != fqdn) && s.Domain != "" && !skipDomainVerification {
+	// Ownership verification.
+	// Wildcard domains are verified by their parent domain, only with
+	// DNS TXT records, as a file served by the parent domain does not
+	// prove the ownership of its subdomains.
+	if verify && s.Domain != "" && !skipDomainVerification {
+		verificationFQDN := strings.TrimPrefix(fqdn, "*.")
 		switch {
-		case fqdn == s.Domain, strings.HasSuffix(fqdn, "."+s.Domain):
-			if strings.Count(fqdn, ".") > strings.Count(s.Domain, ".")+1 {
-				warningf("domain with too many subdomains: %s", fqdn)
-				jsonresponse.BadRequest(w, api.ErrDomainWithTooManySubdomains)
-				return
+		case verificationFQDN == s.Domain, strings.HasSuffix(verificationFQDN, "."+s.Domain):
+			if strings.Count(verificationFQDN, ".") > strings.Count(s.Domain, ".")+1 {
+				return api.ErrDomainWithTooManySubdomains, fmt.Errorf("domain with too many subdomains: %s", fqdn)
 			}
 		default:
-			publicSuffix, icann := publicsuffix.PublicSuffix(fqdn)
+			publicSuffix, icann := publicsuffix.PublicSuffix(verificationFQDN)
 			if !icann {
-				warningf("domain not icann: %s", fqdn)
-				jsonresponse.BadRequest(w, api.ErrDomainFQDNInvalid)
-				return
+				return api.ErrDomainFQDNInvalid, fmt.Errorf("domain not icann: %s", fqdn)
 			}
-			if fqdn == publicSuffix {
-				warningf("domain is public suffix: %s", fqdn)
-				jsonresponse.BadRequest(w, api.ErrDomainFQDNInvalid)
-				return
+			if verificationFQDN == publicSuffix {
+				return api.ErrDomainFQDNInvalid, fmt.Errorf("domain is public suffix: %s", fqdn)
 			}
 
-			domainParts := strings.Split(fqdn, ".")
+			domainParts := strings.Split(verificationFQDN, ".")
 			startIndex := len(domainParts) - strings.Count(publicSuffix, ".") - 2
 			if startIndex < 0 {
-				warningf("domain is invalid: %s", fqdn)
-				jsonresponse.BadRequest(w, api.ErrDomainFQDNInvalid)
-				return
+				return api.ErrDomainFQDNInvalid, fmt.Errorf("domain is invalid: %s", fqdn)
 			}
 
-			domain, err = s.PackagesService.Domain(fqdn)
-			if err != nil {
+			if _, err = s.PackagesService.Domain(fqdn); err != nil {
 				if err != packages.ErrDomainNotFound {
-					errorf("get domain: %s: %s", fqdn, err)
-					jsonresponse.InternalServerError(w, nil)
-					return
+					return nil, fmt.Errorf("get domain: %s: %s", fqdn, err)
 				}
 			} else {
-				warningf("get domain: %s: %s", fqdn, err)
-				jsonresponse.BadRequest(w, api.ErrDomainAlreadyExists)
-				return
+				return api.ErrDomainAlreadyExists, fmt.Errorf("get domain: %s: domain already exists", fqdn)
 			}
 
+			// HTTP file verifies only the exact domain that is not a
+			// wildcard, and DNS TXT records also verify all subdomains.
 			d := publicSuffix
-			var token, verificationDomain string
-			var verified bool
-			var x [20]byte
+			var method api.DomainVerificationMethod
 			for i := startIndex; i >= 0; i-- {
 				d = fmt.Sprintf("%s.%s", domainParts[i], d)
 
-				x = sha1.Sum(append(s.salt, []byte(u.ID+d)...))
-				token = base64.URLEncoding.EncodeToString(x[:])
-
-				verificationDomain = s.VerificationSubdomain + "." + d
-
-				verified, err = verifyDomain(verificationDomain, token)
+				method, err = s.verifyDomain(d, s.domainVerificationToken(userID, d), d == fqdn)
 				if err != nil {
-					warningf("verify domain: %s: %s", verificationDomain, err)
+					s.Logger.Warningf("domain options: user %s: verify domain: %s: %s", userID, d, err)
 				}
-				if verified {
+				if method != "" {
+					s.Logger.Debugf("domain options: user %s: domain %s verified by %s", userID, d, method)
 					break
 				}
 			}
 
-			if !verified {
-				warningf("domain needs verification: %s", fqdn)
-				jsonresponse.BadRequest(w, api.ErrDomainNeedsVerification)
-				return
+			if method == "" {
+				return api.ErrDomainNeedsVerification, fmt.Errorf("domain needs verification: %s", fqdn)
 			}
 		}
 	}
 
-	ownerUserID := &u.ID
-	if request.OwnerUserID != nil {
-		owner, err := s.UserService.User(*request.OwnerUserID)
-		if err != nil {
-			if err == user.ErrUserNotFound {
-				warningf("get owner user: %s: %s", *request.OwnerUserID, err)
-				jsonresponse.BadRequest(w, api.ErrUserDoesNotExist)
+	return nil, nil
+}
+
+// obtainDomainCertificate obtains a certificate for a newly added domain
+// in the background, if TLS server is active, and clears the domain
+// certificate ignore missing flag when it is done. Certificates for
+// hosts under wildcard domains are obtained on their first request.
+func (s *Server) obtainDomainCertificate(domain *packages.Domain, userID string) {
+	if !s.tlsEnabled || domain.IsWildcard() {
+		return
+	}
+	s.obtainDomainAliasCertificates(domain.Aliases, userID)
+	go func() {
+		defer s.RecoveryService.Recover()
+		defer func() {
+			f := false
+			for {
+				if _, err := s.PackagesService.UpdateDomain(domain.ID, &packages.DomainOptions{
+					CertificateIgnoreMissing: &f,
+				}, userID); err != nil {
+					s.Logger.Errorf("obtain domain certificate: user %s: update domain %s: certificate ignore missing false: %s", userID, domain.FQDN, err)
+					time.Sleep(60 * time.Second)
+					continue
+				}
 				return
 			}
-			errorf("get owner user: %s: %s", *request.OwnerUserID, err)
-			jsonresponse.InternalServerError(w, nil)
+		}()
+		certificate, err := s.CertificateService.ObtainCertificate(domain.FQDN)
+		if err != nil {
+			s.Logger.Errorf("obtain domain certificate: user %s: %s: %s", userID, domain.FQDN, err)
 			return
 		}
-		ownerUserID = &owner.ID
-	}
+		s.Logger.Infof("obtain domain certificate: user %s: success for %s: expiration time: %s", userID, certificate.FQDN, certificate.ExpirationTime)
+	}()
+}
 
-	var editedDomain *packages.Domain
-	if id == "" {
-		t := true
-		editedDomain, err = s.PackagesService.AddDomain(&packages.DomainOptions{
-			FQDN:              request.FQDN,
-			OwnerUserID:       ownerUserID,
-			CertificateIgnore: request.CertificateIgnore,
-			Disabled:          request.Disabled,
-
-			CertificateIgnoreMissing: &t,
-		}, u.ID)
-	} else {
-		if request.OwnerUserID == nil {
-			ownerUserID = nil
-		}
-		editedDomain, err = s.PackagesService.UpdateDomain(id, &packages.DomainOptions{
-			FQDN:              request.FQDN,
-			OwnerUserID:       ownerUserID,
-			CertificateIgnore: request.CertificateIgnore,
-			Disabled:          request.Disabled,
-		}, u.ID)
-	}
-	if err != nil {
-		switch err {
-		case packages.ErrDomainFQDNRequired:
-			warningf("add/update domain: %s: %s", fqdn, err)
-			jsonresponse.BadRequest(w, api.ErrDomainFQDNRequired)
-			return
-		case packages.ErrDomainNotFound:
-			warningf("add/update domain: %s: %s", fqdn, err)
-			jsonresponse.BadRequest(w, api.ErrDomainNotFound)
-			return
-		case packages.ErrForbidden:
-			warningf("add/update domain: %s: %s", fqdn, err)
-			jsonresponse.Forbidden(w, api.ErrForbidden)
-			return
-		case packages.ErrDomainAlreadyExists:
-			warningf("add/update domain: %s: %s", fqdn, err)
-			jsonresponse.BadRequest(w, api.ErrDomainAlreadyExists)
-			return
-		case nil:
-		default:
-			errorf("add/update domain: %s: %s", fqdn, err)
-			jsonresponse.InternalServerError(w, nil)
-			return
-		}
+// obtainDomainAliasCertificates obtains certificates for domain aliases
+// that do not have them in the background, if TLS server is active.
+func (s *Server) obtainDomainAliasCertificates(aliases []string, userID string) {
+	if !s.tlsEnabled || len(aliases) == 0 {
+		return
 	}
-
-	// Obtain certificate only if:
-	// - it is the new domain (id == "")
-	// - TLS server is active (s.tlsEnabled == true)
-	// - the domain as actually created (editedDomain != nil)
-	if id == "" && s.tlsEnabled && editedDomain != nil {
-		go func() {
-			defer s.RecoveryService.Recover()
-			defer func() {
-				f := false
-				for {
-					if _, err = s.PackagesService.UpdateDomain(editedDomain.ID, &packages.DomainOptions{
-						CertificateIgnoreMissing: &f,
-					}, u.ID); err != nil {
-						errorf("update domain %s: certificate ignore missing false: %s", editedDomain.FQDN, err)
-						time.Sleep(60 * time.Second)
-						continue
-					}
-					return
+	go func() {
+		defer s.RecoveryService.Recover()
+		for _, alias := range aliases {
+			if _, err := s.CertificateService.Certificate(alias); err != certificate.ErrCertificateNotFound {
+				if err != nil {
+					s.Logger.Errorf("obtain domain alias certificate: user %s: %s: %s", userID, alias, err)
 				}
-			}()
-			certificate, err := s.CertificateService.ObtainCertificate(editedDomain.FQDN)
+				continue
+			}
+			c, err := s.CertificateService.ObtainCertificate(alias)
 			if err != nil {
-				errorf("obtain certificate: %s: %s", editedDomain.FQDN, err)
-				return
+				s.Logger.Errorf("obtain domain alias certificate: user %s: %s: %s", userID, alias, err)
+				continue
 			}
-			s.Logger.Infof("update domain api: %q: user %s: obtain certificate: success for %s: expiration time: %s", id, u.ID, certificate.FQDN, certificate.ExpirationTime)
-		}()
-	}
-
-	action := "domain update"
-	if id == "" {
-		action = "domain add"
-	}
-	s.auditf(r, request, action, "%s: %s", editedDomain.ID, editedDomain.FQDN)
-
-	jsonresponse.OK(w, packagesDomainToAPIDomain(*editedDomain))
+			s.Logger.Infof("obtain domain alias certificate: user %s: success for %s: expiration time: %s", userID, c.FQDN, c.ExpirationTime)
+		}
+	}()
 }
 
 func (s *Server) deleteDomainAPIHandler(w http.ResponseWriter, r *http.Request) {
@@ -393,7 +529,14 @@ func (s *Server) deleteDomainAPIHandler(w http.ResponseWriter, r *http.Request)
 	vars := mux.Vars(r)
 	id := vars["id"]
 
-	domain, err := s.PackagesService.DeleteDomain(id, u.ID)
+	ifRevision, ok := ifMatchRevision(r)
+	if !ok {
+		s.Logger.Warningf("delete domain api: delete domain %s: invalid if-match header %q", id, r.Header.Get("If-Match"))
+		jsonresponse.PreconditionFailed(w, api.ErrPreconditionFailed)
+		return
+	}
+
+	domain, err := s.PackagesService.DeleteDomain(id, ifRevision, u.ID)
 	if err != nil {
 		switch err {
 		case packages.ErrDomainNotFound:
@@ -404,6 +547,10 @@ func (s *Server) deleteDomainAPIHandler(w http.ResponseWriter, r *http.Request)
 			s.Logger.Warningf("delete domain api: delete domain %s: %s", id, err)
 			jsonresponse.Forbidden(w, api.ErrForbidden)
 			return
+		case packages.ErrDomainRevisionMismatch:
+			s.Logger.Warningf("delete domain api: delete domain %s: %s", id, err)
+			jsonresponse.PreconditionFailed(w, api.ErrPreconditionFailed)
+			return
 		case nil:
 		default:
 			s.Logger.Errorf("delete domain api: delete domain %s: %s", id, err)
@@ -412,6 +559,8 @@ func (s *Server) deleteDomainAPIHandler(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
+	s.moveDomainACMESettings(domain.FQDN, "")
+
 	jsonresponse.OK(w, packagesDomainToAPIDomain(*domain))
 }
 
@@ -433,7 +582,13 @@ func (s *Server) domainsAPIHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	domains, err := s.PackagesService.DomainsByUser(u.ID, startRef, limit)
+	var domains packages.DomainsPage
+	if org := getRequestOrganization(r); org != nil {
+		// Organization API keys list domains owned by the organization.
+		domains, err = s.PackagesService.DomainsByOrganization(org.ID, startRef, limit)
+	} else {
+		domains, err = s.PackagesService.DomainsByUser(u.ID, startRef, limit)
+	}
 	if err != nil {
 		switch err {
 		case packages.ErrDomainNotFound:
@@ -483,7 +638,14 @@ func (s *Server) domainUsersAPIHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if domain.OwnerUserID != u.ID {
+	role, err := s.PackagesService.DomainUserRole(domain.ID, u.ID)
+	if err != nil {
+		s.Logger.Errorf("domain users api: domain user role %s: %s", id, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	if role != packages.DomainRoleOwner {
 		s.Logger.Warningf("domain users api: domain %s: user %s: is not the owner", id, u.ID)
 		jsonresponse.Forbidden(w, nil)
 		return
@@ -504,10 +666,7 @@ func (s *Server) domainUsersAPIHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	jsonresponse.OK(w, api.DomainUsers{
-		OwnerUserID: users.OwnerUserID,
-		UserIDs:     users.UserIDs,
-	})
+	jsonresponse.OK(w, packagesDomainUsersToAPIDomainUsers(users))
 }
 
 func (s *Server) grantDomainUserAPIHandler(w http.ResponseWriter, r *http.Request) {
@@ -531,7 +690,16 @@ func (s *Server) grantDomainUserAPIHandler(w http.ResponseWriter, r *http.Reques
 		jsonresponse.InternalServerError(w, nil)
 		return
 	}
-	err = s.PackagesService.AddUserToDomain(domainID, grantUser.ID, u.ID)
+
+	// Request body is optional and the maintainer role is given by default.
+	request := api.DomainUserOptions{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil && err != io.EOF {
+		s.Logger.Warningf("domain user grant api: request decode: %s", err)
+		jsonresponse.BadRequest(w, api.ErrBadRequest)
+		return
+	}
+
+	err = s.PackagesService.AddUserToDomain(domainID, grantUser.ID, packages.DomainRole(request.Role), u.ID)
 	switch err {
 	case packages.ErrDomainNotFound:
 		s.Logger.Warningf("domain user grant api: user %s: add user %s to domain %s: %s", u.ID, userID, domainID, err)
@@ -539,6 +707,9 @@ func (s *Server) grantDomainUserAPIHandler(w http.ResponseWriter, r *http.Reques
 	case packages.ErrUserExists:
 		s.Logger.Warningf("domain user grant api: user %s: add user %s to domain %s: %s", u.ID, userID, domainID, err)
 		jsonresponse.BadRequest(w, api.ErrUserAlreadyGranted)
+	case packages.ErrDomainRoleInvalid:
+		s.Logger.Warningf("domain user grant api: user %s: add user %s to domain %s: %s", u.ID, userID, domainID, err)
+		jsonresponse.BadRequest(w, api.ErrDomainRoleInvalid)
 	case packages.ErrForbidden:
 		s.Logger.Warningf("domain user grant api: user %s: add user %s to domain %s: %s", u.ID, userID, domainID, err)
 		jsonresponse.Forbidden(w, nil)
@@ -550,6 +721,56 @@ func (s *Server) grantDomainUserAPIHandler(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+func (s *Server) updateDomainUserRoleAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	vars := mux.Vars(r)
+	domainID := vars["id"]
+	userID := vars["user-id"]
+
+	request := api.DomainUserOptions{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.Logger.Warningf("domain user role api: request decode: %s", err)
+		jsonresponse.BadRequest(w, api.ErrBadRequest)
+		return
+	}
+
+	roleUser, err := s.UserService.User(userID)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			s.Logger.Warningf("domain user role api: user %s: domain %s: get user %s: %s", u.ID, domainID, userID, err)
+			jsonresponse.BadRequest(w, api.ErrUserDoesNotExist)
+			return
+		}
+		s.Logger.Errorf("domain user role api: user %s: domain %s: get user %s: %s", u.ID, domainID, userID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+	err = s.PackagesService.SetDomainUserRole(domainID, roleUser.ID, packages.DomainRole(request.Role), u.ID)
+	switch err {
+	case packages.ErrDomainNotFound:
+		s.Logger.Warningf("domain user role api: user %s: set user %s role on domain %s: %s", u.ID, userID, domainID, err)
+		jsonresponse.BadRequest(w, api.ErrDomainNotFound)
+	case packages.ErrUserDoesNotExist:
+		s.Logger.Warningf("domain user role api: user %s: set user %s role on domain %s: %s", u.ID, userID, domainID, err)
+		jsonresponse.BadRequest(w, api.ErrUserNotGranted)
+	case packages.ErrDomainRoleInvalid:
+		s.Logger.Warningf("domain user role api: user %s: set user %s role on domain %s: %s", u.ID, userID, domainID, err)
+		jsonresponse.BadRequest(w, api.ErrDomainRoleInvalid)
+	case packages.ErrForbidden:
+		s.Logger.Warningf("domain user role api: user %s: set user %s role on domain %s: %s", u.ID, userID, domainID, err)
+		jsonresponse.Forbidden(w, nil)
+	case nil:
+		jsonresponse.OK(w, nil)
+	default:
+		s.Logger.Errorf("domain user role api: user %s: set user %s role on domain %s: %s", u.ID, userID, domainID, err)
+		jsonresponse.InternalServerError(w, nil)
+	}
+}
+
 func (s *Server) revokeDomainUserAPIHandler(w http.ResponseWriter, r *http.Request) {
 	u, r, err := s.getRequestUser(r)
 	if err != nil {
@@ -509,96 +509,41 @@ func TestDomainsAPI(t *testing.T) {
 			})
 		})
 		t.Run("change owner localhost", func(t *testing.T) {
-			t.Run("by id", func(t *testing.T) {
-				fqdn := "localhost"
-				owner := users["bob"]
-				domain, err := httpClients["alice"].UpdateDomain(fqdn, &api.DomainOptions{
-					OwnerUserID: &owner.ID,
-				})
-				if err != nil {
-					t.Fatal(err)
-				}
-				if domain.FQDN != fqdn {
-					t.Errorf("expected %q, got %q", fqdn, domain.FQDN)
-				}
-				if domain.OwnerUserID != owner.ID {
-					t.Errorf("expected %q, got %q", owner.ID, domain.OwnerUserID)
-				}
-
-				owner = users["alice"]
-				domain, err = httpClients["bob"].UpdateDomain(fqdn, &api.DomainOptions{
-					OwnerUserID: &owner.ID,
-				})
-				if err != nil {
-					t.Fatal(err)
-				}
-				if domain.FQDN != fqdn {
-					t.Errorf("expected %q, got %q", fqdn, domain.FQDN)
-				}
-				if domain.OwnerUserID != owner.ID {
-					t.Errorf("expected %q, got %q", owner.ID, domain.OwnerUserID)
-				}
-			})
-			t.Run("by username", func(t *testing.T) {
-				fqdn := "localhost"
-				owner := users["bob"]
-				domain, err := httpClients["alice"].UpdateDomain(fqdn, &api.DomainOptions{
-					OwnerUserID: &owner.Username,
-				})
-				if err != nil {
-					t.Fatal(err)
-				}
-				if domain.FQDN != fqdn {
-					t.Errorf("expected %q, got %q", fqdn, domain.FQDN)
-				}
-				if domain.OwnerUserID != owner.ID {
-					t.Errorf("expected %q, got %q", owner.ID, domain.OwnerUserID)
-				}
-
-				owner = users["alice"]
-				domain, err = httpClients["bob"].UpdateDomain(fqdn, &api.DomainOptions{
-					OwnerUserID: &owner.Username,
-				})
-				if err != nil {
-					t.Fatal(err)
-				}
-				if domain.FQDN != fqdn {
-					t.Errorf("expected %q, got %q", fqdn, domain.FQDN)
-				}
-				if domain.OwnerUserID != owner.ID {
-					t.Errorf("expected %q, got %q", owner.ID, domain.OwnerUserID)
-				}
-			})
-			t.Run("by email", func(t *testing.T) {
-				fqdn := "localhost"
-				owner := users["bob"]
-				domain, err := httpClients["alice"].UpdateDomain(fqdn, &api.DomainOptions{
-					OwnerUserID: &owner.Email,
-				})
-				if err != nil {
-					t.Fatal(err)
-				}
-				if domain.FQDN != fqdn {
-					t.Errorf("expected %q, got %q", fqdn, domain.FQDN)
-				}
-				if domain.OwnerUserID != owner.ID {
-					t.Errorf("expected %q, got %q", owner.ID, domain.OwnerUserID)
-				}
+			fqdn := "localhost"
+			bob := users["bob"]
+			alice := users["alice"]
+			for _, tc := range []struct {
+				name    string
+				bob     string
+				current string
+			}{
+				{"by id", bob.ID, alice.ID},
+				{"by username", bob.Username, alice.Username},
+				{"by email", bob.Email, alice.Email},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					// Owner is changed only by domain transfers.
+					_, err := httpClients["alice"].UpdateDomain(fqdn, &api.DomainOptions{
+						OwnerUserID: &tc.bob,
+					})
+					if err != api.ErrDomainTransferRequired {
+						t.Errorf("expected %q, got %q", api.ErrDomainTransferRequired, err)
+					}
 
-				owner = users["alice"]
-				domain, err = httpClients["bob"].UpdateDomain(fqdn, &api.DomainOptions{
-					OwnerUserID: &owner.Email,
+					domain, err := httpClients["alice"].UpdateDomain(fqdn, &api.DomainOptions{
+						OwnerUserID: &tc.current,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+					if domain.FQDN != fqdn {
+						t.Errorf("expected %q, got %q", fqdn, domain.FQDN)
+					}
+					if domain.OwnerUserID != alice.ID {
+						t.Errorf("expected %q, got %q", alice.ID, domain.OwnerUserID)
+					}
 				})
-				if err != nil {
-					t.Fatal(err)
-				}
-				if domain.FQDN != fqdn {
-					t.Errorf("expected %q, got %q", fqdn, domain.FQDN)
-				}
-				if domain.OwnerUserID != owner.ID {
-					t.Errorf("expected %q, got %q", owner.ID, domain.OwnerUserID)
-				}
-			})
+			}
 		})
 		t.Run("forbidden", func(t *testing.T) {
 			_, err := httpClients["chuck"].UpdateDomain("localhost", &api.DomainOptions{})
@@ -686,6 +631,51 @@ func TestDomainsAPI(t *testing.T) {
 			}
 		})
 	})
+	t.Run("domain if-match", func(t *testing.T) {
+		fqdn := "to-delete.localhost"
+		domain, err := httpClients["alice"].Domain(fqdn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if domain.ETag == "" {
+			t.Fatal("expected etag, got none")
+		}
+		staleETag := domain.ETag
+
+		disabled := !domain.Disabled
+		domain, err = httpClients["alice"].UpdateDomainIfMatch(fqdn, staleETag, &api.DomainOptions{
+			Disabled: &disabled,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if domain.ETag == staleETag {
+			t.Errorf("expected etag different from %s", staleETag)
+		}
+
+		disabled = !disabled
+		_, err = httpClients["alice"].UpdateDomainIfMatch(fqdn, staleETag, &api.DomainOptions{
+			Disabled: &disabled,
+		})
+		if err != api.ErrPreconditionFailed {
+			t.Errorf("expected %q, got %q", api.ErrPreconditionFailed, err)
+		}
+
+		_, err = httpClients["alice"].DeleteDomainIfMatch(fqdn, staleETag)
+		if err != api.ErrPreconditionFailed {
+			t.Errorf("expected %q, got %q", api.ErrPreconditionFailed, err)
+		}
+
+		domain, err = httpClients["alice"].UpdateDomainIfMatch(fqdn, domain.ETag, &api.DomainOptions{
+			Disabled: &disabled,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if domain.Disabled != disabled {
+			t.Errorf("expected %v, got %v", disabled, domain.Disabled)
+		}
+	})
 	t.Run("delete domain", func(t *testing.T) {
 		fqdn := "to-delete.localhost"
 		domain, err := httpClients["alice"].DeleteDomain(fqdn)
@@ -717,7 +707,7 @@ func TestDomainsAPI(t *testing.T) {
 		t.Run("by users", func(t *testing.T) {
 			expected := map[string][]api.Domain{
 				"alice": {api.Domain{FQDN: "alice.localhost"}, api.Domain{FQDN: "alice.trusted.com"}, api.Domain{FQDN: "cert-ignore.localhost", CertificateIgnore: true}, api.Domain{FQDN: "disabled.localhost", Disabled: true}, api.Domain{FQDN: "gopherpit.localhost"}, api.Domain{FQDN: "localhost", CertificateIgnore: true, Disabled: true}, api.Domain{FQDN: "trusted.com"}},
-				"bob":   {api.Domain{FQDN: "2.trusted.com"}, api.Domain{FQDN: "bob.localhost"}, api.Domain{FQDN: "bob.trusted.com"}, api.Domain{FQDN: "gopherpit.localhost"}, api.Domain{FQDN: "localhost", CertificateIgnore: true, Disabled: true}, api.Domain{FQDN: "to-bob.localhost"}},
+				"bob":   {api.Domain{FQDN: "2.trusted.com"}, api.Domain{FQDN: "bob.localhost"}, api.Domain{FQDN: "bob.trusted.com"}, api.Domain{FQDN: "gopherpit.localhost"}, api.Domain{FQDN: "to-bob.localhost"}},
 				"chuck": {api.Domain{FQDN: "chuck.localhost"}},
 			}
 			for username := range users {
@@ -726,10 +716,10 @@ func TestDomainsAPI(t *testing.T) {
 					t.Fatal(err)
 				}
 				if domains.Count != len(expected[username]) {
-					t.Fatalf("%s: expected %q, got %q", username, len(expected[username]), domains.Count)
+					t.Fatalf("%s: expected %d, got %d", username, len(expected[username]), domains.Count)
 				}
 				if len(domains.Domains) != len(expected[username]) {
-					t.Fatalf("%s: expected %q, got %q", username, len(expected[username]), len(domains.Domains))
+					t.Fatalf("%s: expected %d, got %d", username, len(expected[username]), len(domains.Domains))
 				}
 				for i, d := range domains.Domains {
 					e := expected[username][i]
@@ -847,44 +837,48 @@ func TestDomainsAPI(t *testing.T) {
 		})
 	})
 	t.Run("list domain tokens", func(t *testing.T) {
-		tokens, err := httpClients["alice"].DomainTokens("example.com")
-		if err != nil {
-			t.Fatal(err)
-		}
-		if len(tokens.Tokens) != 1 {
-			t.Errorf("expected %v, got %v", 1, len(tokens.Tokens))
-		} else {
-			if tokens.Tokens[0].FQDN != "_gopherpit.example.com" {
-				t.Errorf("expected %q, got %q", "_gopherpit.example.com", tokens.Tokens[0].FQDN)
+		for _, tc := range []struct {
+			fqdn    string
+			domains []string
+		}{
+			{"example.com", []string{"example.com"}},
+			{"subsub.sub.example.com", []string{"example.com", "sub.example.com", "subsub.sub.example.com"}},
+		} {
+			tokens, err := httpClients["alice"].DomainTokens(tc.fqdn)
+			if err != nil {
+				t.Fatal(err)
 			}
-			if len(tokens.Tokens[0].Token) != 28 {
-				t.Errorf("expected %v, got %v", 28, len(tokens.Tokens[0].Token))
+			// HTTP file token is only for the exact domain.
+			if len(tokens.Tokens) != len(tc.domains)+1 {
+				t.Errorf("%s: expected %v, got %v", tc.fqdn, len(tc.domains)+1, len(tokens.Tokens))
+				continue
 			}
-		}
-		tokens, err = httpClients["alice"].DomainTokens("subsub.sub.example.com")
-		if err != nil {
-			t.Fatal(err)
-		}
-		if len(tokens.Tokens) != 3 {
-			t.Errorf("expected %v, got %v", 3, len(tokens.Tokens))
-		} else {
-			if tokens.Tokens[0].FQDN != "_gopherpit.example.com" {
-				t.Errorf("expected %q, got %q", "_gopherpit.example.com", tokens.Tokens[0].FQDN)
-			}
-			if len(tokens.Tokens[0].Token) != 28 {
-				t.Errorf("expected %v, got %v", 28, len(tokens.Tokens[0].Token))
+			for i, d := range tc.domains {
+				txt := tokens.Tokens[i]
+				if txt.Method != api.DomainVerificationDNSTXT {
+					t.Errorf("%s: expected %q, got %q", tc.fqdn, api.DomainVerificationDNSTXT, txt.Method)
+				}
+				if txt.FQDN != "_gopherpit."+d {
+					t.Errorf("%s: expected %q, got %q", tc.fqdn, "_gopherpit."+d, txt.FQDN)
+				}
+				if len(txt.Token) != 28 {
+					t.Errorf("%s: expected %v, got %v", tc.fqdn, 28, len(txt.Token))
+				}
 			}
-			if tokens.Tokens[1].FQDN != "_gopherpit.sub.example.com" {
-				t.Errorf("expected %q, got %q", "_gopherpit.sub.example.com", tokens.Tokens[1].FQDN)
+			txt := tokens.Tokens[len(tc.domains)-1]
+			file := tokens.Tokens[len(tc.domains)]
+			if file.Method != api.DomainVerificationHTTPFile {
+				t.Errorf("%s: expected %q, got %q", tc.fqdn, api.DomainVerificationHTTPFile, file.Method)
 			}
-			if len(tokens.Tokens[1].Token) != 28 {
-				t.Errorf("expected %v, got %v", 28, len(tokens.Tokens[1].Token))
+			if file.FQDN != tc.fqdn {
+				t.Errorf("%s: expected %q, got %q", tc.fqdn, tc.fqdn, file.FQDN)
 			}
-			if tokens.Tokens[2].FQDN != "_gopherpit.subsub.sub.example.com" {
-				t.Errorf("expected %q, got %q", "_gopherpit.subsub.sub.example.com", tokens.Tokens[2].FQDN)
+			if file.Token != txt.Token {
+				t.Errorf("%s: expected %q, got %q", tc.fqdn, txt.Token, file.Token)
 			}
-			if len(tokens.Tokens[2].Token) != 28 {
-				t.Errorf("expected %v, got %v", 28, len(tokens.Tokens[2].Token))
+			url := "https://" + tc.fqdn + "/.well-known/gopherpit-verification/" + txt.Token
+			if file.URL != url {
+				t.Errorf("%s: expected %q, got %q", tc.fqdn, url, file.URL)
 			}
 		}
 		t.Run("domain fqdn invalid", func(t *testing.T) {
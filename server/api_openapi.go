@@ -0,0 +1,1067 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"resenje.org/jsonresponse"
+	"resenje.org/web/client/api"
+
+	"gopherpit.com/gopherpit/api"
+)
+
+const openAPIPathPrefix = "/api/v1"
+
+// openAPIParameter describes a query parameter of an API operation.
+type openAPIParameter struct {
+	Name        string
+	Description string
+	Type        string
+}
+
+// openAPIOperation describes a single method of an API route.
+// Every route and method registered in newAPIRouter must have
+// a corresponding element in openAPIOperations.
+type openAPIOperation struct {
+	Method   string
+	Path     string
+	ID       string
+	Summary  string
+	Query    []openAPIParameter
+	Request  interface{}
+	Response interface{}
+	Errors   []*apiClient.Error
+	// IfMatch is true if the operation accepts If-Match header.
+	IfMatch bool
+	// ETag is true if the response contains ETag header.
+	ETag bool
+	// RequestOptional is true if the Request body may be omitted.
+	RequestOptional bool
+}
+
+var (
+	openAPIPagingParameters = []openAPIParameter{
+		{
+			Name:        "start",
+			Description: "Reference to the first element on the page, as returned in the next or previous field of the previous response.",
+			Type:        "string",
+		},
+		{
+			Name:        "limit",
+			Description: "Maximum number of elements on the page. The value must not be greater than " + strconv.Itoa(api.MaxLimit) + ".",
+			Type:        "integer",
+		},
+	}
+
+	openAPIOperations = []openAPIOperation{
+		{
+			Method:   "GET",
+			Path:     "/api/v1/domains",
+			ID:       "getDomains",
+			Summary:  "List domains that the user has access to.",
+			Query:    openAPIPagingParameters,
+			Response: api.DomainsPage{},
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/domains",
+			ID:       "addDomain",
+			Summary:  "Add a new domain.",
+			Request:  api.DomainOptions{},
+			Response: api.Domain{},
+			ETag:     true,
+			Errors: []*apiClient.Error{
+				api.ErrDomainAlreadyExists,
+				api.ErrDomainFQDNRequired,
+				api.ErrDomainFQDNInvalid,
+				api.ErrDomainNotAvailable,
+				api.ErrDomainWithTooManySubdomains,
+				api.ErrDomainNeedsVerification,
+				api.ErrDomainAliasInvalid,
+				api.ErrDomainAliasAlreadyExists,
+				api.ErrDomainQuotaExceeded,
+				api.ErrUserDoesNotExist,
+				api.ErrOrganizationNotFound,
+				api.ErrPackageRepoRootInvalid,
+				api.ErrPackageRepoRootSchemeRequired,
+				api.ErrPackageRepoRootSchemeInvalid,
+				api.ErrPackageRepoRootHostInvalid,
+				api.ErrPackageRefTypeInvalid,
+				api.ErrPackageRefNameRequired,
+				api.ErrPackageRedirectURLInvalid,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/domains/{id}",
+			ID:       "getDomain",
+			Summary:  "Get a domain by its ID or fully qualified domain name.",
+			Response: api.Domain{},
+			ETag:     true,
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/domains/{id}",
+			ID:       "updateDomain",
+			Summary:  "Update fields of an existing domain.",
+			Request:  api.DomainOptions{},
+			Response: api.Domain{},
+			IfMatch:  true,
+			ETag:     true,
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+				api.ErrDomainAlreadyExists,
+				api.ErrDomainFQDNRequired,
+				api.ErrDomainFQDNInvalid,
+				api.ErrDomainNotAvailable,
+				api.ErrDomainWithTooManySubdomains,
+				api.ErrDomainNeedsVerification,
+				api.ErrDomainAliasInvalid,
+				api.ErrDomainAliasAlreadyExists,
+				api.ErrDomainQuotaExceeded,
+				api.ErrDomainTransferRequired,
+				api.ErrPackageQuotaExceeded,
+				api.ErrUserDoesNotExist,
+				api.ErrOrganizationNotFound,
+				api.ErrPackageRepoRootInvalid,
+				api.ErrPackageRepoRootSchemeRequired,
+				api.ErrPackageRepoRootSchemeInvalid,
+				api.ErrPackageRepoRootHostInvalid,
+				api.ErrPackageRefTypeInvalid,
+				api.ErrPackageRefNameRequired,
+				api.ErrPackageRedirectURLInvalid,
+			},
+		},
+		{
+			Method:   "DELETE",
+			Path:     "/api/v1/domains/{id}",
+			ID:       "deleteDomain",
+			Summary:  "Delete a domain and all of its packages.",
+			Response: api.Domain{},
+			IfMatch:  true,
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/domains/{id}/tokens",
+			ID:       "getDomainTokens",
+			Summary:  "List verification tokens for a domain and its parent domains.",
+			Response: api.DomainTokens{},
+			Errors: []*apiClient.Error{
+				api.ErrDomainFQDNInvalid,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/domains/{id}/users",
+			ID:       "getDomainUsers",
+			Summary:  "List users that have access to a domain.",
+			Response: api.DomainUsers{},
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+			},
+		},
+		{
+			Method:          "POST",
+			Path:            "/api/v1/domains/{id}/users/{user-id}",
+			ID:              "grantDomainUser",
+			Summary:         "Grant a user access to a domain, with the maintainer role if the role is not specified.",
+			Request:         api.DomainUserOptions{},
+			RequestOptional: true,
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+				api.ErrUserDoesNotExist,
+				api.ErrUserAlreadyGranted,
+				api.ErrDomainRoleInvalid,
+			},
+		},
+		{
+			Method:  "POST",
+			Path:    "/api/v1/domains/{id}/users/{user-id}/role",
+			ID:      "updateDomainUserRole",
+			Summary: "Change the role of a user that has access to a domain.",
+			Request: api.DomainUserOptions{},
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+				api.ErrUserDoesNotExist,
+				api.ErrUserNotGranted,
+				api.ErrDomainRoleInvalid,
+			},
+		},
+		{
+			Method:  "DELETE",
+			Path:    "/api/v1/domains/{id}/users/{user-id}",
+			ID:      "revokeDomainUser",
+			Summary: "Revoke access to a domain from a user.",
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+				api.ErrUserDoesNotExist,
+				api.ErrUserNotGranted,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/domains/{id}/acme-settings",
+			ID:       "getDomainACMESettings",
+			Summary:  "Get parameters for obtaining TLS certificates for a domain.",
+			Response: api.ACMESettings{},
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/domains/{id}/acme-settings",
+			ID:       "updateDomainACMESettings",
+			Summary:  "Select a DNS provider for dns-01 challenges, or http-01 challenges with an empty DNS provider. Only domain owners can change it.",
+			Request:  api.ACMESettingsOptions{},
+			Response: api.ACMESettings{},
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+				api.ErrDNSProviderNotFound,
+				api.ErrKeyTypeInvalid,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/domains/{id}/certificate",
+			ID:       "getDomainCertificate",
+			Summary:  "Get the expiration time and the last renewal attempt of a domain TLS certificate.",
+			Response: api.Certificate{},
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+				api.ErrCertificateNotFound,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/domains/{id}/certificate/renew",
+			ID:       "renewDomainCertificate",
+			Summary:  "Queue a domain TLS certificate for renewal, regardless of its expiration time. Only domain owners can renew it.",
+			Response: api.Certificate{},
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+				api.ErrCertificateNotFound,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/domains/{id}/transfer",
+			ID:       "getDomainTransfer",
+			Summary:  "Get a pending transfer of a domain to a new owner.",
+			Response: api.DomainTransfer{},
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+				api.ErrDomainTransferNotFound,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/domains/{id}/transfer",
+			ID:       "requestDomainTransfer",
+			Summary:  "Request a transfer of a domain to a new owner that must accept it.",
+			Request:  api.DomainTransferOptions{},
+			Response: api.DomainTransfer{},
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+				api.ErrUserDoesNotExist,
+				api.ErrDomainTransferAlreadyExists,
+				api.ErrDomainTransferToOwner,
+			},
+		},
+		{
+			Method:   "DELETE",
+			Path:     "/api/v1/domains/{id}/transfer",
+			ID:       "cancelDomainTransfer",
+			Summary:  "Cancel or decline a pending transfer of a domain.",
+			Response: api.DomainTransfer{},
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+				api.ErrDomainTransferNotFound,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/domains/{id}/transfer/accept",
+			ID:       "acceptDomainTransfer",
+			Summary:  "Accept a pending transfer of a domain and become its owner.",
+			Response: api.Domain{},
+			ETag:     true,
+			Errors: []*apiClient.Error{
+				api.ErrDomainNotFound,
+				api.ErrDomainTransferNotFound,
+				api.ErrDomainTransferExpired,
+				api.ErrDomainQuotaExceeded,
+				api.ErrPackageQuotaExceeded,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/domain-transfers",
+			ID:       "getDomainTransfers",
+			Summary:  "List pending domain transfers requested by or for the user.",
+			Response: api.DomainTransfers{},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/organizations",
+			ID:       "getOrganizations",
+			Summary:  "List organizations that the user is a member of.",
+			Response: api.Organizations{},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/organizations",
+			ID:       "addOrganization",
+			Summary:  "Add a new organization with the user as its owner.",
+			Request:  api.OrganizationOptions{},
+			Response: api.Organization{},
+			Errors: []*apiClient.Error{
+				api.ErrOrganizationAlreadyExists,
+				api.ErrOrganizationNameRequired,
+				api.ErrOrganizationNameInvalid,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/organizations/{id}",
+			ID:       "getOrganization",
+			Summary:  "Get an organization by its ID or name.",
+			Response: api.Organization{},
+			Errors: []*apiClient.Error{
+				api.ErrOrganizationNotFound,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/organizations/{id}",
+			ID:       "updateOrganization",
+			Summary:  "Update fields of an existing organization.",
+			Request:  api.OrganizationOptions{},
+			Response: api.Organization{},
+			Errors: []*apiClient.Error{
+				api.ErrOrganizationNotFound,
+				api.ErrOrganizationAlreadyExists,
+				api.ErrOrganizationNameRequired,
+				api.ErrOrganizationNameInvalid,
+			},
+		},
+		{
+			Method:   "DELETE",
+			Path:     "/api/v1/organizations/{id}",
+			ID:       "deleteOrganization",
+			Summary:  "Delete an organization that does not own any domains.",
+			Response: api.Organization{},
+			Errors: []*apiClient.Error{
+				api.ErrOrganizationNotFound,
+				api.ErrOrganizationHasDomains,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/organizations/{id}/members",
+			ID:       "getOrganizationMembers",
+			Summary:  "List members of an organization and their roles.",
+			Response: api.OrganizationMembers{},
+			Errors: []*apiClient.Error{
+				api.ErrOrganizationNotFound,
+			},
+		},
+		{
+			Method:          "POST",
+			Path:            "/api/v1/organizations/{id}/members/{user-id}",
+			ID:              "setOrganizationMember",
+			Summary:         "Add a member to an organization or change the role of a member, with the member role if the role is not specified.",
+			Request:         api.OrganizationMemberOptions{},
+			RequestOptional: true,
+			Errors: []*apiClient.Error{
+				api.ErrOrganizationNotFound,
+				api.ErrUserDoesNotExist,
+				api.ErrOrganizationRoleInvalid,
+				api.ErrOrganizationLastOwner,
+			},
+		},
+		{
+			Method:  "DELETE",
+			Path:    "/api/v1/organizations/{id}/members/{user-id}",
+			ID:      "removeOrganizationMember",
+			Summary: "Remove a member from an organization.",
+			Errors: []*apiClient.Error{
+				api.ErrOrganizationNotFound,
+				api.ErrUserDoesNotExist,
+				api.ErrOrganizationMemberNotFound,
+				api.ErrOrganizationLastOwner,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/organizations/{id}/domains",
+			ID:       "getOrganizationDomains",
+			Summary:  "List domains owned by an organization.",
+			Query:    openAPIPagingParameters,
+			Response: api.DomainsPage{},
+			Errors: []*apiClient.Error{
+				api.ErrOrganizationNotFound,
+				api.ErrDomainNotFound,
+			},
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/v1/domains/{id}/packages",
+			ID:      "getDomainPackages",
+			Summary: "List packages of a domain ordered by path, optionally filtered.",
+			Query: append([]openAPIParameter{
+				{
+					Name:        "vcs",
+					Description: "List only packages with this version control system.",
+					Type:        "string",
+				},
+				{
+					Name:        "ref_type",
+					Description: "List only packages with this reference type.",
+					Type:        "string",
+				},
+				{
+					Name:        "repo_root_host",
+					Description: "List only packages with repository root on this host.",
+					Type:        "string",
+				},
+				{
+					Name:        "disabled",
+					Description: "List only disabled or only enabled packages.",
+					Type:        "boolean",
+				},
+				{
+					Name:        "query",
+					Description: "List only packages with paths that contain this case insensitive substring.",
+					Type:        "string",
+				},
+			}, openAPIPagingParameters...),
+			Response: api.PackagesPage{},
+			Errors: []*apiClient.Error{
+				api.ErrBadRequest,
+				api.ErrDomainNotFound,
+				api.ErrPackageNotFound,
+			},
+		},
+		{
+			Method:  "POST",
+			Path:    "/api/v1/domains/{id}/packages/sync",
+			ID:      "syncDomainPackages",
+			Summary: "Add, update and delete packages of a domain to match the manifest.",
+			Query: []openAPIParameter{
+				{
+					Name:        "dry_run",
+					Description: "Only return the plan without applying changes.",
+					Type:        "boolean",
+				},
+			},
+			Request:  api.PackagesManifest{},
+			Response: api.PackagesPlan{},
+			Errors:   openAPIPackageUpdateErrors,
+		},
+		{
+			Method:  "POST",
+			Path:    "/api/v1/domains/{id}/packages/defaults",
+			ID:      "applyDomainPackageDefaults",
+			Summary: "Apply package defaults of a domain to its existing packages.",
+			Query: []openAPIParameter{
+				{
+					Name:        "dry_run",
+					Description: "Only return the plan without applying changes.",
+					Type:        "boolean",
+				},
+			},
+			Request:  api.PackageDefaultsApplication{},
+			Response: api.PackagesPlan{},
+			Errors: append([]*apiClient.Error{
+				api.ErrPackageDefaultsFieldInvalid,
+			}, openAPIPackageUpdateErrors...),
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/v1/packages",
+			ID:      "searchPackages",
+			Summary: "Search packages under all domains that the user has access to.",
+			Query: []openAPIParameter{
+				{
+					Name:        "query",
+					Description: "Case insensitive substring of the package import prefix, repository root or reference name.",
+					Type:        "string",
+				},
+				{
+					Name:        "start",
+					Description: "ID of the first package on the page, as returned in the next field of the previous response.",
+					Type:        "string",
+				},
+				openAPIPagingParameters[1],
+			},
+			Response: api.PackagesPage{},
+			Errors: []*apiClient.Error{
+				api.ErrBadRequest,
+				api.ErrPackageNotFound,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/packages",
+			ID:       "addPackage",
+			Summary:  "Add a new package.",
+			Request:  api.PackageOptions{},
+			Response: api.Package{},
+			ETag:     true,
+			Errors:   openAPIPackageUpdateErrors,
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/packages/{id}",
+			ID:       "getPackage",
+			Summary:  "Get a package by its ID.",
+			Response: api.Package{},
+			ETag:     true,
+			Errors: []*apiClient.Error{
+				api.ErrPackageNotFound,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/packages/{id}",
+			ID:       "updatePackage",
+			Summary:  "Update fields of an existing package.",
+			Request:  api.PackageOptions{},
+			Response: api.Package{},
+			IfMatch:  true,
+			ETag:     true,
+			Errors:   openAPIPackageUpdateErrors,
+		},
+		{
+			Method:   "DELETE",
+			Path:     "/api/v1/packages/{id}",
+			ID:       "deletePackage",
+			Summary:  "Delete a package.",
+			Response: api.Package{},
+			IfMatch:  true,
+			Errors: []*apiClient.Error{
+				api.ErrPackageNotFound,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/batch",
+			ID:       "batch",
+			Summary:  "Apply a list of domain and package operations atomically. If an operation fails, none of them are applied and the error response contains the index of the failed operation in the operation field.",
+			Request:  api.BatchRequest{},
+			Response: api.BatchResponse{},
+			Errors: append([]*apiClient.Error{
+				api.ErrPreconditionFailed,
+				api.ErrBatchActionInvalid,
+				api.ErrDomainAlreadyExists,
+				api.ErrDomainFQDNRequired,
+				api.ErrDomainFQDNInvalid,
+				api.ErrDomainNotAvailable,
+				api.ErrDomainWithTooManySubdomains,
+				api.ErrDomainNeedsVerification,
+				api.ErrDomainAliasInvalid,
+				api.ErrDomainAliasAlreadyExists,
+				api.ErrDomainQuotaExceeded,
+				api.ErrDomainTransferRequired,
+				api.ErrUserDoesNotExist,
+				api.ErrUserAlreadyGranted,
+				api.ErrUserNotGranted,
+				api.ErrDomainRoleInvalid,
+			}, openAPIPackageUpdateErrors...),
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/users/{id}/rate-limits",
+			ID:       "getRateLimits",
+			Summary:  "Get API rate limits of a user. Only administrators can get rate limits of other users.",
+			Response: api.RateLimits{},
+			Errors: []*apiClient.Error{
+				api.ErrUserDoesNotExist,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/users/{id}/rate-limits",
+			ID:       "updateRateLimits",
+			Summary:  "Change API rate limit overrides of a user. Only administrators can change rate limits.",
+			Request:  api.RateLimitsOptions{},
+			Response: api.RateLimits{},
+			Errors: []*apiClient.Error{
+				api.ErrUserDoesNotExist,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/users/{id}/quota",
+			ID:       "getQuota",
+			Summary:  "Get domain and package quota of a user. Only administrators can get quotas of other users.",
+			Response: api.Quota{},
+			Errors: []*apiClient.Error{
+				api.ErrUserDoesNotExist,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/users/{id}/quota",
+			ID:       "updateQuota",
+			Summary:  "Change domain and package quota overrides of a user. Only administrators can change quotas.",
+			Request:  api.QuotaOptions{},
+			Response: api.Quota{},
+			Errors: []*apiClient.Error{
+				api.ErrUserDoesNotExist,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/organizations/{id}/quota",
+			ID:       "getOrganizationQuota",
+			Summary:  "Get domain and package quota of an organization. Only organization members and administrators can get it.",
+			Response: api.Quota{},
+			Errors: []*apiClient.Error{
+				api.ErrOrganizationNotFound,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/organizations/{id}/quota",
+			ID:       "updateOrganizationQuota",
+			Summary:  "Change domain and package quota overrides of an organization. Only administrators can change quotas.",
+			Request:  api.QuotaOptions{},
+			Response: api.Quota{},
+			Errors: []*apiClient.Error{
+				api.ErrOrganizationNotFound,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/acme/user",
+			ID:       "getACMEUser",
+			Summary:  "Get the ACME account that is used to obtain TLS certificates. Only administrators can get it.",
+			Response: api.ACMEUser{},
+			Errors: []*apiClient.Error{
+				api.ErrACMEUserNotFound,
+			},
+		},
+		{
+			Method:   "POST",
+			Path:     "/api/v1/acme/user/key-rollover",
+			ID:       "rolloverACMEUserKey",
+			Summary:  "Replace the ACME account key with a newly generated one. Only administrators can do it.",
+			Response: api.ACMEUser{},
+			Errors: []*apiClient.Error{
+				api.ErrACMEUserNotFound,
+			},
+		},
+		{
+			Method:   "GET",
+			Path:     "/api/v1/acme/dns-providers",
+			ID:       "getDNSProviders",
+			Summary:  "List DNS providers that can be selected for domains to obtain TLS certificates with dns-01 challenges.",
+			Response: api.DNSProviders{},
+		},
+	}
+
+	openAPIPackageUpdateErrors = []*apiClient.Error{
+		api.ErrDomainNotFound,
+		api.ErrPackageNotFound,
+		api.ErrPackageAlreadyExists,
+		api.ErrPackageDomainRequired,
+		api.ErrPackagePathRequired,
+		api.ErrPackageVCSRequired,
+		api.ErrPackageRepoRootRequired,
+		api.ErrPackageRepoRootInvalid,
+		api.ErrPackageRepoRootSchemeRequired,
+		api.ErrPackageRepoRootSchemeInvalid,
+		api.ErrPackageRepoRootHostInvalid,
+		api.ErrPackageRefTypeInvalid,
+		api.ErrPackageRefNameRequired,
+		api.ErrPackageRefChangeRejected,
+		api.ErrPackageRedirectURLInvalid,
+		api.ErrPackageQuotaExceeded,
+	}
+
+	// Errors that can be returned by any API operation.
+	openAPICommonErrors = []*apiClient.Error{
+		api.ErrBadRequest,
+		api.ErrUnauthorized,
+		api.ErrForbidden,
+		api.ErrTooManyRequests,
+		api.ErrInternalServerError,
+		api.ErrMaintenance,
+	}
+
+	// Possible values of string types in API schemas.
+	openAPIEnums = map[reflect.Type][]string{
+		reflect.TypeOf(api.VCSGit): {
+			string(api.VCSGit),
+			string(api.VCSMercurial),
+			string(api.VCSBazaar),
+			string(api.VCSSubversion),
+		},
+		reflect.TypeOf(api.RefTypeBranch): {
+			string(api.RefTypeBranch),
+			string(api.RefTypeTag),
+		},
+		reflect.TypeOf(api.DomainVerificationDNSTXT): {
+			string(api.DomainVerificationDNSTXT),
+			string(api.DomainVerificationHTTPFile),
+		},
+		reflect.TypeOf(api.DomainRoleOwner): {
+			string(api.DomainRoleOwner),
+			string(api.DomainRoleMaintainer),
+			string(api.DomainRoleViewer),
+		},
+		reflect.TypeOf(api.OrganizationRoleOwner): {
+			string(api.OrganizationRoleOwner),
+			string(api.OrganizationRoleMember),
+		},
+		reflect.TypeOf(api.PackageDefaultsFieldVCS): {
+			string(api.PackageDefaultsFieldVCS),
+			string(api.PackageDefaultsFieldRepoRoot),
+			string(api.PackageDefaultsFieldRef),
+			string(api.PackageDefaultsFieldGoSource),
+			string(api.PackageDefaultsFieldRedirectURL),
+		},
+		reflect.TypeOf(api.BatchActionAddDomain): {
+			string(api.BatchActionAddDomain),
+			string(api.BatchActionUpdateDomain),
+			string(api.BatchActionDeleteDomain),
+			string(api.BatchActionGrantDomainUser),
+			string(api.BatchActionRevokeDomainUser),
+			string(api.BatchActionUpdateDomainUser),
+			string(api.BatchActionAddPackage),
+			string(api.BatchActionUpdatePackage),
+			string(api.BatchActionDeletePackage),
+		},
+	}
+
+	openAPIPathParameterRegex = regexp.MustCompile(`\{([^}]+)\}`)
+)
+
+// openAPIErrorStatus returns HTTP status code of a response
+// that contains the API error. Errors with codes that are not HTTP
+// status codes are returned with Bad Request responses.
+func openAPIErrorStatus(err *apiClient.Error) int {
+	if err.Code < 1000 {
+		return err.Code
+	}
+	return http.StatusBadRequest
+}
+
+// openAPISchemas generates OpenAPI schema objects from Go types
+// based on their JSON encoding rules.
+type openAPISchemas map[string]interface{}
+
+func (schemas openAPISchemas) schema(t reflect.Type) map[string]interface{} {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		name := t.Name()
+		if _, ok := schemas[name]; !ok {
+			// Reserve the name before processing fields
+			// to support recursive types.
+			schemas[name] = nil
+			schemas[name] = schemas.object(t)
+		}
+		return map[string]interface{}{
+			"$ref": "#/components/schemas/" + name,
+		}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{
+			"type":  "array",
+			"items": schemas.schema(t.Elem()),
+		}
+	case reflect.String:
+		s := map[string]interface{}{
+			"type": "string",
+		}
+		if enum, ok := openAPIEnums[t]; ok {
+			s["enum"] = enum
+		}
+		return s
+	case reflect.Bool:
+		return map[string]interface{}{
+			"type": "boolean",
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{
+			"type": "integer",
+		}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{
+			"type": "number",
+		}
+	}
+	return map[string]interface{}{}
+}
+
+func (schemas openAPISchemas) object(t reflect.Type) map[string]interface{} {
+	properties := map[string]interface{}{}
+	required := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		name := parts[0]
+		if name == "" {
+			name = f.Name
+		}
+		omitempty := false
+		for _, p := range parts[1:] {
+			if p == "omitempty" {
+				omitempty = true
+			}
+		}
+		properties[name] = schemas.schema(f.Type)
+		if !omitempty && f.Type.Kind() != reflect.Ptr {
+			required = append(required, name)
+		}
+	}
+	o := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		o["required"] = required
+	}
+	return o
+}
+
+// openAPIRateLimitHeaders returns descriptions of rate limit response
+// headers that are returned if the request is rate limited.
+func openAPIRateLimitHeaders() map[string]interface{} {
+	headers := map[string]interface{}{}
+	for name, description := range map[string]string{
+		"RateLimit-Limit":     "Maximum number of requests per hour.",
+		"RateLimit-Remaining": "Number of requests remaining in the current window.",
+		"RateLimit-Reset":     "Seconds until the current window is reset.",
+	} {
+		headers[name] = map[string]interface{}{
+			"description": description,
+			"schema": map[string]interface{}{
+				"type": "integer",
+			},
+		}
+	}
+	return headers
+}
+
+func openAPIErrorDescription(errs []*apiClient.Error) string {
+	lines := make([]string, 0, len(errs))
+	for _, err := range errs {
+		lines = append(lines, fmt.Sprintf("%d: %s", err.Code, err.Message))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// openAPIDocument returns OpenAPI 3 description of all API operations
+// as a value that can be encoded to JSON.
+func (s *Server) openAPIDocument() map[string]interface{} {
+	schemas := openAPISchemas{}
+
+	errorSchema := map[string]interface{}{
+		"$ref": "#/components/schemas/Error",
+	}
+	codes := []int{}
+	for _, err := range api.Errors() {
+		codes = append(codes, err.Code)
+	}
+	sort.Ints(codes)
+	schemas["Error"] = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"message": map[string]interface{}{
+				"type": "string",
+			},
+			"code": map[string]interface{}{
+				"type":        "integer",
+				"enum":        codes,
+				"description": openAPIErrorDescription(api.Errors()),
+			},
+		},
+		"required": []string{"message", "code"},
+	}
+
+	paths := map[string]interface{}{}
+	for _, o := range openAPIOperations {
+		path := strings.TrimPrefix(o.Path, openAPIPathPrefix)
+		item, ok := paths[path].(map[string]interface{})
+		if !ok {
+			item = map[string]interface{}{}
+			paths[path] = item
+		}
+
+		parameters := []interface{}{}
+		for _, m := range openAPIPathParameterRegex.FindAllStringSubmatch(o.Path, -1) {
+			parameters = append(parameters, map[string]interface{}{
+				"name":     m[1],
+				"in":       "path",
+				"required": true,
+				"schema": map[string]interface{}{
+					"type": "string",
+				},
+			})
+		}
+		if o.IfMatch {
+			parameters = append(parameters, map[string]interface{}{
+				"name":        "If-Match",
+				"in":          "header",
+				"description": "Entity tag of the resource revision that the operation is conditioned on.",
+				"schema": map[string]interface{}{
+					"type": "string",
+				},
+			})
+		}
+		for _, p := range o.Query {
+			parameters = append(parameters, map[string]interface{}{
+				"name":        p.Name,
+				"in":          "query",
+				"description": p.Description,
+				"schema": map[string]interface{}{
+					"type": p.Type,
+				},
+			})
+		}
+
+		success := map[string]interface{}{
+			"description": "OK",
+		}
+		if o.Response != nil {
+			success["content"] = map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": schemas.schema(reflect.TypeOf(o.Response)),
+				},
+			}
+		}
+		headers := openAPIRateLimitHeaders()
+		if o.ETag {
+			headers["ETag"] = map[string]interface{}{
+				"description": "Entity tag of the resource revision.",
+				"schema": map[string]interface{}{
+					"type": "string",
+				},
+			}
+		}
+		success["headers"] = headers
+		responses := map[string]interface{}{
+			"200": success,
+		}
+		operationErrors := append(append([]*apiClient.Error{}, openAPICommonErrors...), o.Errors...)
+		if o.IfMatch {
+			operationErrors = append(operationErrors, api.ErrPreconditionFailed)
+		}
+		errs := map[int][]*apiClient.Error{}
+		for _, err := range operationErrors {
+			status := openAPIErrorStatus(err)
+			errs[status] = append(errs[status], err)
+		}
+		for status, e := range errs {
+			codes := []int{}
+			for _, err := range e {
+				codes = append(codes, err.Code)
+			}
+			response := map[string]interface{}{
+				"description":   openAPIErrorDescription(e),
+				"x-error-codes": codes,
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": errorSchema,
+					},
+				},
+			}
+			if status == http.StatusTooManyRequests {
+				headers := openAPIRateLimitHeaders()
+				headers["Retry-After"] = map[string]interface{}{
+					"description": "Seconds until new requests are permitted.",
+					"schema": map[string]interface{}{
+						"type": "integer",
+					},
+				}
+				response["headers"] = headers
+			}
+			responses[strconv.Itoa(status)] = response
+		}
+
+		operation := map[string]interface{}{
+			"operationId": o.ID,
+			"summary":     o.Summary,
+			"responses":   responses,
+		}
+		if len(parameters) > 0 {
+			operation["parameters"] = parameters
+		}
+		if o.Request != nil {
+			operation["requestBody"] = map[string]interface{}{
+				"required": !o.RequestOptional,
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": schemas.schema(reflect.TypeOf(o.Request)),
+					},
+				},
+			}
+		}
+		item[strings.ToLower(o.Method)] = operation
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.0",
+		"info": map[string]interface{}{
+			"title":   s.Brand + " API",
+			"version": "1",
+		},
+		"servers": []interface{}{
+			map[string]interface{}{
+				"url": openAPIPathPrefix,
+			},
+		},
+		"security": []interface{}{
+			map[string]interface{}{
+				"key": []string{},
+			},
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+			"securitySchemes": map[string]interface{}{
+				"key": map[string]interface{}{
+					"type": "apiKey",
+					"in":   "header",
+					"name": "X-Key",
+				},
+			},
+		},
+	}
+}
+
+func (s *Server) openAPIHandler(w http.ResponseWriter, r *http.Request) {
+	jsonresponse.OK(w, s.openAPIDocument())
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"gopherpit.com/gopherpit/api"
+)
+
+func TestOpenAPIOperations(t *testing.T) {
+	routes := []string{}
+	router := newAPIRouter(&Server{}).(*mux.Router)
+	if err := router.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return err
+		}
+		h, ok := route.GetHandler().(jsonMethodHandler)
+		if !ok {
+			t.Errorf("route %s: handler is not jsonMethodHandler", path)
+			return nil
+		}
+		for method := range h {
+			routes = append(routes, method+" "+path)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(routes)
+
+	operations := []string{}
+	ids := map[string]struct{}{}
+	for _, o := range openAPIOperations {
+		operations = append(operations, o.Method+" "+o.Path)
+		if _, ok := ids[o.ID]; ok {
+			t.Errorf("operation %s %s: duplicate id %q", o.Method, o.Path, o.ID)
+		}
+		ids[o.ID] = struct{}{}
+	}
+	sort.Strings(operations)
+
+	if strings.Join(routes, "\n") != strings.Join(operations, "\n") {
+		t.Errorf("api routes and openapi operations differ:\nroutes:\n%s\noperations:\n%s", strings.Join(routes, "\n"), strings.Join(operations, "\n"))
+	}
+}
+
+func TestOpenAPIHandler(t *testing.T) {
+	s, err := newTestServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	resp, err := http.Get("http://localhost:" + strconv.Itoa(s.servers.Addr("HTTP").Port) + "/api/v1/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	var doc struct {
+		OpenAPI    string                                `json:"openapi"`
+		Paths      map[string]map[string]json.RawMessage `json:"paths"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.OpenAPI != "3.0.0" {
+		t.Errorf("expected openapi version %q, got %q", "3.0.0", doc.OpenAPI)
+	}
+
+	for _, o := range openAPIOperations {
+		path := strings.TrimPrefix(o.Path, "/api/v1")
+		if _, ok := doc.Paths[path][strings.ToLower(o.Method)]; !ok {
+			t.Errorf("operation %s %s not found in document", o.Method, path)
+		}
+	}
+
+	for _, name := range []string{
+		"Domain",
+		"DomainOptions",
+		"DomainsPage",
+		"DomainTokens",
+		"DomainToken",
+		"DomainUsers",
+		"Package",
+		"PackageOptions",
+		"PackagesPage",
+		"PackagesManifest",
+		"PackagesPlan",
+		"BatchRequest",
+		"BatchResponse",
+		"RateLimits",
+		"RateLimitsOptions",
+		"Error",
+	} {
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			t.Errorf("schema %s not found in document", name)
+		}
+	}
+
+	var errorSchema struct {
+		Properties struct {
+			Code struct {
+				Enum []int `json:"enum"`
+			} `json:"code"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(doc.Components.Schemas["Error"], &errorSchema); err != nil {
+		t.Fatal(err)
+	}
+	codes := errorSchema.Properties.Code.Enum
+	errs := api.Errors()
+	if len(codes) != len(errs) {
+		t.Fatalf("expected %d error codes, got %d", len(errs), len(codes))
+	}
+	for _, err := range errs {
+		found := false
+		for _, code := range codes {
+			if code == err.Code {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("error code %d not found in document", err.Code)
+		}
+	}
+}
@@ -0,0 +1,367 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"resenje.org/jsonresponse"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+// requestOrganizationMembers returns the organization and its members
+// if the request user is a member of the organization or the request is
+// authenticated with the key of the same organization. Otherwise, an
+// appropriate response is written and nil organization is returned.
+func (s *Server) requestOrganizationMembers(w http.ResponseWriter, r *http.Request, prefix string, u *user.User, ref string) (*packages.Organization, packages.OrganizationMembers) {
+	org, err := s.PackagesService.Organization(ref)
+	if err != nil {
+		if err == packages.ErrOrganizationNotFound {
+			s.Logger.Warningf("%s: user %s: organization %s: %s", prefix, u.ID, ref, err)
+			jsonresponse.BadRequest(w, api.ErrOrganizationNotFound)
+			return nil, packages.OrganizationMembers{}
+		}
+		s.Logger.Errorf("%s: user %s: organization %s: %s", prefix, u.ID, ref, err)
+		jsonresponse.InternalServerError(w, nil)
+		return nil, packages.OrganizationMembers{}
+	}
+	members, err := s.PackagesService.OrganizationMembers(org.ID)
+	if err != nil {
+		s.Logger.Errorf("%s: user %s: organization %s members: %s", prefix, u.ID, ref, err)
+		jsonresponse.InternalServerError(w, nil)
+		return nil, packages.OrganizationMembers{}
+	}
+	if members.Role(u.ID) == "" && u.ID != org.ID {
+		s.Logger.Warningf("%s: user %s: organization %s: not a member", prefix, u.ID, ref)
+		jsonresponse.Forbidden(w, nil)
+		return nil, packages.OrganizationMembers{}
+	}
+	return org, members
+}
+
+func (s *Server) organizationsAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	response := api.Organizations{
+		Organizations: []api.Organization{},
+	}
+
+	if org := getRequestOrganization(r); org != nil {
+		response.Organizations = append(response.Organizations, packagesOrganizationToAPIOrganization(*org))
+		jsonresponse.OK(w, response)
+		return
+	}
+
+	orgs, err := s.PackagesService.OrganizationsByUser(u.ID)
+	if err != nil {
+		s.Logger.Errorf("organizations api: user %s: organizations by user: %s", u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	for _, o := range orgs {
+		response.Organizations = append(response.Organizations, packagesOrganizationToAPIOrganization(o))
+	}
+
+	jsonresponse.OK(w, response)
+}
+
+func (s *Server) organizationAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	org, _ := s.requestOrganizationMembers(w, r, "organization api", u, mux.Vars(r)["id"])
+	if org == nil {
+		return
+	}
+
+	jsonresponse.OK(w, packagesOrganizationToAPIOrganization(*org))
+}
+
+func (s *Server) updateOrganizationAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	if id == "" && getRequestOrganization(r) != nil {
+		s.Logger.Warningf("update organization api: user %s: add organization: organization api key", u.ID)
+		jsonresponse.Forbidden(w, api.ErrForbidden)
+		return
+	}
+
+	request := api.OrganizationOptions{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.Logger.Warningf("update organization api: user %s: request decode: %s", u.ID, err)
+		jsonresponse.BadRequest(w, api.ErrBadRequest)
+		return
+	}
+
+	o := &packages.OrganizationOptions{
+		Name: request.Name,
+	}
+
+	var org *packages.Organization
+	if id == "" {
+		org, err = s.PackagesService.AddOrganization(o, u.ID)
+	} else {
+		org, err = s.PackagesService.UpdateOrganization(id, o, u.ID)
+	}
+	switch err {
+	case packages.ErrOrganizationNotFound:
+		s.Logger.Warningf("update organization api: user %s: add/update organization %q: %s", u.ID, id, err)
+		jsonresponse.BadRequest(w, api.ErrOrganizationNotFound)
+		return
+	case packages.ErrOrganizationAlreadyExists:
+		s.Logger.Warningf("update organization api: user %s: add/update organization %q: %s", u.ID, id, err)
+		jsonresponse.BadRequest(w, api.ErrOrganizationAlreadyExists)
+		return
+	case packages.ErrOrganizationNameRequired:
+		s.Logger.Warningf("update organization api: user %s: add/update organization %q: %s", u.ID, id, err)
+		jsonresponse.BadRequest(w, api.ErrOrganizationNameRequired)
+		return
+	case packages.ErrOrganizationNameInvalid:
+		s.Logger.Warningf("update organization api: user %s: add/update organization %q: %s", u.ID, id, err)
+		jsonresponse.BadRequest(w, api.ErrOrganizationNameInvalid)
+		return
+	case packages.ErrForbidden:
+		s.Logger.Warningf("update organization api: user %s: add/update organization %q: %s", u.ID, id, err)
+		jsonresponse.Forbidden(w, api.ErrForbidden)
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("update organization api: user %s: add/update organization %q: %s", u.ID, id, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	action := "organization update"
+	if id == "" {
+		action = "organization add"
+	}
+	s.auditf(r, request, action, "%s: %s", org.ID, org.Name)
+
+	jsonresponse.OK(w, packagesOrganizationToAPIOrganization(*org))
+}
+
+func (s *Server) deleteOrganizationAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	org, err := s.PackagesService.DeleteOrganization(id, u.ID)
+	switch err {
+	case packages.ErrOrganizationNotFound:
+		s.Logger.Warningf("delete organization api: user %s: delete organization %s: %s", u.ID, id, err)
+		jsonresponse.BadRequest(w, api.ErrOrganizationNotFound)
+		return
+	case packages.ErrOrganizationHasDomains:
+		s.Logger.Warningf("delete organization api: user %s: delete organization %s: %s", u.ID, id, err)
+		jsonresponse.BadRequest(w, api.ErrOrganizationHasDomains)
+		return
+	case packages.ErrForbidden:
+		s.Logger.Warningf("delete organization api: user %s: delete organization %s: %s", u.ID, id, err)
+		jsonresponse.Forbidden(w, api.ErrForbidden)
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("delete organization api: user %s: delete organization %s: %s", u.ID, id, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	if err := s.KeyService.DeleteKey(org.ID); err != nil && err != key.ErrKeyNotFound {
+		s.Logger.Errorf("delete organization api: user %s: delete organization %s key: %s", u.ID, org.ID, err)
+	}
+
+	s.auditf(r, nil, "organization delete", "%s: %s", org.ID, org.Name)
+
+	jsonresponse.OK(w, packagesOrganizationToAPIOrganization(*org))
+}
+
+func (s *Server) organizationMembersAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	org, members := s.requestOrganizationMembers(w, r, "organization members api", u, mux.Vars(r)["id"])
+	if org == nil {
+		return
+	}
+
+	jsonresponse.OK(w, packagesOrganizationMembersToAPIOrganizationMembers(members))
+}
+
+func (s *Server) setOrganizationMemberAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+	userID := vars["user-id"]
+
+	memberUser, err := s.UserService.User(userID)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			s.Logger.Warningf("organization member api: user %s: organization %s: get user %s: %s", u.ID, id, userID, err)
+			jsonresponse.BadRequest(w, api.ErrUserDoesNotExist)
+			return
+		}
+		s.Logger.Errorf("organization member api: user %s: organization %s: get user %s: %s", u.ID, id, userID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	// Request body is optional and the member role is given by default.
+	request := api.OrganizationMemberOptions{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil && err != io.EOF {
+		s.Logger.Warningf("organization member api: request decode: %s", err)
+		jsonresponse.BadRequest(w, api.ErrBadRequest)
+		return
+	}
+	role := packages.OrganizationRole(request.Role)
+	if role == "" {
+		role = packages.OrganizationRoleMember
+	}
+
+	err = s.PackagesService.SetOrganizationMember(id, memberUser.ID, role, u.ID)
+	switch err {
+	case packages.ErrOrganizationNotFound:
+		s.Logger.Warningf("organization member api: user %s: set member %s of organization %s: %s", u.ID, userID, id, err)
+		jsonresponse.BadRequest(w, api.ErrOrganizationNotFound)
+	case packages.ErrOrganizationRoleInvalid:
+		s.Logger.Warningf("organization member api: user %s: set member %s of organization %s: %s", u.ID, userID, id, err)
+		jsonresponse.BadRequest(w, api.ErrOrganizationRoleInvalid)
+	case packages.ErrOrganizationLastOwner:
+		s.Logger.Warningf("organization member api: user %s: set member %s of organization %s: %s", u.ID, userID, id, err)
+		jsonresponse.BadRequest(w, api.ErrOrganizationLastOwner)
+	case packages.ErrForbidden:
+		s.Logger.Warningf("organization member api: user %s: set member %s of organization %s: %s", u.ID, userID, id, err)
+		jsonresponse.Forbidden(w, nil)
+	case nil:
+		s.auditf(r, request, "organization member set", "%s: %s: %s", id, memberUser.ID, role)
+		jsonresponse.OK(w, nil)
+	default:
+		s.Logger.Errorf("organization member api: user %s: set member %s of organization %s: %s", u.ID, userID, id, err)
+		jsonresponse.InternalServerError(w, nil)
+	}
+}
+
+func (s *Server) removeOrganizationMemberAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+	userID := vars["user-id"]
+
+	memberUser, err := s.UserService.User(userID)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			s.Logger.Warningf("organization member remove api: user %s: organization %s: get user %s: %s", u.ID, id, userID, err)
+			jsonresponse.BadRequest(w, api.ErrUserDoesNotExist)
+			return
+		}
+		s.Logger.Errorf("organization member remove api: user %s: organization %s: get user %s: %s", u.ID, id, userID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	err = s.PackagesService.RemoveOrganizationMember(id, memberUser.ID, u.ID)
+	switch err {
+	case packages.ErrOrganizationNotFound:
+		s.Logger.Warningf("organization member remove api: user %s: remove member %s from organization %s: %s", u.ID, userID, id, err)
+		jsonresponse.BadRequest(w, api.ErrOrganizationNotFound)
+	case packages.ErrUserDoesNotExist:
+		s.Logger.Warningf("organization member remove api: user %s: remove member %s from organization %s: %s", u.ID, userID, id, err)
+		jsonresponse.BadRequest(w, api.ErrOrganizationMemberNotFound)
+	case packages.ErrOrganizationLastOwner:
+		s.Logger.Warningf("organization member remove api: user %s: remove member %s from organization %s: %s", u.ID, userID, id, err)
+		jsonresponse.BadRequest(w, api.ErrOrganizationLastOwner)
+	case packages.ErrForbidden:
+		s.Logger.Warningf("organization member remove api: user %s: remove member %s from organization %s: %s", u.ID, userID, id, err)
+		jsonresponse.Forbidden(w, nil)
+	case nil:
+		s.auditf(r, nil, "organization member remove", "%s: %s", id, memberUser.ID)
+		jsonresponse.OK(w, nil)
+	default:
+		s.Logger.Errorf("organization member remove api: user %s: remove member %s from organization %s: %s", u.ID, userID, id, err)
+		jsonresponse.InternalServerError(w, nil)
+	}
+}
+
+func (s *Server) organizationDomainsAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	org, _ := s.requestOrganizationMembers(w, r, "organization domains api", u, mux.Vars(r)["id"])
+	if org == nil {
+		return
+	}
+
+	startRef := r.URL.Query().Get("start")
+
+	limit := 0
+	if l := r.URL.Query().Get("limit"); l != "" {
+		var err error
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit < 1 || limit > api.MaxLimit {
+			limit = 0
+		}
+	}
+
+	domains, err := s.PackagesService.DomainsByOrganization(org.ID, startRef, limit)
+	if err != nil {
+		switch err {
+		case packages.ErrDomainNotFound:
+			s.Logger.Warningf("organization domains api: user %s: organization %s: start ref %q: %s", u.ID, org.ID, startRef, err)
+			jsonresponse.BadRequest(w, api.ErrDomainNotFound)
+			return
+		default:
+			s.Logger.Errorf("organization domains api: user %s: organization %s: start ref %q: %s", u.ID, org.ID, startRef, err)
+			jsonresponse.InternalServerError(w, nil)
+			return
+		}
+	}
+
+	response := api.DomainsPage{
+		Domains:  []api.Domain{},
+		Previous: domains.Previous,
+		Next:     domains.Next,
+		Count:    domains.Count,
+	}
+
+	for _, d := range domains.Domains {
+		response.Domains = append(response.Domains, packagesDomainToAPIDomain(d))
+	}
+
+	jsonresponse.OK(w, response)
+}
@@ -0,0 +1,382 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+func TestOrganizationsAPI(t *testing.T) {
+	s, err := newTestServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+	if err != nil {
+		t.Fatalf("parse IPv4 net: %s", err)
+	}
+	_, ipV6Net, err := net.ParseCIDR("::/0")
+	if err != nil {
+		t.Fatalf("parse IPv6 net: %s", err)
+	}
+	newClient := func(ref string) *api.Client {
+		k, err := s.KeyService.CreateKey(ref, &key.Options{
+			AuthorizedNetworks: &[]net.IPNet{
+				*ipV4Net,
+				*ipV6Net,
+			},
+		})
+		if err != nil {
+			t.Fatalf("create key: %s", err)
+		}
+		return api.NewClientWithEndpoint(
+			"localhost:"+strconv.Itoa(s.servers.Addr("HTTP").Port)+"/api/v1",
+			k.Secret,
+		)
+	}
+
+	httpClients := map[string]*api.Client{}
+	users := map[string]*user.User{}
+	for _, username := range []string{"alice", "bob", "chuck"} {
+		t.Run(fmt.Sprintf("create account %s", username), func(t *testing.T) {
+			email := username + "@localhost.loc"
+			name := strings.ToUpper(username)
+			u, err := s.UserService.CreateUser(&user.Options{
+				Email:    &email,
+				Username: &username,
+				Name:     &name,
+			})
+			if err != nil {
+				t.Fatalf("create user: %s", err)
+			}
+
+			users[username] = u
+			httpClients[username] = newClient(u.ID)
+		})
+	}
+
+	fqdn := "organization.localhost"
+	if _, err := s.PackagesService.AddDomain(&packages.DomainOptions{
+		FQDN:        &fqdn,
+		OwnerUserID: &users["alice"].ID,
+	}, users["alice"].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	addPackage := func(c *api.Client, path string) error {
+		vcs := api.VCSGit
+		repoRoot := "https://github.com/gopherpit/gopherpit.git"
+		_, err := c.AddPackage(&api.PackageOptions{
+			Domain:   &fqdn,
+			Path:     &path,
+			VCS:      &vcs,
+			RepoRoot: &repoRoot,
+		})
+		return err
+	}
+
+	var org api.Organization
+	t.Run("add organization", func(t *testing.T) {
+		for _, name := range []string{"", "  "} {
+			o := &api.OrganizationOptions{Name: &name}
+			if _, err := httpClients["alice"].AddOrganization(o); err != api.ErrOrganizationNameRequired {
+				t.Errorf("%q: expected error %v, got %v", name, api.ErrOrganizationNameRequired, err)
+			}
+		}
+		for _, name := range []string{"my team", "-team", "team_1"} {
+			o := &api.OrganizationOptions{Name: &name}
+			if _, err := httpClients["alice"].AddOrganization(o); err != api.ErrOrganizationNameInvalid {
+				t.Errorf("%q: expected error %v, got %v", name, api.ErrOrganizationNameInvalid, err)
+			}
+		}
+		name := "Team-1"
+		org, err = httpClients["alice"].AddOrganization(&api.OrganizationOptions{Name: &name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if org.Name != "team-1" {
+			t.Errorf("expected name %q, got %q", "team-1", org.Name)
+		}
+		if _, err := httpClients["bob"].AddOrganization(&api.OrganizationOptions{Name: &name}); err != api.ErrOrganizationAlreadyExists {
+			t.Errorf("expected error %v, got %v", api.ErrOrganizationAlreadyExists, err)
+		}
+	})
+
+	t.Run("get organization", func(t *testing.T) {
+		o, err := httpClients["alice"].Organization(org.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if o.ID != org.ID {
+			t.Errorf("expected id %q, got %q", org.ID, o.ID)
+		}
+		if _, err := httpClients["bob"].Organization(org.ID); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+		if _, err := httpClients["alice"].Organization("unknown"); err != api.ErrOrganizationNotFound {
+			t.Errorf("expected error %v, got %v", api.ErrOrganizationNotFound, err)
+		}
+	})
+
+	t.Run("members", func(t *testing.T) {
+		if err := httpClients["bob"].SetOrganizationMember(org.ID, "chuck", api.OrganizationRoleMember); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+		if err := httpClients["alice"].SetOrganizationMember(org.ID, "bob", "unknown"); err != api.ErrOrganizationRoleInvalid {
+			t.Errorf("expected error %v, got %v", api.ErrOrganizationRoleInvalid, err)
+		}
+		if err := httpClients["alice"].SetOrganizationMember(org.ID, "alice", api.OrganizationRoleMember); err != api.ErrOrganizationLastOwner {
+			t.Errorf("expected error %v, got %v", api.ErrOrganizationLastOwner, err)
+		}
+		if err := httpClients["alice"].SetOrganizationMember(org.ID, "bob", api.OrganizationRoleMember); err != nil {
+			t.Fatal(err)
+		}
+		if err := httpClients["bob"].SetOrganizationMember(org.ID, "chuck", api.OrganizationRoleMember); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+		members, err := httpClients["bob"].OrganizationMembers(org.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for id, role := range map[string]api.OrganizationRole{
+			users["alice"].ID: api.OrganizationRoleOwner,
+			users["bob"].ID:   api.OrganizationRoleMember,
+		} {
+			if members.Roles[id] != role {
+				t.Errorf("expected role %q for user %s, got %q", role, id, members.Roles[id])
+			}
+		}
+		orgs, err := httpClients["bob"].Organizations()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(orgs.Organizations) != 1 || orgs.Organizations[0].ID != org.ID {
+			t.Errorf("expected organization %s, got %v", org.ID, orgs.Organizations)
+		}
+		if err := httpClients["alice"].RemoveOrganizationMember(org.ID, "chuck"); err != api.ErrOrganizationMemberNotFound {
+			t.Errorf("expected error %v, got %v", api.ErrOrganizationMemberNotFound, err)
+		}
+	})
+
+	t.Run("give domain to organization", func(t *testing.T) {
+		if err := addPackage(httpClients["bob"], "/before"); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+		other := "other"
+		if _, err := httpClients["bob"].AddOrganization(&api.OrganizationOptions{Name: &other}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := httpClients["alice"].UpdateDomain(fqdn, &api.DomainOptions{
+			OrganizationID: &other,
+		}); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+		unknown := "unknown"
+		if _, err := httpClients["alice"].UpdateDomain(fqdn, &api.DomainOptions{
+			OrganizationID: &unknown,
+		}); err != api.ErrOrganizationNotFound {
+			t.Errorf("expected error %v, got %v", api.ErrOrganizationNotFound, err)
+		}
+		d, err := httpClients["alice"].UpdateDomain(fqdn, &api.DomainOptions{
+			OrganizationID: &org.Name,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.OrganizationID != org.ID {
+			t.Errorf("expected organization id %q, got %q", org.ID, d.OrganizationID)
+		}
+		if d.OwnerUserID != "" {
+			t.Errorf("expected no owner user id, got %q", d.OwnerUserID)
+		}
+		page, err := httpClients["bob"].OrganizationDomains(org.ID, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page.Domains) != 1 || page.Domains[0].FQDN != fqdn {
+			t.Errorf("expected domain %s, got %v", fqdn, page.Domains)
+		}
+	})
+
+	t.Run("member access", func(t *testing.T) {
+		if err := addPackage(httpClients["bob"], "/member"); err != nil {
+			t.Error(err)
+		}
+		disabled := false
+		if _, err := httpClients["bob"].UpdateDomain(fqdn, &api.DomainOptions{
+			Disabled: &disabled,
+		}); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+		if _, err := httpClients["alice"].UpdateDomain(fqdn, &api.DomainOptions{
+			Disabled: &disabled,
+		}); err != nil {
+			t.Error(err)
+		}
+		if _, err := httpClients["chuck"].Domain(fqdn); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+	})
+
+	bobFQDN := "bob.localhost"
+	if _, err := s.PackagesService.AddDomain(&packages.DomainOptions{
+		FQDN:        &bobFQDN,
+		OwnerUserID: &users["bob"].ID,
+	}, users["bob"].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("member domains", func(t *testing.T) {
+		for username, fqdns := range map[string][]string{
+			"alice": {fqdn},
+			"bob":   {bobFQDN, fqdn},
+			"chuck": {},
+		} {
+			page, err := httpClients[username].Domains("", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := []string{}
+			for _, d := range page.Domains {
+				got = append(got, d.FQDN)
+			}
+			if strings.Join(got, " ") != strings.Join(fqdns, " ") {
+				t.Errorf("%s: expected domains %v, got %v", username, fqdns, got)
+			}
+		}
+
+		page1, err := httpClients["bob"].Domains("", 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page1.Domains) != 1 || page1.Domains[0].FQDN != bobFQDN || page1.Next != fqdn {
+			t.Errorf("expected domain %s and next %s, got %v", bobFQDN, fqdn, page1)
+		}
+		page2, err := httpClients["bob"].Domains(page1.Next, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page2.Domains) != 1 || page2.Domains[0].FQDN != fqdn || page2.Next != "" || page2.Previous != bobFQDN {
+			t.Errorf("expected domain %s and previous %s, got %v", fqdn, bobFQDN, page2)
+		}
+	})
+
+	var orgClient *api.Client
+	t.Run("organization key", func(t *testing.T) {
+		orgClient = newClient(org.ID)
+		c := orgClient
+		if err := addPackage(c, "/key"); err != nil {
+			t.Error(err)
+		}
+		page, err := c.Domains("", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page.Domains) != 1 || page.Domains[0].FQDN != fqdn {
+			t.Errorf("expected domain %s, got %v", fqdn, page.Domains)
+		}
+		newFQDN := "key.organization.localhost"
+		if _, err := c.AddDomain(&api.DomainOptions{FQDN: &newFQDN}); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+		name := "from-key"
+		if _, err := c.AddOrganization(&api.OrganizationOptions{Name: &name}); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+		disabled := true
+		if _, err := c.UpdateDomain(fqdn, &api.DomainOptions{
+			Disabled: &disabled,
+		}); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+	})
+
+	t.Run("search packages", func(t *testing.T) {
+		search := func(c *api.Client) (paths []string) {
+			paths = []string{}
+			var start string
+			for {
+				page, err := c.SearchPackages("", start, 1)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, p := range page.Packages {
+					paths = append(paths, p.FQDN+p.Path)
+				}
+				if page.Next == "" {
+					return
+				}
+				start = page.Next
+			}
+		}
+		orgPaths := []string{fqdn + "/key", fqdn + "/member"}
+		for name, c := range map[string]*api.Client{
+			"alice":            httpClients["alice"],
+			"bob":              httpClients["bob"],
+			"organization key": orgClient,
+		} {
+			if paths := search(c); strings.Join(paths, " ") != strings.Join(orgPaths, " ") {
+				t.Errorf("%s: expected packages %v, got %v", name, orgPaths, paths)
+			}
+		}
+		if paths := search(httpClients["chuck"]); len(paths) != 0 {
+			t.Errorf("chuck: expected no packages, got %v", paths)
+		}
+	})
+
+	t.Run("remove member", func(t *testing.T) {
+		if err := httpClients["alice"].RemoveOrganizationMember(org.ID, "alice"); err != api.ErrOrganizationLastOwner {
+			t.Errorf("expected error %v, got %v", api.ErrOrganizationLastOwner, err)
+		}
+		if err := httpClients["bob"].RemoveOrganizationMember(org.ID, "bob"); err != nil {
+			t.Fatal(err)
+		}
+		if err := addPackage(httpClients["bob"], "/removed"); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+		page, err := httpClients["bob"].Domains("", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page.Domains) != 1 || page.Domains[0].FQDN != bobFQDN {
+			t.Errorf("expected domain %s, got %v", bobFQDN, page.Domains)
+		}
+	})
+
+	t.Run("delete organization", func(t *testing.T) {
+		if _, err := httpClients["alice"].DeleteOrganization(org.ID); err != api.ErrOrganizationHasDomains {
+			t.Errorf("expected error %v, got %v", api.ErrOrganizationHasDomains, err)
+		}
+		if _, err := httpClients["alice"].UpdateDomain(fqdn, &api.DomainOptions{
+			OwnerUserID: &users["alice"].ID,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := httpClients["bob"].DeleteOrganization(org.ID); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+		if _, err := httpClients["alice"].DeleteOrganization(org.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.KeyService.KeyByRef(org.ID); err != key.ErrKeyNotFound {
+			t.Errorf("expected error %v, got %v", key.ErrKeyNotFound, err)
+		}
+		if _, err := httpClients["alice"].Organization(org.ID); err != api.ErrOrganizationNotFound {
+			t.Errorf("expected error %v, got %v", api.ErrOrganizationNotFound, err)
+		}
+	})
+}
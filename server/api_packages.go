@@ -7,6 +7,7 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"resenje.org/jsonresponse"
+	"resenje.org/web/client/api"
 
 	"gopherpit.com/gopherpit/api"
 	"gopherpit.com/gopherpit/services/packages"
@@ -76,6 +78,7 @@ func (s *Server) packageAPIHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.Header().Set("ETag", revisionETag(p.Revision))
 	jsonresponse.OK(w, packagesPackageToAPIPackage(*p, nil))
 }
 
@@ -94,6 +97,13 @@ func (s *Server) updatePackageAPIHandler(w http.ResponseWriter, r *http.Request)
 		s.Logger.Errorf("update package api: %q: user %s: %s", id, u.ID, fmt.Sprintf(format, a...))
 	}
 
+	ifRevision, ok := ifMatchRevision(r)
+	if !ok {
+		warningf("request: invalid if-match header %q", r.Header.Get("If-Match"))
+		jsonresponse.PreconditionFailed(w, api.ErrPreconditionFailed)
+		return
+	}
+
 	request := api.PackageOptions{}
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		warningf("request: request decode: %s", err)
@@ -101,31 +111,127 @@ func (s *Server) updatePackageAPIHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var repoRoot *url.URL
+	if id == "" {
+		if err := s.applyAPIPackageDefaults(&request); err != nil {
+			errorf("package defaults: %s", err)
+			jsonresponse.InternalServerError(w, nil)
+			return
+		}
+	}
 
+	o, apiErr, err := apiPackageOptions(request, id == "")
+	if err != nil {
+		warningf("%s", err)
+		jsonresponse.BadRequest(w, apiErr)
+		return
+	}
+	var p *packages.Package
 	if id == "" {
+		p, err = s.PackagesService.AddPackage(o, u.ID)
+	} else {
+		o.IfRevision = ifRevision
+		p, err = s.PackagesService.UpdatePackage(id, o, u.ID)
+	}
+	switch err {
+	case packages.ErrForbidden:
+		warningf("add/update package: %s", err)
+		jsonresponse.Forbidden(w, nil)
+		return
+	case packages.ErrDomainNotFound:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrDomainNotFound)
+		return
+	case packages.ErrPackageNotFound:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageNotFound)
+		return
+	case packages.ErrPackageDomainRequired:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageDomainRequired)
+		return
+	case packages.ErrPackagePathRequired:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackagePathRequired)
+		return
+	case packages.ErrPackageVCSRequired:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageVCSRequired)
+		return
+	case packages.ErrPackageRepoRootRequired:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageRepoRootRequired)
+		return
+	case packages.ErrPackageRepoRootInvalid:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageRepoRootInvalid)
+		return
+	case packages.ErrPackageRepoRootSchemeRequired:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageRepoRootSchemeRequired)
+		return
+	case packages.ErrPackageRepoRootSchemeInvalid:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageRepoRootSchemeInvalid)
+		return
+	case packages.ErrPackageRepoRootHostInvalid:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageRepoRootHostInvalid)
+		return
+	case packages.ErrPackageAlreadyExists:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageAlreadyExists)
+		return
+	case packages.ErrPackageQuotaExceeded:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageQuotaExceeded)
+		return
+	case packages.ErrPackageRefChangeRejected:
+		warningf("add/update package: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageRefChangeRejected)
+		return
+	case packages.ErrPackageRevisionMismatch:
+		warningf("add/update package: %s", err)
+		jsonresponse.PreconditionFailed(w, api.ErrPreconditionFailed)
+		return
+	case nil:
+	default:
+		errorf("add/update package: %s", err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	action := "package update"
+	if id == "" {
+		action = "package add"
+	}
+	s.auditf(r, request, action, "%s %s (domain: %s)", p.ID, p.ImportPrefix(), p.Domain.ID)
+
+	w.Header().Set("ETag", revisionETag(p.Revision))
+	jsonresponse.OK(w, packagesPackageToAPIPackage(*p, nil))
+}
+
+// apiPackageOptions validates package options from the API request and
+// converts them to packages service options. If add is true, all fields
+// required for a new package must be set. If err is not nil, apiErr should
+// be returned in the response.
+func apiPackageOptions(request api.PackageOptions, add bool) (o *packages.PackageOptions, apiErr *apiClient.Error, err error) {
+	var repoRoot *url.URL
+
+	if add {
 		if request.Domain == nil || *request.Domain == "" {
-			warningf("request: domain absent")
-			jsonresponse.BadRequest(w, api.ErrPackageDomainRequired)
-			return
+			return nil, api.ErrPackageDomainRequired, errors.New("request: domain absent")
 		}
 
 		if request.Path == nil || *request.Path == "" {
-			warningf("request: path absent")
-			jsonresponse.BadRequest(w, api.ErrPackagePathRequired)
-			return
+			return nil, api.ErrPackagePathRequired, errors.New("request: path absent")
 		}
 
 		if request.VCS == nil || *request.VCS == "" {
-			warningf("request: vcs absent")
-			jsonresponse.BadRequest(w, api.ErrPackageVCSRequired)
-			return
+			return nil, api.ErrPackageVCSRequired, errors.New("request: vcs absent")
 		}
 
 		if request.RepoRoot == nil || *request.RepoRoot == "" {
-			warningf("request: repo root absent")
-			jsonresponse.BadRequest(w, api.ErrPackageRepoRootRequired)
-			return
+			return nil, api.ErrPackageRepoRootRequired, errors.New("request: repo root absent")
 		}
 	}
 
@@ -133,14 +239,10 @@ func (s *Server) updatePackageAPIHandler(w http.ResponseWriter, r *http.Request)
 		repoRoot, err = url.Parse(*request.RepoRoot)
 		switch {
 		case err != nil:
-			warningf("request: parse repo root: %s", err)
-			jsonresponse.BadRequest(w, api.ErrPackageRepoRootInvalid)
-			return
+			return nil, api.ErrPackageRepoRootInvalid, fmt.Errorf("request: parse repo root: %s", err)
 		case request.VCS != nil && *request.VCS != "":
 			if repoRoot.Scheme == "" {
-				warningf("repo root: missing url scheme")
-				jsonresponse.BadRequest(w, api.ErrPackageRepoRootSchemeRequired)
-				return
+				return nil, api.ErrPackageRepoRootSchemeRequired, errors.New("repo root: missing url scheme")
 			}
 			ok := false
 			for _, s := range packages.VCSSchemes[packages.VCS(*request.VCS)] {
@@ -150,14 +252,10 @@ func (s *Server) updatePackageAPIHandler(w http.ResponseWriter, r *http.Request)
 				}
 			}
 			if !ok {
-				warningf("repo root: invalid url scheme %q", repoRoot.Scheme)
-				jsonresponse.BadRequest(w, api.ErrPackageRepoRootSchemeInvalid)
-				return
+				return nil, api.ErrPackageRepoRootSchemeInvalid, fmt.Errorf("repo root: invalid url scheme %q", repoRoot.Scheme)
 			}
 			if !hostAndPortRegex.MatchString(repoRoot.Host) {
-				warningf("repo root: invalid url host %q", repoRoot.Host)
-				jsonresponse.BadRequest(w, api.ErrPackageRepoRootHostInvalid)
-				return
+				return nil, api.ErrPackageRepoRootHostInvalid, fmt.Errorf("repo root: invalid url host %q", repoRoot.Host)
 			}
 		}
 	}
@@ -170,9 +268,7 @@ func (s *Server) updatePackageAPIHandler(w http.ResponseWriter, r *http.Request)
 		switch *refType {
 		case "", packages.RefTypeTag, packages.RefTypeBranch:
 		default:
-			warningf("invalid reference type %q", refType)
-			jsonresponse.BadRequest(w, api.ErrPackageRefTypeInvalid)
-			return
+			return nil, api.ErrPackageRefTypeInvalid, fmt.Errorf("invalid reference type %q", *refType)
 		}
 	}
 
@@ -182,15 +278,11 @@ func (s *Server) updatePackageAPIHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	if refType != nil && *refType != "" && refName == "" {
-		warningf("missing reference name")
-		jsonresponse.BadRequest(w, api.ErrPackageRefNameRequired)
-		return
+		return nil, api.ErrPackageRefNameRequired, errors.New("missing reference name")
 	}
 
 	if request.RedirectURL != nil && !urlRegex.MatchString(*request.RedirectURL) {
-		warningf("invalid redirect url: %s", *request.RedirectURL)
-		jsonresponse.BadRequest(w, api.ErrPackageRedirectURLInvalid)
-		return
+		return nil, api.ErrPackageRedirectURLInvalid, fmt.Errorf("invalid redirect url: %s", *request.RedirectURL)
 	}
 
 	if request.Path != nil && !strings.HasPrefix(*request.Path, "/") {
@@ -203,13 +295,11 @@ func (s *Server) updatePackageAPIHandler(w http.ResponseWriter, r *http.Request)
 		vcs = &v
 
 		if refName != "" && (*vcs != packages.VCSGit || (*vcs == packages.VCSGit && repoRoot != nil && !(repoRoot.Scheme == "http" || repoRoot.Scheme == "https"))) {
-			warningf("reference change rejected")
-			jsonresponse.BadRequest(w, api.ErrPackageRefChangeRejected)
-			return
+			return nil, api.ErrPackageRefChangeRejected, errors.New("reference change rejected")
 		}
 	}
 
-	o := &packages.PackageOptions{
+	o = &packages.PackageOptions{
 		Domain:      request.Domain,
 		Path:        request.Path,
 		VCS:         vcs,
@@ -220,79 +310,189 @@ func (s *Server) updatePackageAPIHandler(w http.ResponseWriter, r *http.Request)
 		RedirectURL: request.RedirectURL,
 		Disabled:    request.Disabled,
 	}
-	var p *packages.Package
-	if id == "" {
-		p, err = s.PackagesService.AddPackage(o, u.ID)
-	} else {
-		p, err = s.PackagesService.UpdatePackage(id, o, u.ID)
+	return o, nil, nil
+}
+
+// applyAPIPackageDefaults sets fields of the new package request that
+// are not provided to the package defaults of its domain.
+func (s *Server) applyAPIPackageDefaults(request *api.PackageOptions) error {
+	if request.Domain == nil || *request.Domain == "" || request.Path == nil {
+		return nil
+	}
+	domain, err := s.PackagesService.Domain(*request.Domain)
+	if err != nil {
+		if err == packages.ErrDomainNotFound {
+			// Unknown domain error is returned by the packages service.
+			return nil
+		}
+		return fmt.Errorf("get domain %s: %s", *request.Domain, err)
+	}
+	if domain.PackageDefaults == nil {
+		return nil
+	}
+	d := domain.PackageDefaults.ForPackage(domain.FQDN, *request.Path)
+	if (request.VCS == nil || *request.VCS == "") && d.VCS != "" {
+		vcs := api.VCS(d.VCS)
+		request.VCS = &vcs
+	}
+	if (request.RepoRoot == nil || *request.RepoRoot == "") && d.RepoRoot != "" {
+		request.RepoRoot = &d.RepoRoot
+	}
+	if request.RefType == nil && request.RefName == nil && d.RefType != "" {
+		refType := api.RefType(d.RefType)
+		request.RefType = &refType
+		request.RefName = &d.RefName
+	}
+	if request.GoSource == nil && d.GoSource != "" {
+		request.GoSource = &d.GoSource
+	}
+	if request.RedirectURL == nil && d.RedirectURL != "" {
+		request.RedirectURL = &d.RedirectURL
 	}
+	return nil
+}
+
+// apiPackageDefaults validates package defaults from the API request by
+// validating options of an example package under the domain with fqdn
+// that inherits them, and converts them to packages service package
+// defaults. If err is not nil, apiErr should be returned in the response.
+func apiPackageDefaults(request api.PackageDefaults, fqdn string) (d *packages.PackageDefaults, apiErr *apiClient.Error, err error) {
+	d = &packages.PackageDefaults{
+		VCS:         packages.VCS(request.VCS),
+		RepoRoot:    strings.TrimSpace(request.RepoRoot),
+		RefType:     packages.RefType(request.RefType),
+		RefName:     strings.TrimSpace(request.RefName),
+		GoSource:    strings.TrimSpace(request.GoSource),
+		RedirectURL: strings.TrimSpace(request.RedirectURL),
+	}
+	switch d.VCS {
+	case "", packages.VCSGit, packages.VCSMercurial, packages.VCSBazaar, packages.VCSSubversion:
+	default:
+		return nil, api.ErrBadRequest, fmt.Errorf("package defaults: invalid vcs %q", d.VCS)
+	}
+
+	e := d.ForPackage(fqdn, "example")
+	o := api.PackageOptions{}
+	if e.VCS != "" {
+		vcs := api.VCS(e.VCS)
+		o.VCS = &vcs
+	}
+	if e.RepoRoot != "" {
+		o.RepoRoot = &e.RepoRoot
+	}
+	if e.RefType != "" || e.RefName != "" {
+		refType := api.RefType(e.RefType)
+		o.RefType = &refType
+		o.RefName = &e.RefName
+	}
+	if e.RedirectURL != "" {
+		o.RedirectURL = &e.RedirectURL
+	}
+	if _, apiErr, err = apiPackageOptions(o, false); err != nil {
+		return nil, apiErr, fmt.Errorf("package defaults: %s", err)
+	}
+	return d, nil, nil
+}
+
+func (s *Server) applyDomainPackageDefaultsAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	warningf := func(format string, a ...interface{}) {
+		s.Logger.Warningf("apply domain package defaults api: %q: user %s: %s", id, u.ID, fmt.Sprintf(format, a...))
+	}
+	errorf := func(format string, a ...interface{}) {
+		s.Logger.Errorf("apply domain package defaults api: %q: user %s: %s", id, u.ID, fmt.Sprintf(format, a...))
+	}
+
+	dryRun := false
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		dryRun, err = strconv.ParseBool(v)
+		if err != nil {
+			warningf("request: invalid dry run value %q", v)
+			jsonresponse.BadRequest(w, nil)
+			return
+		}
+	}
+
+	request := api.PackageDefaultsApplication{}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			warningf("request: request decode: %s", err)
+			jsonresponse.BadRequest(w, nil)
+			return
+		}
+	}
+
+	fields := make([]packages.PackageDefaultsField, 0, len(request.Fields))
+	for _, f := range request.Fields {
+		field, ok := apiPackageDefaultsFields[f]
+		if !ok {
+			warningf("request: invalid field %q", f)
+			jsonresponse.BadRequest(w, api.ErrPackageDefaultsFieldInvalid)
+			return
+		}
+		fields = append(fields, field)
+	}
+
+	plan, err := s.PackagesService.ApplyPackageDefaults(id, fields, dryRun, u.ID)
 	switch err {
 	case packages.ErrForbidden:
-		warningf("add/update package: %s", err)
+		warningf("apply package defaults: %s", err)
 		jsonresponse.Forbidden(w, nil)
 		return
 	case packages.ErrDomainNotFound:
-		warningf("add/update package: %s", err)
+		warningf("apply package defaults: %s", err)
 		jsonresponse.BadRequest(w, api.ErrDomainNotFound)
 		return
-	case packages.ErrPackageNotFound:
-		warningf("add/update package: %s", err)
-		jsonresponse.BadRequest(w, api.ErrPackageNotFound)
-		return
-	case packages.ErrPackageDomainRequired:
-		warningf("add/update package: %s", err)
-		jsonresponse.BadRequest(w, api.ErrPackageDomainRequired)
-		return
-	case packages.ErrPackagePathRequired:
-		warningf("add/update package: %s", err)
-		jsonresponse.BadRequest(w, api.ErrPackagePathRequired)
+	case packages.ErrPackageDefaultsFieldInvalid:
+		warningf("apply package defaults: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageDefaultsFieldInvalid)
 		return
 	case packages.ErrPackageVCSRequired:
-		warningf("add/update package: %s", err)
+		warningf("apply package defaults: %s", err)
 		jsonresponse.BadRequest(w, api.ErrPackageVCSRequired)
 		return
 	case packages.ErrPackageRepoRootRequired:
-		warningf("add/update package: %s", err)
+		warningf("apply package defaults: %s", err)
 		jsonresponse.BadRequest(w, api.ErrPackageRepoRootRequired)
 		return
 	case packages.ErrPackageRepoRootInvalid:
-		warningf("add/update package: %s", err)
+		warningf("apply package defaults: %s", err)
 		jsonresponse.BadRequest(w, api.ErrPackageRepoRootInvalid)
 		return
 	case packages.ErrPackageRepoRootSchemeRequired:
-		warningf("add/update package: %s", err)
+		warningf("apply package defaults: %s", err)
 		jsonresponse.BadRequest(w, api.ErrPackageRepoRootSchemeRequired)
 		return
 	case packages.ErrPackageRepoRootSchemeInvalid:
-		warningf("add/update package: %s", err)
+		warningf("apply package defaults: %s", err)
 		jsonresponse.BadRequest(w, api.ErrPackageRepoRootSchemeInvalid)
 		return
 	case packages.ErrPackageRepoRootHostInvalid:
-		warningf("add/update package: %s", err)
+		warningf("apply package defaults: %s", err)
 		jsonresponse.BadRequest(w, api.ErrPackageRepoRootHostInvalid)
 		return
-	case packages.ErrPackageAlreadyExists:
-		warningf("add/update package: %s", err)
-		jsonresponse.BadRequest(w, api.ErrPackageAlreadyExists)
-		return
 	case packages.ErrPackageRefChangeRejected:
-		warningf("add/update package: %s", err)
+		warningf("apply package defaults: %s", err)
 		jsonresponse.BadRequest(w, api.ErrPackageRefChangeRejected)
 		return
 	case nil:
 	default:
-		errorf("add/update package: %s", err)
+		errorf("apply package defaults: %s", err)
 		jsonresponse.InternalServerError(w, nil)
 		return
 	}
 
-	action := "package update"
-	if id == "" {
-		action = "package add"
+	if plan.Applied {
+		s.auditf(r, request, "package defaults apply", "%s: %d changes", plan.Domain.ID, len(plan.Changes))
 	}
-	s.auditf(r, request, action, "%s %s (domain: %s)", p.ID, p.ImportPrefix(), p.Domain.ID)
 
-	jsonresponse.OK(w, packagesPackageToAPIPackage(*p, nil))
+	jsonresponse.OK(w, packagesPlanToAPIPackagesPlan(*plan))
 }
 
 func (s *Server) deletePackageAPIHandler(w http.ResponseWriter, r *http.Request) {
@@ -303,8 +503,15 @@ func (s *Server) deletePackageAPIHandler(w http.ResponseWriter, r *http.Request)
 
 	id := mux.Vars(r)["id"]
 
+	ifRevision, ok := ifMatchRevision(r)
+	if !ok {
+		s.Logger.Warningf("package delete api: user %s: delete package %s: invalid if-match header %q", u.ID, id, r.Header.Get("If-Match"))
+		jsonresponse.PreconditionFailed(w, api.ErrPreconditionFailed)
+		return
+	}
+
 	// Delete package checks permissions.
-	p, err := s.PackagesService.DeletePackage(id, u.ID)
+	p, err := s.PackagesService.DeletePackage(id, ifRevision, u.ID)
 	switch err {
 	case packages.ErrForbidden:
 		s.Logger.Warningf("package delete api: user %s: delete package %s: %s", u.ID, id, err)
@@ -314,6 +521,10 @@ func (s *Server) deletePackageAPIHandler(w http.ResponseWriter, r *http.Request)
 		s.Logger.Warningf("package delete api: user %s: delete package %s: %s", u.ID, id, err)
 		jsonresponse.BadRequest(w, api.ErrPackageNotFound)
 		return
+	case packages.ErrPackageRevisionMismatch:
+		s.Logger.Warningf("package delete api: user %s: delete package %s: %s", u.ID, id, err)
+		jsonresponse.PreconditionFailed(w, api.ErrPreconditionFailed)
+		return
 	case nil:
 	default:
 		s.Logger.Errorf("package delete api: user %s: delete package %s: %s", u.ID, id, err)
@@ -323,11 +534,34 @@ func (s *Server) deletePackageAPIHandler(w http.ResponseWriter, r *http.Request)
 
 	s.Logger.Debugf("package delete api: %s deleted by %s", p.ID, u.ID)
 
-	s.auditf(r, nil, "package delete", "%s: %s", p.ID, p.ImportPrefix)
+	s.auditf(r, nil, "package delete", "%s: %s", p.ID, p.ImportPrefix())
 
 	jsonresponse.OK(w, packagesPackageToAPIPackage(*p, nil))
 }
 
+// packagesFilterFromQuery constructs packages filter from URL query
+// parameters vcs, ref_type, repo_root_host, disabled and query.
+// It returns nil if none of the parameters are set.
+func packagesFilterFromQuery(query url.Values) (filter *packages.PackagesFilter, err error) {
+	f := packages.PackagesFilter{
+		VCS:          packages.VCS(strings.TrimSpace(query.Get("vcs"))),
+		RefType:      packages.RefType(strings.TrimSpace(query.Get("ref_type"))),
+		RepoRootHost: strings.TrimSpace(query.Get("repo_root_host")),
+		Query:        strings.TrimSpace(query.Get("query")),
+	}
+	if d := strings.TrimSpace(query.Get("disabled")); d != "" {
+		disabled, err := strconv.ParseBool(d)
+		if err != nil {
+			return nil, fmt.Errorf("disabled: %s", err)
+		}
+		f.Disabled = &disabled
+	}
+	if f == (packages.PackagesFilter{}) {
+		return nil, nil
+	}
+	return &f, nil
+}
+
 func (s *Server) domainPackagesAPIHandler(w http.ResponseWriter, r *http.Request) {
 	u, r, err := s.getRequestUser(r)
 	if err != nil {
@@ -348,7 +582,14 @@ func (s *Server) domainPackagesAPIHandler(w http.ResponseWriter, r *http.Request
 		}
 	}
 
-	pkgs, err := s.PackagesService.PackagesByDomain(id, start, limit)
+	filter, err := packagesFilterFromQuery(r.URL.Query())
+	if err != nil {
+		s.Logger.Warningf("domain packages api: packages filter: %s", err)
+		jsonresponse.BadRequest(w, api.ErrBadRequest)
+		return
+	}
+
+	pkgs, err := s.PackagesService.PackagesByDomain(id, start, limit, filter)
 	if err != nil {
 		if err == packages.ErrDomainNotFound {
 			s.Logger.Warningf("domain packages api: packages by domain %s: %s", id, err)
@@ -412,3 +653,162 @@ func (s *Server) domainPackagesAPIHandler(w http.ResponseWriter, r *http.Request
 
 	jsonresponse.OK(w, response)
 }
+
+func (s *Server) searchPackagesAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	query := strings.TrimSpace(r.URL.Query().Get("query"))
+	start := r.URL.Query().Get("start")
+
+	limit := 0
+	if l := r.URL.Query().Get("limit"); l != "" {
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit < 1 || limit > api.MaxLimit {
+			s.Logger.Warningf("search packages api: user %s: invalid limit %q", u.ID, l)
+			jsonresponse.BadRequest(w, api.ErrBadRequest)
+			return
+		}
+	}
+
+	// Administrators search packages under all domains.
+	userID := u.ID
+	if u.Admin {
+		userID = ""
+	}
+
+	pkgs, err := s.PackagesService.SearchPackages(userID, query, start, limit)
+	if err != nil {
+		if err == packages.ErrPackageNotFound {
+			s.Logger.Warningf("search packages api: user %s: start %s: %s", u.ID, start, err)
+			jsonresponse.BadRequest(w, api.ErrPackageNotFound)
+			return
+		}
+		s.Logger.Errorf("search packages api: user %s: %s", u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	response := api.PackagesPage{
+		Packages: []api.Package{},
+		Next:     pkgs.Next,
+		Count:    pkgs.Count,
+	}
+
+	for _, p := range pkgs.Packages {
+		response.Packages = append(response.Packages, packagesPackageToAPIPackage(p, nil))
+	}
+
+	jsonresponse.OK(w, response)
+}
+
+func (s *Server) syncDomainPackagesAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	warningf := func(format string, a ...interface{}) {
+		s.Logger.Warningf("sync domain packages api: %q: user %s: %s", id, u.ID, fmt.Sprintf(format, a...))
+	}
+	errorf := func(format string, a ...interface{}) {
+		s.Logger.Errorf("sync domain packages api: %q: user %s: %s", id, u.ID, fmt.Sprintf(format, a...))
+	}
+
+	dryRun := false
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		dryRun, err = strconv.ParseBool(v)
+		if err != nil {
+			warningf("request: invalid dry run value %q", v)
+			jsonresponse.BadRequest(w, nil)
+			return
+		}
+	}
+
+	request := api.PackagesManifest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		warningf("request: request decode: %s", err)
+		jsonresponse.BadRequest(w, nil)
+		return
+	}
+
+	o := make([]packages.PackageOptions, 0, len(request.Packages))
+	for i, p := range request.Packages {
+		// Packages are always synchronized under the requested domain.
+		p.Domain = &id
+		po, apiErr, err := apiPackageOptions(p, true)
+		if err != nil {
+			warningf("package %d: %s", i, err)
+			jsonresponse.BadRequest(w, apiErr)
+			return
+		}
+		o = append(o, *po)
+	}
+
+	plan, err := s.PackagesService.SyncPackages(id, o, dryRun, u.ID)
+	switch err {
+	case packages.ErrForbidden:
+		warningf("sync packages: %s", err)
+		jsonresponse.Forbidden(w, nil)
+		return
+	case packages.ErrDomainNotFound:
+		warningf("sync packages: %s", err)
+		jsonresponse.BadRequest(w, api.ErrDomainNotFound)
+		return
+	case packages.ErrPackagePathRequired:
+		warningf("sync packages: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackagePathRequired)
+		return
+	case packages.ErrPackageVCSRequired:
+		warningf("sync packages: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageVCSRequired)
+		return
+	case packages.ErrPackageRepoRootRequired:
+		warningf("sync packages: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageRepoRootRequired)
+		return
+	case packages.ErrPackageRepoRootInvalid:
+		warningf("sync packages: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageRepoRootInvalid)
+		return
+	case packages.ErrPackageRepoRootSchemeRequired:
+		warningf("sync packages: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageRepoRootSchemeRequired)
+		return
+	case packages.ErrPackageRepoRootSchemeInvalid:
+		warningf("sync packages: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageRepoRootSchemeInvalid)
+		return
+	case packages.ErrPackageRepoRootHostInvalid:
+		warningf("sync packages: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageRepoRootHostInvalid)
+		return
+	case packages.ErrPackageAlreadyExists:
+		warningf("sync packages: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageAlreadyExists)
+		return
+	case packages.ErrPackageQuotaExceeded:
+		warningf("sync packages: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageQuotaExceeded)
+		return
+	case packages.ErrPackageRefChangeRejected:
+		warningf("sync packages: %s", err)
+		jsonresponse.BadRequest(w, api.ErrPackageRefChangeRejected)
+		return
+	case nil:
+	default:
+		errorf("sync packages: %s", err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	if plan.Applied {
+		s.auditf(r, request, "packages sync", "%s: %d changes", plan.Domain.ID, len(plan.Changes))
+	}
+
+	jsonresponse.OK(w, packagesPlanToAPIPackagesPlan(*plan))
+}
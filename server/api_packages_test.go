@@ -6,6 +6,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"strconv"
@@ -428,7 +429,7 @@ func TestPackagesAPI(t *testing.T) {
 			}
 		})
 		t.Run("package repository root host invalid", func(t *testing.T) {
-			repoRoot := "https://domain:port/path"
+			repoRoot := "https://-domain/path"
 			_, err := httpClients["alice"].AddPackage(&api.PackageOptions{
 				Domain:   &pkg.DomainID,
 				Path:     &pkg.Path,
@@ -880,7 +881,7 @@ func TestPackagesAPI(t *testing.T) {
 			}
 		})
 		t.Run("package repository root host invalid", func(t *testing.T) {
-			repoRoot := "https://domain:port/path"
+			repoRoot := "https://-domain/path"
 			_, err := httpClients["alice"].UpdatePackage(referencePkgID, &api.PackageOptions{
 				RepoRoot: &repoRoot,
 			})
@@ -1027,6 +1028,54 @@ func TestPackagesAPI(t *testing.T) {
 			t.Errorf("expected %v, got %v", True, refPkg.Disabled)
 		}
 	})
+	t.Run("package if-match", func(t *testing.T) {
+		pkg, err := httpClients["alice"].Package(refPkg.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pkg.ETag == "" {
+			t.Fatal("expected etag, got none")
+		}
+		staleETag := pkg.ETag
+
+		goSource := "https://example.com/if-match"
+		pkg, err = httpClients["alice"].UpdatePackageIfMatch(refPkg.ID, staleETag, &api.PackageOptions{
+			GoSource: &goSource,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pkg.ETag == staleETag {
+			t.Errorf("expected etag different from %s", staleETag)
+		}
+
+		_, err = httpClients["alice"].UpdatePackageIfMatch(refPkg.ID, staleETag, &api.PackageOptions{
+			GoSource: &refPkg.GoSource,
+		})
+		if err != api.ErrPreconditionFailed {
+			t.Errorf("expected %q, got %q", api.ErrPreconditionFailed, err)
+		}
+
+		_, err = httpClients["alice"].DeletePackageIfMatch(refPkg.ID, staleETag)
+		if err != api.ErrPreconditionFailed {
+			t.Errorf("expected %q, got %q", api.ErrPreconditionFailed, err)
+		}
+
+		_, err = httpClients["alice"].DeletePackageIfMatch(refPkg.ID, "invalid")
+		if err != api.ErrPreconditionFailed {
+			t.Errorf("expected %q, got %q", api.ErrPreconditionFailed, err)
+		}
+
+		pkg, err = httpClients["alice"].UpdatePackageIfMatch(refPkg.ID, pkg.ETag, &api.PackageOptions{
+			GoSource: &refPkg.GoSource,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pkg.GoSource != refPkg.GoSource {
+			t.Errorf("expected %q, got %q", refPkg.GoSource, pkg.GoSource)
+		}
+	})
 	t.Run("delete package", func(t *testing.T) {
 		pkg, err := httpClients["alice"].DeletePackage(refPkg.ID)
 		if err != nil {
@@ -1171,5 +1220,624 @@ func TestPackagesAPI(t *testing.T) {
 				t.Errorf("expected %q, got %q", api.ErrPackageNotFound, err)
 			}
 		})
+		t.Run("filter", func(t *testing.T) {
+			all, err := httpClients["alice"].DomainPackages(domains["alice"][0].ID, "", api.MaxLimit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bTrue := true
+			bFalse := false
+			for _, tc := range []struct {
+				name   string
+				filter api.PackagesFilter
+				match  func(p api.Package) bool
+			}{
+				{
+					name:   "vcs",
+					filter: api.PackagesFilter{VCS: api.VCSBazaar},
+					match:  func(p api.Package) bool { return p.VCS == api.VCSBazaar },
+				},
+				{
+					name:   "reference type",
+					filter: api.PackagesFilter{RefType: api.RefTypeTag},
+					match:  func(p api.Package) bool { return p.RefType == api.RefTypeTag },
+				},
+				{
+					name:   "repository root host",
+					filter: api.PackagesFilter{RepoRootHost: "GitHub.com"},
+					match:  func(p api.Package) bool { return strings.Contains(p.RepoRoot, "://github.com/") },
+				},
+				{
+					name:   "disabled",
+					filter: api.PackagesFilter{Disabled: &bTrue},
+					match:  func(p api.Package) bool { return p.Disabled },
+				},
+				{
+					name:   "enabled",
+					filter: api.PackagesFilter{Disabled: &bFalse},
+					match:  func(p api.Package) bool { return !p.Disabled },
+				},
+				{
+					name:   "query",
+					filter: api.PackagesFilter{Query: "HTTP"},
+					match:  func(p api.Package) bool { return strings.Contains(p.Path, "http") },
+				},
+				{
+					name:   "combined",
+					filter: api.PackagesFilter{VCS: api.VCSGit, Disabled: &bTrue, Query: "git"},
+					match: func(p api.Package) bool {
+						return p.VCS == api.VCSGit && p.Disabled && strings.Contains(p.Path, "git")
+					},
+				},
+				{
+					name:   "no match",
+					filter: api.PackagesFilter{RepoRootHost: "missing.example.com"},
+					match:  func(p api.Package) bool { return false },
+				},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					want := []string{}
+					for _, p := range all.Packages {
+						if tc.match(p) {
+							want = append(want, p.Path)
+						}
+					}
+					got := []string{}
+					start := ""
+					previous := ""
+					for {
+						page, err := httpClients["alice"].DomainPackagesFiltered(domains["alice"][0].ID, start, 2, &tc.filter)
+						if err != nil {
+							t.Fatal(err)
+						}
+						if page.Count != len(page.Packages) {
+							t.Errorf("expected %v, got %v", len(page.Packages), page.Count)
+						}
+						if page.Previous != previous {
+							t.Errorf("expected %q, got %q", previous, page.Previous)
+						}
+						for _, p := range page.Packages {
+							got = append(got, p.Path)
+						}
+						if page.Next == "" {
+							break
+						}
+						if len(got) >= 2 {
+							previous = got[len(got)-2]
+						}
+						start = page.Next
+					}
+					if strings.Join(got, ",") != strings.Join(want, ",") {
+						t.Errorf("expected %q, got %q", want, got)
+					}
+				})
+			}
+			t.Run("start not matching", func(t *testing.T) {
+				_, err := httpClients["alice"].DomainPackagesFiltered(domains["alice"][0].ID, "/test-ref-branch", 0, &api.PackagesFilter{RefType: api.RefTypeTag})
+				if err != api.ErrPackageNotFound {
+					t.Errorf("expected %q, got %q", api.ErrPackageNotFound, err)
+				}
+			})
+		})
+	})
+	t.Run("sync domain packages", func(t *testing.T) {
+		domain := domains["alice"][1]
+		str := func(s string) *string { return &s }
+		vcsGit := api.VCSGit
+		refTypeBranch := api.RefTypeBranch
+
+		manifest := &api.PackagesManifest{
+			Packages: []api.PackageOptions{
+				{
+					Path:     str("/application"),
+					VCS:      &vcsGit,
+					RepoRoot: str("https://github.com/me/application.git"),
+				},
+				{
+					Path:     str("library"),
+					VCS:      &vcsGit,
+					RepoRoot: str("https://github.com/me/library.git"),
+				},
+			},
+		}
+
+		t.Run("dry run", func(t *testing.T) {
+			plan, err := httpClients["alice"].SyncDomainPackages(domain.FQDN, manifest, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if plan.Applied {
+				t.Errorf("expected %v, got %v", false, plan.Applied)
+			}
+			if len(plan.Changes) != 2 {
+				t.Fatalf("expected %v, got %v", 2, len(plan.Changes))
+			}
+			for i, path := range []string{"/application", "/library"} {
+				if plan.Changes[i].Action != api.PackageActionAdd {
+					t.Errorf("%d: expected %q, got %q", i, api.PackageActionAdd, plan.Changes[i].Action)
+				}
+				if plan.Changes[i].Package.Path != path {
+					t.Errorf("%d: expected %q, got %q", i, path, plan.Changes[i].Package.Path)
+				}
+				if plan.Changes[i].Package.ID != "" {
+					t.Errorf("%d: expected %q, got %q", i, "", plan.Changes[i].Package.ID)
+				}
+			}
+			page, err := httpClients["alice"].DomainPackages(domain.ID, "", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if page.Count != 0 {
+				t.Errorf("expected %v, got %v", 0, page.Count)
+			}
+		})
+		t.Run("apply", func(t *testing.T) {
+			plan, err := httpClients["alice"].SyncDomainPackages(domain.FQDN, manifest, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !plan.Applied {
+				t.Errorf("expected %v, got %v", true, plan.Applied)
+			}
+			if len(plan.Changes) != 2 {
+				t.Fatalf("expected %v, got %v", 2, len(plan.Changes))
+			}
+			page, err := httpClients["alice"].DomainPackages(domain.ID, "", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if page.Count != 2 {
+				t.Errorf("expected %v, got %v", 2, page.Count)
+			}
+			changelog, err := s.PackagesService.ChangelogForDomain(domain.ID, "", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, r := range changelog.Records {
+				if r.UserID != users["alice"].ID {
+					t.Errorf("expected %q, got %q", users["alice"].ID, r.UserID)
+				}
+			}
+		})
+		t.Run("apply again", func(t *testing.T) {
+			plan, err := httpClients["alice"].SyncDomainPackages(domain.FQDN, manifest, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(plan.Changes) != 0 {
+				t.Errorf("expected %v, got %v", 0, len(plan.Changes))
+			}
+		})
+		t.Run("add update delete", func(t *testing.T) {
+			plan, err := httpClients["alice"].SyncDomainPackages(domain.FQDN, &api.PackagesManifest{
+				Packages: []api.PackageOptions{
+					{
+						Path:     str("/application"),
+						VCS:      &vcsGit,
+						RepoRoot: str("https://github.com/me/application.git"),
+						RefType:  &refTypeBranch,
+						RefName:  str("stable"),
+					},
+					{
+						Path:     str("/tool"),
+						VCS:      &vcsGit,
+						RepoRoot: str("https://github.com/me/tool.git"),
+					},
+				},
+			}, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := []struct {
+				action api.PackageAction
+				path   string
+			}{
+				{api.PackageActionUpdate, "/application"},
+				{api.PackageActionDelete, "/library"},
+				{api.PackageActionAdd, "/tool"},
+			}
+			if len(plan.Changes) != len(want) {
+				t.Fatalf("expected %v, got %v", len(want), len(plan.Changes))
+			}
+			for i, w := range want {
+				if plan.Changes[i].Action != w.action {
+					t.Errorf("%d: expected %q, got %q", i, w.action, plan.Changes[i].Action)
+				}
+				if plan.Changes[i].Package.Path != w.path {
+					t.Errorf("%d: expected %q, got %q", i, w.path, plan.Changes[i].Package.Path)
+				}
+			}
+			fields := []string{}
+			for _, c := range plan.Changes[0].Changes {
+				fields = append(fields, c.Field)
+			}
+			if strings.Join(fields, ",") != "ref_type,ref_name" {
+				t.Errorf("expected %q, got %q", "ref_type,ref_name", strings.Join(fields, ","))
+			}
+			page, err := httpClients["alice"].DomainPackages(domain.ID, "", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			paths := []string{}
+			for _, p := range page.Packages {
+				paths = append(paths, p.Path)
+			}
+			if strings.Join(paths, ",") != "/application,/tool" {
+				t.Errorf("expected %q, got %q", "/application,/tool", strings.Join(paths, ","))
+			}
+		})
+		t.Run("atomic", func(t *testing.T) {
+			_, err := httpClients["alice"].SyncDomainPackages(domain.FQDN, &api.PackagesManifest{
+				Packages: []api.PackageOptions{
+					{
+						Path:     str("/new"),
+						VCS:      &vcsGit,
+						RepoRoot: str("https://github.com/me/new.git"),
+					},
+					{
+						Path:     str("/"),
+						VCS:      &vcsGit,
+						RepoRoot: str("https://github.com/me/invalid.git"),
+					},
+				},
+			}, false)
+			if err != api.ErrPackagePathRequired {
+				t.Errorf("expected %q, got %q", api.ErrPackagePathRequired, err)
+			}
+			page, err := httpClients["alice"].DomainPackages(domain.ID, "", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if page.Count != 2 {
+				t.Errorf("expected %v, got %v", 2, page.Count)
+			}
+		})
+		t.Run("duplicate path", func(t *testing.T) {
+			_, err := httpClients["alice"].SyncDomainPackages(domain.FQDN, &api.PackagesManifest{
+				Packages: []api.PackageOptions{manifest.Packages[0], manifest.Packages[0]},
+			}, true)
+			if err != api.ErrPackageAlreadyExists {
+				t.Errorf("expected %q, got %q", api.ErrPackageAlreadyExists, err)
+			}
+		})
+		t.Run("forbidden", func(t *testing.T) {
+			_, err := httpClients["chuck"].SyncDomainPackages(domain.FQDN, manifest, true)
+			if err != api.ErrForbidden {
+				t.Errorf("expected %q, got %q", api.ErrForbidden, err)
+			}
+		})
+		t.Run("domain not found", func(t *testing.T) {
+			_, err := httpClients["alice"].SyncDomainPackages("missing.example.com", manifest, true)
+			if err != api.ErrDomainNotFound {
+				t.Errorf("expected %q, got %q", api.ErrDomainNotFound, err)
+			}
+		})
+	})
+	t.Run("search packages", func(t *testing.T) {
+		vcsGit := api.VCSGit
+		for _, o := range []struct {
+			username string
+			domain   api.Domain
+			path     string
+			repoRoot string
+		}{
+			{"alice", domains["alice"][2], "/library", "https://git.example.com/Searchable/library.git"},
+			{"chuck", domains["chuck"][0], "/searchable", "https://github.com/chuck/library.git"},
+		} {
+			o := o
+			if _, err := httpClients[o.username].AddPackage(&api.PackageOptions{
+				Domain:   &o.domain.ID,
+				Path:     &o.path,
+				VCS:      &vcsGit,
+				RepoRoot: &o.repoRoot,
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		search := func(t *testing.T, username, query string) (paths []string) {
+			start := ""
+			for {
+				page, err := httpClients[username].SearchPackages(query, start, 2)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if page.Count != len(page.Packages) {
+					t.Errorf("expected %v, got %v", len(page.Packages), page.Count)
+				}
+				for _, p := range page.Packages {
+					paths = append(paths, p.FQDN+p.Path)
+				}
+				if page.Next == "" {
+					return
+				}
+				start = page.Next
+			}
+		}
+
+		for _, tc := range []struct {
+			name     string
+			username string
+			query    string
+			want     []string
+		}{
+			{"repository root", "alice", "searchable", []string{"alice3.trusted.com/library"}},
+			{"import path", "chuck", "SEARCHABLE", []string{"chuck.trusted.com/searchable"}},
+			{"not accessible", "alice", "chuck", nil},
+			{"no access", "bob", "searchable", nil},
+			{"reference name", "alice", "stable", []string{"alice2.trusted.com/application"}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				got := search(t, tc.username, tc.query)
+				if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+					t.Errorf("expected %q, got %q", tc.want, got)
+				}
+			})
+		}
+
+		t.Run("all packages", func(t *testing.T) {
+			want := []string{}
+			for _, domain := range domains["alice"] {
+				page, err := httpClients["alice"].DomainPackages(domain.ID, "", api.MaxLimit)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, p := range page.Packages {
+					want = append(want, p.FQDN+p.Path)
+				}
+			}
+			got := search(t, "alice", "")
+			if strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		})
+
+		t.Run("all packages iterators", func(t *testing.T) {
+			ctx := context.Background()
+			want := []string{}
+			for _, domain := range domains["alice"] {
+				packages, err := httpClients["alice"].AllDomainPackages(ctx, domain.ID, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, p := range packages {
+					want = append(want, p.FQDN+p.Path)
+				}
+			}
+			packages, err := httpClients["alice"].AllSearchPackages(ctx, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := []string{}
+			for _, p := range packages {
+				got = append(got, p.FQDN+p.Path)
+			}
+			if strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		})
+
+		t.Run("admin", func(t *testing.T) {
+			admin := true
+			if _, err := s.UserService.UpdateUser(users["bob"].ID, &user.Options{Admin: &admin}); err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				admin = false
+				if _, err := s.UserService.UpdateUser(users["bob"].ID, &user.Options{Admin: &admin}); err != nil {
+					t.Fatal(err)
+				}
+			}()
+			want := []string{"alice3.trusted.com/library", "chuck.trusted.com/searchable"}
+			got := search(t, "bob", "searchable")
+			if strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		})
+
+		t.Run("package not found", func(t *testing.T) {
+			_, err := httpClients["alice"].SearchPackages("", "missing", 0)
+			if err != api.ErrPackageNotFound {
+				t.Errorf("expected %q, got %q", api.ErrPackageNotFound, err)
+			}
+			page, err := httpClients["alice"].SearchPackages("", "", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = httpClients["chuck"].SearchPackages("", page.Packages[0].ID, 0)
+			if err != api.ErrPackageNotFound {
+				t.Errorf("expected %q, got %q", api.ErrPackageNotFound, err)
+			}
+		})
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		vcsGit := api.VCSGit
+		domain := domains["alice"][2]
+		packageOptions := func(path string) *api.PackageOptions {
+			repoRoot := "https://github.com/alice" + path + ".git"
+			return &api.PackageOptions{
+				Domain:   &domain.FQDN,
+				Path:     &path,
+				VCS:      &vcsGit,
+				RepoRoot: &repoRoot,
+			}
+		}
+		domainPaths := func(t *testing.T, domainRef, query string) (paths []string) {
+			page, err := httpClients["alice"].DomainPackagesFiltered(domainRef, "", api.MaxLimit, &api.PackagesFilter{
+				Query: query,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, p := range page.Packages {
+				paths = append(paths, p.Path)
+			}
+			return
+		}
+
+		var batchPkgID string
+
+		t.Run("apply", func(t *testing.T) {
+			results, err := httpClients["alice"].Batch([]api.BatchOperation{
+				{Action: api.BatchActionAddPackage, Package: packageOptions("/batch-one")},
+				{Action: api.BatchActionAddPackage, Package: packageOptions("/batch-two")},
+				{Action: api.BatchActionGrantDomainUser, Ref: domain.ID, User: "bob"},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(results) != 3 {
+				t.Fatalf("expected %d results, got %d", 3, len(results))
+			}
+			for i, path := range []string{"/batch-one", "/batch-two"} {
+				r := results[i]
+				if r.Action != api.BatchActionAddPackage {
+					t.Errorf("expected %q, got %q", api.BatchActionAddPackage, r.Action)
+				}
+				if r.Package == nil {
+					t.Fatal("expected package in result")
+				}
+				if r.Package.Path != path {
+					t.Errorf("expected %q, got %q", path, r.Package.Path)
+				}
+				if r.ETag == "" {
+					t.Error("expected etag in result")
+				}
+			}
+			if results[2].Domain == nil || results[2].Domain.ID != domain.ID {
+				t.Errorf("expected domain %q in result, got %v", domain.ID, results[2].Domain)
+			}
+			batchPkgID = results[0].Package.ID
+
+			if _, err := httpClients["bob"].Package(batchPkgID); err != nil {
+				t.Errorf("get package by granted user: %s", err)
+			}
+
+			got := domainPaths(t, domain.ID, "batch")
+			want := []string{"/batch-one", "/batch-two"}
+			if strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		})
+
+		t.Run("rollback", func(t *testing.T) {
+			_, err := httpClients["alice"].Batch([]api.BatchOperation{
+				{Action: api.BatchActionAddPackage, Package: packageOptions("/batch-three")},
+				{Action: api.BatchActionDeletePackage, Ref: batchPkgID},
+				{Action: api.BatchActionAddPackage, Package: packageOptions("/batch-two")},
+			})
+			e, ok := err.(*api.BatchError)
+			if !ok {
+				t.Fatalf("expected batch error, got %#v", err)
+			}
+			if e.Operation != 2 {
+				t.Errorf("expected operation %d, got %d", 2, e.Operation)
+			}
+			if e.Err != api.ErrPackageAlreadyExists {
+				t.Errorf("expected %q, got %q", api.ErrPackageAlreadyExists, e.Err)
+			}
+
+			got := domainPaths(t, domain.ID, "batch")
+			want := []string{"/batch-one", "/batch-two"}
+			if strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		})
+
+		for _, tc := range []struct {
+			name       string
+			username   string
+			operations []api.BatchOperation
+			operation  int
+			err        error
+		}{
+			{
+				name:     "forbidden",
+				username: "chuck",
+				operations: []api.BatchOperation{
+					{Action: api.BatchActionAddPackage, Package: packageOptions("/batch-chuck")},
+				},
+				err: api.ErrForbidden,
+			},
+			{
+				name:     "invalid action",
+				username: "alice",
+				operations: []api.BatchOperation{
+					{Action: api.BatchActionDeletePackage, Ref: batchPkgID},
+					{Action: "rename_package", Ref: batchPkgID},
+				},
+				operation: 1,
+				err:       api.ErrBatchActionInvalid,
+			},
+			{
+				name:     "if match",
+				username: "alice",
+				operations: []api.BatchOperation{
+					{Action: api.BatchActionDeletePackage, Ref: batchPkgID, IfMatch: `"999"`},
+				},
+				err: api.ErrPreconditionFailed,
+			},
+			{
+				name:     "user does not exist",
+				username: "alice",
+				operations: []api.BatchOperation{
+					{Action: api.BatchActionGrantDomainUser, Ref: domain.ID, User: "missing"},
+				},
+				err: api.ErrUserDoesNotExist,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := httpClients[tc.username].Batch(tc.operations)
+				e, ok := err.(*api.BatchError)
+				if !ok {
+					t.Fatalf("expected batch error, got %#v", err)
+				}
+				if e.Operation != tc.operation {
+					t.Errorf("expected operation %d, got %d", tc.operation, e.Operation)
+				}
+				if e.Err != tc.err {
+					t.Errorf("expected %q, got %q", tc.err, e.Err)
+				}
+			})
+		}
+
+		t.Run("add domain and package", func(t *testing.T) {
+			fqdn := "chuck-batch.trusted.com"
+			path := "/application"
+			repoRoot := "https://github.com/chuck/application.git"
+			results, err := httpClients["chuck"].Batch([]api.BatchOperation{
+				{Action: api.BatchActionAddDomain, Domain: &api.DomainOptions{FQDN: &fqdn}},
+				{Action: api.BatchActionAddPackage, Package: &api.PackageOptions{
+					Domain:   &fqdn,
+					Path:     &path,
+					VCS:      &vcsGit,
+					RepoRoot: &repoRoot,
+				}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if results[0].Domain == nil || results[0].Domain.FQDN != fqdn {
+				t.Fatalf("expected domain %q in result, got %v", fqdn, results[0].Domain)
+			}
+			if results[0].Domain.OwnerUserID != users["chuck"].ID {
+				t.Errorf("expected %q, got %q", users["chuck"].ID, results[0].Domain.OwnerUserID)
+			}
+			if results[1].Package == nil || results[1].Package.DomainID != results[0].Domain.ID {
+				t.Errorf("expected package under domain %q, got %v", results[0].Domain.ID, results[1].Package)
+			}
+		})
+
+		t.Run("revoke", func(t *testing.T) {
+			if _, err := httpClients["alice"].Batch([]api.BatchOperation{
+				{Action: api.BatchActionRevokeDomainUser, Ref: domain.ID, User: "bob"},
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := httpClients["bob"].Package(batchPkgID); err != api.ErrForbidden {
+				t.Errorf("expected %q, got %q", api.ErrForbidden, err)
+			}
+		})
 	})
 }
@@ -0,0 +1,184 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"resenje.org/jsonresponse"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+func packagesQuotaToAPIQuota(q packages.Quota) api.Quota {
+	return api.Quota{
+		DomainLimit:          q.Domains,
+		PackageLimit:         q.Packages,
+		DomainLimitOverride:  q.DomainsOverride,
+		PackageLimitOverride: q.PackagesOverride,
+		Domains:              q.DomainsUsed,
+		Packages:             q.PackagesUsed,
+	}
+}
+
+// quotaOwnerID returns the ID of the user or the organization referenced
+// in the quota API request URL. Users can access their own quota and
+// quotas of organizations in which they are members, while administrators
+// can access all quotas.
+func (s *Server) quotaOwnerID(w http.ResponseWriter, r *http.Request, prefix string, u *user.User, organization bool) (ownerID string, ok bool) {
+	id := mux.Vars(r)["id"]
+
+	warningf := func(format string, a ...interface{}) {
+		s.Logger.Warningf("%s: %q: user %s: %s", prefix, id, u.ID, fmt.Sprintf(format, a...))
+	}
+	errorf := func(format string, a ...interface{}) {
+		s.Logger.Errorf("%s: %q: user %s: %s", prefix, id, u.ID, fmt.Sprintf(format, a...))
+	}
+
+	if organization {
+		if !u.Admin {
+			org, _ := s.requestOrganizationMembers(w, r, prefix, u, id)
+			if org == nil {
+				return "", false
+			}
+			return org.ID, true
+		}
+		org, err := s.PackagesService.Organization(id)
+		if err != nil {
+			if err == packages.ErrOrganizationNotFound {
+				warningf("get organization: %s", err)
+				jsonresponse.BadRequest(w, api.ErrOrganizationNotFound)
+				return "", false
+			}
+			errorf("get organization: %s", err)
+			jsonresponse.InternalServerError(w, nil)
+			return "", false
+		}
+		return org.ID, true
+	}
+
+	ru, err := s.UserService.User(id)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			warningf("get user: %s", err)
+			jsonresponse.BadRequest(w, api.ErrUserDoesNotExist)
+			return "", false
+		}
+		errorf("get user: %s", err)
+		jsonresponse.InternalServerError(w, nil)
+		return "", false
+	}
+
+	if ru.ID != u.ID && !u.Admin {
+		warningf("not an admin")
+		jsonresponse.Forbidden(w, nil)
+		return "", false
+	}
+	return ru.ID, true
+}
+
+func (s *Server) quotaAPIHandler(w http.ResponseWriter, r *http.Request) {
+	s.handleQuotaAPI(w, r, false)
+}
+
+func (s *Server) organizationQuotaAPIHandler(w http.ResponseWriter, r *http.Request) {
+	s.handleQuotaAPI(w, r, true)
+}
+
+func (s *Server) updateQuotaAPIHandler(w http.ResponseWriter, r *http.Request) {
+	s.handleUpdateQuotaAPI(w, r, false)
+}
+
+func (s *Server) updateOrganizationQuotaAPIHandler(w http.ResponseWriter, r *http.Request) {
+	s.handleUpdateQuotaAPI(w, r, true)
+}
+
+func (s *Server) handleQuotaAPI(w http.ResponseWriter, r *http.Request, organization bool) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	ownerID, ok := s.quotaOwnerID(w, r, "quota api", u, organization)
+	if !ok {
+		return
+	}
+
+	q, err := s.PackagesService.Quota(ownerID)
+	if err != nil {
+		s.Logger.Errorf("quota api: %s: user %s: quota: %s", ownerID, u.ID, err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	jsonresponse.OK(w, packagesQuotaToAPIQuota(*q))
+}
+
+func (s *Server) handleUpdateQuotaAPI(w http.ResponseWriter, r *http.Request, organization bool) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	warningf := func(format string, a ...interface{}) {
+		s.Logger.Warningf("update quota api: %q: user %s: %s", id, u.ID, fmt.Sprintf(format, a...))
+	}
+	errorf := func(format string, a ...interface{}) {
+		s.Logger.Errorf("update quota api: %q: user %s: %s", id, u.ID, fmt.Sprintf(format, a...))
+	}
+
+	if !u.Admin {
+		warningf("not an admin")
+		jsonresponse.Forbidden(w, nil)
+		return
+	}
+
+	request := api.QuotaOptions{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		warningf("request decode: %s", err)
+		jsonresponse.BadRequest(w, nil)
+		return
+	}
+
+	for _, v := range []*int{request.DomainLimitOverride, request.PackageLimitOverride} {
+		if v != nil && *v < -1 {
+			warningf("request: invalid limit override %d", *v)
+			jsonresponse.BadRequest(w, api.ErrBadRequest)
+			return
+		}
+	}
+
+	ownerID, ok := s.quotaOwnerID(w, r, "update quota api", u, organization)
+	if !ok {
+		return
+	}
+
+	q, err := s.PackagesService.SetQuota(ownerID, &packages.QuotaOptions{
+		Domains:  request.DomainLimitOverride,
+		Packages: request.PackageLimitOverride,
+	})
+	if err != nil {
+		if err == packages.ErrQuotaInvalid {
+			warningf("set quota: %s", err)
+			jsonresponse.BadRequest(w, api.ErrBadRequest)
+			return
+		}
+		errorf("set quota: %s", err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	s.auditf(r, request, "quota update", "%s: domain limit %d, package limit %d", ownerID, q.Domains, q.Packages)
+
+	jsonresponse.OK(w, packagesQuotaToAPIQuota(*q))
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/key"
+	boltPackages "gopherpit.com/gopherpit/services/packages/bolt"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+func TestQuotaAPI(t *testing.T) {
+	s, err := newTestServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	packagesService := s.PackagesService.(*boltPackages.Service)
+	packagesService.DomainQuota = 2
+	packagesService.PackageQuota = 3
+
+	_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+	if err != nil {
+		t.Fatalf("parse IPv4 net: %s", err)
+	}
+	_, ipV6Net, err := net.ParseCIDR("::/0")
+	if err != nil {
+		t.Fatalf("parse IPv6 net: %s", err)
+	}
+	clients := map[string]*api.Client{}
+	users := map[string]*user.User{}
+	for _, username := range []string{"alice", "admin"} {
+		email := username + "@localhost.loc"
+		admin := username == "admin"
+		u, err := s.UserService.CreateUser(&user.Options{
+			Email:    &email,
+			Username: &username,
+			Admin:    &admin,
+		})
+		if err != nil {
+			t.Fatalf("create user: %s", err)
+		}
+		k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+			AuthorizedNetworks: &[]net.IPNet{
+				*ipV4Net,
+				*ipV6Net,
+			},
+		})
+		if err != nil {
+			t.Fatalf("create key: %s", err)
+		}
+		users[username] = u
+		clients[username] = api.NewClientWithEndpoint("localhost:"+strconv.Itoa(s.servers.Addr("HTTP").Port)+"/api/v1", k.Secret)
+	}
+	c := clients["alice"]
+
+	addPackage := func(fqdn, path string) error {
+		vcs := api.VCSGit
+		repoRoot := "https://github.com/gopherpit/gopherpit.git"
+		_, err := c.AddPackage(&api.PackageOptions{
+			Domain:   &fqdn,
+			Path:     &path,
+			VCS:      &vcs,
+			RepoRoot: &repoRoot,
+		})
+		return err
+	}
+
+	t.Run("domain quota", func(t *testing.T) {
+		for fqdn, want := range map[string]error{
+			"a.trusted.com": nil,
+			"b.trusted.com": nil,
+		} {
+			fqdn := fqdn
+			if _, err := c.AddDomain(&api.DomainOptions{FQDN: &fqdn}); err != want {
+				t.Errorf("%s: expected error %v, got %v", fqdn, want, err)
+			}
+		}
+		fqdn := "c.trusted.com"
+		if _, err := c.AddDomain(&api.DomainOptions{FQDN: &fqdn}); err != api.ErrDomainQuotaExceeded {
+			t.Errorf("expected error %v, got %v", api.ErrDomainQuotaExceeded, err)
+		}
+	})
+
+	t.Run("package quota", func(t *testing.T) {
+		for _, path := range []string{"/one", "/two"} {
+			if err := addPackage("a.trusted.com", path); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := addPackage("b.trusted.com", "/three"); err != nil {
+			t.Fatal(err)
+		}
+		if err := addPackage("b.trusted.com", "/four"); err != api.ErrPackageQuotaExceeded {
+			t.Errorf("expected error %v, got %v", api.ErrPackageQuotaExceeded, err)
+		}
+		vcs := api.VCSGit
+		repoRoot := "https://github.com/gopherpit/gopherpit.git"
+		manifest := func(paths ...string) (m *api.PackagesManifest) {
+			m = &api.PackagesManifest{}
+			for _, path := range paths {
+				path := path
+				m.Packages = append(m.Packages, api.PackageOptions{
+					Path:     &path,
+					VCS:      &vcs,
+					RepoRoot: &repoRoot,
+				})
+			}
+			return
+		}
+		if _, err := c.SyncDomainPackages("a.trusted.com", manifest("/five", "/six"), false); err != nil {
+			t.Errorf("replace packages: %v", err)
+		}
+		if _, err := c.SyncDomainPackages("a.trusted.com", manifest("/five", "/six", "/seven"), true); err != api.ErrPackageQuotaExceeded {
+			t.Errorf("expected error %v, got %v", api.ErrPackageQuotaExceeded, err)
+		}
+	})
+
+	t.Run("get quota", func(t *testing.T) {
+		q, err := c.Quota(users["alice"].ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := api.Quota{
+			DomainLimit:  2,
+			PackageLimit: 3,
+			Domains:      2,
+			Packages:     3,
+		}
+		if q != want {
+			t.Errorf("expected quota %#v, got %#v", want, q)
+		}
+		if _, err := c.Quota(users["admin"].ID); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+		if _, err := clients["admin"].Quota("alice"); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("update quota", func(t *testing.T) {
+		unlimited := -1
+		o := &api.QuotaOptions{DomainLimitOverride: &unlimited}
+		if _, err := c.UpdateQuota("alice", o); err != api.ErrForbidden {
+			t.Errorf("expected error %v, got %v", api.ErrForbidden, err)
+		}
+		invalid := -2
+		if _, err := clients["admin"].UpdateQuota("alice", &api.QuotaOptions{DomainLimitOverride: &invalid}); err != api.ErrBadRequest {
+			t.Errorf("expected error %v, got %v", api.ErrBadRequest, err)
+		}
+		q, err := clients["admin"].UpdateQuota("alice", o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.DomainLimit != 0 || q.DomainLimitOverride != -1 {
+			t.Errorf("expected unlimited domains, got limit %d and override %d", q.DomainLimit, q.DomainLimitOverride)
+		}
+		fqdn := "c.trusted.com"
+		if _, err := c.AddDomain(&api.DomainOptions{FQDN: &fqdn}); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("organization quota", func(t *testing.T) {
+		name := "team"
+		org, err := c.AddOrganization(&api.OrganizationOptions{Name: &name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		one := 1
+		if _, err := clients["admin"].UpdateOrganizationQuota(org.Name, &api.QuotaOptions{DomainLimitOverride: &one}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.UpdateDomain("a.trusted.com", &api.DomainOptions{OrganizationID: &org.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.UpdateDomain("b.trusted.com", &api.DomainOptions{OrganizationID: &org.ID}); err != api.ErrDomainQuotaExceeded {
+			t.Errorf("expected error %v, got %v", api.ErrDomainQuotaExceeded, err)
+		}
+		q, err := c.OrganizationQuota(org.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := api.Quota{
+			DomainLimit:         1,
+			PackageLimit:        3,
+			DomainLimitOverride: 1,
+			Domains:             1,
+			Packages:            2,
+		}
+		if q != want {
+			t.Errorf("expected quota %#v, got %#v", want, q)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"resenje.org/jsonresponse"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+// userRateLimits returns effective API rate limits and overrides
+// of a user.
+func (s *Server) userRateLimits(userID string) (l *api.RateLimits, err error) {
+	limit, readLimit, err := s.apiRateLimits(userID)
+	if err != nil {
+		return
+	}
+	l = &api.RateLimits{
+		HourlyLimit:     limit,
+		HourlyReadLimit: readLimit,
+	}
+	overrides, err := s.KeyService.RateLimits(userID)
+	if err != nil {
+		return nil, err
+	}
+	l.HourlyLimitOverride = overrides.HourlyLimit
+	l.HourlyReadLimitOverride = overrides.HourlyReadLimit
+	return
+}
+
+func (s *Server) rateLimitsAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	warningf := func(format string, a ...interface{}) {
+		s.Logger.Warningf("rate limits api: %q: user %s: %s", id, u.ID, fmt.Sprintf(format, a...))
+	}
+	errorf := func(format string, a ...interface{}) {
+		s.Logger.Errorf("rate limits api: %q: user %s: %s", id, u.ID, fmt.Sprintf(format, a...))
+	}
+
+	ru, err := s.UserService.User(id)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			warningf("get user: %s", err)
+			jsonresponse.BadRequest(w, api.ErrUserDoesNotExist)
+			return
+		}
+		errorf("get user: %s", err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	if ru.ID != u.ID && !u.Admin {
+		warningf("not an admin")
+		jsonresponse.Forbidden(w, nil)
+		return
+	}
+
+	limits, err := s.userRateLimits(ru.ID)
+	if err != nil {
+		errorf("rate limits: %s", err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	jsonresponse.OK(w, limits)
+}
+
+func (s *Server) updateRateLimitsAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	warningf := func(format string, a ...interface{}) {
+		s.Logger.Warningf("update rate limits api: %q: user %s: %s", id, u.ID, fmt.Sprintf(format, a...))
+	}
+	errorf := func(format string, a ...interface{}) {
+		s.Logger.Errorf("update rate limits api: %q: user %s: %s", id, u.ID, fmt.Sprintf(format, a...))
+	}
+
+	if !u.Admin {
+		warningf("not an admin")
+		jsonresponse.Forbidden(w, nil)
+		return
+	}
+
+	request := api.RateLimitsOptions{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		warningf("request decode: %s", err)
+		jsonresponse.BadRequest(w, nil)
+		return
+	}
+
+	for _, v := range []*int{request.HourlyLimitOverride, request.HourlyReadLimitOverride} {
+		if v != nil && *v < -1 {
+			warningf("request: invalid limit override %d", *v)
+			jsonresponse.BadRequest(w, api.ErrBadRequest)
+			return
+		}
+	}
+
+	ru, err := s.UserService.User(id)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			warningf("get user: %s", err)
+			jsonresponse.BadRequest(w, api.ErrUserDoesNotExist)
+			return
+		}
+		errorf("get user: %s", err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	if _, err := s.KeyService.UpdateRateLimits(ru.ID, &key.RateLimitsOptions{
+		HourlyLimit:     request.HourlyLimitOverride,
+		HourlyReadLimit: request.HourlyReadLimitOverride,
+	}); err != nil {
+		errorf("update rate limits: %s", err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	limits, err := s.userRateLimits(ru.ID)
+	if err != nil {
+		errorf("rate limits: %s", err)
+		jsonresponse.InternalServerError(w, nil)
+		return
+	}
+
+	s.auditf(r, request, "rate limits update", "%s: limit %d, read limit %d", ru.ID, limits.HourlyLimit, limits.HourlyReadLimit)
+
+	jsonresponse.OK(w, limits)
+}
@@ -7,6 +7,7 @@ package server
 
 import (
 	"net"
+	"net/http"
 	"strconv"
 	"strings"
 	"testing"
@@ -144,7 +145,8 @@ func TestAPIAccess(t *testing.T) {
 
 func TestAPIRateLimit(t *testing.T) {
 	s, err := newTestServer(map[string]interface{}{
-		"APIHourlyRateLimit": 5,
+		"APIHourlyRateLimit":      5,
+		"APIHourlyWriteRateLimit": 8,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -212,4 +214,279 @@ func TestAPIRateLimit(t *testing.T) {
 	if err != api.ErrTooManyRequests {
 		t.Errorf("expected %q, got %q", api.ErrTooManyRequests, err)
 	}
+
+	// Other requests are limited only by the write rate limit, which
+	// also counts domain requests.
+	domain := "1.localhost"
+	vcs := api.VCSGit
+	repoRoot := "https://github.com/gopherpit/gopherpit.git"
+	for _, path := range []string{"/a", "/b"} {
+		if _, err := c.AddPackage(&api.PackageOptions{
+			Domain:   &domain,
+			Path:     &path,
+			VCS:      &vcs,
+			RepoRoot: &repoRoot,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path := "/c"
+	_, err = c.AddPackage(&api.PackageOptions{
+		Domain:   &domain,
+		Path:     &path,
+		VCS:      &vcs,
+		RepoRoot: &repoRoot,
+	})
+	if err != api.ErrTooManyRequests {
+		t.Errorf("expected %q, got %q", api.ErrTooManyRequests, err)
+	}
+}
+
+func TestAPIRateLimitOverrides(t *testing.T) {
+	s, err := newTestServer(map[string]interface{}{
+		"APIHourlyWriteRateLimit": 5,
+		"APIHourlyReadRateLimit":  3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	endpoint := "localhost:" + strconv.Itoa(s.servers.Addr("HTTP").Port) + "/api/v1"
+
+	_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+	if err != nil {
+		t.Fatalf("parse IPv4 net: %s", err)
+	}
+	_, ipV6Net, err := net.ParseCIDR("::/0")
+	if err != nil {
+		t.Fatalf("parse IPv6 net: %s", err)
+	}
+
+	secrets := map[string]string{}
+	clients := map[string]*api.Client{}
+	for _, username := range []string{"alice", "bob", "chuck"} {
+		username := username
+		email := username + "@localhost.loc"
+		name := strings.ToUpper(username)
+		admin := username == "alice"
+		u, err := s.UserService.CreateUser(&user.Options{
+			Email:    &email,
+			Username: &username,
+			Name:     &name,
+			Admin:    &admin,
+		})
+		if err != nil {
+			t.Fatalf("create user: %s", err)
+		}
+		if username == "chuck" {
+			// User without a key.
+			continue
+		}
+		k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+			AuthorizedNetworks: &[]net.IPNet{
+				*ipV4Net,
+				*ipV6Net,
+			},
+		})
+		if err != nil {
+			t.Fatalf("create key: %s", err)
+		}
+		secrets[username] = k.Secret
+		clients[username] = api.NewClientWithEndpoint(endpoint, k.Secret)
+		clients[username].UserAgent = username + "-gopherpit-test-client"
+	}
+
+	getDomains := func(t *testing.T, username string) *http.Response {
+		req, err := http.NewRequest("GET", "http://"+endpoint+"/domains", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("X-Key", secrets[username])
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	t.Run("read limit headers", func(t *testing.T) {
+		for i, remaining := range []string{"2", "1", "0"} {
+			resp := getDomains(t, "bob")
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("request %d: expected status %d, got %d", i, http.StatusOK, resp.StatusCode)
+			}
+			if v := resp.Header.Get("RateLimit-Limit"); v != "3" {
+				t.Errorf("request %d: expected limit %q, got %q", i, "3", v)
+			}
+			if v := resp.Header.Get("RateLimit-Remaining"); v != remaining {
+				t.Errorf("request %d: expected remaining %q, got %q", i, remaining, v)
+			}
+			if v := resp.Header.Get("RateLimit-Reset"); v == "" {
+				t.Errorf("request %d: expected reset header", i)
+			}
+		}
+		resp := getDomains(t, "bob")
+		if resp.StatusCode != http.StatusTooManyRequests {
+			t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, resp.StatusCode)
+		}
+		if v, _ := strconv.Atoi(resp.Header.Get("Retry-After")); v <= 0 {
+			t.Errorf("expected positive retry after, got %q", resp.Header.Get("Retry-After"))
+		}
+		if _, err := clients["bob"].Domains("", 0); err != api.ErrTooManyRequests {
+			t.Errorf("expected %q, got %q", api.ErrTooManyRequests, err)
+		}
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		unlimited := -1
+		if _, err := clients["bob"].UpdateRateLimits("bob", &api.RateLimitsOptions{
+			HourlyReadLimitOverride: &unlimited,
+		}); err != api.ErrForbidden {
+			t.Errorf("expected %q, got %q", api.ErrForbidden, err)
+		}
+	})
+
+	t.Run("override", func(t *testing.T) {
+		unlimited := -1
+		limit := 10
+		l, err := clients["alice"].UpdateRateLimits("bob", &api.RateLimitsOptions{
+			HourlyLimitOverride:     &limit,
+			HourlyReadLimitOverride: &unlimited,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := api.RateLimits{
+			HourlyLimit:             10,
+			HourlyReadLimit:         0,
+			HourlyLimitOverride:     10,
+			HourlyReadLimitOverride: -1,
+		}
+		if l != want {
+			t.Errorf("expected %v, got %v", want, l)
+		}
+
+		resp := getDomains(t, "bob")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		if v := resp.Header.Get("RateLimit-Limit"); v != "" {
+			t.Errorf("expected no limit header, got %q", v)
+		}
+
+		l, err = clients["alice"].RateLimits("alice")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want = api.RateLimits{
+			HourlyLimit:     5,
+			HourlyReadLimit: 3,
+		}
+		if l != want {
+			t.Errorf("expected %v, got %v", want, l)
+		}
+	})
+
+	t.Run("reset override", func(t *testing.T) {
+		reset := 0
+		l, err := clients["alice"].UpdateRateLimits("bob", &api.RateLimitsOptions{
+			HourlyLimitOverride: &reset,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l.HourlyLimit != 5 || l.HourlyLimitOverride != 0 || l.HourlyReadLimitOverride != -1 {
+			t.Errorf("unexpected rate limits %v", l)
+		}
+	})
+
+	t.Run("own limits", func(t *testing.T) {
+		if _, err := clients["bob"].RateLimits("bob@localhost.loc"); err != nil {
+			t.Errorf("get own rate limits: %s", err)
+		}
+		if _, err := clients["bob"].RateLimits("alice"); err != api.ErrForbidden {
+			t.Errorf("expected %q, got %q", api.ErrForbidden, err)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		limit := 10
+		if _, err := clients["alice"].UpdateRateLimits("missing", &api.RateLimitsOptions{
+			HourlyLimitOverride: &limit,
+		}); err != api.ErrUserDoesNotExist {
+			t.Errorf("expected %q, got %q", api.ErrUserDoesNotExist, err)
+		}
+		invalid := -2
+		if _, err := clients["alice"].UpdateRateLimits("bob", &api.RateLimitsOptions{
+			HourlyLimitOverride: &invalid,
+		}); err != api.ErrBadRequest {
+			t.Errorf("expected %q, got %q", api.ErrBadRequest, err)
+		}
+	})
+
+	// Administrator requests are not limited in the following tests.
+	admin, err := s.UserService.User("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlimited := -1
+	if _, err := s.KeyService.UpdateRateLimits(admin.ID, &key.RateLimitsOptions{
+		HourlyLimit: &unlimited,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("key recreated", func(t *testing.T) {
+		readLimit := 7
+		if _, err := clients["alice"].UpdateRateLimits("bob", &api.RateLimitsOptions{
+			HourlyReadLimitOverride: &readLimit,
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		u, err := s.UserService.User("bob")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.KeyService.DeleteKey(u.ID); err != nil {
+			t.Fatal(err)
+		}
+		k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+			AuthorizedNetworks: &[]net.IPNet{
+				*ipV4Net,
+				*ipV6Net,
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		secrets["bob"] = k.Secret
+		clients["bob"] = api.NewClientWithEndpoint(endpoint, k.Secret)
+
+		if v := getDomains(t, "bob").Header.Get("RateLimit-Limit"); v != "7" {
+			t.Errorf("expected limit %q, got %q", "7", v)
+		}
+		l, err := clients["alice"].RateLimits("bob")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l.HourlyReadLimit != 7 || l.HourlyReadLimitOverride != 7 {
+			t.Errorf("unexpected rate limits %v", l)
+		}
+	})
+
+	t.Run("user without key", func(t *testing.T) {
+		limit := 10
+		l, err := clients["alice"].UpdateRateLimits("chuck", &api.RateLimitsOptions{
+			HourlyLimitOverride: &limit,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l.HourlyLimit != 10 || l.HourlyLimitOverride != 10 {
+			t.Errorf("unexpected rate limits %v", l)
+		}
+	})
 }
@@ -0,0 +1,203 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"time"
+
+	"gopherpit.com/gopherpit/services/certificate"
+	"gopherpit.com/gopherpit/services/notification"
+	"gopherpit.com/gopherpit/services/packages"
+)
+
+// PeriodicCertificateExpiryNotifications checks on CertExpiryCheckPeriod
+// if there are certificates that expire in CertExpiryNotifyPeriod
+// and which renewal failed. Domain owners and administrators are notified
+// about them by email, again only if the certificate expiration time
+// changed or after CertExpiryRepeatPeriod.
+func (s *Server) PeriodicCertificateExpiryNotifications() error {
+	if s.CertExpiryCheckPeriod <= 0 || s.CertExpiryNotifyPeriod <= 0 {
+		return nil
+	}
+	s.Logger.Info("certificate expiry notifications: initialized")
+	go func() {
+		defer s.RecoveryService.Recover()
+
+		ticker := time.NewTicker(s.CertExpiryCheckPeriod)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := s.notifyCertificateExpiry(time.Now()); err != nil {
+				s.Logger.Errorf("certificate expiry notifications: %s", err)
+			}
+		}
+	}()
+	return nil
+}
+
+// notifyCertificateExpiry sends notifications for all certificates that
+// expire in CertExpiryNotifyPeriod after now and which last
+// renewal failed. The time of the notification and the expiration time
+// are saved on the certificate to avoid repeated notifications.
+func (s *Server) notifyCertificateExpiry(now time.Time) error {
+	s.Logger.Debug("certificate expiry notifications: started")
+	defer s.Logger.Debug("certificate expiry notifications: ended")
+
+	since := now.Add(s.CertExpiryNotifyPeriod)
+	var start string
+	for {
+		page, err := s.CertificateService.CertificatesInfoByExpiry(since, start, 100)
+		if err != nil {
+			return fmt.Errorf("get certificates by expiry: %s", err)
+		}
+		if page == nil {
+			return nil
+		}
+		for _, info := range page.Infos {
+			if info.RenewalFailures == 0 || !s.isCertificateExpiryNotificationDue(info, now) {
+				continue
+			}
+			if err := s.notifyCertificateExpiryInfo(info, now); err != nil {
+				s.Logger.Errorf("certificate expiry notifications: %s: %s", info.FQDN, err)
+				continue
+			}
+			if _, err := s.CertificateService.UpdateCertificate(info.FQDN, &certificate.Options{
+				ExpiryNotificationTime: &now,
+				NotifiedExpirationTime: info.ExpirationTime,
+			}); err != nil {
+				s.Logger.Errorf("certificate expiry notifications: %s: update certificate: %s", info.FQDN, err)
+			}
+		}
+		if page.Next == "" {
+			return nil
+		}
+		start = page.Next
+	}
+}
+
+// isCertificateExpiryNotificationDue returns true if no notification was
+// sent for the current certificate expiration time, or if the last one
+// was sent at least CertExpiryRepeatPeriod before now.
+func (s *Server) isCertificateExpiryNotificationDue(info certificate.Info, now time.Time) bool {
+	if info.ExpiryNotificationTime == nil || info.NotifiedExpirationTime == nil {
+		return true
+	}
+	if info.ExpirationTime != nil && !info.ExpirationTime.Equal(*info.NotifiedExpirationTime) {
+		return true
+	}
+	return s.CertExpiryRepeatPeriod > 0 && now.Sub(*info.ExpiryNotificationTime) >= s.CertExpiryRepeatPeriod
+}
+
+// notifyCertificateExpiryInfo sends notifications about the certificate
+// to owners of its domain and to administrators. The domain is found by
+// its FQDN, alias or as the wildcard domain that covers the certificate
+// host. Certificates for the service domain, that are not related to any
+// domain, are reported only to administrators.
+func (s *Server) notifyCertificateExpiryInfo(info certificate.Info, now time.Time) error {
+	domain, err := s.PackagesService.DomainByHost(info.FQDN)
+	switch err {
+	case nil:
+		if domain.Disabled || domain.CertificateIgnore {
+			return nil
+		}
+		if err := s.sendCertificateExpiryEmail(*domain, info, now); err != nil {
+			return err
+		}
+	case packages.ErrDomainNotFound:
+	default:
+		return fmt.Errorf("get domain: %s", err)
+	}
+	return s.sendCertificateExpiryAdminEmail(info, now)
+}
+
+// certificateExpiryEmailData returns data for certificate expiry email
+// templates.
+func (s *Server) certificateExpiryEmailData(info certificate.Info, now time.Time) map[string]interface{} {
+	var expirationTime string
+	var days int
+	if info.ExpirationTime != nil {
+		expirationTime = info.ExpirationTime.UTC().Format(time.RFC1123)
+		days = int(info.ExpirationTime.Sub(now).Hours() / 24)
+	}
+	var renewalAttemptTime string
+	if info.RenewalAttemptTime != nil {
+		renewalAttemptTime = info.RenewalAttemptTime.UTC().Format(time.RFC1123)
+	}
+	return map[string]interface{}{
+		"Brand":              s.Brand,
+		"Host":               "https://" + s.Domain,
+		"FQDN":               info.FQDN,
+		"ExpirationTime":     expirationTime,
+		"Days":               days,
+		"RenewalAttemptTime": renewalAttemptTime,
+		"RenewalError":       info.RenewalError,
+		"RenewalFailures":    info.RenewalFailures,
+	}
+}
+
+// sendCertificateExpiryEmail sends the certificate expiry email to the
+// domain owners that have notifications enabled.
+func (s *Server) sendCertificateExpiryEmail(domain packages.Domain, info certificate.Info, now time.Time) error {
+	ownerIDs, err := s.domainOwnerUserIDs(domain)
+	if err != nil {
+		return err
+	}
+	for _, id := range ownerIDs {
+		owner, err := s.UserService.UserByID(id)
+		if err != nil {
+			return fmt.Errorf("get owner: %s: %s", id, err)
+		}
+		if owner.Email == "" || owner.NotificationsDisabled {
+			continue
+		}
+
+		emailSettingsToken, err := s.tokenFromEmail(owner.Email)
+		if err != nil {
+			return fmt.Errorf("email settings token from email: %s", err)
+		}
+
+		data := s.certificateExpiryEmailData(info, now)
+		data["Domain"] = domain
+		data["EmailSettingsToken"] = string(emailSettingsToken)
+		if err := s.sendCertificateExpiryEmailTemplate([]string{owner.Email}, emailTemplateCertificateExpiryText, data); err != nil {
+			return err
+		}
+		s.Logger.Infof("certificate expiry email for %s sent to %s", info.FQDN, owner.Email)
+	}
+	return nil
+}
+
+// sendCertificateExpiryAdminEmail sends the certificate expiry email to
+// NotifyAddresses.
+func (s *Server) sendCertificateExpiryAdminEmail(info certificate.Info, now time.Time) error {
+	if len(s.NotifyAddresses) == 0 {
+		return nil
+	}
+	if err := s.sendCertificateExpiryEmailTemplate(s.NotifyAddresses, emailTemplateCertificateExpiryAdminText, s.certificateExpiryEmailData(info, now)); err != nil {
+		return err
+	}
+	s.Logger.Infof("certificate expiry email for %s sent to administrators", info.FQDN)
+	return nil
+}
+
+func (s *Server) sendCertificateExpiryEmailTemplate(to []string, tmpl *template.Template, data map[string]interface{}) error {
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, data); err != nil {
+		return fmt.Errorf("email template execute: %s", err)
+	}
+
+	if _, err := s.NotificationService.SendEmail(notification.Email{
+		To:      to,
+		From:    s.DefaultFrom,
+		Subject: fmt.Sprintf("%s - TLS certificate expires in %d days: %s", s.Brand, data["Days"], data["FQDN"]),
+		Body:    body.String(),
+	}); err != nil {
+		return fmt.Errorf("notifier api send email: %s", err)
+	}
+	return nil
+}
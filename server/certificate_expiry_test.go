@@ -0,0 +1,259 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"gopherpit.com/gopherpit/pkg/acme/acmetest"
+	"gopherpit.com/gopherpit/services/certificate"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+func TestCertificateExpiryNotifications(t *testing.T) {
+	acmeServer, err := acmetest.NewServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer acmeServer.Close()
+
+	certificateService := newTestCertificateService(t, acmeServer.DirectoryURL())
+	defer removeTestCertificateService(certificateService)
+	if _, err := certificateService.RegisterACMEUser("", "admin@localhost.loc", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	notificationService := &testNotificationService{}
+	adminEmail := "admin@localhost.loc"
+	s, err := newTestServer(map[string]interface{}{
+		"CertificateService":     certificateService,
+		"NotificationService":    notificationService,
+		"ACMEDirectoryURL":       acmeServer.DirectoryURL(),
+		"CertExpiryNotifyPeriod": 365 * 24 * time.Hour,
+		"CertExpiryRepeatPeriod": 24 * time.Hour,
+		"NotifyAddresses":        []string{adminEmail},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	username := "alice"
+	email := username + "@localhost.loc"
+	u, err := s.UserService.CreateUser(&user.Options{
+		Email:    &email,
+		Username: &username,
+	})
+	if err != nil {
+		t.Fatalf("create user: %s", err)
+	}
+
+	// Subdomains of the example domain do not resolve, so that every
+	// renewal fails on the dns lookup.
+	fqdn := "expiry.example.com"
+	domain, err := s.PackagesService.AddDomain(&packages.DomainOptions{
+		FQDN:        &fqdn,
+		OwnerUserID: &u.ID,
+	}, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := certificateService.ObtainCertificate(fqdn); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("renewal not failed", func(t *testing.T) {
+		if err := s.notifyCertificateExpiry(time.Now()); err != nil {
+			t.Fatal(err)
+		}
+		if emails := notificationService.Emails(); len(emails) != 0 {
+			t.Errorf("expected no emails, got %d", len(emails))
+		}
+	})
+
+	failRenewal := func(t *testing.T, fqdn string) {
+		if err := certificateService.RenewCertificate(fqdn); err != nil {
+			t.Fatal(err)
+		}
+		deadline := time.Now().Add(10 * time.Second)
+		for {
+			c, err := certificateService.Certificate(fqdn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.RenewalFailures > 0 {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("certificate renewal did not fail: %s", fqdn)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	failRenewal(t, fqdn)
+
+	t.Run("renewal failed", func(t *testing.T) {
+		if err := s.notifyCertificateExpiry(time.Now()); err != nil {
+			t.Fatal(err)
+		}
+		emails := notificationService.Emails()
+		if len(emails) != 2 {
+			t.Fatalf("expected 2 emails, got %d", len(emails))
+		}
+		if emails[0].To[0] != email {
+			t.Errorf("expected email to %q, got %q", email, emails[0].To)
+		}
+		if !strings.Contains(emails[0].Body, s.Domain+"/domain/"+domain.ID+"/settings") {
+			t.Errorf("expected domain settings link in email body %q", emails[0].Body)
+		}
+		if emails[1].To[0] != adminEmail {
+			t.Errorf("expected email to %q, got %q", adminEmail, emails[1].To)
+		}
+		for _, e := range emails {
+			if !strings.Contains(e.Subject, fqdn) {
+				t.Errorf("expected domain in email subject %q", e.Subject)
+			}
+			if !strings.Contains(e.Body, "lookup dns host") {
+				t.Errorf("expected renewal error in email body %q", e.Body)
+			}
+		}
+	})
+
+	t.Run("already notified", func(t *testing.T) {
+		c, err := certificateService.Certificate(fqdn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.ExpiryNotificationTime == nil {
+			t.Error("expected expiry notification time")
+		}
+		if c.NotifiedExpirationTime == nil || !c.NotifiedExpirationTime.Equal(*c.ExpirationTime) {
+			t.Errorf("got notified expiration time %v, expected %v", c.NotifiedExpirationTime, c.ExpirationTime)
+		}
+
+		if err := s.notifyCertificateExpiry(time.Now().Add(time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+		if emails := notificationService.Emails(); len(emails) != 2 {
+			t.Errorf("expected 2 emails, got %d", len(emails))
+		}
+	})
+
+	t.Run("not in notification period", func(t *testing.T) {
+		if err := s.notifyCertificateExpiry(time.Now().Add(-365 * 24 * time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+		if emails := notificationService.Emails(); len(emails) != 2 {
+			t.Errorf("expected 2 emails, got %d", len(emails))
+		}
+	})
+
+	t.Run("expiration time changed", func(t *testing.T) {
+		c, err := certificateService.Certificate(fqdn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		previous := c.ExpirationTime.Add(-90 * 24 * time.Hour)
+		if _, err := certificateService.UpdateCertificate(fqdn, &certificate.Options{
+			NotifiedExpirationTime: &previous,
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := s.notifyCertificateExpiry(time.Now().Add(time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+		if emails := notificationService.Emails(); len(emails) != 4 {
+			t.Errorf("expected 4 emails, got %d", len(emails))
+		}
+	})
+
+	t.Run("notifications disabled", func(t *testing.T) {
+		disabled := true
+		if _, err := s.UserService.UpdateUser(u.ID, &user.Options{
+			NotificationsDisabled: &disabled,
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		// Notifications are repeated after CertExpiryRepeatPeriod.
+		if err := s.notifyCertificateExpiry(time.Now().Add(26 * time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+		emails := notificationService.Emails()
+		if len(emails) != 5 {
+			t.Fatalf("expected 5 emails, got %d", len(emails))
+		}
+		if emails[4].To[0] != adminEmail {
+			t.Errorf("expected email to %q, got %q", adminEmail, emails[4].To)
+		}
+	})
+	t.Run("alias and wildcard host", func(t *testing.T) {
+		username := "bob"
+		email := username + "@localhost.loc"
+		u, err := s.UserService.CreateUser(&user.Options{
+			Email:    &email,
+			Username: &username,
+		})
+		if err != nil {
+			t.Fatalf("create user: %s", err)
+		}
+
+		fqdn := "aliased.example.com"
+		alias := "alias.example.com"
+		if _, err := s.PackagesService.AddDomain(&packages.DomainOptions{
+			FQDN:        &fqdn,
+			OwnerUserID: &u.ID,
+			Aliases:     &[]string{alias},
+		}, u.ID); err != nil {
+			t.Fatal(err)
+		}
+		wildcard := "*.wildcard.example.com"
+		if _, err := s.PackagesService.AddDomain(&packages.DomainOptions{
+			FQDN:        &wildcard,
+			OwnerUserID: &u.ID,
+		}, u.ID); err != nil {
+			t.Fatal(err)
+		}
+		host := "host.wildcard.example.com"
+
+		for _, name := range []string{alias, host} {
+			if _, err := certificateService.ObtainCertificate(name); err != nil {
+				t.Fatal(err)
+			}
+			failRenewal(t, name)
+		}
+
+		if err := s.notifyCertificateExpiry(time.Now()); err != nil {
+			t.Fatal(err)
+		}
+		var subjects []string
+		for _, e := range notificationService.Emails() {
+			if e.To[0] == email {
+				subjects = append(subjects, e.Subject)
+			}
+		}
+		if len(subjects) != 2 {
+			t.Fatalf("expected 2 emails to %q, got %d", email, len(subjects))
+		}
+		for _, name := range []string{alias, host} {
+			var found bool
+			for _, subject := range subjects {
+				if strings.Contains(subject, name) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("expected email about %q to %q", name, email)
+			}
+		}
+	})
+}
@@ -19,9 +19,15 @@ var (
 		packages.ActionDeleteDomain:     "deleted domain",
 		packages.ActionDomainAddUser:    "added user to domain",
 		packages.ActionDomainRemoveUser: "removed user from domain",
+		packages.ActionDomainUpdateUser: "changed user role on domain",
 		packages.ActionAddPackage:       "added package",
 		packages.ActionUpdatePackage:    "updated package",
 		packages.ActionDeletePackage:    "deleted package",
+
+		packages.ActionDisableUnverifiedDomain: "disabled domain with unverified ownership",
+		packages.ActionRequestDomainTransfer:   "requested domain transfer",
+		packages.ActionAcceptDomainTransfer:    "accepted domain transfer",
+		packages.ActionCancelDomainTransfer:    "canceled domain transfer",
 	}
 )
 
@@ -48,6 +54,7 @@ type changelogRecord struct {
 	PackageID string
 	Path      string
 	User      *user.User
+	System    bool
 	Action    changelogAction
 	Changes   []changelogRecordChange
 }
@@ -80,7 +87,9 @@ func (c changelogs) Less(i, j int) bool {
 }
 
 func (s *Server) updateChangelogRecords(u user.User, record packages.ChangelogRecord, records *[]changelogRecord, users *map[string]*user.User) (err error) {
-	if _, ok := (*users)[record.UserID]; !ok {
+	// Records without the user are made by the system.
+	system := record.UserID == ""
+	if _, ok := (*users)[record.UserID]; !ok && !system {
 		ru, err := s.UserService.UserByID(record.UserID)
 		switch err {
 		case nil:
@@ -98,13 +107,14 @@ func (s *Server) updateChangelogRecords(u user.User, record packages.ChangelogRe
 		FQDN:      record.FQDN,
 		PackageID: record.PackageID,
 		Path:      record.Path,
+		System:    system,
 		Action:    changelogAction(record.Action),
 		Changes:   []changelogRecordChange{},
 	}
 	if ru, ok := (*users)[record.UserID]; ok {
 		r.User = ru
 	}
-	if r.User == nil {
+	if r.User == nil && !system {
 		r.User = &user.User{
 			ID: record.UserID,
 		}
@@ -112,7 +122,8 @@ func (s *Server) updateChangelogRecords(u user.User, record packages.ChangelogRe
 
 	var c changelogRecordChange
 	switch record.Action {
-	case packages.ActionAddDomain, packages.ActionUpdateDomain:
+	case packages.ActionAddDomain, packages.ActionUpdateDomain, packages.ActionDisableUnverifiedDomain,
+		packages.ActionRequestDomainTransfer, packages.ActionAcceptDomainTransfer, packages.ActionCancelDomainTransfer:
 	Loop1:
 		for _, change := range record.Changes {
 			c = changelogRecordChange{}
@@ -168,6 +179,14 @@ func (s *Server) updateChangelogRecords(u user.User, record packages.ChangelogRe
 						}
 					}
 				}
+			case "organization-id":
+				c.Field = "Organization"
+				if change.To != nil {
+					c.To, c.ToHref = s.changelogOrganization(*change.To)
+				}
+				if change.From != nil {
+					c.From, c.FromHref = s.changelogOrganization(*change.From)
+				}
 			case "fqdn":
 				c.Field = "FQDN"
 				c.To = change.To
@@ -179,6 +198,38 @@ func (s *Server) updateChangelogRecords(u user.User, record packages.ChangelogRe
 				c.Field = "Ignore TLS Certificate"
 				c.To = change.To
 				c.From = change.From
+			case "aliases":
+				c.Field = "Aliases"
+				c.To = change.To
+				c.From = change.From
+			case "alias-redirect":
+				c.Field = "Redirect Aliases"
+				c.To = change.To
+				c.From = change.From
+			case "default-vcs":
+				c.Field = "Default VCS"
+				c.To = change.To
+				c.From = change.From
+			case "default-repo-root":
+				c.Field = "Default Repository"
+				c.To = change.To
+				c.From = change.From
+			case "default-ref-type":
+				c.Field = "Default Reference type"
+				c.To = change.To
+				c.From = change.From
+			case "default-ref-name":
+				c.Field = "Default Reference name"
+				c.To = change.To
+				c.From = change.From
+			case "default-go-source":
+				c.Field = "Default Go Source"
+				c.To = change.To
+				c.From = change.From
+			case "default-redirect-url":
+				c.Field = "Default Redirect URL"
+				c.To = change.To
+				c.From = change.From
 			case "disabled":
 				c.Field = "Disabled"
 				c.To = change.To
@@ -189,7 +240,7 @@ func (s *Server) updateChangelogRecords(u user.User, record packages.ChangelogRe
 			r.Changes = append(r.Changes, c)
 		}
 	case packages.ActionDeleteDomain:
-	case packages.ActionDomainAddUser, packages.ActionDomainRemoveUser:
+	case packages.ActionDomainAddUser, packages.ActionDomainRemoveUser, packages.ActionDomainUpdateUser:
 	Loop2:
 		for _, change := range record.Changes {
 			c = changelogRecordChange{}
@@ -240,6 +291,10 @@ func (s *Server) updateChangelogRecords(u user.User, record packages.ChangelogRe
 						c.FromInfo = stringToPtr("ID: " + *change.From)
 					}
 				}
+			case "role":
+				c.Field = "Role"
+				c.To = change.To
+				c.From = change.From
 			default:
 				continue Loop2
 			}
@@ -307,3 +362,13 @@ func (s *Server) updateChangelogRecords(u user.User, record packages.ChangelogRe
 func stringToPtr(s string) *string {
 	return &s
 }
+
+// changelogOrganization returns the name of the organization and the link
+// to its page, or only the ID if the organization does not exist anymore.
+func (s *Server) changelogOrganization(id string) (name, href *string) {
+	o, err := s.PackagesService.Organization(id)
+	if err != nil {
+		return stringToPtr(id), nil
+	}
+	return stringToPtr(o.Name), stringToPtr("/organization/" + o.Name)
+}
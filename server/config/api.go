@@ -9,8 +9,14 @@ package config
 type APIOptions struct {
 	TrustedProxyCIDRs []string `json:"trusted-proxy-cidrs" yaml:"trusted-proxy-cidrs" envconfig:"TRUSTED_PROXY_CIDRS"`
 	ProxyRealIPHeader string   `json:"proxy-real-ip-header" yaml:"proxy-real-ip-header" envconfig:"PROXY_REAL_IP_HEADER"`
-	HourlyRateLimit   int      `json:"hourly-rate-limit" yaml:"hourly-rate-limit" envconfig:"HOURLY_RATE_LIMIT"`
-	Disabled          bool     `json:"disabled" yaml:"disable" envconfig:"DISABLE"`
+	// HourlyRateLimit limits requests that add or update domains and
+	// batch requests.
+	HourlyRateLimit int `json:"hourly-rate-limit" yaml:"hourly-rate-limit" envconfig:"HOURLY_RATE_LIMIT"`
+	// HourlyReadRateLimit limits GET requests, while HourlyWriteRateLimit
+	// limits all other requests.
+	HourlyReadRateLimit  int  `json:"hourly-read-rate-limit" yaml:"hourly-read-rate-limit" envconfig:"HOURLY_READ_RATE_LIMIT"`
+	HourlyWriteRateLimit int  `json:"hourly-write-rate-limit" yaml:"hourly-write-rate-limit" envconfig:"HOURLY_WRITE_RATE_LIMIT"`
+	Disabled             bool `json:"disabled" yaml:"disable" envconfig:"DISABLE"`
 }
 
 // NewAPIOptions initializes APIOptions with default values.
@@ -20,9 +26,11 @@ func NewAPIOptions() *APIOptions {
 		// "127.0.0.0/8",
 		// "::1/128",
 		},
-		ProxyRealIPHeader: "X-Real-Ip",
-		HourlyRateLimit:   0,
-		Disabled:          false,
+		ProxyRealIPHeader:    "X-Real-Ip",
+		HourlyRateLimit:      0,
+		HourlyReadRateLimit:  0,
+		HourlyWriteRateLimit: 0,
+		Disabled:             false,
 	}
 }
 
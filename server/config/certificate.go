@@ -6,28 +6,132 @@
 package config
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"resenje.org/marshal"
+
+	"gopherpit.com/gopherpit/pkg/acme/dnsprovider"
+	"gopherpit.com/gopherpit/services/certificate"
 )
 
 // CertificateOptions defines parameters related to service's core functionality.
 type CertificateOptions struct {
 	DirectoryURL        string           `json:"directory-url" yaml:"directory-url" envconfig:"DIRECTORY_URL"`
 	DirectoryURLStaging string           `json:"directory-url-staging" yaml:"directory-url-staging" envconfig:"DIRECTORY_URL_STAGING"`
+	EABKeyID            string           `json:"eab-key-id" yaml:"eab-key-id" envconfig:"EAB_KEY_ID"`
+	EABHMACKey          string           `json:"eab-hmac-key" yaml:"eab-hmac-key" envconfig:"EAB_HMAC_KEY"`
 	RenewPeriod         marshal.Duration `json:"renew-period" yaml:"renew-period" envconfig:"RENEW_PERIOD"`
 	RenewCheckPeriod    marshal.Duration `json:"renew-check-period" yaml:"renew-check-period" envconfig:"RENEW_CHECK_PERIOD"`
+	RenewWorkers        int              `json:"renew-workers" yaml:"renew-workers" envconfig:"RENEW_WORKERS"`
+	// RenewBackoff is a duration to wait before retrying a failed
+	// renewal. It doubles with every consecutive failure up to
+	// RenewMaxBackoff.
+	RenewBackoff    marshal.Duration `json:"renew-backoff" yaml:"renew-backoff" envconfig:"RENEW_BACKOFF"`
+	RenewMaxBackoff marshal.Duration `json:"renew-max-backoff" yaml:"renew-max-backoff" envconfig:"RENEW_MAX_BACKOFF"`
+	// ExpiryNotificationPeriod is the duration before the certificate
+	// expiration time in which domain owners and notify addresses are
+	// notified by email if the certificate renewal failed. Certificates
+	// are checked on every ExpiryCheckPeriod, and a notification about
+	// the same certificate is repeated only after ExpiryRepeatPeriod. If
+	// ExpiryRepeatPeriod is zero, only one notification is sent for every
+	// certificate.
+	ExpiryNotificationPeriod marshal.Duration `json:"expiry-notification-period" yaml:"expiry-notification-period" envconfig:"EXPIRY_NOTIFICATION_PERIOD"`
+	ExpiryCheckPeriod        marshal.Duration `json:"expiry-check-period" yaml:"expiry-check-period" envconfig:"EXPIRY_CHECK_PERIOD"`
+	ExpiryRepeatPeriod       marshal.Duration `json:"expiry-repeat-period" yaml:"expiry-repeat-period" envconfig:"EXPIRY_REPEAT_PERIOD"`
+	// ChallengeType is "http-01" or "tls-alpn-01". The latter allows
+	// obtaining certificates when only the TLS listener on port 443 is
+	// reachable by the ACME provider.
+	ChallengeType string `json:"challenge-type" yaml:"challenge-type" envconfig:"CHALLENGE_TYPE"`
+	// KeyType is the default type of certificate private keys: "p256",
+	// "p384", "rsa2048", "rsa4096" or "dual" for P-256 certificate with
+	// alternative RSA-2048 certificate. It can be changed per domain.
+	KeyType string `json:"key-type" yaml:"key-type" envconfig:"KEY_TYPE"`
+	// DNSProviders are named providers for ACME dns-01 challenges
+	// that can be selected for a domain.
+	DNSProviders map[string]DNSProviderOptions `json:"dns-providers" yaml:"dns-providers" ignored:"true"`
+}
+
+// DNSProviderOptions defines a DNS provider of type "rfc2136" or "exec".
+type DNSProviderOptions struct {
+	Type             string           `json:"type" yaml:"type"`
+	Nameserver       string           `json:"nameserver,omitempty" yaml:"nameserver,omitempty"`
+	Zone             string           `json:"zone,omitempty" yaml:"zone,omitempty"`
+	TSIGKey          string           `json:"tsig-key,omitempty" yaml:"tsig-key,omitempty"`
+	TSIGAlgorithm    string           `json:"tsig-algorithm,omitempty" yaml:"tsig-algorithm,omitempty"`
+	TSIGSecret       string           `json:"tsig-secret,omitempty" yaml:"tsig-secret,omitempty"`
+	TTL              int              `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	Command          string           `json:"command,omitempty" yaml:"command,omitempty"`
+	Args             []string         `json:"args,omitempty" yaml:"args,omitempty"`
+	PropagationDelay marshal.Duration `json:"propagation-delay,omitempty" yaml:"propagation-delay,omitempty"`
+	Timeout          marshal.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+// NewDNSProvider creates a new DNS provider.
+func (o DNSProviderOptions) NewDNSProvider() (dnsprovider.Provider, error) {
+	return dnsprovider.New(dnsprovider.Options{
+		Type:             o.Type,
+		Nameserver:       o.Nameserver,
+		Zone:             o.Zone,
+		TSIGKey:          o.TSIGKey,
+		TSIGAlgorithm:    o.TSIGAlgorithm,
+		TSIGSecret:       o.TSIGSecret,
+		TTL:              o.TTL,
+		Command:          o.Command,
+		Args:             o.Args,
+		PropagationDelay: o.PropagationDelay.Duration(),
+		Timeout:          o.Timeout.Duration(),
+	})
+}
+
+// NewDNSProviders creates all configured DNS providers.
+func (o *CertificateOptions) NewDNSProviders() (providers map[string]dnsprovider.Provider, err error) {
+	providers = map[string]dnsprovider.Provider{}
+	for name, p := range o.DNSProviders {
+		providers[name], err = p.NewDNSProvider()
+		if err != nil {
+			return nil, fmt.Errorf("dns provider %s: %s", name, err)
+		}
+	}
+	return
 }
 
 // NewCertificateOptions initializes CertificateOptions with default values.
 func NewCertificateOptions() *CertificateOptions {
 	return &CertificateOptions{
-		DirectoryURL:        "https://acme-v01.api.letsencrypt.org/directory",
-		DirectoryURLStaging: "https://acme-staging.api.letsencrypt.org/directory",
-		RenewPeriod:         marshal.Duration(21 * 24 * time.Hour),
-		RenewCheckPeriod:    marshal.Duration(23 * time.Hour),
+		DirectoryURL:             "https://acme-v02.api.letsencrypt.org/directory",
+		DirectoryURLStaging:      "https://acme-staging-v02.api.letsencrypt.org/directory",
+		RenewPeriod:              marshal.Duration(21 * 24 * time.Hour),
+		RenewCheckPeriod:         marshal.Duration(23 * time.Hour),
+		RenewWorkers:             2,
+		RenewBackoff:             marshal.Duration(time.Hour),
+		RenewMaxBackoff:          marshal.Duration(24 * time.Hour),
+		ExpiryNotificationPeriod: marshal.Duration(14 * 24 * time.Hour),
+		ExpiryCheckPeriod:        marshal.Duration(24 * time.Hour),
+		ExpiryRepeatPeriod:       marshal.Duration(7 * 24 * time.Hour),
+		ChallengeType:            certificate.ChallengeTypeHTTP01,
+		KeyType:                  certificate.KeyTypeRSA2048,
+		DNSProviders:             map[string]DNSProviderOptions{},
 	}
 }
 
 // VerifyAndPrepare implements application.Options interface.
-func (o *CertificateOptions) VerifyAndPrepare() error { return nil }
+func (o *CertificateOptions) VerifyAndPrepare() (err error) {
+	switch o.ChallengeType {
+	case certificate.ChallengeTypeHTTP01, certificate.ChallengeTypeTLSALPN01:
+	default:
+		return fmt.Errorf("challenge type must be %q or %q", certificate.ChallengeTypeHTTP01, certificate.ChallengeTypeTLSALPN01)
+	}
+	if o.RenewWorkers < 1 {
+		return fmt.Errorf("renew workers must be greater than 0")
+	}
+	if o.RenewBackoff <= 0 || o.RenewMaxBackoff < o.RenewBackoff {
+		return fmt.Errorf("renew backoff must be greater than 0 and not greater than renew max backoff")
+	}
+	if !certificate.IsValidKeyType(o.KeyType) {
+		return fmt.Errorf("key type must be one of %s", strings.Join(certificate.KeyTypes, ", "))
+	}
+	_, err = o.NewDNSProviders()
+	return
+}
@@ -11,8 +11,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"resenje.org/marshal"
+
+	"gopherpit.com/gopherpit/pkg/resolver"
 )
 
 // GopherPitOptions defines parameters related to service's core functionality.
@@ -40,8 +43,19 @@ type GopherPitOptions struct {
 	ContactRecipientEmail  string            `json:"contact-recipient-email" yaml:"contact-recipient-email" envconfig:"CONTACT_RECIPIENT_EMAIL"`
 	SkipDomainVerification bool              `json:"skip-domain-verification" yaml:"skip-domain-verification" envconfig:"SKIP_DOMAIN_VERIFICATION"`
 	VerificationSubdomain  string            `json:"verification-subdomain" yaml:"verification-subdomain" envconfig:"VERIFICATION_SUBDOMAIN"`
+	VerificationResolvers  []string          `json:"verification-resolvers" yaml:"verification-resolvers" envconfig:"VERIFICATION_RESOLVERS"`
+	VerificationTimeout    marshal.Duration  `json:"verification-timeout" yaml:"verification-timeout" envconfig:"VERIFICATION_TIMEOUT"`
+	VerificationNSLookup   bool              `json:"verification-ns-lookup" yaml:"verification-ns-lookup" envconfig:"VERIFICATION_NS_LOOKUP"`
+	ReverifyPeriod         marshal.Duration  `json:"reverify-period" yaml:"reverify-period" envconfig:"REVERIFY_PERIOD"`
+	ReverifyGracePeriod    marshal.Duration  `json:"reverify-grace-period" yaml:"reverify-grace-period" envconfig:"REVERIFY_GRACE_PERIOD"`
+	DomainTransferPeriod   marshal.Duration  `json:"domain-transfer-period" yaml:"domain-transfer-period" envconfig:"DOMAIN_TRANSFER_PERIOD"`
 	TrustedDomains         []string          `json:"trusted-domains" yaml:"trusted-domains" envconfig:"TRUSTED_DOMAINS"`
 	ForbiddenDomains       []string          `json:"forbidden-domains" yaml:"forbidden-domains" envconfig:"FORBIDDEN_DOMAINS"`
+	// DomainQuota and PackageQuota limit the number of domains and
+	// packages that a user or an organization can own, unless they are
+	// overridden by administrators. Zero value means no limit.
+	DomainQuota  int `json:"domain-quota" yaml:"domain-quota" envconfig:"DOMAIN_QUOTA"`
+	PackageQuota int `json:"package-quota" yaml:"package-quota" envconfig:"PACKAGE_QUOTA"`
 }
 
 // NewGopherPitOptions initializes GopherPitOptions with default values.
@@ -74,8 +88,16 @@ func NewGopherPitOptions() *GopherPitOptions {
 		ContactRecipientEmail:  Name + "@localhost",
 		SkipDomainVerification: false,
 		VerificationSubdomain:  "_gopherpit",
+		VerificationResolvers:  []string{},
+		VerificationTimeout:    marshal.Duration(5 * time.Second),
+		VerificationNSLookup:   true,
+		ReverifyPeriod:         marshal.Duration(24 * time.Hour),
+		ReverifyGracePeriod:    marshal.Duration(7 * 24 * time.Hour),
+		DomainTransferPeriod:   marshal.Duration(7 * 24 * time.Hour),
 		TrustedDomains:         []string{},
 		ForbiddenDomains:       []string{},
+		DomainQuota:            0,
+		PackageQuota:           0,
 	}
 }
 
@@ -117,6 +139,10 @@ func (o *GopherPitOptions) VerifyAndPrepare() (err error) {
 			return
 		}
 	}
+	if _, err = resolver.New(o.VerificationResolvers, o.VerificationTimeout.Duration()); err != nil {
+		err = fmt.Errorf("verification resolvers: %s", err)
+		return
+	}
 	ln, err := net.Listen("tcp", o.Listen)
 	if err != nil {
 		return
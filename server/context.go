@@ -10,4 +10,5 @@ type contextKey int
 const (
 	contextKeySession contextKey = iota
 	contextKeyUser
+	contextKeyOrganization
 )
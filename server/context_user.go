@@ -9,6 +9,7 @@ import (
 	"context"
 	"net/http"
 
+	"gopherpit.com/gopherpit/services/packages"
 	"gopherpit.com/gopherpit/services/user"
 )
 
@@ -40,6 +41,25 @@ func (s *Server) getRequestUser(r *http.Request) (u *user.User, rr *http.Request
 	return
 }
 
+// getRequestOrganization returns the organization if the request is
+// authenticated with the organization API key.
+func getRequestOrganization(r *http.Request) *packages.Organization {
+	o, _ := r.Context().Value(contextKeyOrganization).(*packages.Organization)
+	return o
+}
+
+// organizationKeyUser returns a user that represents the organization in
+// requests that are authenticated with the organization API key. Its ID is
+// the organization ID, which gives the maintainer role on all domains that
+// the organization owns.
+func organizationKeyUser(o *packages.Organization) *user.User {
+	return &user.User{
+		ID:       o.ID,
+		Username: o.Name,
+		Name:     o.Name,
+	}
+}
+
 // logout deletes session cookie and session data from session service.
 func (s *Server) logout(w http.ResponseWriter, r *http.Request) (*http.Request, error) {
 	ses, _, err := s.getSession(r)
@@ -1,6 +1,7 @@
 // Code generated by go-bindata.
 // sources:
 // templates/about.html
+// templates/api-key.html
 // templates/app.html
 // templates/base.html
 // templates/changelog-record.html
@@ -15,6 +16,7 @@
 // templates/domain-packages.html
 // templates/domain-settings.html
 // templates/domain-team.html
+// templates/domain-transfer.html
 // templates/domain-user-grant.html
 // templates/domain-user-revoke.html
 // templates/email-unvalidated.html
@@ -35,6 +37,8 @@
 // templates/landing-page.html
 // templates/license.html
 // templates/login.html
+// templates/organization-add.html
+// templates/organization.html
 // templates/package-resolution.html
 // templates/password-reset-token.html
 // templates/password-reset.html
@@ -126,6 +130,26 @@ func aboutHtml() (*asset, error) {
 	return a, nil
 }
 
+var _apiKeyHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x37\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x61\x70\x69\x2d\x6b\x65\x79\x2d\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x76\x61\x72\x20\x63\x69\x64\x72\x76\x34\x20\x3d\x20\x2f\x5e\x28\x28\x5b\x30\x2d\x39\x5d\x7c\x5b\x31\x2d\x39\x5d\x5b\x30\x2d\x39\x5d\x7c\x31\x5b\x30\x2d\x39\x5d\x7b\x32\x7d\x7c\x32\x5b\x30\x2d\x34\x5d\x5b\x30\x2d\x39\x5d\x7c\x32\x35\x5b\x30\x2d\x35\x5d\x29\x5c\x2e\x29\x7b\x33\x7d\x28\x5b\x30\x2d\x39\x5d\x7c\x5b\x31\x2d\x39\x5d\x5b\x30\x2d\x39\x5d\x7c\x31\x5b\x30\x2d\x39\x5d\x7b\x32\x7d\x7c\x32\x5b\x30\x2d\x34\x5d\x5b\x30\x2d\x39\x5d\x7c\x32\x35\x5b\x30\x2d\x35\x5d\x29\x28\x5c\x2f\x28\x5b\x30\x2d\x39\x5d\x7c\x5b\x31\x2d\x32\x5d\x5b\x30\x2d\x39\x5d\x7c\x33\x5b\x30\x2d\x32\x5d\x29\x29\x24\x2f\x3b\x0a\x20\x20\x76\x61\x72\x20\x63\x69\x64\x72\x76\x36\x20\x3d\x20\x2f\x5e\x73\x2a\x28\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x37\x7d\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x7c\x3a\x29\x29\x7c\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x36\x7d\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x7c\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x7c\x3a\x29\x29\x7c\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x35\x7d\x28\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x31\x2c\x32\x7d\x29\x7c\x3a\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x7c\x3a\x29\x29\x7c\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x34\x7d\x28\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x31\x2c\x33\x7d\x29\x7c\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x3f\x3a\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x29\x7c\x3a\x29\x29\x7c\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x33\x7d\x28\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x31\x2c\x34\x7d\x29\x7c\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x30\x2c\x32\x7d\x3a\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x29\x7c\x3a\x29\x29\x7c\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x32\x7d\x28\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x31\x2c\x35\x7d\x29\x7c\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x30\x2c\x33\x7d\x3a\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x29\x7c\x3a\x29\x29\x7c\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x31\x7d\x28\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x31\x2c\x36\x7d\x29\x7c\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x30\x2c\x34\x7d\x3a\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x29\x7c\x3a\x29\x29\x7c\x28\x3a\x28\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x31\x2c\x37\x7d\x29\x7c\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x30\x2c\x35\x7d\x3a\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x29\x7c\x3a\x29\x29\x29\x28\x25\x2e\x2b\x29\x3f\x73\x2a\x28\x5c\x2f\x28\x5b\x30\x2d\x39\x5d\x7c\x5b\x31\x2d\x39\x5d\x5b\x30\x2d\x39\x5d\x7c\x31\x5b\x30\x2d\x31\x5d\x5b\x30\x2d\x39\x5d\x7c\x31\x32\x5b\x30\x2d\x38\x5d\x29\x29\x3f\x24\x2f\x3b\x0a\x20\x20\x76\x61\x72\x20\x61\x6c\x6c\x49\x50\x76\x34\x20\x3d\x20\x27\x30\x2e\x30\x2e\x30\x2e\x30\x2f\x30\x27\x3b\x0a\x20\x20\x76\x61\x72\x20\x61\x6c\x6c\x49\x50\x76\x36\x20\x3d\x20\x27\x3a\x3a\x2f\x30\x27\x3b\x0a\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x61\x70\x69\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x65\x63\x72\x65\x74\x3a\x20\x22\x5b\x5b\x20\x2e\x4b\x65\x79\x2e\x53\x65\x63\x72\x65\x74\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x6b\x65\x79\x46\x6f\x72\x6d\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x46\x6f\x72\x6d\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x65\x77\x3a\x20\x22\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x2d\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x2c\x20\x24\x6e\x65\x74\x77\x6f\x72\x6b\x20\x3a\x3d\x20\x2e\x4b\x65\x79\x2e\x41\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x4e\x65\x74\x77\x6f\x72\x6b\x73\x20\x2d\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6e\x65\x20\x24\x69\x20\x30\x20\x5d\x5d\x2c\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x5b\x5b\x20\x24\x6e\x65\x74\x77\x6f\x72\x6b\x2e\x53\x74\x72\x69\x6e\x67\x20\x5d\x5d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x2d\x20\x65\x6e\x64\x20\x2d\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x65\x6e\x61\x62\x6c\x65\x41\x70\x69\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2e\x6b\x65\x79\x46\x6f\x72\x6d\x2c\x20\x27\x5b\x5b\x20\x2e\x55\x52\x4c\x20\x5d\x5d\x2f\x6b\x65\x79\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x20\x21\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x73\x65\x63\x72\x65\x74\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x2e\x73\x65\x63\x72\x65\x74\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x20\x7c\x7c\x20\x5b\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x64\x69\x73\x61\x62\x6c\x65\x41\x70\x69\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x44\x65\x6c\x65\x74\x65\x28\x61\x70\x70\x2e\x6b\x65\x79\x46\x6f\x72\x6d\x2c\x20\x27\x5b\x5b\x20\x2e\x55\x52\x4c\x20\x5d\x5d\x2f\x6b\x65\x79\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x73\x65\x63\x72\x65\x74\x20\x3d\x20\x22\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x20\x3d\x20\x5b\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x53\x65\x63\x72\x65\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2e\x72\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x46\x6f\x72\x6d\x2c\x20\x27\x5b\x5b\x20\x2e\x55\x52\x4c\x20\x5d\x5d\x2f\x73\x65\x63\x72\x65\x74\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x73\x65\x63\x72\x65\x74\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x2e\x73\x65\x63\x72\x65\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x73\x61\x76\x65\x4e\x65\x74\x77\x6f\x72\x6b\x73\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2c\x20\x27\x5b\x5b\x20\x2e\x55\x52\x4c\x20\x5d\x5d\x2f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x20\x7c\x7c\x20\x5b\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x61\x64\x64\x4e\x65\x74\x77\x6f\x72\x6b\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x76\x61\x6c\x75\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x76\x61\x6c\x75\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x6c\x75\x65\x20\x3d\x20\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x20\x26\x26\x20\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x2e\x74\x72\x69\x6d\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x76\x61\x6c\x75\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x4f\x72\x49\x6e\x76\x61\x6c\x69\x64\x28\x76\x61\x6c\x75\x65\x29\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x2e\x70\x75\x73\x68\x28\x76\x61\x6c\x75\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x20\x3d\x20\x27\x27\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x73\x61\x76\x65\x4e\x65\x74\x77\x6f\x72\x6b\x73\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x6d\x6f\x76\x65\x4e\x65\x74\x77\x6f\x72\x6b\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x2e\x73\x70\x6c\x69\x63\x65\x28\x61\x70\x70\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x2c\x20\x31\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x73\x61\x76\x65\x4e\x65\x74\x77\x6f\x72\x6b\x73\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x61\x64\x64\x49\x50\x76\x34\x4e\x65\x74\x77\x6f\x72\x6b\x73\x3a\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x61\x64\x64\x4e\x65\x74\x77\x6f\x72\x6b\x28\x61\x6c\x6c\x49\x50\x76\x34\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x61\x64\x64\x49\x50\x76\x36\x4e\x65\x74\x77\x6f\x72\x6b\x73\x3a\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x61\x64\x64\x4e\x65\x74\x77\x6f\x72\x6b\x28\x61\x6c\x6c\x49\x50\x76\x36\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x6d\x61\x74\x43\x49\x44\x52\x3a\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x69\x64\x72\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x63\x69\x64\x72\x20\x3d\x3d\x20\x61\x6c\x6c\x49\x50\x76\x34\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x27\x41\x6c\x6c\x20\x49\x50\x76\x34\x20\x61\x64\x64\x72\x65\x73\x73\x65\x73\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x63\x69\x64\x72\x20\x3d\x3d\x20\x61\x6c\x6c\x49\x50\x76\x36\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x27\x41\x6c\x6c\x20\x49\x50\x76\x36\x20\x61\x64\x64\x72\x65\x73\x73\x65\x73\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x63\x69\x64\x72\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x3a\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x5f\x2e\x69\x6e\x63\x6c\x75\x64\x65\x73\x28\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x2c\x20\x6e\x65\x74\x77\x6f\x72\x6b\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x4f\x72\x49\x6e\x76\x61\x6c\x69\x64\x3a\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x74\x72\x75\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x63\x69\x64\x72\x76\x34\x2e\x74\x65\x73\x74\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x63\x69\x64\x72\x76\x36\x2e\x74\x65\x73\x74\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x74\x72\x75\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x61\x70\x69\x2d\x6b\x65\x79\x22\x20\x5d\x5d\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x6b\x65\x79\x46\x6f\x72\x6d\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x72\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x46\x6f\x72\x6d\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x76\x2d\x69\x66\x3d\x22\x73\x65\x63\x72\x65\x74\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x64\x61\x72\x6b\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x59\x6f\x75\x72\x20\x41\x50\x49\x20\x6b\x65\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x74\x72\x6f\x6e\x67\x20\x76\x2d\x74\x65\x78\x74\x3d\x22\x73\x65\x63\x72\x65\x74\x22\x3e\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x20\x70\x75\x6c\x6c\x2d\x72\x69\x67\x68\x74\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x52\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x74\x6f\x6b\x65\x6e\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x72\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x53\x65\x63\x72\x65\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x72\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x72\x65\x66\x72\x65\x73\x68\x22\x3e\x3c\x2f\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x64\x69\x73\x61\x62\x6c\x65\x41\x70\x69\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x6b\x65\x79\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x44\x69\x73\x61\x62\x6c\x65\x20\x41\x50\x49\x20\x61\x63\x63\x65\x73\x73\x20\x66\x6f\x72\x20\x5b\x5b\x20\x2e\x53\x75\x62\x6a\x65\x63\x74\x20\x5d\x5d\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x76\x2d\x65\x6c\x73\x65\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x65\x6e\x61\x62\x6c\x65\x41\x70\x69\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x6b\x65\x79\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x45\x6e\x61\x62\x6c\x65\x20\x41\x50\x49\x20\x61\x63\x63\x65\x73\x73\x20\x66\x6f\x72\x20\x5b\x5b\x20\x2e\x53\x75\x62\x6a\x65\x63\x74\x20\x5d\x5d\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x76\x2d\x69\x66\x3d\x22\x73\x65\x63\x72\x65\x74\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x41\x63\x63\x65\x73\x73\x20\x43\x6f\x6e\x74\x72\x6f\x6c\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x3c\x70\x3e\x54\x68\x65\x20\x66\x6f\x6c\x6c\x6f\x77\x69\x6e\x67\x20\x73\x75\x62\x6e\x65\x74\x73\x20\x61\x72\x65\x20\x61\x6c\x6c\x6f\x77\x65\x64\x20\x74\x6f\x20\x75\x73\x65\x20\x74\x68\x69\x73\x20\x41\x50\x49\x20\x6b\x65\x79\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x77\x61\x72\x6e\x69\x6e\x67\x22\x20\x76\x2d\x69\x66\x3d\x22\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3d\x3d\x20\x30\x20\x26\x26\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x65\x72\x72\x6f\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3d\x3d\x20\x30\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x50\x6c\x65\x61\x73\x65\x2c\x20\x61\x64\x64\x20\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x20\x73\x75\x62\x6e\x65\x74\x73\x20\x62\x65\x6c\x6c\x6f\x77\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x20\x76\x2d\x66\x6f\x72\x3d\x22\x6e\x20\x69\x6e\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x6d\x69\x64\x64\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x63\x6f\x64\x65\x20\x76\x2d\x74\x65\x78\x74\x3d\x22\x66\x6f\x72\x6d\x61\x74\x43\x49\x44\x52\x28\x6e\x29\x22\x3e\x3c\x2f\x63\x6f\x64\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x5b\x27\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x5f\x27\x2b\x6e\x5d\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x72\x65\x6d\x6f\x76\x65\x4e\x65\x74\x77\x6f\x72\x6b\x28\x6e\x29\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x52\x65\x6d\x6f\x76\x65\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x49\x50\x2f\x4d\x61\x73\x6b\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x22\x20\x76\x2d\x6f\x6e\x3a\x6b\x65\x79\x75\x70\x2e\x65\x6e\x74\x65\x72\x3d\x22\x61\x64\x64\x4e\x65\x74\x77\x6f\x72\x6b\x28\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x29\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x61\x64\x64\x4e\x65\x74\x77\x6f\x72\x6b\x28\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x29\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x21\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x20\x7c\x7c\x20\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x4f\x72\x49\x6e\x76\x61\x6c\x69\x64\x28\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x29\x20\x7c\x7c\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x41\x64\x64\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6f\x6c\x73\x70\x61\x6e\x3d\x22\x32\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x61\x64\x64\x49\x50\x76\x34\x4e\x65\x74\x77\x6f\x72\x6b\x73\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x20\x76\x2d\x69\x66\x3d\x22\x21\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x28\x61\x6c\x6c\x49\x50\x76\x34\x29\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x41\x6c\x6c\x6f\x77\x20\x41\x6c\x6c\x20\x49\x50\x76\x34\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x61\x64\x64\x49\x50\x76\x36\x4e\x65\x74\x77\x6f\x72\x6b\x73\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x20\x76\x2d\x69\x66\x3d\x22\x21\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x28\x61\x6c\x6c\x49\x50\x76\x36\x29\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x41\x6c\x6c\x6f\x77\x20\x41\x6c\x6c\x20\x49\x50\x76\x36\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a"
+
+func apiKeyHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_apiKeyHtml,
+		"api-key.html",
+	)
+}
+
+func apiKeyHtml() (*asset, error) {
+	bytes, err := apiKeyHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "api-key.html", size: 7789, mode: os.FileMode(420), modTime: time.Unix(1792333402, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _appHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x62\x61\x73\x65\x2d\x73\x74\x79\x6c\x65\x22\x20\x5d\x5d\x0a\x3c\x6c\x69\x6e\x6b\x20\x72\x65\x6c\x3d\x22\x73\x74\x79\x6c\x65\x73\x68\x65\x65\x74\x22\x20\x68\x72\x65\x66\x3d\x22\x5b\x5b\x20\x22\x61\x70\x70\x2e\x63\x73\x73\x22\x20\x7c\x20\x61\x73\x73\x65\x74\x20\x5d\x5d\x22\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x62\x61\x73\x65\x2d\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x68\x65\x61\x64\x65\x72\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x41\x63\x74\x69\x76\x65\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x62\x6f\x64\x79\x22\x20\x5d\x5d\x0a\x3c\x73\x65\x63\x74\x69\x6f\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x20\x5b\x5b\x20\x62\x6c\x6f\x63\x6b\x20\x22\x68\x65\x72\x6f\x2d\x63\x6c\x61\x73\x73\x22\x20\x2e\x20\x5d\x5d\x69\x73\x2d\x64\x61\x72\x6b\x20\x69\x73\x2d\x62\x6f\x6c\x64\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x68\x65\x61\x64\x22\x20\x69\x64\x3d\x22\x68\x65\x61\x64\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x65\x61\x64\x65\x72\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x69\x74\x65\x6d\x20\x69\x73\x2d\x62\x72\x61\x6e\x64\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x62\x6c\x6f\x63\x6b\x20\x22\x62\x72\x61\x6e\x64\x22\x20\x2e\x20\x5d\x5d\x3c\x69\x6d\x67\x20\x73\x72\x63\x3d\x22\x5b\x5b\x20\x22\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2d\x68\x65\x61\x64\x65\x72\x2e\x70\x6e\x67\x22\x20\x7c\x20\x61\x73\x73\x65\x74\x20\x5d\x5d\x22\x20\x61\x6c\x74\x3d\x22\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x22\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x55\x73\x65\x72\x2e\x45\x6d\x61\x69\x6c\x55\x6e\x76\x61\x6c\x69\x64\x61\x74\x65\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x63\x65\x6e\x74\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x69\x74\x65\x6d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x2f\x65\x6d\x61\x69\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x2d\x6f\x20\x66\x61\x2d\x70\x75\x6c\x73\x65\x22\x3e\x3c\x2f\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x74\x6f\x67\x67\x6c\x65\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x69\x73\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x21\x69\x73\x41\x63\x74\x69\x76\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x27\x3a\x20\x69\x73\x41\x63\x74\x69\x76\x65\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x72\x69\x67\x68\x74\x20\x6e\x61\x76\x2d\x6d\x65\x6e\x75\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x27\x3a\x20\x69\x73\x41\x63\x74\x69\x76\x65\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x69\x74\x65\x6d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x75\x73\x65\x72\x2f\x5b\x5b\x20\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x2e\x55\x73\x65\x72\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x69\x74\x65\x6d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x69\x74\x65\x6d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x6c\x6f\x67\x6f\x75\x74\x22\x3e\x4c\x6f\x67\x6f\x75\x74\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x68\x65\x61\x64\x65\x72\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x5b\x5b\x20\x62\x6c\x6f\x63\x6b\x20\x22\x68\x65\x72\x6f\x22\x20\x2e\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x3c\x2f\x73\x65\x63\x74\x69\x6f\x6e\x3e\x0a\x0a\x3c\x73\x65\x63\x74\x69\x6f\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x61\x69\x6e\x20\x73\x65\x63\x74\x69\x6f\x6e\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x62\x6c\x6f\x63\x6b\x20\x22\x6d\x61\x69\x6e\x22\x20\x2e\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x73\x65\x63\x74\x69\x6f\x6e\x3e\x0a\x0a\x3c\x66\x6f\x6f\x74\x65\x72\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x63\x65\x6e\x74\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x69\x74\x65\x6d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x22\x3e\x48\x6f\x6d\x65\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x69\x74\x65\x6d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x63\x6f\x6e\x74\x61\x63\x74\x22\x3e\x43\x6f\x6e\x74\x61\x63\x74\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x61\x76\x2d\x69\x74\x65\x6d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x61\x62\x6f\x75\x74\x22\x3e\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x66\x6f\x6f\x74\x65\x72\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
 
 func appHtmlBytes() ([]byte, error) {
@@ -166,7 +190,7 @@ func baseHtml() (*asset, error) {
 	return a, nil
 }
 
-var _changelogRecordHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2d\x72\x65\x63\x6f\x72\x64\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x6f\x78\x22\x3e\x0a\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x64\x69\x61\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x64\x69\x61\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x55\x73\x65\x72\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x59\x6f\x75\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x55\x73\x65\x72\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x75\x73\x65\x72\x2f\x5b\x5b\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x55\x73\x65\x72\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x61\x3e\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x3c\x69\x3e\x5b\x5b\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x3c\x2f\x69\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x55\x6e\x6b\x6e\x6f\x77\x6e\x20\x75\x73\x65\x72\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x20\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x41\x63\x74\x69\x6f\x6e\x2e\x44\x69\x73\x70\x6c\x61\x79\x20\x5d\x5d\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x20\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x49\x6d\x70\x6f\x72\x74\x50\x72\x65\x66\x69\x78\x20\x5d\x5d\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x5b\x5b\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x50\x61\x63\x6b\x61\x67\x65\x49\x44\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x49\x6d\x70\x6f\x72\x74\x50\x72\x65\x66\x69\x78\x20\x5d\x5d\x3c\x2f\x61\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x3e\x5b\x5b\x20\x72\x65\x6c\x61\x74\x69\x76\x65\x5f\x74\x69\x6d\x65\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x54\x69\x6d\x65\x20\x5d\x5d\x3c\x2f\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x43\x68\x61\x6e\x67\x65\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x63\x68\x61\x6e\x67\x65\x20\x3a\x3d\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x43\x68\x61\x6e\x67\x65\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x69\x65\x6c\x64\x20\x5d\x5d\x3a\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x20\x5d\x5d\x20\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x48\x72\x65\x66\x20\x5d\x5d\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x48\x72\x65\x66\x20\x5d\x5d\x22\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x49\x6e\x66\x6f\x20\x5d\x5d\x20\x64\x61\x74\x61\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x49\x6e\x66\x6f\x20\x5d\x5d\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x20\x5d\x5d\x3c\x2f\x61\x3e\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x3c\x73\x70\x61\x6e\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x49\x6e\x66\x6f\x20\x5d\x5d\x20\x64\x61\x74\x61\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x49\x6e\x66\x6f\x20\x5d\x5d\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x20\x5d\x5d\x3c\x2f\x73\x70\x61\x6e\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x20\x5d\x5d\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x20\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x48\x72\x65\x66\x20\x5d\x5d\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x48\x72\x65\x66\x20\x5d\x5d\x22\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x49\x6e\x66\x6f\x20\x5d\x5d\x20\x64\x61\x74\x61\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x49\x6e\x66\x6f\x20\x5d\x5d\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x20\x5d\x5d\x3c\x2f\x61\x3e\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x3c\x73\x70\x61\x6e\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x49\x6e\x66\x6f\x20\x5d\x5d\x20\x64\x61\x74\x61\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x49\x6e\x66\x6f\x20\x5d\x5d\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x20\x5d\x5d\x3c\x2f\x73\x70\x61\x6e\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x72\x65\x6d\x6f\x76\x65\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
+var _changelogRecordHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2d\x72\x65\x63\x6f\x72\x64\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x6f\x78\x22\x3e\x0a\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x64\x69\x61\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x64\x69\x61\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x55\x73\x65\x72\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x59\x6f\x75\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x55\x73\x65\x72\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x75\x73\x65\x72\x2f\x5b\x5b\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x55\x73\x65\x72\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x61\x3e\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x3c\x69\x3e\x5b\x5b\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x3c\x2f\x69\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6c\x73\x65\x20\x69\x66\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x53\x79\x73\x74\x65\x6d\x20\x5d\x5d\x53\x79\x73\x74\x65\x6d\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x55\x6e\x6b\x6e\x6f\x77\x6e\x20\x75\x73\x65\x72\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x20\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x41\x63\x74\x69\x6f\x6e\x2e\x44\x69\x73\x70\x6c\x61\x79\x20\x5d\x5d\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x20\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x49\x6d\x70\x6f\x72\x74\x50\x72\x65\x66\x69\x78\x20\x5d\x5d\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x5b\x5b\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x50\x61\x63\x6b\x61\x67\x65\x49\x44\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x49\x6d\x70\x6f\x72\x74\x50\x72\x65\x66\x69\x78\x20\x5d\x5d\x3c\x2f\x61\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x3e\x5b\x5b\x20\x72\x65\x6c\x61\x74\x69\x76\x65\x5f\x74\x69\x6d\x65\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x54\x69\x6d\x65\x20\x5d\x5d\x3c\x2f\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x43\x68\x61\x6e\x67\x65\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x63\x68\x61\x6e\x67\x65\x20\x3a\x3d\x20\x2e\x52\x65\x63\x6f\x72\x64\x2e\x43\x68\x61\x6e\x67\x65\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x69\x65\x6c\x64\x20\x5d\x5d\x3a\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x20\x5d\x5d\x20\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x48\x72\x65\x66\x20\x5d\x5d\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x48\x72\x65\x66\x20\x5d\x5d\x22\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x49\x6e\x66\x6f\x20\x5d\x5d\x20\x64\x61\x74\x61\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x49\x6e\x66\x6f\x20\x5d\x5d\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x20\x5d\x5d\x3c\x2f\x61\x3e\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x3c\x73\x70\x61\x6e\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x49\x6e\x66\x6f\x20\x5d\x5d\x20\x64\x61\x74\x61\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x49\x6e\x66\x6f\x20\x5d\x5d\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x20\x5d\x5d\x3c\x2f\x73\x70\x61\x6e\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x46\x72\x6f\x6d\x20\x5d\x5d\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x20\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x48\x72\x65\x66\x20\x5d\x5d\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x48\x72\x65\x66\x20\x5d\x5d\x22\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x49\x6e\x66\x6f\x20\x5d\x5d\x20\x64\x61\x74\x61\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x49\x6e\x66\x6f\x20\x5d\x5d\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x20\x5d\x5d\x3c\x2f\x61\x3e\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x3c\x73\x70\x61\x6e\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x49\x6e\x66\x6f\x20\x5d\x5d\x20\x64\x61\x74\x61\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x49\x6e\x66\x6f\x20\x5d\x5d\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x2e\x54\x6f\x20\x5d\x5d\x3c\x2f\x73\x70\x61\x6e\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x72\x65\x6d\x6f\x76\x65\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
 
 func changelogRecordHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -181,7 +205,7 @@ func changelogRecordHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "changelog-record.html", size: 1916, mode: os.FileMode(420), modTime: time.Unix(1490556242, 0)}
+	info := bindataFileInfo{name: "changelog-record.html", size: 1950, mode: os.FileMode(420), modTime: time.Unix(1792329820, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -226,7 +250,7 @@ func coverHtml() (*asset, error) {
 	return a, nil
 }
 
-var _dashboardHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x5b\x5b\x20\x69\x66\x20\x6e\x6f\x74\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x20\x69\x73\x2d\x6f\x66\x66\x73\x65\x74\x2d\x33\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x31\x22\x3e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x61\x6e\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x70\x3e\x59\x6f\x75\x72\x20\x6c\x69\x73\x74\x20\x6f\x66\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x61\x6e\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x69\x73\x20\x65\x6d\x70\x74\x79\x2c\x20\x73\x6f\x20\x66\x65\x65\x6c\x20\x66\x72\x65\x65\x20\x74\x6f\x20\x61\x64\x64\x20\x61\x20\x6e\x65\x77\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x6e\x64\x20\x61\x66\x74\x65\x72\x77\x61\x72\x64\x20\x74\x6f\x20\x61\x64\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x75\x6e\x64\x65\x72\x20\x69\x74\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x75\x6c\x6c\x65\x64\x2d\x72\x69\x67\x68\x74\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x44\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x59\x6f\x75\x72\x20\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x39\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x61\x73\x69\x63\x20\x73\x65\x67\x6d\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x20\x3a\x3d\x20\x2e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x5b\x5b\x2d\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x2d\x5d\x5d\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x20\x69\x73\x2d\x68\x69\x64\x64\x65\x6e\x2d\x74\x6f\x75\x63\x68\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x63\x75\x62\x65\x73\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x4f\x77\x6e\x65\x72\x55\x73\x65\x72\x49\x44\x20\x24\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x20\x69\x73\x2d\x68\x69\x64\x64\x65\x6e\x2d\x74\x6f\x75\x63\x68\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x75\x73\x65\x72\x73\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x20\x69\x73\x2d\x68\x69\x64\x64\x65\x6e\x2d\x74\x6f\x75\x63\x68\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x67\x65\x61\x72\x73\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x20\x69\x73\x2d\x68\x69\x64\x64\x65\x6e\x2d\x74\x6f\x75\x63\x68\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x68\x69\x73\x74\x6f\x72\x79\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x44\x69\x73\x61\x62\x6c\x65\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x3e\x64\x69\x73\x61\x62\x6c\x65\x64\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x52\x65\x63\x6f\x72\x64\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x72\x65\x63\x6f\x72\x64\x20\x3a\x3d\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x52\x65\x63\x6f\x72\x64\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2d\x72\x65\x63\x6f\x72\x64\x22\x20\x6d\x61\x70\x20\x22\x52\x65\x63\x6f\x72\x64\x22\x20\x24\x72\x65\x63\x6f\x72\x64\x20\x22\x55\x73\x65\x72\x22\x20\x24\x2e\x55\x73\x65\x72\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x50\x72\x65\x76\x69\x6f\x75\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x3c\x73\x70\x61\x6e\x3e\x4d\x6f\x72\x65\x20\x63\x68\x61\x6e\x67\x65\x73\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x69\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
+var _dashboardHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x5b\x5b\x20\x69\x66\x20\x6e\x6f\x74\x20\x28\x6f\x72\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x20\x2e\x55\x73\x65\x72\x2e\x41\x64\x6d\x69\x6e\x29\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x20\x69\x73\x2d\x6f\x66\x66\x73\x65\x74\x2d\x33\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x31\x22\x3e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x61\x6e\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x70\x3e\x59\x6f\x75\x72\x20\x6c\x69\x73\x74\x20\x6f\x66\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x61\x6e\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x69\x73\x20\x65\x6d\x70\x74\x79\x2c\x20\x73\x6f\x20\x66\x65\x65\x6c\x20\x66\x72\x65\x65\x20\x74\x6f\x20\x61\x64\x64\x20\x61\x20\x6e\x65\x77\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x6e\x64\x20\x61\x66\x74\x65\x72\x77\x61\x72\x64\x20\x74\x6f\x20\x61\x64\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x75\x6e\x64\x65\x72\x20\x69\x74\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x75\x6c\x6c\x65\x64\x2d\x72\x69\x67\x68\x74\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x44\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x75\x6c\x6c\x65\x64\x2d\x72\x69\x67\x68\x74\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x22\x3e\x4e\x65\x77\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x3c\x2f\x61\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x59\x6f\x75\x72\x20\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x59\x6f\x75\x72\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x6f\x72\x67\x20\x3a\x3d\x20\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2f\x5b\x5b\x20\x24\x6f\x72\x67\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x6f\x72\x67\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x22\x3e\x4e\x65\x77\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x39\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x67\x65\x74\x22\x20\x61\x63\x74\x69\x6f\x6e\x3d\x22\x2f\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x20\x68\x61\x73\x2d\x61\x64\x64\x6f\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x69\x73\x2d\x65\x78\x70\x61\x6e\x64\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x6e\x61\x6d\x65\x3d\x22\x71\x75\x65\x72\x79\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x53\x65\x61\x72\x63\x68\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x62\x79\x20\x69\x6d\x70\x6f\x72\x74\x20\x70\x61\x74\x68\x2c\x20\x72\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x6f\x72\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x22\x20\x76\x61\x6c\x75\x65\x3d\x22\x5b\x5b\x20\x2e\x53\x65\x61\x72\x63\x68\x20\x5d\x5d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x73\x65\x61\x72\x63\x68\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x53\x65\x61\x72\x63\x68\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x53\x65\x61\x72\x63\x68\x52\x65\x73\x75\x6c\x74\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x49\x6d\x70\x6f\x72\x74\x20\x70\x61\x74\x68\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x20\x3a\x3d\x20\x2e\x53\x65\x61\x72\x63\x68\x52\x65\x73\x75\x6c\x74\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x3c\x63\x6f\x64\x65\x3e\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x49\x6d\x70\x6f\x72\x74\x50\x72\x65\x66\x69\x78\x20\x5d\x5d\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x61\x3e\x5b\x5b\x20\x69\x66\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x44\x69\x73\x61\x62\x6c\x65\x64\x20\x5d\x5d\x20\x28\x64\x69\x73\x61\x62\x6c\x65\x64\x29\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x70\x6f\x52\x6f\x6f\x74\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x61\x6e\x64\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x54\x79\x70\x65\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x4e\x61\x6d\x65\x20\x5d\x5d\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x63\x61\x72\x65\x74\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x20\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x54\x79\x70\x65\x20\x5d\x5d\x3a\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x4e\x61\x6d\x65\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x70\x65\x6e\x63\x69\x6c\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x53\x65\x61\x72\x63\x68\x4e\x65\x78\x74\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x3f\x71\x75\x65\x72\x79\x3d\x5b\x5b\x20\x2e\x53\x65\x61\x72\x63\x68\x20\x5d\x5d\x26\x73\x74\x61\x72\x74\x3d\x5b\x5b\x20\x2e\x53\x65\x61\x72\x63\x68\x4e\x65\x78\x74\x20\x5d\x5d\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x3e\x3c\x73\x70\x61\x6e\x3e\x4d\x6f\x72\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x4e\x6f\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x66\x6f\x75\x6e\x64\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x61\x73\x69\x63\x20\x73\x65\x67\x6d\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x20\x3a\x3d\x20\x2e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x5b\x5b\x2d\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x2d\x5d\x5d\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x20\x69\x73\x2d\x68\x69\x64\x64\x65\x6e\x2d\x74\x6f\x75\x63\x68\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x63\x75\x62\x65\x73\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x4f\x77\x6e\x65\x72\x55\x73\x65\x72\x49\x44\x20\x24\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x20\x69\x73\x2d\x68\x69\x64\x64\x65\x6e\x2d\x74\x6f\x75\x63\x68\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x75\x73\x65\x72\x73\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x20\x69\x73\x2d\x68\x69\x64\x64\x65\x6e\x2d\x74\x6f\x75\x63\x68\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x67\x65\x61\x72\x73\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x20\x69\x73\x2d\x68\x69\x64\x64\x65\x6e\x2d\x74\x6f\x75\x63\x68\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x68\x69\x73\x74\x6f\x72\x79\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x44\x69\x73\x61\x62\x6c\x65\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x3e\x64\x69\x73\x61\x62\x6c\x65\x64\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x52\x65\x63\x6f\x72\x64\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x72\x65\x63\x6f\x72\x64\x20\x3a\x3d\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x52\x65\x63\x6f\x72\x64\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2d\x72\x65\x63\x6f\x72\x64\x22\x20\x6d\x61\x70\x20\x22\x52\x65\x63\x6f\x72\x64\x22\x20\x24\x72\x65\x63\x6f\x72\x64\x20\x22\x55\x73\x65\x72\x22\x20\x24\x2e\x55\x73\x65\x72\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x50\x72\x65\x76\x69\x6f\x75\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x3c\x73\x70\x61\x6e\x3e\x4d\x6f\x72\x65\x20\x63\x68\x61\x6e\x67\x65\x73\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x69\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
 
 func dashboardHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -241,7 +265,7 @@ func dashboardHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "dashboard.html", size: 3103, mode: os.FileMode(420), modTime: time.Unix(1490556242, 0)}
+	info := bindataFileInfo{name: "dashboard.html", size: 5357, mode: os.FileMode(420), modTime: time.Unix(1792333402, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -266,7 +290,7 @@ func docsApiHtml() (*asset, error) {
 	return a, nil
 }
 
-var _domainAddHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x61\x70\x70\x20\x3d\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x71\x64\x6e\x3a\x20\x22\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x74\x6f\x6b\x65\x6e\x73\x3a\x20\x5b\x5d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x74\x6f\x6b\x65\x6e\x73\x20\x3d\x20\x5b\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x20\x21\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x20\x26\x26\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x5b\x22\x66\x71\x64\x6e\x22\x5d\x20\x21\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x22\x2b\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x5b\x22\x66\x71\x64\x6e\x22\x5d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x22\x2f\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x74\x61\x6e\x64\x61\x72\x64\x48\x54\x54\x50\x45\x72\x72\x6f\x72\x28\x61\x70\x70\x2c\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x20\x3d\x3d\x3d\x20\x6e\x75\x6c\x6c\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x74\x6f\x6b\x65\x6e\x73\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x5b\x27\x74\x6f\x6b\x65\x6e\x73\x27\x5d\x20\x7c\x7c\x20\x5b\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x3b\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x68\x65\x6c\x70\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4e\x67\x69\x6e\x78\x45\x78\x61\x6d\x70\x6c\x65\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x31\x22\x3e\x4e\x65\x77\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x20\x20\x3c\x70\x3e\x41\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x44\x4e\x53\x20\x64\x6f\x6d\x61\x69\x6e\x20\x74\x68\x61\x74\x20\x73\x65\x72\x76\x65\x73\x20\x61\x73\x20\x61\x20\x6c\x6f\x67\x69\x63\x61\x6c\x20\x75\x6e\x69\x74\x20\x75\x6e\x64\x65\x72\x20\x77\x68\x69\x63\x68\x20\x79\x6f\x75\x20\x63\x61\x6e\x20\x73\x70\x65\x63\x69\x66\x79\x20\x69\x6d\x70\x6f\x72\x74\x20\x70\x61\x74\x68\x73\x20\x66\x6f\x72\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x6e\x64\x20\x74\x68\x65\x69\x72\x20\x72\x65\x70\x6f\x73\x69\x74\x6f\x72\x69\x65\x73\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x20\x69\x73\x2d\x6f\x66\x66\x73\x65\x74\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x66\x6f\x72\x6d\x22\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x20\x76\x2d\x69\x66\x3d\x22\x74\x6f\x6b\x65\x6e\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3e\x20\x30\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x20\x76\x2d\x69\x66\x3d\x22\x74\x6f\x6b\x65\x6e\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3d\x3d\x20\x31\x22\x3e\x54\x6f\x20\x76\x65\x72\x69\x66\x79\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x20\x74\x68\x65\x20\x66\x6f\x6c\x6c\x6f\x77\x69\x6e\x67\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x70\x72\x65\x73\x65\x6e\x74\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x20\x76\x2d\x65\x6c\x73\x65\x2d\x69\x66\x3d\x22\x74\x6f\x6b\x65\x6e\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3e\x20\x31\x22\x3e\x54\x6f\x20\x76\x65\x72\x69\x66\x79\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x6e\x79\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x6f\x6c\x6c\x6f\x77\x69\x6e\x67\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x70\x72\x65\x73\x65\x6e\x74\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x76\x2d\x66\x6f\x72\x3d\x22\x74\x6f\x6b\x65\x6e\x20\x69\x6e\x20\x74\x6f\x6b\x65\x6e\x73\x22\x3e\x3c\x63\x6f\x64\x65\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x74\x6f\x6b\x65\x6e\x2e\x66\x71\x64\x6e\x20\x2b\x27\x20\x54\x58\x54\x20\x27\x2b\x20\x74\x6f\x6b\x65\x6e\x2e\x74\x6f\x6b\x65\x6e\x22\x3e\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x41\x66\x74\x65\x72\x20\x79\x6f\x75\x20\x61\x64\x64\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x2c\x20\x63\x6f\x6d\x65\x20\x62\x61\x63\x6b\x20\x74\x6f\x20\x74\x68\x69\x73\x20\x66\x6f\x72\x6d\x20\x61\x6e\x64\x20\x73\x75\x62\x6d\x69\x74\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x67\x61\x69\x6e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x4b\x65\x65\x70\x20\x69\x6e\x20\x6d\x69\x6e\x64\x20\x74\x68\x61\x74\x20\x44\x4e\x53\x20\x70\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x20\x72\x65\x71\x75\x69\x72\x65\x73\x20\x73\x6f\x6d\x65\x20\x74\x69\x6d\x65\x20\x61\x66\x74\x65\x72\x20\x74\x68\x65\x20\x72\x65\x63\x6f\x72\x64\x20\x69\x73\x20\x73\x65\x74\x2c\x20\x64\x65\x70\x65\x6e\x64\x69\x6e\x67\x20\x6f\x6e\x20\x54\x54\x4c\x20\x76\x61\x6c\x75\x65\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x66\x71\x64\x6e\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x46\x75\x6c\x6c\x79\x20\x71\x75\x61\x6c\x69\x66\x69\x65\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x66\x71\x64\x6e\x7d\x22\x20\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x66\x71\x64\x6e\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x22\x3e\x43\x61\x6e\x63\x65\x6c\x3c\x2f\x61\x3e\x20\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x74\x61\x62\x69\x6e\x64\x65\x78\x3d\x22\x30\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x41\x64\x64\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x20\x69\x64\x3d\x22\x68\x65\x6c\x70\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x34\x22\x3e\x59\x6f\x75\x72\x20\x6f\x77\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x72\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x75\x62\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x35\x22\x3e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x20\x6f\x72\x20\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x70\x3e\x43\x75\x73\x74\x6f\x6d\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6d\x75\x73\x74\x20\x68\x61\x76\x65\x20\x61\x20\x43\x4e\x41\x4d\x45\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x77\x69\x74\x68\x20\x76\x61\x6c\x75\x65\x3a\x20\x3c\x63\x6f\x64\x65\x3e\x5b\x5b\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x22\x41\x6c\x69\x61\x73\x43\x4e\x41\x4d\x45\x22\x20\x5d\x5d\x2e\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x62\x72\x20\x2f\x3e\x69\x6e\x20\x6f\x72\x64\x65\x72\x20\x74\x6f\x20\x62\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x61\x6c\x20\x61\x6e\x64\x20\x74\x6f\x20\x73\x75\x63\x63\x65\x73\x73\x66\x75\x6c\x6c\x79\x20\x72\x65\x71\x75\x65\x73\x74\x20\x61\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2e\x20\x41\x20\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x6f\x66\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x69\x73\x20\x72\x65\x71\x75\x69\x72\x65\x64\x20\x62\x79\x20\x61\x64\x64\x69\x6e\x67\x20\x54\x58\x54\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x77\x69\x74\x68\x20\x61\x20\x75\x6e\x69\x71\x75\x65\x20\x76\x61\x6c\x75\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x6e\x65\x78\x74\x20\x73\x74\x65\x70\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x3c\x70\x3e\x49\x66\x20\x79\x6f\x75\x20\x61\x6c\x72\x65\x61\x64\x79\x20\x75\x73\x65\x20\x79\x6f\x75\x72\x20\x64\x6f\x6d\x61\x69\x6e\x20\x74\x6f\x20\x73\x65\x72\x76\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x6f\x76\x65\x72\x20\x48\x54\x54\x50\x28\x53\x29\x2c\x20\x79\x6f\x75\x20\x63\x61\x6e\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x65\x20\x79\x6f\x75\x72\x20\x77\x65\x62\x20\x73\x65\x72\x76\x65\x72\x20\x74\x6f\x20\x70\x72\x6f\x78\x79\x20\x6f\x6e\x6c\x79\x20\x3c\x69\x3e\x67\x6f\x20\x67\x65\x74\x3c\x2f\x69\x3e\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x62\x75\x74\x74\x6f\x6e\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x69\x73\x4e\x67\x69\x6e\x78\x45\x78\x61\x6d\x70\x6c\x65\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x74\x72\x75\x65\x22\x3e\x6e\x67\x69\x6e\x78\x20\x65\x78\x61\x6d\x70\x6c\x65\x3c\x2f\x61\x3e\x2e\x20\x49\x6e\x20\x74\x68\x69\x73\x20\x63\x61\x73\x65\x20\x79\x6f\x75\x20\x63\x61\x6e\x20\x73\x69\x6c\x65\x6e\x63\x65\x20\x54\x4c\x53\x20\x77\x61\x72\x6e\x69\x6e\x67\x20\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x2e\x3c\x2f\x70\x3e\x0a\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x27\x3a\x20\x69\x73\x4e\x67\x69\x6e\x78\x45\x78\x61\x6d\x70\x6c\x65\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x7d\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x69\x73\x4e\x67\x69\x6e\x78\x45\x78\x61\x6d\x70\x6c\x65\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x20\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x4e\x67\x69\x6e\x78\x20\x70\x72\x6f\x78\x79\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x65\x78\x61\x6d\x70\x6c\x65\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x72\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3e\x0a\x73\x65\x72\x76\x65\x72\x20\x7b\x0a\x20\x20\x20\x20\x73\x65\x72\x76\x65\x72\x5f\x6e\x61\x6d\x65\x20\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x3b\x0a\x20\x20\x20\x20\x2e\x2e\x2e\x0a\x20\x20\x20\x20\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x2f\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x24\x61\x72\x67\x73\x20\x7e\x20\x67\x6f\x2d\x67\x65\x74\x3d\x31\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x6e\x63\x6c\x75\x64\x65\x20\x2f\x65\x74\x63\x2f\x6e\x67\x69\x6e\x78\x2f\x70\x72\x6f\x78\x79\x5f\x70\x61\x72\x61\x6d\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x78\x79\x5f\x70\x61\x73\x73\x20\x68\x74\x74\x70\x3a\x2f\x2f\x5b\x5b\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x22\x41\x6c\x69\x61\x73\x43\x4e\x41\x4d\x45\x22\x20\x5d\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x24\x61\x72\x67\x73\x20\x21\x7e\x20\x67\x6f\x2d\x67\x65\x74\x3d\x31\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x23\x20\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x66\x6f\x72\x20\x79\x6f\x75\x72\x20\x73\x65\x72\x76\x69\x63\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2e\x2e\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x0a\x7d\x3c\x2f\x70\x72\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6c\x6f\x73\x65\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x69\x73\x4e\x67\x69\x6e\x78\x45\x78\x61\x6d\x70\x6c\x65\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x34\x22\x3e\x50\x72\x6f\x76\x69\x64\x65\x64\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x75\x62\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x35\x22\x3e\x6d\x79\x2d\x70\x72\x6f\x6a\x65\x63\x74\x2e\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x70\x3e\x41\x6c\x73\x6f\x2c\x20\x79\x6f\x75\x20\x63\x61\x6e\x20\x75\x73\x65\x20\x61\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x20\x75\x6e\x64\x65\x72\x20\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x20\x61\x73\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x20\x66\x6f\x72\x20\x79\x6f\x75\x72\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x2c\x20\x77\x68\x69\x63\x68\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x61\x6c\x20\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x72\x69\x67\x68\x74\x20\x61\x77\x61\x79\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x20\x61\x73\x20\x6e\x6f\x20\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x6f\x72\x20\x44\x4e\x53\x20\x73\x65\x74\x75\x70\x20\x69\x73\x20\x6e\x65\x65\x64\x65\x64\x2e\x20\x54\x68\x65\x20\x6f\x6e\x6c\x79\x20\x6c\x69\x6d\x69\x74\x61\x74\x69\x6f\x6e\x20\x69\x73\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
+var _domainAddHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x61\x70\x70\x20\x3d\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x71\x64\x6e\x3a\x20\x22\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x74\x6f\x6b\x65\x6e\x73\x3a\x20\x5b\x5d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x74\x6f\x6b\x65\x6e\x73\x20\x3d\x20\x5b\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x20\x21\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x20\x26\x26\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x5b\x22\x66\x71\x64\x6e\x22\x5d\x20\x21\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x22\x2b\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x5b\x22\x66\x71\x64\x6e\x22\x5d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x22\x2f\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x74\x61\x6e\x64\x61\x72\x64\x48\x54\x54\x50\x45\x72\x72\x6f\x72\x28\x61\x70\x70\x2c\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x20\x3d\x3d\x3d\x20\x6e\x75\x6c\x6c\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x74\x6f\x6b\x65\x6e\x73\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x5b\x27\x74\x6f\x6b\x65\x6e\x73\x27\x5d\x20\x7c\x7c\x20\x5b\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x3b\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x68\x65\x6c\x70\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4e\x67\x69\x6e\x78\x45\x78\x61\x6d\x70\x6c\x65\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x31\x22\x3e\x4e\x65\x77\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x20\x20\x3c\x70\x3e\x41\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x44\x4e\x53\x20\x64\x6f\x6d\x61\x69\x6e\x20\x74\x68\x61\x74\x20\x73\x65\x72\x76\x65\x73\x20\x61\x73\x20\x61\x20\x6c\x6f\x67\x69\x63\x61\x6c\x20\x75\x6e\x69\x74\x20\x75\x6e\x64\x65\x72\x20\x77\x68\x69\x63\x68\x20\x79\x6f\x75\x20\x63\x61\x6e\x20\x73\x70\x65\x63\x69\x66\x79\x20\x69\x6d\x70\x6f\x72\x74\x20\x70\x61\x74\x68\x73\x20\x66\x6f\x72\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x6e\x64\x20\x74\x68\x65\x69\x72\x20\x72\x65\x70\x6f\x73\x69\x74\x6f\x72\x69\x65\x73\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x20\x69\x73\x2d\x6f\x66\x66\x73\x65\x74\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x66\x6f\x72\x6d\x22\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x20\x76\x2d\x69\x66\x3d\x22\x74\x6f\x6b\x65\x6e\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3e\x20\x30\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x3e\x54\x6f\x20\x76\x65\x72\x69\x66\x79\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x6e\x79\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x6f\x6c\x6c\x6f\x77\x69\x6e\x67\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x6f\x72\x20\x48\x54\x54\x50\x53\x20\x66\x69\x6c\x65\x73\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x70\x72\x65\x73\x65\x6e\x74\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x44\x4e\x53\x20\x54\x58\x54\x20\x72\x65\x63\x6f\x72\x64\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x76\x2d\x66\x6f\x72\x3d\x22\x74\x6f\x6b\x65\x6e\x20\x69\x6e\x20\x74\x6f\x6b\x65\x6e\x73\x22\x20\x76\x2d\x69\x66\x3d\x22\x74\x6f\x6b\x65\x6e\x2e\x6d\x65\x74\x68\x6f\x64\x20\x3d\x3d\x20\x27\x64\x6e\x73\x2d\x74\x78\x74\x27\x22\x3e\x3c\x63\x6f\x64\x65\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x74\x6f\x6b\x65\x6e\x2e\x66\x71\x64\x6e\x20\x2b\x27\x20\x54\x58\x54\x20\x27\x2b\x20\x74\x6f\x6b\x65\x6e\x2e\x74\x6f\x6b\x65\x6e\x22\x3e\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x4b\x65\x65\x70\x20\x69\x6e\x20\x6d\x69\x6e\x64\x20\x74\x68\x61\x74\x20\x44\x4e\x53\x20\x70\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x20\x72\x65\x71\x75\x69\x72\x65\x73\x20\x73\x6f\x6d\x65\x20\x74\x69\x6d\x65\x20\x61\x66\x74\x65\x72\x20\x74\x68\x65\x20\x72\x65\x63\x6f\x72\x64\x20\x69\x73\x20\x73\x65\x74\x2c\x20\x64\x65\x70\x65\x6e\x64\x69\x6e\x67\x20\x6f\x6e\x20\x54\x54\x4c\x20\x76\x61\x6c\x75\x65\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x48\x54\x54\x50\x53\x20\x66\x69\x6c\x65\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x74\x6f\x6b\x65\x6e\x20\x61\x73\x20\x69\x74\x73\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x76\x2d\x66\x6f\x72\x3d\x22\x74\x6f\x6b\x65\x6e\x20\x69\x6e\x20\x74\x6f\x6b\x65\x6e\x73\x22\x20\x76\x2d\x69\x66\x3d\x22\x74\x6f\x6b\x65\x6e\x2e\x6d\x65\x74\x68\x6f\x64\x20\x3d\x3d\x20\x27\x68\x74\x74\x70\x2d\x66\x69\x6c\x65\x27\x22\x3e\x3c\x63\x6f\x64\x65\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x74\x6f\x6b\x65\x6e\x2e\x75\x72\x6c\x22\x3e\x3c\x2f\x63\x6f\x64\x65\x3e\x20\x3c\x63\x6f\x64\x65\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x74\x6f\x6b\x65\x6e\x2e\x74\x6f\x6b\x65\x6e\x22\x3e\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x54\x68\x65\x20\x66\x69\x6c\x65\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x73\x65\x72\x76\x65\x64\x20\x77\x69\x74\x68\x20\x73\x74\x61\x74\x75\x73\x20\x32\x30\x30\x20\x4f\x4b\x2c\x20\x77\x69\x74\x68\x6f\x75\x74\x20\x72\x65\x64\x69\x72\x65\x63\x74\x73\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x41\x66\x74\x65\x72\x20\x79\x6f\x75\x20\x61\x64\x64\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x6f\x72\x20\x48\x54\x54\x50\x53\x20\x66\x69\x6c\x65\x2c\x20\x63\x6f\x6d\x65\x20\x62\x61\x63\x6b\x20\x74\x6f\x20\x74\x68\x69\x73\x20\x66\x6f\x72\x6d\x20\x61\x6e\x64\x20\x73\x75\x62\x6d\x69\x74\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x67\x61\x69\x6e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x66\x71\x64\x6e\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x46\x75\x6c\x6c\x79\x20\x71\x75\x61\x6c\x69\x66\x69\x65\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x66\x71\x64\x6e\x7d\x22\x20\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x66\x71\x64\x6e\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x22\x3e\x43\x61\x6e\x63\x65\x6c\x3c\x2f\x61\x3e\x20\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x74\x61\x62\x69\x6e\x64\x65\x78\x3d\x22\x30\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x41\x64\x64\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x20\x69\x64\x3d\x22\x68\x65\x6c\x70\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x34\x22\x3e\x59\x6f\x75\x72\x20\x6f\x77\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x72\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x75\x62\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x35\x22\x3e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x20\x6f\x72\x20\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x70\x3e\x43\x75\x73\x74\x6f\x6d\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6d\x75\x73\x74\x20\x68\x61\x76\x65\x20\x61\x20\x43\x4e\x41\x4d\x45\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x77\x69\x74\x68\x20\x76\x61\x6c\x75\x65\x3a\x20\x3c\x63\x6f\x64\x65\x3e\x5b\x5b\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x22\x41\x6c\x69\x61\x73\x43\x4e\x41\x4d\x45\x22\x20\x5d\x5d\x2e\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x62\x72\x20\x2f\x3e\x69\x6e\x20\x6f\x72\x64\x65\x72\x20\x74\x6f\x20\x62\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x61\x6c\x20\x61\x6e\x64\x20\x74\x6f\x20\x73\x75\x63\x63\x65\x73\x73\x66\x75\x6c\x6c\x79\x20\x72\x65\x71\x75\x65\x73\x74\x20\x61\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2e\x20\x41\x20\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x6f\x66\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x69\x73\x20\x72\x65\x71\x75\x69\x72\x65\x64\x20\x62\x79\x20\x61\x64\x64\x69\x6e\x67\x20\x54\x58\x54\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x77\x69\x74\x68\x20\x61\x20\x75\x6e\x69\x71\x75\x65\x20\x76\x61\x6c\x75\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x6e\x65\x78\x74\x20\x73\x74\x65\x70\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x3c\x70\x3e\x49\x66\x20\x79\x6f\x75\x20\x61\x6c\x72\x65\x61\x64\x79\x20\x75\x73\x65\x20\x79\x6f\x75\x72\x20\x64\x6f\x6d\x61\x69\x6e\x20\x74\x6f\x20\x73\x65\x72\x76\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x6f\x76\x65\x72\x20\x48\x54\x54\x50\x28\x53\x29\x2c\x20\x79\x6f\x75\x20\x63\x61\x6e\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x65\x20\x79\x6f\x75\x72\x20\x77\x65\x62\x20\x73\x65\x72\x76\x65\x72\x20\x74\x6f\x20\x70\x72\x6f\x78\x79\x20\x6f\x6e\x6c\x79\x20\x3c\x69\x3e\x67\x6f\x20\x67\x65\x74\x3c\x2f\x69\x3e\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x62\x75\x74\x74\x6f\x6e\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x69\x73\x4e\x67\x69\x6e\x78\x45\x78\x61\x6d\x70\x6c\x65\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x74\x72\x75\x65\x22\x3e\x6e\x67\x69\x6e\x78\x20\x65\x78\x61\x6d\x70\x6c\x65\x3c\x2f\x61\x3e\x2e\x20\x49\x6e\x20\x74\x68\x69\x73\x20\x63\x61\x73\x65\x20\x79\x6f\x75\x20\x63\x61\x6e\x20\x73\x69\x6c\x65\x6e\x63\x65\x20\x54\x4c\x53\x20\x77\x61\x72\x6e\x69\x6e\x67\x20\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x3c\x70\x3e\x41\x20\x77\x69\x6c\x64\x63\x61\x72\x64\x20\x64\x6f\x6d\x61\x69\x6e\x2c\x20\x6c\x69\x6b\x65\x20\x3c\x63\x6f\x64\x65\x3e\x2a\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x3c\x2f\x63\x6f\x64\x65\x3e\x2c\x20\x73\x65\x72\x76\x65\x73\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x66\x6f\x72\x20\x65\x76\x65\x72\x79\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x66\x20\x3c\x63\x6f\x64\x65\x3e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x3c\x2f\x63\x6f\x64\x65\x3e\x20\x74\x68\x61\x74\x20\x69\x73\x20\x6e\x6f\x74\x20\x72\x65\x67\x69\x73\x74\x65\x72\x65\x64\x20\x61\x73\x20\x61\x20\x73\x65\x70\x61\x72\x61\x74\x65\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x20\x49\x74\x20\x72\x65\x71\x75\x69\x72\x65\x73\x20\x61\x20\x77\x69\x6c\x64\x63\x61\x72\x64\x20\x43\x4e\x41\x4d\x45\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x6f\x66\x20\x69\x74\x73\x20\x70\x61\x72\x65\x6e\x74\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x3c\x2f\x70\x3e\x0a\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x27\x3a\x20\x69\x73\x4e\x67\x69\x6e\x78\x45\x78\x61\x6d\x70\x6c\x65\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x7d\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x69\x73\x4e\x67\x69\x6e\x78\x45\x78\x61\x6d\x70\x6c\x65\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x20\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x4e\x67\x69\x6e\x78\x20\x70\x72\x6f\x78\x79\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x65\x78\x61\x6d\x70\x6c\x65\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x72\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3e\x0a\x73\x65\x72\x76\x65\x72\x20\x7b\x0a\x20\x20\x20\x20\x73\x65\x72\x76\x65\x72\x5f\x6e\x61\x6d\x65\x20\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x3b\x0a\x20\x20\x20\x20\x2e\x2e\x2e\x0a\x20\x20\x20\x20\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x2f\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x24\x61\x72\x67\x73\x20\x7e\x20\x67\x6f\x2d\x67\x65\x74\x3d\x31\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x6e\x63\x6c\x75\x64\x65\x20\x2f\x65\x74\x63\x2f\x6e\x67\x69\x6e\x78\x2f\x70\x72\x6f\x78\x79\x5f\x70\x61\x72\x61\x6d\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x78\x79\x5f\x70\x61\x73\x73\x20\x68\x74\x74\x70\x3a\x2f\x2f\x5b\x5b\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x22\x41\x6c\x69\x61\x73\x43\x4e\x41\x4d\x45\x22\x20\x5d\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x24\x61\x72\x67\x73\x20\x21\x7e\x20\x67\x6f\x2d\x67\x65\x74\x3d\x31\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x23\x20\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x66\x6f\x72\x20\x79\x6f\x75\x72\x20\x73\x65\x72\x76\x69\x63\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2e\x2e\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x0a\x7d\x3c\x2f\x70\x72\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6c\x6f\x73\x65\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x69\x73\x4e\x67\x69\x6e\x78\x45\x78\x61\x6d\x70\x6c\x65\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x34\x22\x3e\x50\x72\x6f\x76\x69\x64\x65\x64\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x75\x62\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x35\x22\x3e\x6d\x79\x2d\x70\x72\x6f\x6a\x65\x63\x74\x2e\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x70\x3e\x41\x6c\x73\x6f\x2c\x20\x79\x6f\x75\x20\x63\x61\x6e\x20\x75\x73\x65\x20\x61\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x20\x75\x6e\x64\x65\x72\x20\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x20\x61\x73\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x20\x66\x6f\x72\x20\x79\x6f\x75\x72\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x2c\x20\x77\x68\x69\x63\x68\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x61\x6c\x20\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x72\x69\x67\x68\x74\x20\x61\x77\x61\x79\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x20\x61\x73\x20\x6e\x6f\x20\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x6f\x72\x20\x44\x4e\x53\x20\x73\x65\x74\x75\x70\x20\x69\x73\x20\x6e\x65\x65\x64\x65\x64\x2e\x20\x54\x68\x65\x20\x6f\x6e\x6c\x79\x20\x6c\x69\x6d\x69\x74\x61\x74\x69\x6f\x6e\x20\x69\x73\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
 
 func domainAddHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -281,7 +305,7 @@ func domainAddHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "domain-add.html", size: 5547, mode: os.FileMode(420), modTime: time.Unix(1490556242, 0)}
+	info := bindataFileInfo{name: "domain-add.html", size: 6048, mode: os.FileMode(420), modTime: time.Unix(1792333746, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -306,7 +330,7 @@ func domainChangelogHtml() (*asset, error) {
 	return a, nil
 }
 
-var _domainOwnerChangeHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x63\x68\x61\x6e\x67\x65\x20\x6f\x77\x6e\x65\x72\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x64\x3a\x20\x22\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x2f\x6f\x77\x6e\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x66\x6f\x6f\x74\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x73\x20\x69\x73\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x62\x6f\x78\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x74\x65\x61\x6d\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x20\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x6c\x65\x66\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x20\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x59\x6f\x75\x20\x63\x61\x6e\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x63\x6f\x6d\x70\x6c\x65\x74\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x74\x6f\x20\x61\x6e\x6f\x74\x68\x65\x72\x20\x75\x73\x65\x72\x20\x62\x79\x20\x65\x2d\x6d\x61\x69\x6c\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x49\x44\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x55\x73\x65\x72\x20\x65\x2d\x6d\x61\x69\x6c\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x49\x44\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x69\x64\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x69\x64\x7d\x22\x20\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x69\x64\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x43\x61\x6e\x63\x65\x6c\x3c\x2f\x61\x3e\x20\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x59\x65\x73\x2c\x20\x63\x68\x61\x6e\x67\x65\x20\x6f\x77\x6e\x65\x72\x21\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
+var _domainOwnerChangeHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x63\x68\x61\x6e\x67\x65\x20\x6f\x77\x6e\x65\x72\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x64\x3a\x20\x22\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x2f\x6f\x77\x6e\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x72\x61\x6e\x73\x66\x65\x72\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x66\x6f\x6f\x74\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x73\x20\x69\x73\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x62\x6f\x78\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x74\x65\x61\x6d\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x20\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x6c\x65\x66\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x20\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x59\x6f\x75\x20\x63\x61\x6e\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x63\x6f\x6d\x70\x6c\x65\x74\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x74\x6f\x20\x61\x6e\x6f\x74\x68\x65\x72\x20\x75\x73\x65\x72\x20\x62\x79\x20\x65\x2d\x6d\x61\x69\x6c\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x49\x44\x2e\x20\x54\x68\x65\x20\x75\x73\x65\x72\x20\x77\x69\x6c\x6c\x20\x72\x65\x63\x65\x69\x76\x65\x20\x61\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x72\x65\x71\x75\x65\x73\x74\x20\x62\x79\x20\x65\x2d\x6d\x61\x69\x6c\x20\x61\x6e\x64\x20\x77\x69\x6c\x6c\x20\x62\x65\x63\x6f\x6d\x65\x20\x74\x68\x65\x20\x6f\x77\x6e\x65\x72\x20\x61\x66\x74\x65\x72\x20\x61\x63\x63\x65\x70\x74\x69\x6e\x67\x20\x69\x74\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x55\x73\x65\x72\x20\x65\x2d\x6d\x61\x69\x6c\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x49\x44\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x69\x64\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x69\x64\x7d\x22\x20\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x69\x64\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x43\x61\x6e\x63\x65\x6c\x3c\x2f\x61\x3e\x20\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x53\x65\x6e\x64\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x72\x65\x71\x75\x65\x73\x74\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
 
 func domainOwnerChangeHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -321,12 +345,12 @@ func domainOwnerChangeHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "domain-owner-change.html", size: 3273, mode: os.FileMode(420), modTime: time.Unix(1490556242, 0)}
+	info := bindataFileInfo{name: "domain-owner-change.html", size: 3380, mode: os.FileMode(420), modTime: time.Unix(1792330468, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _domainPackageEditHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x6d\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x74\x68\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x50\x61\x74\x68\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x70\x6f\x52\x6f\x6f\x74\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x70\x6f\x52\x6f\x6f\x74\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x63\x73\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x56\x43\x53\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x66\x54\x79\x70\x65\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x54\x79\x70\x65\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x66\x4e\x61\x6d\x65\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x4e\x61\x6d\x65\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x67\x6f\x53\x6f\x75\x72\x63\x65\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x47\x6f\x53\x6f\x75\x72\x63\x65\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x64\x69\x72\x65\x63\x74\x55\x72\x6c\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x64\x69\x72\x65\x63\x74\x55\x52\x4c\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x6f\x6d\x61\x69\x6e\x49\x64\x3a\x20\x22\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x3a\x20\x5b\x5b\x20\x69\x66\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x44\x69\x73\x61\x62\x6c\x65\x64\x20\x5d\x5d\x74\x72\x75\x65\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x66\x61\x6c\x73\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x74\x68\x69\x73\x2e\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x76\x63\x73\x20\x21\x3d\x20\x22\x67\x69\x74\x22\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x20\x3d\x20\x22\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x4e\x61\x6d\x65\x20\x3d\x20\x22\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2e\x66\x6f\x72\x6d\x2c\x20\x27\x5b\x5b\x20\x69\x66\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x2f\x69\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x2f\x69\x2f\x70\x61\x63\x6b\x61\x67\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x50\x61\x63\x6b\x61\x67\x65\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x44\x65\x6c\x65\x74\x65\x28\x74\x68\x69\x73\x2e\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2c\x20\x27\x2f\x69\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x66\x6f\x6f\x74\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x73\x20\x69\x73\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x62\x6f\x78\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x20\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x39\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x6c\x65\x66\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x20\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x2d\x66\x6f\x72\x6d\x22\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x47\x6f\x20\x72\x65\x6d\x6f\x74\x65\x20\x69\x6d\x70\x6f\x72\x74\x20\x70\x61\x74\x68\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x20\x68\x61\x73\x2d\x61\x64\x64\x6f\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x75\x6e\x73\x65\x6c\x65\x63\x74\x61\x62\x6c\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x70\x61\x74\x68\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x69\x73\x2d\x65\x78\x70\x61\x6e\x64\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x70\x61\x74\x68\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x70\x61\x74\x68\x7d\x22\x20\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x70\x61\x74\x68\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x20\x68\x61\x73\x2d\x61\x64\x64\x6f\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x69\x73\x2d\x65\x78\x70\x61\x6e\x64\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x70\x6f\x52\x6f\x6f\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x70\x6f\x52\x6f\x6f\x74\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x76\x63\x73\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x76\x63\x73\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x76\x63\x73\x20\x3a\x3d\x20\x2e\x56\x43\x53\x49\x6e\x66\x6f\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x5b\x5b\x20\x24\x76\x63\x73\x2e\x56\x43\x53\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x76\x63\x73\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x20\x76\x2d\x69\x66\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x76\x63\x73\x20\x3d\x3d\x20\x27\x67\x69\x74\x27\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x22\x3e\x4d\x61\x73\x74\x65\x72\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x62\x72\x61\x6e\x63\x68\x22\x3e\x42\x72\x61\x6e\x63\x68\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x74\x61\x67\x22\x3e\x54\x61\x67\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x4e\x61\x6d\x65\x22\x20\x76\x2d\x69\x66\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x20\x26\x26\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x76\x63\x73\x20\x3d\x3d\x20\x27\x67\x69\x74\x27\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x66\x4e\x61\x6d\x65\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x76\x63\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x70\x6f\x52\x6f\x6f\x74\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x66\x4e\x61\x6d\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x61\x20\x76\x61\x6c\x69\x64\x20\x55\x52\x49\x20\x77\x69\x74\x68\x20\x61\x20\x73\x63\x68\x65\x6d\x65\x20\x76\x61\x6c\x69\x64\x20\x68\x6f\x73\x74\x6e\x61\x6d\x65\x2c\x20\x6f\x70\x74\x69\x6f\x6e\x61\x6c\x20\x70\x6f\x72\x74\x20\x73\x65\x70\x61\x72\x61\x74\x65\x64\x20\x62\x79\x20\x61\x20\x63\x6f\x6c\x6f\x6e\x20\x22\x3a\x22\x20\x61\x6e\x64\x20\x61\x20\x70\x61\x74\x68\x2e\x3c\x62\x72\x3e\x3c\x62\x72\x3e\x45\x78\x61\x6d\x70\x6c\x65\x73\x3a\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x67\x69\x74\x2b\x73\x73\x68\x3a\x2f\x2f\x67\x69\x74\x40\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x67\x69\x74\x2b\x73\x73\x68\x3a\x2f\x2f\x67\x69\x74\x40\x72\x65\x70\x6f\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x3a\x32\x32\x30\x32\x32\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x3c\x62\x72\x3e\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x41\x20\x3c\x69\x3e\x73\x63\x70\x3c\x2f\x69\x3e\x20\x73\x74\x79\x6c\x65\x20\x28\x67\x69\x74\x40\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x3a\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x67\x69\x74\x29\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x69\x6e\x67\x20\x69\x73\x20\x6e\x6f\x74\x20\x76\x61\x6c\x69\x64\x20\x66\x6f\x72\x20\x3c\x69\x3e\x67\x6f\x20\x67\x65\x74\x3c\x2f\x69\x3e\x20\x74\x6f\x6f\x6c\x2e\x3c\x62\x72\x3e\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x46\x6f\x72\x20\x47\x69\x74\x20\x48\x54\x54\x50\x20\x61\x6e\x64\x20\x48\x54\x54\x50\x53\x20\x72\x65\x70\x6f\x73\x69\x74\x6f\x72\x69\x65\x73\x20\x61\x20\x63\x75\x73\x74\x6f\x6d\x20\x62\x72\x61\x6e\x63\x68\x20\x6f\x72\x20\x74\x61\x67\x20\x63\x61\x6e\x20\x62\x65\x20\x73\x70\x65\x63\x69\x66\x69\x65\x64\x2e\x20\x49\x74\x20\x61\x6c\x6c\x6f\x77\x73\x20\x74\x6f\x20\x68\x61\x76\x65\x20\x64\x69\x66\x66\x65\x72\x65\x6e\x74\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x70\x61\x74\x68\x73\x20\x66\x6f\x72\x20\x64\x69\x66\x66\x65\x72\x65\x6e\x74\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x76\x65\x72\x73\x69\x6f\x6e\x73\x20\x69\x6e\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x72\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x74\x68\x61\x74\x20\x62\x72\x65\x61\x6b\x20\x62\x61\x63\x6b\x77\x61\x72\x64\x20\x63\x6f\x6d\x70\x61\x74\x69\x62\x69\x6c\x69\x74\x79\x20\x77\x69\x74\x68\x20\x6d\x61\x73\x74\x65\x72\x20\x6f\x72\x20\x6f\x74\x68\x65\x72\x20\x62\x72\x61\x6e\x63\x68\x65\x73\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x47\x6f\x20\x53\x6f\x75\x72\x63\x65\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x67\x6f\x53\x6f\x75\x72\x63\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x67\x6f\x53\x6f\x75\x72\x63\x65\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x67\x6f\x53\x6f\x75\x72\x63\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x41\x6e\x20\x6f\x70\x74\x69\x6f\x6e\x61\x6c\x20\x76\x61\x6c\x75\x65\x20\x66\x6f\x72\x20\x48\x54\x4d\x4c\x20\x6d\x65\x74\x61\x20\x74\x61\x67\x20\x67\x6f\x2d\x73\x6f\x75\x72\x63\x65\x2c\x20\x75\x73\x65\x64\x20\x66\x6f\x72\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x6f\x6c\x61\x6e\x67\x2f\x67\x64\x64\x6f\x2f\x77\x69\x6b\x69\x2f\x53\x6f\x75\x72\x63\x65\x2d\x43\x6f\x64\x65\x2d\x4c\x69\x6e\x6b\x73\x22\x20\x74\x61\x72\x67\x65\x74\x3d\x22\x5f\x62\x6c\x61\x6e\x6b\x22\x3e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x61\x74\x69\x6f\x6e\x20\x6c\x69\x6e\x6b\x73\x3c\x2f\x61\x3e\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x72\x3e\x3c\x62\x72\x3e\x45\x78\x61\x6d\x70\x6c\x65\x3a\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x70\x61\x63\x6b\x61\x67\x65\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x74\x72\x65\x65\x2f\x6d\x61\x73\x74\x65\x72\x7b\x2f\x64\x69\x72\x7d\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x62\x6c\x6f\x62\x2f\x6d\x61\x73\x74\x65\x72\x7b\x2f\x64\x69\x72\x7d\x2f\x7b\x66\x69\x6c\x65\x7d\x23\x4c\x7b\x6c\x69\x6e\x65\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x64\x69\x72\x65\x63\x74\x55\x72\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x64\x69\x72\x65\x63\x74\x55\x72\x6c\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x64\x69\x72\x65\x63\x74\x55\x72\x6c\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x41\x6e\x20\x6f\x74\x70\x6f\x6e\x61\x6c\x20\x55\x52\x4c\x20\x74\x6f\x20\x72\x65\x64\x69\x72\x65\x63\x74\x20\x72\x65\x71\x75\x65\x73\x74\x20\x6e\x6f\x74\x20\x6d\x61\x64\x65\x20\x62\x79\x20\x74\x68\x65\x20\x3c\x69\x3e\x67\x6f\x20\x67\x65\x74\x3c\x2f\x69\x3e\x20\x74\x6f\x6f\x6c\x2e\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x69\x73\x61\x62\x6c\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x74\x72\x75\x65\x22\x20\x74\x61\x62\x69\x6e\x64\x65\x78\x3d\x22\x31\x22\x3e\x44\x65\x6c\x65\x74\x65\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x7d\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x20\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x44\x65\x6c\x65\x74\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x50\x61\x74\x68\x20\x5d\x5d\x3f\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x6d\x6f\x64\x61\x6c\x2d\x63\x6c\x6f\x73\x65\x2d\x62\x75\x74\x74\x6f\x6e\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x4e\x6f\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x64\x65\x6c\x65\x74\x65\x50\x61\x63\x6b\x61\x67\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x59\x65\x73\x2c\x20\x64\x65\x6c\x65\x74\x65\x20\x69\x74\x21\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6c\x6f\x73\x65\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x43\x61\x6e\x63\x65\x6c\x3c\x2f\x61\x3e\x20\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x53\x61\x76\x65\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
+var _domainPackageEditHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x6d\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x74\x68\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x50\x61\x74\x68\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x70\x6f\x52\x6f\x6f\x74\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x70\x6f\x52\x6f\x6f\x74\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x63\x73\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x56\x43\x53\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x66\x54\x79\x70\x65\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x54\x79\x70\x65\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x66\x4e\x61\x6d\x65\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x4e\x61\x6d\x65\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x67\x6f\x53\x6f\x75\x72\x63\x65\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x47\x6f\x53\x6f\x75\x72\x63\x65\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x64\x69\x72\x65\x63\x74\x55\x72\x6c\x3a\x20\x22\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x64\x69\x72\x65\x63\x74\x55\x52\x4c\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x6f\x6d\x61\x69\x6e\x49\x64\x3a\x20\x22\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x3a\x20\x5b\x5b\x20\x69\x66\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x44\x69\x73\x61\x62\x6c\x65\x64\x20\x5d\x5d\x74\x72\x75\x65\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x66\x61\x6c\x73\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x74\x68\x69\x73\x2e\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x76\x63\x73\x20\x21\x3d\x20\x22\x67\x69\x74\x22\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x20\x3d\x20\x22\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x4e\x61\x6d\x65\x20\x3d\x20\x22\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2e\x66\x6f\x72\x6d\x2c\x20\x27\x5b\x5b\x20\x69\x66\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x2f\x69\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x2f\x69\x2f\x70\x61\x63\x6b\x61\x67\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x50\x61\x63\x6b\x61\x67\x65\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x44\x65\x6c\x65\x74\x65\x28\x74\x68\x69\x73\x2e\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2c\x20\x27\x2f\x69\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x66\x6f\x6f\x74\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x73\x20\x69\x73\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x62\x6f\x78\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x20\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x39\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x6c\x65\x66\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x20\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x2d\x66\x6f\x72\x6d\x22\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x61\x64\x4f\x6e\x6c\x79\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x69\x6e\x66\x6f\x22\x3e\x59\x6f\x75\x20\x68\x61\x76\x65\x20\x72\x65\x61\x64\x20\x6f\x6e\x6c\x79\x20\x61\x63\x63\x65\x73\x73\x20\x74\x6f\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x66\x69\x65\x6c\x64\x73\x65\x74\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x61\x64\x4f\x6e\x6c\x79\x20\x5d\x5d\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x47\x6f\x20\x72\x65\x6d\x6f\x74\x65\x20\x69\x6d\x70\x6f\x72\x74\x20\x70\x61\x74\x68\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x20\x68\x61\x73\x2d\x61\x64\x64\x6f\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x75\x6e\x73\x65\x6c\x65\x63\x74\x61\x62\x6c\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x70\x61\x74\x68\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x69\x73\x2d\x65\x78\x70\x61\x6e\x64\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x70\x61\x74\x68\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x70\x61\x74\x68\x7d\x22\x20\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x70\x61\x74\x68\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x20\x68\x61\x73\x2d\x61\x64\x64\x6f\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x69\x73\x2d\x65\x78\x70\x61\x6e\x64\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x70\x6f\x52\x6f\x6f\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x70\x6f\x52\x6f\x6f\x74\x7d\x22\x5b\x5b\x20\x77\x69\x74\x68\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x70\x6f\x52\x6f\x6f\x74\x20\x5d\x5d\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x5b\x5b\x20\x2e\x52\x65\x70\x6f\x52\x6f\x6f\x74\x20\x5d\x5d\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x76\x63\x73\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x76\x63\x73\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x76\x63\x73\x20\x3a\x3d\x20\x2e\x56\x43\x53\x49\x6e\x66\x6f\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x5b\x5b\x20\x24\x76\x63\x73\x2e\x56\x43\x53\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x76\x63\x73\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x20\x76\x2d\x69\x66\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x76\x63\x73\x20\x3d\x3d\x20\x27\x67\x69\x74\x27\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x22\x3e\x4d\x61\x73\x74\x65\x72\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x62\x72\x61\x6e\x63\x68\x22\x3e\x42\x72\x61\x6e\x63\x68\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x74\x61\x67\x22\x3e\x54\x61\x67\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x4e\x61\x6d\x65\x22\x20\x76\x2d\x69\x66\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x20\x26\x26\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x76\x63\x73\x20\x3d\x3d\x20\x27\x67\x69\x74\x27\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x66\x4e\x61\x6d\x65\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x76\x63\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x70\x6f\x52\x6f\x6f\x74\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x66\x4e\x61\x6d\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x61\x20\x76\x61\x6c\x69\x64\x20\x55\x52\x49\x20\x77\x69\x74\x68\x20\x61\x20\x73\x63\x68\x65\x6d\x65\x20\x76\x61\x6c\x69\x64\x20\x68\x6f\x73\x74\x6e\x61\x6d\x65\x2c\x20\x6f\x70\x74\x69\x6f\x6e\x61\x6c\x20\x70\x6f\x72\x74\x20\x73\x65\x70\x61\x72\x61\x74\x65\x64\x20\x62\x79\x20\x61\x20\x63\x6f\x6c\x6f\x6e\x20\x22\x3a\x22\x20\x61\x6e\x64\x20\x61\x20\x70\x61\x74\x68\x2e\x3c\x62\x72\x3e\x3c\x62\x72\x3e\x45\x78\x61\x6d\x70\x6c\x65\x73\x3a\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x67\x69\x74\x2b\x73\x73\x68\x3a\x2f\x2f\x67\x69\x74\x40\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x67\x69\x74\x2b\x73\x73\x68\x3a\x2f\x2f\x67\x69\x74\x40\x72\x65\x70\x6f\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x3a\x32\x32\x30\x32\x32\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x3c\x62\x72\x3e\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x41\x20\x3c\x69\x3e\x73\x63\x70\x3c\x2f\x69\x3e\x20\x73\x74\x79\x6c\x65\x20\x28\x67\x69\x74\x40\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x3a\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x67\x69\x74\x29\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x69\x6e\x67\x20\x69\x73\x20\x6e\x6f\x74\x20\x76\x61\x6c\x69\x64\x20\x66\x6f\x72\x20\x3c\x69\x3e\x67\x6f\x20\x67\x65\x74\x3c\x2f\x69\x3e\x20\x74\x6f\x6f\x6c\x2e\x3c\x62\x72\x3e\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x46\x6f\x72\x20\x47\x69\x74\x20\x48\x54\x54\x50\x20\x61\x6e\x64\x20\x48\x54\x54\x50\x53\x20\x72\x65\x70\x6f\x73\x69\x74\x6f\x72\x69\x65\x73\x20\x61\x20\x63\x75\x73\x74\x6f\x6d\x20\x62\x72\x61\x6e\x63\x68\x20\x6f\x72\x20\x74\x61\x67\x20\x63\x61\x6e\x20\x62\x65\x20\x73\x70\x65\x63\x69\x66\x69\x65\x64\x2e\x20\x49\x74\x20\x61\x6c\x6c\x6f\x77\x73\x20\x74\x6f\x20\x68\x61\x76\x65\x20\x64\x69\x66\x66\x65\x72\x65\x6e\x74\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x70\x61\x74\x68\x73\x20\x66\x6f\x72\x20\x64\x69\x66\x66\x65\x72\x65\x6e\x74\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x76\x65\x72\x73\x69\x6f\x6e\x73\x20\x69\x6e\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x72\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x74\x68\x61\x74\x20\x62\x72\x65\x61\x6b\x20\x62\x61\x63\x6b\x77\x61\x72\x64\x20\x63\x6f\x6d\x70\x61\x74\x69\x62\x69\x6c\x69\x74\x79\x20\x77\x69\x74\x68\x20\x6d\x61\x73\x74\x65\x72\x20\x6f\x72\x20\x6f\x74\x68\x65\x72\x20\x62\x72\x61\x6e\x63\x68\x65\x73\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x47\x6f\x20\x53\x6f\x75\x72\x63\x65\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x67\x6f\x53\x6f\x75\x72\x63\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x67\x6f\x53\x6f\x75\x72\x63\x65\x7d\x22\x5b\x5b\x20\x77\x69\x74\x68\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x2e\x47\x6f\x53\x6f\x75\x72\x63\x65\x20\x5d\x5d\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x5b\x5b\x20\x2e\x47\x6f\x53\x6f\x75\x72\x63\x65\x20\x5d\x5d\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x67\x6f\x53\x6f\x75\x72\x63\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x41\x6e\x20\x6f\x70\x74\x69\x6f\x6e\x61\x6c\x20\x76\x61\x6c\x75\x65\x20\x66\x6f\x72\x20\x48\x54\x4d\x4c\x20\x6d\x65\x74\x61\x20\x74\x61\x67\x20\x67\x6f\x2d\x73\x6f\x75\x72\x63\x65\x2c\x20\x75\x73\x65\x64\x20\x66\x6f\x72\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x6f\x6c\x61\x6e\x67\x2f\x67\x64\x64\x6f\x2f\x77\x69\x6b\x69\x2f\x53\x6f\x75\x72\x63\x65\x2d\x43\x6f\x64\x65\x2d\x4c\x69\x6e\x6b\x73\x22\x20\x74\x61\x72\x67\x65\x74\x3d\x22\x5f\x62\x6c\x61\x6e\x6b\x22\x3e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x61\x74\x69\x6f\x6e\x20\x6c\x69\x6e\x6b\x73\x3c\x2f\x61\x3e\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x72\x3e\x3c\x62\x72\x3e\x45\x78\x61\x6d\x70\x6c\x65\x3a\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x70\x61\x63\x6b\x61\x67\x65\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x74\x72\x65\x65\x2f\x6d\x61\x73\x74\x65\x72\x7b\x2f\x64\x69\x72\x7d\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x62\x6c\x6f\x62\x2f\x6d\x61\x73\x74\x65\x72\x7b\x2f\x64\x69\x72\x7d\x2f\x7b\x66\x69\x6c\x65\x7d\x23\x4c\x7b\x6c\x69\x6e\x65\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x64\x69\x72\x65\x63\x74\x55\x72\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x64\x69\x72\x65\x63\x74\x55\x72\x6c\x7d\x22\x5b\x5b\x20\x77\x69\x74\x68\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x64\x69\x72\x65\x63\x74\x55\x52\x4c\x20\x5d\x5d\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x5b\x5b\x20\x2e\x52\x65\x64\x69\x72\x65\x63\x74\x55\x52\x4c\x20\x5d\x5d\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x64\x69\x72\x65\x63\x74\x55\x72\x6c\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x41\x6e\x20\x6f\x74\x70\x6f\x6e\x61\x6c\x20\x55\x52\x4c\x20\x74\x6f\x20\x72\x65\x64\x69\x72\x65\x63\x74\x20\x72\x65\x71\x75\x65\x73\x74\x20\x6e\x6f\x74\x20\x6d\x61\x64\x65\x20\x62\x79\x20\x74\x68\x65\x20\x3c\x69\x3e\x67\x6f\x20\x67\x65\x74\x3c\x2f\x69\x3e\x20\x74\x6f\x6f\x6c\x2e\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x77\x69\x74\x68\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x69\x6e\x66\x6f\x22\x3e\x45\x6d\x70\x74\x79\x20\x66\x69\x65\x6c\x64\x73\x20\x61\x72\x65\x20\x73\x65\x74\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x64\x6f\x6d\x61\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x73\x3c\x2f\x61\x3e\x20\x77\x68\x65\x72\x65\x20\x3c\x63\x6f\x64\x65\x3e\x7b\x64\x6f\x6d\x61\x69\x6e\x7d\x3c\x2f\x63\x6f\x64\x65\x3e\x20\x69\x73\x20\x72\x65\x70\x6c\x61\x63\x65\x64\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x20\x61\x6e\x64\x20\x3c\x63\x6f\x64\x65\x3e\x7b\x70\x61\x74\x68\x7d\x3c\x2f\x63\x6f\x64\x65\x3e\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x70\x61\x74\x68\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x69\x73\x61\x62\x6c\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x66\x69\x65\x6c\x64\x73\x65\x74\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6e\x6f\x74\x20\x2e\x52\x65\x61\x64\x4f\x6e\x6c\x79\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x74\x72\x75\x65\x22\x20\x74\x61\x62\x69\x6e\x64\x65\x78\x3d\x22\x31\x22\x3e\x44\x65\x6c\x65\x74\x65\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x7d\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x20\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x44\x65\x6c\x65\x74\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x5b\x5b\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x2e\x50\x61\x74\x68\x20\x5d\x5d\x3f\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x6d\x6f\x64\x61\x6c\x2d\x63\x6c\x6f\x73\x65\x2d\x62\x75\x74\x74\x6f\x6e\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x4e\x6f\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x64\x65\x6c\x65\x74\x65\x50\x61\x63\x6b\x61\x67\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x59\x65\x73\x2c\x20\x64\x65\x6c\x65\x74\x65\x20\x69\x74\x21\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6c\x6f\x73\x65\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x43\x61\x6e\x63\x65\x6c\x3c\x2f\x61\x3e\x20\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x53\x61\x76\x65\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
 
 func domainPackageEditHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -341,12 +365,12 @@ func domainPackageEditHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "domain-package-edit.html", size: 9992, mode: os.FileMode(420), modTime: time.Unix(1497948292, 0)}
+	info := bindataFileInfo{name: "domain-package-edit.html", size: 10844, mode: os.FileMode(420), modTime: time.Unix(1792334266, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _domainPackagesHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x66\x6f\x6f\x74\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x73\x20\x69\x73\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x62\x6f\x78\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x20\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x39\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x31\x32\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x61\x73\x69\x63\x20\x73\x65\x67\x6d\x65\x6e\x74\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x6c\x65\x20\x63\x61\x72\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x50\x61\x74\x68\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x70\x61\x63\x6b\x61\x67\x65\x22\x3e\x41\x64\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x3c\x2f\x61\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x20\x3a\x3d\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x20\x5b\x5b\x20\x69\x66\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x44\x69\x73\x61\x62\x6c\x65\x64\x20\x5d\x5d\x63\x6c\x61\x73\x73\x3d\x22\x6e\x65\x67\x61\x74\x69\x76\x65\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x3c\x63\x6f\x64\x65\x3e\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x50\x61\x74\x68\x20\x5d\x5d\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x61\x3e\x5b\x5b\x20\x69\x66\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x44\x69\x73\x61\x62\x6c\x65\x64\x20\x5d\x5d\x20\x28\x64\x69\x73\x61\x62\x6c\x65\x64\x29\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x70\x6f\x52\x6f\x6f\x74\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x61\x6e\x64\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x54\x79\x70\x65\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x4e\x61\x6d\x65\x20\x5d\x5d\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x63\x61\x72\x65\x74\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x20\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x54\x79\x70\x65\x20\x5d\x5d\x3a\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x4e\x61\x6d\x65\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x70\x65\x6e\x63\x69\x6c\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x5b\x5b\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x50\x61\x74\x68\x20\x5d\x5d\x3f\x67\x6f\x2d\x67\x65\x74\x3d\x31\x22\x20\x74\x61\x72\x67\x65\x74\x3d\x22\x5f\x62\x6c\x61\x6e\x6b\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x65\x78\x74\x65\x72\x6e\x61\x6c\x2d\x6c\x69\x6e\x6b\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x50\x72\x65\x76\x69\x6f\x75\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3f\x73\x74\x61\x72\x74\x3d\x5b\x5b\x20\x2e\x50\x72\x65\x76\x69\x6f\x75\x73\x20\x7c\x20\x62\x61\x73\x65\x33\x32\x65\x6e\x63\x6f\x64\x65\x20\x5d\x5d\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x6c\x65\x66\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x73\x70\x61\x6e\x3e\x50\x72\x65\x76\x69\x6f\x75\x73\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x4e\x65\x78\x74\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3f\x73\x74\x61\x72\x74\x3d\x5b\x5b\x20\x2e\x4e\x65\x78\x74\x20\x7c\x20\x62\x61\x73\x65\x33\x32\x65\x6e\x63\x6f\x64\x65\x20\x5d\x5d\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x3e\x3c\x73\x70\x61\x6e\x3e\x4e\x65\x78\x74\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x38\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6e\x6f\x74\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x47\x6f\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x72\x65\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x64\x20\x62\x79\x20\x70\x61\x74\x68\x73\x2e\x3c\x62\x72\x3e\x50\x61\x63\x6b\x61\x67\x65\x20\x70\x61\x74\x68\x73\x20\x75\x6e\x64\x65\x72\x20\x64\x6f\x6d\x61\x69\x6e\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x63\x61\x6e\x20\x62\x65\x20\x6d\x61\x6e\x61\x67\x65\x64\x20\x68\x65\x72\x65\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6c\x61\x72\x67\x65\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x70\x61\x63\x6b\x61\x67\x65\x22\x3e\x41\x64\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
+var _domainPackagesHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x66\x6f\x6f\x74\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x73\x20\x69\x73\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x62\x6f\x78\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x20\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x39\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x31\x32\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x67\x65\x74\x22\x20\x61\x63\x74\x69\x6f\x6e\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x20\x68\x61\x73\x2d\x61\x64\x64\x6f\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x69\x73\x2d\x65\x78\x70\x61\x6e\x64\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x6e\x61\x6d\x65\x3d\x22\x71\x75\x65\x72\x79\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x53\x65\x61\x72\x63\x68\x20\x70\x61\x74\x68\x73\x22\x20\x76\x61\x6c\x75\x65\x3d\x22\x5b\x5b\x20\x2e\x46\x69\x6c\x74\x65\x72\x2e\x71\x75\x65\x72\x79\x20\x5d\x5d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x6e\x61\x6d\x65\x3d\x22\x76\x63\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x22\x3e\x41\x6e\x79\x20\x56\x43\x53\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x76\x63\x73\x20\x3a\x3d\x20\x2e\x56\x43\x53\x49\x6e\x66\x6f\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x5b\x5b\x20\x24\x76\x63\x73\x2e\x56\x43\x53\x20\x5d\x5d\x22\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x28\x70\x72\x69\x6e\x74\x20\x24\x76\x63\x73\x2e\x56\x43\x53\x29\x20\x24\x2e\x46\x69\x6c\x74\x65\x72\x2e\x76\x63\x73\x20\x5d\x5d\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x5b\x5b\x20\x24\x76\x63\x73\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x66\x5f\x74\x79\x70\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x22\x3e\x41\x6e\x79\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x62\x72\x61\x6e\x63\x68\x22\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x2e\x46\x69\x6c\x74\x65\x72\x2e\x72\x65\x66\x5f\x74\x79\x70\x65\x20\x22\x62\x72\x61\x6e\x63\x68\x22\x20\x5d\x5d\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x42\x72\x61\x6e\x63\x68\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x74\x61\x67\x22\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x2e\x46\x69\x6c\x74\x65\x72\x2e\x72\x65\x66\x5f\x74\x79\x70\x65\x20\x22\x74\x61\x67\x22\x20\x5d\x5d\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x54\x61\x67\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x5f\x68\x6f\x73\x74\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x68\x6f\x73\x74\x22\x20\x76\x61\x6c\x75\x65\x3d\x22\x5b\x5b\x20\x2e\x46\x69\x6c\x74\x65\x72\x2e\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x5f\x68\x6f\x73\x74\x20\x5d\x5d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x6e\x61\x6d\x65\x3d\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x22\x3e\x41\x6e\x79\x20\x73\x74\x61\x74\x75\x73\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x66\x61\x6c\x73\x65\x22\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x2e\x46\x69\x6c\x74\x65\x72\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x20\x22\x66\x61\x6c\x73\x65\x22\x20\x5d\x5d\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x45\x6e\x61\x62\x6c\x65\x64\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x74\x72\x75\x65\x22\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x2e\x46\x69\x6c\x74\x65\x72\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x20\x22\x74\x72\x75\x65\x22\x20\x5d\x5d\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x44\x69\x73\x61\x62\x6c\x65\x64\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x73\x65\x61\x72\x63\x68\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x61\x6e\x64\x20\x2e\x46\x69\x6c\x74\x65\x72\x51\x75\x65\x72\x79\x20\x28\x6e\x6f\x74\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x73\x29\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x4e\x6f\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x6d\x61\x74\x63\x68\x20\x74\x68\x65\x20\x66\x69\x6c\x74\x65\x72\x2e\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x53\x68\x6f\x77\x20\x61\x6c\x6c\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x61\x73\x69\x63\x20\x73\x65\x67\x6d\x65\x6e\x74\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x6c\x65\x20\x63\x61\x72\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x50\x61\x74\x68\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x72\x69\x67\x68\x74\x22\x3e\x5b\x5b\x20\x69\x66\x20\x2e\x43\x61\x6e\x45\x64\x69\x74\x20\x5d\x5d\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x70\x61\x63\x6b\x61\x67\x65\x22\x3e\x41\x64\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x3c\x2f\x61\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x20\x3a\x3d\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x20\x5b\x5b\x20\x69\x66\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x44\x69\x73\x61\x62\x6c\x65\x64\x20\x5d\x5d\x63\x6c\x61\x73\x73\x3d\x22\x6e\x65\x67\x61\x74\x69\x76\x65\x22\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x3c\x63\x6f\x64\x65\x3e\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x50\x61\x74\x68\x20\x5d\x5d\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x61\x3e\x5b\x5b\x20\x69\x66\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x44\x69\x73\x61\x62\x6c\x65\x64\x20\x5d\x5d\x20\x28\x64\x69\x73\x61\x62\x6c\x65\x64\x29\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x70\x6f\x52\x6f\x6f\x74\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x61\x6e\x64\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x54\x79\x70\x65\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x4e\x61\x6d\x65\x20\x5d\x5d\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x63\x61\x72\x65\x74\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x20\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x54\x79\x70\x65\x20\x5d\x5d\x3a\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x52\x65\x66\x4e\x61\x6d\x65\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x72\x69\x67\x68\x74\x22\x3e\x5b\x5b\x20\x69\x66\x20\x24\x2e\x43\x61\x6e\x45\x64\x69\x74\x20\x5d\x5d\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x70\x61\x63\x6b\x61\x67\x65\x2f\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x70\x65\x6e\x63\x69\x6c\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x5b\x5b\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x5b\x5b\x20\x24\x70\x61\x63\x6b\x61\x67\x65\x2e\x50\x61\x74\x68\x20\x5d\x5d\x3f\x67\x6f\x2d\x67\x65\x74\x3d\x31\x22\x20\x74\x61\x72\x67\x65\x74\x3d\x22\x5f\x62\x6c\x61\x6e\x6b\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x65\x78\x74\x65\x72\x6e\x61\x6c\x2d\x6c\x69\x6e\x6b\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x50\x72\x65\x76\x69\x6f\x75\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3f\x73\x74\x61\x72\x74\x3d\x5b\x5b\x20\x2e\x50\x72\x65\x76\x69\x6f\x75\x73\x20\x7c\x20\x62\x61\x73\x65\x33\x32\x65\x6e\x63\x6f\x64\x65\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x2e\x46\x69\x6c\x74\x65\x72\x51\x75\x65\x72\x79\x20\x5d\x5d\x26\x5b\x5b\x20\x2e\x46\x69\x6c\x74\x65\x72\x51\x75\x65\x72\x79\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x6c\x65\x66\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x73\x70\x61\x6e\x3e\x50\x72\x65\x76\x69\x6f\x75\x73\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x4e\x65\x78\x74\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3f\x73\x74\x61\x72\x74\x3d\x5b\x5b\x20\x2e\x4e\x65\x78\x74\x20\x7c\x20\x62\x61\x73\x65\x33\x32\x65\x6e\x63\x6f\x64\x65\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x2e\x46\x69\x6c\x74\x65\x72\x51\x75\x65\x72\x79\x20\x5d\x5d\x26\x5b\x5b\x20\x2e\x46\x69\x6c\x74\x65\x72\x51\x75\x65\x72\x79\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x3e\x3c\x73\x70\x61\x6e\x3e\x4e\x65\x78\x74\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x72\x69\x67\x68\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x38\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6e\x6f\x74\x20\x28\x6f\x72\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x2e\x46\x69\x6c\x74\x65\x72\x51\x75\x65\x72\x79\x29\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x47\x6f\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x72\x65\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x64\x20\x62\x79\x20\x70\x61\x74\x68\x73\x2e\x3c\x62\x72\x3e\x50\x61\x63\x6b\x61\x67\x65\x20\x70\x61\x74\x68\x73\x20\x75\x6e\x64\x65\x72\x20\x64\x6f\x6d\x61\x69\x6e\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x63\x61\x6e\x20\x62\x65\x20\x6d\x61\x6e\x61\x67\x65\x64\x20\x68\x65\x72\x65\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x43\x61\x6e\x45\x64\x69\x74\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6c\x61\x72\x67\x65\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x70\x61\x63\x6b\x61\x67\x65\x22\x3e\x41\x64\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x59\x6f\x75\x20\x68\x61\x76\x65\x20\x72\x65\x61\x64\x20\x6f\x6e\x6c\x79\x20\x61\x63\x63\x65\x73\x73\x20\x74\x6f\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
 
 func domainPackagesHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -361,12 +385,12 @@ func domainPackagesHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "domain-packages.html", size: 3695, mode: os.FileMode(420), modTime: time.Unix(1502224084, 0)}
+	info := bindataFileInfo{name: "domain-packages.html", size: 6230, mode: os.FileMode(420), modTime: time.Unix(1792330793, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _domainSettingsHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x2f\x6a\x61\x76\x61\x73\x63\x72\x69\x70\x74\x22\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x6d\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x71\x64\x6e\x3a\x20\x22\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x49\x67\x6e\x6f\x72\x65\x3a\x20\x5b\x5b\x20\x69\x66\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x49\x67\x6e\x6f\x72\x65\x20\x5d\x5d\x74\x72\x75\x65\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x66\x61\x6c\x73\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x3a\x20\x5b\x5b\x20\x69\x66\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x44\x69\x73\x61\x62\x6c\x65\x64\x20\x5d\x5d\x74\x72\x75\x65\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x66\x61\x6c\x73\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x6f\x6b\x65\x6e\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x44\x6f\x6e\x65\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x66\x6f\x72\x6d\x2e\x74\x6f\x6b\x65\x6e\x73\x20\x3d\x20\x5b\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2e\x66\x6f\x72\x6d\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x66\x6f\x72\x6d\x2e\x69\x73\x44\x6f\x6e\x65\x20\x3d\x20\x74\x72\x75\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x74\x61\x6e\x64\x61\x72\x64\x48\x54\x54\x50\x45\x72\x72\x6f\x72\x28\x61\x70\x70\x2e\x66\x6f\x72\x6d\x2c\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x20\x3d\x3d\x3d\x20\x6e\x75\x6c\x6c\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x66\x6f\x72\x6d\x2e\x74\x6f\x6b\x65\x6e\x73\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x5b\x27\x74\x6f\x6b\x65\x6e\x73\x27\x5d\x20\x7c\x7c\x20\x5b\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x6c\x6f\x61\x64\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x22\x2b\x74\x68\x69\x73\x2e\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x66\x71\x64\x6e\x2e\x74\x72\x69\x6d\x28\x29\x2b\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x44\x6f\x6d\x61\x69\x6e\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x44\x65\x6c\x65\x74\x65\x28\x74\x68\x69\x73\x2e\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x22\x2f\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x67\x65\x74\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2e\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2c\x20\x27\x2f\x69\x2f\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x72\x65\x6c\x6f\x61\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x66\x6f\x6f\x74\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x73\x20\x69\x73\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x62\x6f\x78\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x20\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x22\x20\x69\x64\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x66\x6f\x72\x6d\x22\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x77\x61\x72\x6e\x69\x6e\x67\x22\x3e\x4f\x6e\x6c\x79\x20\x6f\x77\x6e\x65\x72\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x63\x61\x6e\x20\x63\x68\x61\x6e\x67\x65\x20\x69\x74\x73\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x73\x75\x63\x63\x65\x73\x73\x22\x20\x76\x2d\x69\x66\x3d\x22\x66\x6f\x72\x6d\x2e\x69\x73\x44\x6f\x6e\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x64\x65\x6c\x65\x74\x65\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x72\x65\x6c\x6f\x61\x64\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x20\x61\x72\x65\x20\x73\x61\x76\x65\x64\x2e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x20\x76\x2d\x69\x66\x3d\x22\x21\x66\x6f\x72\x6d\x2e\x69\x73\x44\x6f\x6e\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x20\x76\x2d\x69\x66\x3d\x22\x66\x6f\x72\x6d\x2e\x74\x6f\x6b\x65\x6e\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3e\x20\x30\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x20\x76\x2d\x69\x66\x3d\x22\x66\x6f\x72\x6d\x2e\x74\x6f\x6b\x65\x6e\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3d\x3d\x20\x31\x22\x3e\x54\x6f\x20\x76\x65\x72\x69\x66\x79\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x20\x74\x68\x65\x20\x66\x6f\x6c\x6c\x6f\x77\x69\x6e\x67\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x70\x72\x65\x73\x65\x6e\x74\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x20\x76\x2d\x65\x6c\x73\x65\x2d\x69\x66\x3d\x22\x66\x6f\x72\x6d\x2e\x74\x6f\x6b\x65\x6e\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3e\x20\x31\x22\x3e\x54\x6f\x20\x76\x65\x72\x69\x66\x79\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x6e\x79\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x6f\x6c\x6c\x6f\x77\x69\x6e\x67\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x70\x72\x65\x73\x65\x6e\x74\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x76\x2d\x66\x6f\x72\x3d\x22\x74\x6f\x6b\x65\x6e\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x74\x6f\x6b\x65\x6e\x73\x22\x3e\x3c\x63\x6f\x64\x65\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x74\x6f\x6b\x65\x6e\x2e\x66\x71\x64\x6e\x20\x2b\x27\x20\x54\x58\x54\x20\x27\x2b\x20\x74\x6f\x6b\x65\x6e\x2e\x74\x6f\x6b\x65\x6e\x22\x3e\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x41\x66\x74\x65\x72\x20\x79\x6f\x75\x20\x61\x64\x64\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x2c\x20\x63\x6f\x6d\x65\x20\x62\x61\x63\x6b\x20\x74\x6f\x20\x74\x68\x69\x73\x20\x66\x6f\x72\x6d\x20\x61\x6e\x64\x20\x73\x75\x62\x6d\x69\x74\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x67\x61\x69\x6e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x4b\x65\x65\x70\x20\x69\x6e\x20\x6d\x69\x6e\x64\x20\x74\x68\x61\x74\x20\x44\x4e\x53\x20\x70\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x20\x72\x65\x71\x75\x69\x72\x65\x73\x20\x73\x6f\x6d\x65\x20\x74\x69\x6d\x65\x20\x61\x66\x74\x65\x72\x20\x74\x68\x65\x20\x72\x65\x63\x6f\x72\x64\x20\x69\x73\x20\x73\x65\x74\x2c\x20\x64\x65\x70\x65\x6e\x64\x69\x6e\x67\x20\x6f\x6e\x20\x54\x54\x4c\x20\x76\x61\x6c\x75\x65\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x46\x75\x6c\x6c\x79\x20\x71\x75\x61\x6c\x69\x66\x69\x65\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x66\x71\x64\x6e\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x66\x71\x64\x6e\x7d\x22\x20\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x66\x71\x64\x6e\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x69\x73\x61\x62\x6c\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x49\x73\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x42\x65\x69\x6e\x67\x4f\x62\x74\x61\x69\x6e\x65\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x77\x61\x72\x6e\x69\x6e\x67\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x62\x65\x69\x6e\x67\x20\x6f\x62\x74\x61\x69\x6e\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x2e\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x45\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x73\x75\x63\x63\x65\x73\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x76\x61\x6c\x69\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x62\x6f\x64\x79\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x45\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x20\x74\x69\x6d\x65\x3a\x20\x5b\x5b\x20\x2e\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x45\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x20\x5d\x5d\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x6e\x6f\x74\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x62\x6f\x64\x79\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x54\x68\x65\x72\x65\x20\x68\x61\x73\x20\x62\x65\x65\x6e\x20\x61\x20\x70\x72\x6f\x62\x6c\x65\x6d\x20\x77\x69\x74\x68\x20\x67\x65\x6e\x65\x72\x61\x74\x69\x6e\x67\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x66\x6f\x72\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x20\x49\x66\x20\x74\x68\x65\x20\x70\x72\x6f\x62\x6c\x65\x6d\x20\x70\x65\x72\x73\x69\x73\x74\x73\x2c\x20\x70\x6c\x65\x61\x73\x65\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x63\x6f\x6e\x74\x61\x63\x74\x22\x3e\x63\x6f\x6e\x74\x61\x63\x74\x3c\x2f\x61\x3e\x20\x75\x73\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x59\x6f\x75\x20\x63\x61\x6e\x20\x74\x72\x79\x20\x74\x6f\x20\x67\x65\x74\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x6d\x61\x6e\x75\x61\x6c\x6c\x79\x20\x62\x79\x20\x63\x6c\x69\x63\x6b\x69\x6e\x67\x20\x74\x68\x65\x20\x62\x75\x74\x74\x6f\x6e\x20\x62\x65\x6c\x6f\x77\x2c\x20\x6f\x72\x20\x74\x6f\x20\x69\x67\x6e\x6f\x72\x65\x20\x74\x68\x65\x20\x6d\x69\x73\x73\x69\x6e\x67\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x62\x79\x20\x63\x68\x65\x63\x6b\x69\x6e\x67\x20\x74\x68\x65\x20\x63\x68\x65\x63\x6b\x62\x6f\x78\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6e\x6f\x74\x20\x28\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x7c\x20\x69\x73\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x5f\x64\x6f\x6d\x61\x69\x6e\x29\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x4d\x61\x6b\x65\x20\x73\x75\x72\x65\x20\x74\x68\x61\x74\x20\x74\x68\x69\x73\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x65\x78\x69\x73\x74\x73\x3c\x62\x72\x3e\x3c\x63\x6f\x64\x65\x3e\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2e\x20\x43\x4e\x41\x4d\x45\x20\x5b\x5b\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x22\x41\x6c\x69\x61\x73\x43\x4e\x41\x4d\x45\x22\x20\x5d\x5d\x2e\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x6e\x6b\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x67\x65\x74\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x20\x76\x2d\x69\x66\x3d\x22\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2e\x65\x72\x72\x6f\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3d\x3d\x20\x30\x22\x3e\x54\x72\x79\x20\x74\x6f\x20\x67\x65\x74\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x61\x67\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x49\x67\x6e\x6f\x72\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x6e\x6f\x74\x20\x6e\x65\x65\x64\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x74\x72\x75\x65\x22\x20\x74\x61\x62\x69\x6e\x64\x65\x78\x3d\x22\x31\x22\x3e\x44\x65\x6c\x65\x74\x65\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x27\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x7d\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x20\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x44\x65\x6c\x65\x74\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x61\x6e\x64\x20\x61\x6c\x6c\x20\x69\x74\x73\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x3f\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x6d\x6f\x64\x61\x6c\x2d\x63\x6c\x6f\x73\x65\x2d\x62\x75\x74\x74\x6f\x6e\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x4e\x6f\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x64\x65\x6c\x65\x74\x65\x44\x6f\x6d\x61\x69\x6e\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x59\x65\x73\x2c\x20\x64\x65\x6c\x65\x74\x65\x20\x69\x74\x21\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6c\x6f\x73\x65\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x5b\x5b\x20\x69\x66\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x3e\x43\x61\x6e\x63\x65\x6c\x3c\x2f\x61\x3e\x20\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x53\x61\x76\x65\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
+var _domainSettingsHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x2f\x6a\x61\x76\x61\x73\x63\x72\x69\x70\x74\x22\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x6d\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x71\x64\x6e\x3a\x20\x22\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x49\x67\x6e\x6f\x72\x65\x3a\x20\x5b\x5b\x20\x69\x66\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x49\x67\x6e\x6f\x72\x65\x20\x5d\x5d\x74\x72\x75\x65\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x66\x61\x6c\x73\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x3a\x20\x5b\x5b\x20\x69\x66\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x44\x69\x73\x61\x62\x6c\x65\x64\x20\x5d\x5d\x74\x72\x75\x65\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x66\x61\x6c\x73\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x22\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x49\x44\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x69\x61\x73\x65\x73\x3a\x20\x22\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x2c\x20\x24\x61\x6c\x69\x61\x73\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x41\x6c\x69\x61\x73\x65\x73\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x24\x69\x20\x5d\x5d\x2c\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x24\x61\x6c\x69\x61\x73\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x69\x61\x73\x52\x65\x64\x69\x72\x65\x63\x74\x3a\x20\x5b\x5b\x20\x69\x66\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x41\x6c\x69\x61\x73\x52\x65\x64\x69\x72\x65\x63\x74\x20\x5d\x5d\x74\x72\x75\x65\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x66\x61\x6c\x73\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x2c\x5b\x5b\x20\x69\x66\x20\x2e\x44\x4e\x53\x50\x72\x6f\x76\x69\x64\x65\x72\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x6e\x73\x50\x72\x6f\x76\x69\x64\x65\x72\x3a\x20\x22\x5b\x5b\x20\x2e\x44\x4e\x53\x50\x72\x6f\x76\x69\x64\x65\x72\x20\x5d\x5d\x22\x2c\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6b\x65\x79\x54\x79\x70\x65\x3a\x20\x22\x5b\x5b\x20\x2e\x4b\x65\x79\x54\x79\x70\x65\x20\x5d\x5d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x6f\x6b\x65\x6e\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x44\x6f\x6e\x65\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x63\x73\x3a\x20\x22\x5b\x5b\x20\x77\x69\x74\x68\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x20\x5d\x5d\x5b\x5b\x20\x2e\x56\x43\x53\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x70\x6f\x52\x6f\x6f\x74\x3a\x20\x22\x5b\x5b\x20\x77\x69\x74\x68\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x20\x5d\x5d\x5b\x5b\x20\x2e\x52\x65\x70\x6f\x52\x6f\x6f\x74\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x66\x54\x79\x70\x65\x3a\x20\x22\x5b\x5b\x20\x77\x69\x74\x68\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x20\x5d\x5d\x5b\x5b\x20\x2e\x52\x65\x66\x54\x79\x70\x65\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x66\x4e\x61\x6d\x65\x3a\x20\x22\x5b\x5b\x20\x77\x69\x74\x68\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x20\x5d\x5d\x5b\x5b\x20\x2e\x52\x65\x66\x4e\x61\x6d\x65\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x67\x6f\x53\x6f\x75\x72\x63\x65\x3a\x20\x22\x5b\x5b\x20\x77\x69\x74\x68\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x20\x5d\x5d\x5b\x5b\x20\x2e\x47\x6f\x53\x6f\x75\x72\x63\x65\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x64\x69\x72\x65\x63\x74\x55\x72\x6c\x3a\x20\x22\x5b\x5b\x20\x77\x69\x74\x68\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x20\x5d\x5d\x5b\x5b\x20\x2e\x52\x65\x64\x69\x72\x65\x63\x74\x55\x52\x4c\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x44\x6f\x6e\x65\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x41\x70\x70\x6c\x79\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x5b\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x68\x61\x6e\x67\x65\x73\x3a\x20\x6e\x75\x6c\x6c\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x66\x6f\x72\x6d\x2e\x74\x6f\x6b\x65\x6e\x73\x20\x3d\x20\x5b\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2e\x66\x6f\x72\x6d\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x66\x6f\x72\x6d\x2e\x69\x73\x44\x6f\x6e\x65\x20\x3d\x20\x74\x72\x75\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x74\x61\x6e\x64\x61\x72\x64\x48\x54\x54\x50\x45\x72\x72\x6f\x72\x28\x61\x70\x70\x2e\x66\x6f\x72\x6d\x2c\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x20\x3d\x3d\x3d\x20\x6e\x75\x6c\x6c\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x66\x6f\x72\x6d\x2e\x74\x6f\x6b\x65\x6e\x73\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x5b\x27\x74\x6f\x6b\x65\x6e\x73\x27\x5d\x20\x7c\x7c\x20\x5b\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x6c\x6f\x61\x64\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x22\x2b\x74\x68\x69\x73\x2e\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x66\x71\x64\x6e\x2e\x74\x72\x69\x6d\x28\x29\x2b\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x44\x6f\x6d\x61\x69\x6e\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x44\x65\x6c\x65\x74\x65\x28\x74\x68\x69\x73\x2e\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x22\x2f\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x61\x70\x70\x2e\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x73\x2e\x76\x63\x73\x20\x21\x3d\x20\x22\x67\x69\x74\x22\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x20\x3d\x20\x22\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x4e\x61\x6d\x65\x20\x3d\x20\x22\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x69\x73\x44\x6f\x6e\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2e\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x2f\x70\x61\x63\x6b\x61\x67\x65\x2d\x64\x65\x66\x61\x75\x6c\x74\x73\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x69\x73\x44\x6f\x6e\x65\x20\x3d\x20\x74\x72\x75\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x79\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x41\x70\x70\x6c\x79\x2e\x63\x68\x61\x6e\x67\x65\x73\x20\x3d\x20\x6e\x75\x6c\x6c\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2e\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x41\x70\x70\x6c\x79\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x2f\x70\x61\x63\x6b\x61\x67\x65\x2d\x64\x65\x66\x61\x75\x6c\x74\x73\x2f\x61\x70\x70\x6c\x79\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x41\x70\x70\x6c\x79\x2e\x63\x68\x61\x6e\x67\x65\x73\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x2e\x63\x68\x61\x6e\x67\x65\x73\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x67\x65\x74\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2e\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2c\x20\x27\x2f\x69\x2f\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x72\x65\x6c\x6f\x61\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x66\x6f\x6f\x74\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x73\x20\x69\x73\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x62\x6f\x78\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x20\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x22\x20\x69\x64\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x66\x6f\x72\x6d\x22\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x77\x61\x72\x6e\x69\x6e\x67\x22\x3e\x4f\x6e\x6c\x79\x20\x6f\x77\x6e\x65\x72\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x63\x61\x6e\x20\x63\x68\x61\x6e\x67\x65\x20\x69\x74\x73\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x73\x75\x63\x63\x65\x73\x73\x22\x20\x76\x2d\x69\x66\x3d\x22\x66\x6f\x72\x6d\x2e\x69\x73\x44\x6f\x6e\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x64\x65\x6c\x65\x74\x65\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x72\x65\x6c\x6f\x61\x64\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x20\x61\x72\x65\x20\x73\x61\x76\x65\x64\x2e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x20\x76\x2d\x69\x66\x3d\x22\x21\x66\x6f\x72\x6d\x2e\x69\x73\x44\x6f\x6e\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x20\x76\x2d\x69\x66\x3d\x22\x66\x6f\x72\x6d\x2e\x74\x6f\x6b\x65\x6e\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3e\x20\x30\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x3e\x54\x6f\x20\x76\x65\x72\x69\x66\x79\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x6e\x79\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x6f\x6c\x6c\x6f\x77\x69\x6e\x67\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x6f\x72\x20\x48\x54\x54\x50\x53\x20\x66\x69\x6c\x65\x73\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x70\x72\x65\x73\x65\x6e\x74\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x44\x4e\x53\x20\x54\x58\x54\x20\x72\x65\x63\x6f\x72\x64\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x76\x2d\x66\x6f\x72\x3d\x22\x74\x6f\x6b\x65\x6e\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x74\x6f\x6b\x65\x6e\x73\x22\x20\x76\x2d\x69\x66\x3d\x22\x74\x6f\x6b\x65\x6e\x2e\x6d\x65\x74\x68\x6f\x64\x20\x3d\x3d\x20\x27\x64\x6e\x73\x2d\x74\x78\x74\x27\x22\x3e\x3c\x63\x6f\x64\x65\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x74\x6f\x6b\x65\x6e\x2e\x66\x71\x64\x6e\x20\x2b\x27\x20\x54\x58\x54\x20\x27\x2b\x20\x74\x6f\x6b\x65\x6e\x2e\x74\x6f\x6b\x65\x6e\x22\x3e\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x4b\x65\x65\x70\x20\x69\x6e\x20\x6d\x69\x6e\x64\x20\x74\x68\x61\x74\x20\x44\x4e\x53\x20\x70\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x20\x72\x65\x71\x75\x69\x72\x65\x73\x20\x73\x6f\x6d\x65\x20\x74\x69\x6d\x65\x20\x61\x66\x74\x65\x72\x20\x74\x68\x65\x20\x72\x65\x63\x6f\x72\x64\x20\x69\x73\x20\x73\x65\x74\x2c\x20\x64\x65\x70\x65\x6e\x64\x69\x6e\x67\x20\x6f\x6e\x20\x54\x54\x4c\x20\x76\x61\x6c\x75\x65\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x48\x54\x54\x50\x53\x20\x66\x69\x6c\x65\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x74\x6f\x6b\x65\x6e\x20\x61\x73\x20\x69\x74\x73\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x76\x2d\x66\x6f\x72\x3d\x22\x74\x6f\x6b\x65\x6e\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x74\x6f\x6b\x65\x6e\x73\x22\x20\x76\x2d\x69\x66\x3d\x22\x74\x6f\x6b\x65\x6e\x2e\x6d\x65\x74\x68\x6f\x64\x20\x3d\x3d\x20\x27\x68\x74\x74\x70\x2d\x66\x69\x6c\x65\x27\x22\x3e\x3c\x63\x6f\x64\x65\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x74\x6f\x6b\x65\x6e\x2e\x75\x72\x6c\x22\x3e\x3c\x2f\x63\x6f\x64\x65\x3e\x20\x3c\x63\x6f\x64\x65\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x74\x6f\x6b\x65\x6e\x2e\x74\x6f\x6b\x65\x6e\x22\x3e\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x54\x68\x65\x20\x66\x69\x6c\x65\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x73\x65\x72\x76\x65\x64\x20\x77\x69\x74\x68\x20\x73\x74\x61\x74\x75\x73\x20\x32\x30\x30\x20\x4f\x4b\x2c\x20\x77\x69\x74\x68\x6f\x75\x74\x20\x72\x65\x64\x69\x72\x65\x63\x74\x73\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x41\x66\x74\x65\x72\x20\x79\x6f\x75\x20\x61\x64\x64\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x6f\x72\x20\x48\x54\x54\x50\x53\x20\x66\x69\x6c\x65\x2c\x20\x63\x6f\x6d\x65\x20\x62\x61\x63\x6b\x20\x74\x6f\x20\x74\x68\x69\x73\x20\x66\x6f\x72\x6d\x20\x61\x6e\x64\x20\x73\x75\x62\x6d\x69\x74\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x67\x61\x69\x6e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x46\x75\x6c\x6c\x79\x20\x71\x75\x61\x6c\x69\x66\x69\x65\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x66\x71\x64\x6e\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x66\x71\x64\x6e\x7d\x22\x20\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x66\x71\x64\x6e\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6e\x6f\x74\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x73\x57\x69\x6c\x64\x63\x61\x72\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x41\x6c\x69\x61\x73\x65\x73\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x6c\x69\x61\x73\x65\x73\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x67\x6f\x6c\x61\x6e\x67\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2c\x20\x67\x6f\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x6f\x72\x67\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x61\x6c\x69\x61\x73\x65\x73\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x61\x6c\x69\x61\x73\x65\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x43\x6f\x6d\x6d\x61\x20\x73\x65\x70\x61\x72\x61\x74\x65\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x73\x20\x74\x68\x61\x74\x20\x73\x65\x72\x76\x65\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x2e\x20\x45\x76\x65\x72\x79\x20\x61\x6c\x69\x61\x73\x20\x6e\x65\x65\x64\x73\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x61\x6e\x64\x20\x61\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x61\x73\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x6c\x69\x61\x73\x52\x65\x64\x69\x72\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x52\x65\x64\x69\x72\x65\x63\x74\x20\x61\x6c\x69\x61\x73\x65\x73\x20\x74\x6f\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x42\x72\x6f\x77\x73\x65\x72\x73\x20\x61\x6e\x64\x20\x3c\x69\x3e\x67\x6f\x20\x67\x65\x74\x3c\x2f\x69\x3e\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x61\x72\x65\x20\x72\x65\x64\x69\x72\x65\x63\x74\x65\x64\x20\x74\x6f\x20\x74\x68\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x75\x6e\x64\x65\x72\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2c\x20\x69\x6e\x73\x74\x65\x61\x64\x20\x6f\x66\x20\x73\x65\x72\x76\x69\x6e\x67\x20\x69\x74\x20\x75\x6e\x64\x65\x72\x20\x74\x68\x65\x20\x61\x6c\x69\x61\x73\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6f\x72\x20\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x49\x44\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x4f\x77\x6e\x65\x72\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x49\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x22\x3e\x5b\x5b\x20\x2e\x55\x73\x65\x72\x2e\x55\x73\x65\x72\x6e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x6f\x72\x67\x20\x3a\x3d\x20\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x5b\x5b\x20\x24\x6f\x72\x67\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x5b\x5b\x20\x24\x6f\x72\x67\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x49\x64\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x69\x73\x61\x62\x6c\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x44\x4e\x53\x50\x72\x6f\x76\x69\x64\x65\x72\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x64\x6e\x73\x50\x72\x6f\x76\x69\x64\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x22\x3e\x48\x54\x54\x50\x20\x72\x65\x71\x75\x65\x73\x74\x20\x28\x68\x74\x74\x70\x2d\x30\x31\x29\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x3a\x3d\x20\x2e\x44\x4e\x53\x50\x72\x6f\x76\x69\x64\x65\x72\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x5b\x5b\x20\x24\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x5d\x5d\x22\x3e\x44\x4e\x53\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x5b\x5b\x20\x24\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x5d\x5d\x20\x28\x64\x6e\x73\x2d\x30\x31\x29\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x64\x6e\x73\x50\x72\x6f\x76\x69\x64\x65\x72\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x44\x4e\x53\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x63\x72\x65\x61\x74\x65\x73\x20\x54\x58\x54\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x74\x6f\x20\x70\x72\x6f\x76\x65\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x2c\x20\x77\x68\x69\x63\x68\x20\x69\x73\x20\x72\x65\x71\x75\x69\x72\x65\x64\x20\x66\x6f\x72\x20\x68\x6f\x73\x74\x73\x20\x74\x68\x61\x74\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x72\x65\x61\x63\x68\x61\x62\x6c\x65\x20\x62\x79\x20\x74\x68\x65\x20\x41\x43\x4d\x45\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x6f\x76\x65\x72\x20\x48\x54\x54\x50\x2c\x20\x61\x6e\x64\x20\x66\x6f\x72\x20\x61\x20\x73\x69\x6e\x67\x6c\x65\x20\x77\x69\x6c\x64\x63\x61\x72\x64\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x6f\x66\x20\x61\x20\x77\x69\x6c\x64\x63\x61\x72\x64\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x6b\x65\x79\x20\x74\x79\x70\x65\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x6b\x65\x79\x54\x79\x70\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x22\x3e\x44\x65\x66\x61\x75\x6c\x74\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x6e\x66\x6f\x20\x3a\x3d\x20\x2e\x4b\x65\x79\x54\x79\x70\x65\x49\x6e\x66\x6f\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x5b\x5b\x20\x24\x69\x6e\x66\x6f\x2e\x4b\x65\x79\x54\x79\x70\x65\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x69\x6e\x66\x6f\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x6b\x65\x79\x54\x79\x70\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x57\x69\x74\x68\x20\x62\x6f\x74\x68\x20\x45\x43\x44\x53\x41\x20\x61\x6e\x64\x20\x52\x53\x41\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x73\x2c\x20\x74\x68\x65\x20\x52\x53\x41\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x73\x65\x72\x76\x65\x64\x20\x6f\x6e\x6c\x79\x20\x74\x6f\x20\x63\x6c\x69\x65\x6e\x74\x73\x20\x74\x68\x61\x74\x20\x64\x6f\x20\x6e\x6f\x74\x20\x73\x75\x70\x70\x6f\x72\x74\x20\x45\x43\x44\x53\x41\x2e\x20\x54\x68\x65\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x6f\x62\x74\x61\x69\x6e\x65\x64\x20\x61\x67\x61\x69\x6e\x20\x77\x68\x65\x6e\x20\x74\x68\x65\x20\x6b\x65\x79\x20\x74\x79\x70\x65\x20\x69\x73\x20\x63\x68\x61\x6e\x67\x65\x64\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x49\x73\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x42\x65\x69\x6e\x67\x4f\x62\x74\x61\x69\x6e\x65\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x77\x61\x72\x6e\x69\x6e\x67\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x62\x65\x69\x6e\x67\x20\x6f\x62\x74\x61\x69\x6e\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x2e\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x45\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x73\x75\x63\x63\x65\x73\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x76\x61\x6c\x69\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x62\x6f\x64\x79\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x45\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x20\x74\x69\x6d\x65\x3a\x20\x5b\x5b\x20\x2e\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x45\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x20\x5d\x5d\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x69\x66\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x73\x57\x69\x6c\x64\x63\x61\x72\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x69\x6e\x66\x6f\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x72\x65\x71\x75\x69\x72\x65\x73\x20\x61\x20\x44\x4e\x53\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x62\x6f\x64\x79\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x41\x6c\x6c\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x20\x63\x6f\x76\x65\x72\x65\x64\x20\x62\x79\x20\x74\x68\x69\x73\x20\x77\x69\x6c\x64\x63\x61\x72\x64\x20\x64\x6f\x6d\x61\x69\x6e\x2c\x20\x74\x68\x61\x74\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x72\x65\x67\x69\x73\x74\x65\x72\x65\x64\x20\x61\x73\x20\x73\x65\x70\x61\x72\x61\x74\x65\x20\x64\x6f\x6d\x61\x69\x6e\x73\x2c\x20\x61\x72\x65\x20\x73\x65\x72\x76\x65\x64\x20\x77\x69\x74\x68\x20\x61\x20\x73\x69\x6e\x67\x6c\x65\x20\x77\x69\x6c\x64\x63\x61\x72\x64\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2e\x20\x49\x74\x20\x69\x73\x20\x6f\x62\x74\x61\x69\x6e\x65\x64\x20\x6f\x6e\x6c\x79\x20\x69\x66\x20\x61\x20\x44\x4e\x53\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x69\x73\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x20\x66\x6f\x72\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x49\x67\x6e\x6f\x72\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x6e\x6f\x74\x20\x6e\x65\x65\x64\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x68\x65\x61\x64\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x6e\x6f\x74\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x62\x6f\x64\x79\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x54\x68\x65\x72\x65\x20\x68\x61\x73\x20\x62\x65\x65\x6e\x20\x61\x20\x70\x72\x6f\x62\x6c\x65\x6d\x20\x77\x69\x74\x68\x20\x67\x65\x6e\x65\x72\x61\x74\x69\x6e\x67\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x66\x6f\x72\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x20\x49\x66\x20\x74\x68\x65\x20\x70\x72\x6f\x62\x6c\x65\x6d\x20\x70\x65\x72\x73\x69\x73\x74\x73\x2c\x20\x70\x6c\x65\x61\x73\x65\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x63\x6f\x6e\x74\x61\x63\x74\x22\x3e\x63\x6f\x6e\x74\x61\x63\x74\x3c\x2f\x61\x3e\x20\x75\x73\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x59\x6f\x75\x20\x63\x61\x6e\x20\x74\x72\x79\x20\x74\x6f\x20\x67\x65\x74\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x6d\x61\x6e\x75\x61\x6c\x6c\x79\x20\x62\x79\x20\x63\x6c\x69\x63\x6b\x69\x6e\x67\x20\x74\x68\x65\x20\x62\x75\x74\x74\x6f\x6e\x20\x62\x65\x6c\x6f\x77\x2c\x20\x6f\x72\x20\x74\x6f\x20\x69\x67\x6e\x6f\x72\x65\x20\x74\x68\x65\x20\x6d\x69\x73\x73\x69\x6e\x67\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x62\x79\x20\x63\x68\x65\x63\x6b\x69\x6e\x67\x20\x74\x68\x65\x20\x63\x68\x65\x63\x6b\x62\x6f\x78\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6e\x6f\x74\x20\x28\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x7c\x20\x69\x73\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x5f\x64\x6f\x6d\x61\x69\x6e\x29\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x4d\x61\x6b\x65\x20\x73\x75\x72\x65\x20\x74\x68\x61\x74\x20\x74\x68\x69\x73\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x65\x78\x69\x73\x74\x73\x3c\x62\x72\x3e\x3c\x63\x6f\x64\x65\x3e\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2e\x20\x43\x4e\x41\x4d\x45\x20\x5b\x5b\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x22\x41\x6c\x69\x61\x73\x43\x4e\x41\x4d\x45\x22\x20\x5d\x5d\x2e\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x6e\x6b\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x67\x65\x74\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x20\x76\x2d\x69\x66\x3d\x22\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2e\x65\x72\x72\x6f\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3d\x3d\x20\x30\x22\x3e\x54\x72\x79\x20\x74\x6f\x20\x67\x65\x74\x20\x54\x4c\x53\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x61\x67\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x49\x67\x6e\x6f\x72\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x6e\x6f\x74\x20\x6e\x65\x65\x64\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x74\x72\x75\x65\x22\x20\x74\x61\x62\x69\x6e\x64\x65\x78\x3d\x22\x31\x22\x3e\x44\x65\x6c\x65\x74\x65\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x27\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x7d\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x20\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x44\x65\x6c\x65\x74\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x61\x6e\x64\x20\x61\x6c\x6c\x20\x69\x74\x73\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x3f\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x6d\x6f\x64\x61\x6c\x2d\x63\x6c\x6f\x73\x65\x2d\x62\x75\x74\x74\x6f\x6e\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x4e\x6f\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x64\x65\x6c\x65\x74\x65\x44\x6f\x6d\x61\x69\x6e\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x59\x65\x73\x2c\x20\x64\x65\x6c\x65\x74\x65\x20\x69\x74\x21\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6c\x6f\x73\x65\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x44\x65\x6c\x65\x74\x65\x2e\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x5b\x5b\x20\x69\x66\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x3e\x43\x61\x6e\x63\x65\x6c\x3c\x2f\x61\x3e\x20\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x66\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x53\x61\x76\x65\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x0a\x20\x20\x20\x20\x3c\x68\x72\x3e\x0a\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x75\x62\x74\x69\x74\x6c\x65\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x73\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x73\x75\x63\x63\x65\x73\x73\x22\x20\x76\x2d\x69\x66\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x69\x73\x44\x6f\x6e\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x50\x61\x63\x6b\x61\x67\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x73\x20\x61\x72\x65\x20\x73\x61\x76\x65\x64\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x4e\x65\x77\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x69\x6e\x68\x65\x72\x69\x74\x20\x74\x68\x65\x73\x65\x20\x76\x61\x6c\x75\x65\x73\x20\x77\x68\x65\x6e\x20\x74\x68\x65\x79\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x2e\x20\x49\x6e\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x2c\x20\x47\x6f\x20\x53\x6f\x75\x72\x63\x65\x20\x61\x6e\x64\x20\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x2c\x20\x3c\x63\x6f\x64\x65\x3e\x7b\x64\x6f\x6d\x61\x69\x6e\x7d\x3c\x2f\x63\x6f\x64\x65\x3e\x20\x69\x73\x20\x72\x65\x70\x6c\x61\x63\x65\x64\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x20\x61\x6e\x64\x20\x3c\x63\x6f\x64\x65\x3e\x7b\x70\x61\x74\x68\x7d\x3c\x2f\x63\x6f\x64\x65\x3e\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x70\x61\x74\x68\x2c\x20\x66\x6f\x72\x20\x65\x78\x61\x6d\x70\x6c\x65\x20\x3c\x63\x6f\x64\x65\x3e\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x7b\x70\x61\x74\x68\x7d\x3c\x2f\x63\x6f\x64\x65\x3e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x20\x68\x61\x73\x2d\x61\x64\x64\x6f\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x69\x73\x2d\x65\x78\x70\x61\x6e\x64\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x70\x6f\x52\x6f\x6f\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x70\x6f\x52\x6f\x6f\x74\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x73\x2e\x76\x63\x73\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x76\x63\x73\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x22\x3e\x4e\x6f\x6e\x65\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x76\x63\x73\x20\x3a\x3d\x20\x2e\x56\x43\x53\x49\x6e\x66\x6f\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x5b\x5b\x20\x24\x76\x63\x73\x2e\x56\x43\x53\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x76\x63\x73\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x20\x76\x2d\x69\x66\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x73\x2e\x76\x63\x73\x20\x3d\x3d\x20\x27\x67\x69\x74\x27\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x22\x3e\x4d\x61\x73\x74\x65\x72\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x62\x72\x61\x6e\x63\x68\x22\x3e\x42\x72\x61\x6e\x63\x68\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x74\x61\x67\x22\x3e\x54\x61\x67\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x4e\x61\x6d\x65\x22\x20\x76\x2d\x69\x66\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x20\x26\x26\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x73\x2e\x76\x63\x73\x20\x3d\x3d\x20\x27\x67\x69\x74\x27\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x66\x4e\x61\x6d\x65\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x76\x63\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x70\x6f\x52\x6f\x6f\x74\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x66\x4e\x61\x6d\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x66\x54\x79\x70\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x47\x6f\x20\x53\x6f\x75\x72\x63\x65\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x73\x2e\x67\x6f\x53\x6f\x75\x72\x63\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x67\x6f\x53\x6f\x75\x72\x63\x65\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x67\x6f\x53\x6f\x75\x72\x63\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x73\x2e\x72\x65\x64\x69\x72\x65\x63\x74\x55\x72\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x64\x69\x72\x65\x63\x74\x55\x72\x6c\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x65\x64\x69\x72\x65\x63\x74\x55\x72\x6c\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x53\x61\x76\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x73\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x61\x70\x70\x6c\x79\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x41\x70\x70\x6c\x79\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x41\x70\x70\x6c\x79\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x73\x75\x63\x63\x65\x73\x73\x22\x20\x76\x2d\x69\x66\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x41\x70\x70\x6c\x79\x2e\x63\x68\x61\x6e\x67\x65\x73\x20\x21\x3d\x3d\x20\x6e\x75\x6c\x6c\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x50\x61\x63\x6b\x61\x67\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x73\x20\x61\x72\x65\x20\x61\x70\x70\x6c\x69\x65\x64\x20\x74\x6f\x20\x7b\x7b\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x41\x70\x70\x6c\x79\x2e\x63\x68\x61\x6e\x67\x65\x73\x20\x7d\x7d\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x41\x70\x70\x6c\x79\x20\x73\x61\x76\x65\x64\x20\x64\x65\x66\x61\x75\x6c\x74\x73\x20\x74\x6f\x20\x65\x78\x69\x73\x74\x69\x6e\x67\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x20\x69\x73\x2d\x67\x72\x6f\x75\x70\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x66\x69\x65\x6c\x64\x20\x3a\x3d\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x46\x69\x65\x6c\x64\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x20\x76\x61\x6c\x75\x65\x3d\x22\x5b\x5b\x20\x24\x66\x69\x65\x6c\x64\x2e\x46\x69\x65\x6c\x64\x20\x5d\x5d\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x41\x70\x70\x6c\x79\x2e\x66\x69\x65\x6c\x64\x73\x2e\x66\x69\x65\x6c\x64\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x24\x66\x69\x65\x6c\x64\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x41\x70\x70\x6c\x79\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x66\x69\x65\x6c\x64\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x77\x61\x72\x6e\x69\x6e\x67\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x44\x65\x66\x61\x75\x6c\x74\x73\x41\x70\x70\x6c\x79\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x41\x70\x70\x6c\x79\x20\x74\x6f\x20\x61\x6c\x6c\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
 
 func domainSettingsHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -381,12 +405,12 @@ func domainSettingsHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "domain-settings.html", size: 9434, mode: os.FileMode(420), modTime: time.Unix(1490556242, 0)}
+	info := bindataFileInfo{name: "domain-settings.html", size: 20918, mode: os.FileMode(420), modTime: time.Unix(1792339846, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _domainTeamHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x74\x65\x61\x6d\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x66\x6f\x6f\x74\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x73\x20\x69\x73\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x62\x6f\x78\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x74\x65\x61\x6d\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x20\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x22\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x77\x61\x72\x6e\x69\x6e\x67\x22\x3e\x4f\x6e\x6c\x79\x20\x6f\x77\x6e\x65\x72\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x63\x61\x6e\x20\x6d\x61\x6e\x61\x67\x65\x20\x74\x68\x65\x20\x74\x65\x61\x6d\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x55\x73\x65\x72\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x6c\x65\x20\x63\x61\x72\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x4e\x61\x6d\x65\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x49\x44\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x20\x63\x6c\x61\x73\x73\x3d\x22\x72\x69\x67\x68\x74\x20\x61\x6c\x69\x67\x6e\x65\x64\x22\x3e\x52\x65\x76\x6f\x6b\x65\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x75\x73\x65\x72\x20\x3a\x3d\x20\x2e\x55\x73\x65\x72\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x75\x73\x65\x72\x2f\x5b\x5b\x20\x24\x75\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x75\x73\x65\x72\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x61\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x63\x6f\x64\x65\x3e\x5b\x5b\x20\x24\x75\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6c\x61\x73\x73\x3d\x22\x72\x69\x67\x68\x74\x20\x61\x6c\x69\x67\x6e\x65\x64\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x75\x73\x65\x72\x2f\x5b\x5b\x20\x24\x75\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x2f\x72\x65\x76\x6f\x6b\x65\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x72\x65\x6d\x6f\x76\x65\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6e\x6f\x74\x20\x2e\x55\x73\x65\x72\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x54\x65\x61\x6d\x20\x69\x73\x20\x61\x20\x73\x65\x74\x20\x6f\x66\x20\x75\x73\x65\x72\x73\x2c\x20\x61\x73\x73\x6f\x63\x69\x61\x74\x65\x64\x20\x77\x69\x74\x68\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x2c\x20\x77\x68\x69\x63\x68\x20\x63\x61\x6e\x20\x61\x64\x64\x2c\x20\x63\x68\x61\x6e\x67\x65\x20\x61\x6e\x64\x20\x72\x65\x6d\x6f\x76\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x2e\x20\x54\x68\x65\x79\x20\x61\x72\x65\x20\x61\x6c\x73\x6f\x20\x61\x62\x6c\x65\x20\x74\x6f\x20\x73\x65\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x44\x6f\x6d\x61\x69\x6e\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x20\x61\x6e\x64\x20\x74\x65\x61\x6d\x20\x6d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x20\x61\x72\x65\x20\x6f\x6e\x6c\x79\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x66\x6f\x72\x20\x61\x20\x73\x69\x6e\x67\x6c\x65\x20\x75\x73\x65\x72\x20\x63\x61\x6c\x6c\x65\x64\x20\x6f\x77\x6e\x65\x72\x2c\x20\x77\x68\x69\x63\x68\x20\x69\x73\x20\x79\x6f\x75\x20\x66\x6f\x72\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x20\x54\x68\x69\x73\x20\x70\x72\x69\x76\x69\x6c\x65\x67\x65\x73\x20\x61\x72\x65\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x61\x62\x6c\x65\x20\x74\x6f\x20\x61\x6e\x6f\x74\x68\x65\x72\x20\x75\x73\x65\x72\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x75\x73\x65\x72\x22\x3e\x47\x72\x61\x6e\x74\x20\x61\x63\x63\x65\x73\x73\x20\x74\x6f\x20\x61\x20\x75\x73\x65\x72\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x6f\x77\x6e\x65\x72\x22\x3e\x43\x68\x61\x6e\x67\x65\x20\x6f\x77\x6e\x65\x72\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
+var _domainTeamHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x74\x65\x61\x6d\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x75\x73\x65\x72\x20\x3a\x3d\x20\x2e\x55\x73\x65\x72\x73\x20\x5d\x5d\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x2d\x72\x6f\x6c\x65\x2d\x5b\x5b\x20\x24\x75\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x64\x3a\x20\x22\x5b\x5b\x20\x24\x75\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x6f\x6c\x65\x3a\x20\x22\x5b\x5b\x20\x69\x6e\x64\x65\x78\x20\x24\x2e\x52\x6f\x6c\x65\x73\x20\x24\x75\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x2f\x75\x73\x65\x72\x2f\x72\x6f\x6c\x65\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x66\x6f\x6f\x74\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x73\x20\x69\x73\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x62\x6f\x78\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x74\x65\x61\x6d\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x20\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x22\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x77\x61\x72\x6e\x69\x6e\x67\x22\x3e\x4f\x6e\x6c\x79\x20\x6f\x77\x6e\x65\x72\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x63\x61\x6e\x20\x6d\x61\x6e\x61\x67\x65\x20\x74\x68\x65\x20\x74\x65\x61\x6d\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x5b\x5b\x20\x69\x66\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x49\x73\x45\x78\x70\x69\x72\x65\x64\x20\x5d\x5d\x20\x69\x73\x2d\x77\x61\x72\x6e\x69\x6e\x67\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x20\x69\x73\x2d\x69\x6e\x66\x6f\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x3e\x4f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x74\x6f\x20\x75\x73\x65\x72\x20\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x55\x73\x65\x72\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x20\x5b\x5b\x20\x69\x66\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x49\x73\x45\x78\x70\x69\x72\x65\x64\x20\x5d\x5d\x68\x61\x73\x20\x65\x78\x70\x69\x72\x65\x64\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x69\x73\x20\x77\x61\x69\x74\x69\x6e\x67\x20\x66\x6f\x72\x20\x61\x63\x63\x65\x70\x74\x61\x6e\x63\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x2e\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x72\x61\x6e\x73\x66\x65\x72\x2f\x5b\x5b\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x44\x6f\x6d\x61\x69\x6e\x49\x44\x20\x5d\x5d\x22\x3e\x53\x68\x6f\x77\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x3c\x2f\x61\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x55\x73\x65\x72\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x6c\x65\x20\x63\x61\x72\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x4e\x61\x6d\x65\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x49\x44\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x52\x6f\x6c\x65\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x20\x63\x6c\x61\x73\x73\x3d\x22\x72\x69\x67\x68\x74\x20\x61\x6c\x69\x67\x6e\x65\x64\x22\x3e\x52\x65\x76\x6f\x6b\x65\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x75\x73\x65\x72\x20\x3a\x3d\x20\x2e\x55\x73\x65\x72\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x75\x73\x65\x72\x2f\x5b\x5b\x20\x24\x75\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x75\x73\x65\x72\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x61\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x63\x6f\x64\x65\x3e\x5b\x5b\x20\x24\x75\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x2d\x72\x6f\x6c\x65\x2d\x5b\x5b\x20\x24\x75\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x72\x6f\x6c\x65\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x68\x61\x6e\x67\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x4d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x76\x69\x65\x77\x65\x72\x22\x3e\x56\x69\x65\x77\x65\x72\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6c\x61\x73\x73\x3d\x22\x72\x69\x67\x68\x74\x20\x61\x6c\x69\x67\x6e\x65\x64\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x75\x73\x65\x72\x2f\x5b\x5b\x20\x24\x75\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x2f\x72\x65\x76\x6f\x6b\x65\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x72\x65\x6d\x6f\x76\x65\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6e\x6f\x74\x20\x2e\x55\x73\x65\x72\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x54\x65\x61\x6d\x20\x69\x73\x20\x61\x20\x73\x65\x74\x20\x6f\x66\x20\x75\x73\x65\x72\x73\x2c\x20\x61\x73\x73\x6f\x63\x69\x61\x74\x65\x64\x20\x77\x69\x74\x68\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x2c\x20\x77\x68\x69\x63\x68\x20\x63\x61\x6e\x20\x73\x65\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x6e\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x20\x4d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x73\x20\x63\x61\x6e\x20\x61\x6c\x73\x6f\x20\x61\x64\x64\x2c\x20\x63\x68\x61\x6e\x67\x65\x20\x61\x6e\x64\x20\x72\x65\x6d\x6f\x76\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x2c\x20\x77\x68\x69\x6c\x65\x20\x76\x69\x65\x77\x65\x72\x73\x20\x68\x61\x76\x65\x20\x72\x65\x61\x64\x20\x6f\x6e\x6c\x79\x20\x61\x63\x63\x65\x73\x73\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x44\x6f\x6d\x61\x69\x6e\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x20\x61\x6e\x64\x20\x74\x65\x61\x6d\x20\x6d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x20\x61\x72\x65\x20\x6f\x6e\x6c\x79\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x66\x6f\x72\x20\x61\x20\x73\x69\x6e\x67\x6c\x65\x20\x75\x73\x65\x72\x20\x63\x61\x6c\x6c\x65\x64\x20\x6f\x77\x6e\x65\x72\x2c\x20\x77\x68\x69\x63\x68\x20\x69\x73\x20\x79\x6f\x75\x20\x66\x6f\x72\x20\x74\x68\x69\x73\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x20\x54\x68\x69\x73\x20\x70\x72\x69\x76\x69\x6c\x65\x67\x65\x73\x20\x61\x72\x65\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x61\x62\x6c\x65\x20\x74\x6f\x20\x61\x6e\x6f\x74\x68\x65\x72\x20\x75\x73\x65\x72\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x75\x73\x65\x72\x22\x3e\x47\x72\x61\x6e\x74\x20\x61\x63\x63\x65\x73\x73\x20\x74\x6f\x20\x61\x20\x75\x73\x65\x72\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x6f\x77\x6e\x65\x72\x22\x3e\x43\x68\x61\x6e\x67\x65\x20\x6f\x77\x6e\x65\x72\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
 
 func domainTeamHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -401,12 +425,32 @@ func domainTeamHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "domain-team.html", size: 2826, mode: os.FileMode(420), modTime: time.Unix(1490556242, 0)}
+	info := bindataFileInfo{name: "domain-team.html", size: 4339, mode: os.FileMode(420), modTime: time.Unix(1792330793, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _domainTransferHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x37\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x72\x61\x6e\x73\x66\x65\x72\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x61\x63\x63\x65\x70\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x44\x6f\x6d\x61\x69\x6e\x49\x44\x20\x5d\x5d\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x6e\x63\x65\x6c\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x44\x65\x6c\x65\x74\x65\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x44\x6f\x6d\x61\x69\x6e\x49\x44\x20\x5d\x5d\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x22\x2f\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x75\x62\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x0a\x20\x20\x20\x20\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x20\x69\x73\x2d\x6f\x66\x66\x73\x65\x74\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x54\x6f\x55\x73\x65\x72\x49\x44\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x55\x73\x65\x72\x20\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x2e\x46\x72\x6f\x6d\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x69\x66\x20\x2e\x46\x72\x6f\x6d\x2e\x55\x73\x65\x72\x6e\x61\x6d\x65\x20\x5d\x5d\x20\x28\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x2e\x46\x72\x6f\x6d\x2e\x55\x73\x65\x72\x6e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x29\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x20\x77\x61\x6e\x74\x73\x20\x74\x6f\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x74\x68\x65\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x6f\x66\x20\x64\x6f\x6d\x61\x69\x6e\x20\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x20\x74\x6f\x20\x79\x6f\x75\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x4f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x6f\x66\x20\x64\x6f\x6d\x61\x69\x6e\x20\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x20\x69\x73\x20\x6f\x66\x66\x65\x72\x65\x64\x20\x74\x6f\x20\x75\x73\x65\x72\x20\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x2e\x54\x6f\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x69\x66\x20\x2e\x54\x6f\x2e\x55\x73\x65\x72\x6e\x61\x6d\x65\x20\x5d\x5d\x20\x28\x3c\x73\x74\x72\x6f\x6e\x67\x3e\x5b\x5b\x20\x2e\x54\x6f\x2e\x55\x73\x65\x72\x6e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x29\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x49\x44\x20\x3c\x63\x6f\x64\x65\x3e\x5b\x5b\x20\x2e\x54\x6f\x2e\x49\x44\x20\x5d\x5d\x3c\x2f\x63\x6f\x64\x65\x3e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x49\x73\x45\x78\x70\x69\x72\x65\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x77\x61\x72\x6e\x69\x6e\x67\x22\x3e\x54\x68\x69\x73\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x72\x65\x71\x75\x65\x73\x74\x20\x65\x78\x70\x69\x72\x65\x64\x20\x61\x74\x20\x5b\x5b\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x45\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x2e\x55\x54\x43\x2e\x46\x6f\x72\x6d\x61\x74\x20\x22\x32\x30\x30\x36\x2d\x30\x31\x2d\x30\x32\x20\x31\x35\x3a\x30\x34\x3a\x30\x35\x20\x4d\x53\x54\x22\x20\x5d\x5d\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x54\x68\x69\x73\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x72\x65\x71\x75\x65\x73\x74\x20\x65\x78\x70\x69\x72\x65\x73\x20\x61\x74\x20\x5b\x5b\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x45\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x54\x69\x6d\x65\x2e\x55\x54\x43\x2e\x46\x6f\x72\x6d\x61\x74\x20\x22\x32\x30\x30\x36\x2d\x30\x31\x2d\x30\x32\x20\x31\x35\x3a\x30\x34\x3a\x30\x35\x20\x4d\x53\x54\x22\x20\x5d\x5d\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x72\x61\x6e\x73\x66\x65\x72\x2d\x66\x6f\x72\x6d\x22\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x61\x63\x63\x65\x70\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x54\x6f\x55\x73\x65\x72\x49\x44\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x63\x61\x6e\x63\x65\x6c\x22\x3e\x44\x65\x63\x6c\x69\x6e\x65\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6e\x6f\x74\x20\x2e\x54\x72\x61\x6e\x73\x66\x65\x72\x2e\x49\x73\x45\x78\x70\x69\x72\x65\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x41\x63\x63\x65\x70\x74\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x63\x61\x6e\x63\x65\x6c\x22\x3e\x43\x61\x6e\x63\x65\x6c\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a"
+
+func domainTransferHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_domainTransferHtml,
+		"domain-transfer.html",
+	)
+}
+
+func domainTransferHtml() (*asset, error) {
+	bytes, err := domainTransferHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "domain-transfer.html", size: 3272, mode: os.FileMode(420), modTime: time.Unix(1792330468, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _domainUserGrantHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x67\x72\x61\x6e\x74\x20\x75\x73\x65\x72\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x64\x3a\x20\x22\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x2f\x75\x73\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x66\x6f\x6f\x74\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x73\x20\x69\x73\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x62\x6f\x78\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x74\x65\x61\x6d\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x20\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x6c\x65\x66\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x20\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x59\x6f\x75\x20\x63\x61\x6e\x20\x67\x72\x61\x6e\x74\x20\x61\x63\x63\x65\x73\x73\x20\x74\x6f\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x6e\x64\x20\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x20\x74\x6f\x20\x61\x6e\x6f\x74\x68\x65\x72\x20\x75\x73\x65\x72\x20\x62\x79\x20\x65\x2d\x6d\x61\x69\x6c\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x49\x44\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x55\x73\x65\x72\x20\x65\x2d\x6d\x61\x69\x6c\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x49\x44\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x69\x64\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x69\x64\x7d\x22\x20\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x69\x64\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x43\x61\x6e\x63\x65\x6c\x3c\x2f\x61\x3e\x20\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x47\x72\x61\x6e\x74\x20\x61\x63\x63\x65\x73\x73\x20\x74\x6f\x20\x75\x73\x65\x72\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
+var _domainUserGrantHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x20\x67\x72\x61\x6e\x74\x20\x75\x73\x65\x72\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x64\x3a\x20\x22\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x6f\x6c\x65\x3a\x20\x22\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x2f\x75\x73\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x66\x6f\x6f\x74\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x73\x20\x69\x73\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x20\x69\x73\x2d\x62\x6f\x78\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x50\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x53\x65\x74\x74\x69\x6e\x67\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x22\x3e\x43\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x44\x6f\x6d\x61\x69\x6e\x20\x74\x65\x61\x6d\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x24\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x49\x44\x20\x5d\x5d\x20\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x20\x69\x73\x2d\x66\x75\x6c\x6c\x77\x69\x64\x74\x68\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x22\x3e\x41\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x61\x72\x72\x6f\x77\x2d\x6c\x65\x66\x74\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x20\x54\x65\x61\x6d\x3c\x2f\x61\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x59\x6f\x75\x20\x63\x61\x6e\x20\x67\x72\x61\x6e\x74\x20\x61\x63\x63\x65\x73\x73\x20\x74\x6f\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x6e\x64\x20\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x20\x74\x6f\x20\x61\x6e\x6f\x74\x68\x65\x72\x20\x75\x73\x65\x72\x20\x62\x79\x20\x65\x2d\x6d\x61\x69\x6c\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x49\x44\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x55\x73\x65\x72\x20\x65\x2d\x6d\x61\x69\x6c\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x49\x44\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x69\x64\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x69\x64\x7d\x22\x20\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x69\x64\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x52\x6f\x6c\x65\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x72\x6f\x6c\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x6f\x6c\x65\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x4d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x20\x2d\x20\x63\x61\x6e\x20\x61\x64\x64\x2c\x20\x63\x68\x61\x6e\x67\x65\x20\x61\x6e\x64\x20\x72\x65\x6d\x6f\x76\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x76\x69\x65\x77\x65\x72\x22\x3e\x56\x69\x65\x77\x65\x72\x20\x2d\x20\x63\x61\x6e\x20\x6f\x6e\x6c\x79\x20\x73\x65\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x6e\x64\x20\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x72\x6f\x6c\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x2f\x74\x65\x61\x6d\x22\x3e\x43\x61\x6e\x63\x65\x6c\x3c\x2f\x61\x3e\x20\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x47\x72\x61\x6e\x74\x20\x61\x63\x63\x65\x73\x73\x20\x74\x6f\x20\x75\x73\x65\x72\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
 
 func domainUserGrantHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -421,7 +465,7 @@ func domainUserGrantHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "domain-user-grant.html", size: 3283, mode: os.FileMode(420), modTime: time.Unix(1490556242, 0)}
+	info := bindataFileInfo{name: "domain-user-grant.html", size: 3877, mode: os.FileMode(420), modTime: time.Unix(1792330793, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -706,7 +750,7 @@ func fragmentsAboutMd() (*asset, error) {
 	return a, nil
 }
 
-var _fragmentsDocsApiMd = "\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x31\x22\x3e\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x41\x50\x49\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x3c\x2f\x68\x31\x3e\x0a\x0a\x23\x23\x20\x31\x2e\x20\x4f\x76\x65\x72\x76\x69\x65\x77\x0a\x0a\x54\x68\x69\x73\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x61\x74\x69\x6f\x6e\x20\x64\x65\x73\x63\x72\x69\x62\x65\x73\x20\x74\x68\x65\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x73\x20\x74\x68\x61\x74\x20\x6d\x61\x6b\x65\x20\x75\x70\x20\x74\x68\x65\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x41\x50\x49\x20\x76\x31\x2e\x0a\x0a\x47\x6f\x20\x41\x50\x49\x20\x63\x6c\x69\x65\x6e\x74\x20\x69\x73\x20\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x65\x64\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x3a\x0a\x0a\x20\x20\x20\x20\x67\x6f\x20\x67\x65\x74\x20\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x61\x70\x69\x0a\x0a\x61\x6e\x64\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x65\x64\x20\x75\x73\x69\x6e\x67\x20\x47\x6f\x64\x6f\x63\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x64\x6f\x63\x2e\x6f\x72\x67\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x61\x70\x69\x2e\x0a\x0a\x0a\x23\x23\x20\x32\x2e\x20\x52\x6f\x6f\x74\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x0a\x0a\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x64\x20\x61\x73\x20\x61\x20\x77\x65\x62\x20\x73\x65\x72\x76\x69\x63\x65\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x6f\x6e\x20\x60\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x60\x20\x61\x64\x64\x72\x65\x73\x73\x2c\x20\x6f\x72\x20\x63\x61\x6e\x20\x62\x65\x20\x69\x6e\x73\x74\x61\x6c\x6c\x65\x64\x20\x2a\x6f\x6e\x2d\x70\x72\x65\x6d\x69\x73\x65\x73\x2a\x20\x75\x6e\x64\x65\x72\x20\x61\x6e\x20\x61\x72\x62\x69\x74\x72\x61\x72\x79\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x20\x49\x6e\x20\x65\x69\x74\x68\x65\x72\x20\x63\x61\x73\x65\x20\x61\x6c\x6c\x20\x41\x50\x49\x20\x70\x61\x74\x68\x73\x20\x61\x72\x65\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x2c\x20\x62\x75\x74\x20\x74\x68\x65\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x20\x69\x73\x20\x64\x69\x66\x66\x65\x72\x65\x6e\x74\x2e\x0a\x0a\x54\x6f\x20\x75\x73\x65\x20\x70\x75\x62\x6c\x69\x63\x6c\x79\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x73\x65\x72\x76\x69\x63\x65\x2c\x20\x74\x68\x65\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x20\x69\x73\x3a\x0a\x0a\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x0a\x0a\x49\x66\x20\x2a\x6f\x6e\x2d\x70\x72\x65\x6d\x69\x73\x65\x73\x2a\x20\x69\x6e\x73\x74\x61\x6c\x6c\x61\x74\x69\x6f\x6e\x20\x69\x73\x20\x75\x73\x65\x64\x2c\x20\x66\x6f\x72\x20\x65\x78\x61\x6d\x70\x6c\x65\x20\x6f\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x20\x60\x67\x6f\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x60\x2c\x20\x74\x68\x65\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x20\x69\x73\x3a\x0a\x0a\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x0a\x0a\x49\x6e\x20\x74\x68\x65\x20\x72\x65\x73\x74\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x20\x60\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x60\x20\x64\x6f\x6d\x61\x69\x6e\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x75\x73\x65\x64\x20\x69\x6e\x20\x65\x78\x61\x6d\x70\x6c\x65\x73\x2e\x0a\x0a\x0a\x23\x23\x20\x33\x2e\x20\x41\x50\x49\x20\x56\x65\x72\x73\x69\x6f\x6e\x0a\x0a\x41\x6c\x6c\x20\x41\x50\x49\x20\x70\x61\x74\x68\x73\x20\x61\x72\x65\x20\x70\x72\x65\x66\x69\x78\x65\x64\x20\x77\x69\x74\x68\x20\x61\x20\x76\x65\x72\x73\x69\x6f\x6e\x20\x6e\x75\x6d\x62\x65\x72\x2c\x20\x61\x73\x20\x70\x61\x72\x74\x20\x6f\x66\x20\x74\x68\x65\x20\x72\x6f\x6f\x74\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x2e\x0a\x0a\x54\x68\x69\x73\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x20\x64\x65\x73\x63\x72\x69\x62\x65\x73\x20\x6f\x6e\x6c\x79\x20\x76\x31\x20\x76\x65\x72\x73\x69\x6f\x6e\x20\x6f\x66\x20\x74\x68\x65\x20\x41\x50\x49\x2e\x0a\x0a\x0a\x23\x23\x20\x34\x2e\x20\x41\x75\x74\x68\x65\x6e\x74\x69\x63\x61\x74\x69\x6f\x6e\x0a\x0a\x45\x61\x63\x68\x20\x48\x54\x54\x50\x20\x72\x65\x71\x75\x65\x73\x74\x20\x72\x65\x71\x75\x69\x72\x65\x73\x20\x60\x58\x2d\x4b\x65\x79\x60\x20\x68\x65\x61\x64\x65\x72\x20\x74\x6f\x20\x62\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x77\x69\x74\x68\x20\x61\x20\x50\x65\x72\x73\x6f\x6e\x61\x6c\x20\x41\x63\x63\x65\x73\x73\x20\x54\x6f\x6b\x65\x6e\x20\x61\x73\x20\x61\x20\x76\x61\x6c\x75\x65\x2e\x0a\x0a\x54\x6f\x6b\x65\x6e\x20\x69\x73\x20\x75\x6e\x69\x71\x75\x65\x20\x66\x6f\x72\x20\x65\x76\x65\x72\x79\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x75\x73\x65\x72\x20\x61\x63\x63\x6f\x75\x6e\x74\x20\x61\x6e\x64\x20\x63\x61\x6e\x20\x62\x65\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x64\x20\x6f\x6e\x20\x61\x20\x77\x65\x62\x73\x69\x74\x65\x20\x75\x6e\x64\x65\x72\x20\x2a\x53\x65\x74\x74\x69\x6e\x67\x73\x20\x2d\x3e\x20\x41\x50\x49\x20\x61\x63\x63\x65\x73\x73\x2a\x20\x70\x61\x67\x65\x2e\x20\x49\x74\x20\x69\x73\x20\x61\x6c\x73\x6f\x20\x66\x69\x6c\x74\x65\x72\x65\x64\x20\x62\x79\x20\x49\x50\x20\x73\x75\x62\x6e\x65\x74\x73\x20\x74\x68\x61\x74\x20\x75\x73\x65\x72\x20\x63\x61\x6e\x20\x73\x70\x65\x63\x69\x66\x79\x20\x6f\x6e\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x70\x61\x67\x65\x2e\x0a\x0a\x49\x66\x20\x74\x68\x65\x20\x74\x6f\x6b\x65\x6e\x20\x69\x73\x20\x6d\x69\x73\x73\x69\x6e\x67\x20\x6f\x72\x20\x69\x6e\x76\x61\x6c\x69\x64\x2c\x20\x41\x50\x49\x20\x77\x69\x6c\x6c\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x20\x5b\x55\x6e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x31\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x30\x30\x33\x36\x43\x48\x41\x52\x41\x43\x54\x45\x52\x4c\x4f\x4e\x47\x50\x45\x52\x53\x4f\x4e\x41\x4c\x41\x43\x43\x45\x53\x53\x54\x4f\x4b\x45\x4e\x22\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x23\x23\x20\x35\x2e\x20\x52\x61\x74\x65\x20\x4c\x69\x6d\x69\x74\x69\x6e\x67\x0a\x0a\x52\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x69\x6e\x67\x20\x69\x73\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x62\x6c\x65\x20\x66\x6f\x72\x20\x41\x64\x64\x20\x61\x6e\x64\x20\x55\x70\x64\x61\x74\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x72\x65\x71\x75\x65\x73\x74\x73\x2e\x20\x41\x64\x64\x69\x74\x69\x6f\x6e\x61\x6c\x20\x48\x54\x54\x50\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x68\x65\x61\x64\x65\x72\x73\x20\x61\x72\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x77\x69\x74\x68\x20\x6d\x6f\x72\x65\x20\x69\x6e\x66\x6f\x72\x6d\x61\x74\x69\x6f\x6e\x3a\x0a\x0a\x20\x20\x2d\x20\x60\x58\x2d\x52\x61\x74\x65\x6c\x69\x6d\x69\x74\x2d\x4c\x69\x6d\x69\x74\x60\x3a\x20\x54\x68\x65\x20\x6d\x61\x78\x69\x6d\x75\x6d\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x75\x73\x65\x72\x20\x69\x73\x20\x70\x65\x72\x6d\x69\x74\x74\x65\x64\x20\x74\x6f\x20\x6d\x61\x6b\x65\x20\x70\x65\x72\x20\x68\x6f\x75\x72\x2e\x0a\x20\x20\x2d\x20\x60\x58\x2d\x52\x61\x74\x65\x6c\x69\x6d\x69\x74\x2d\x52\x65\x6d\x61\x69\x6e\x69\x6e\x67\x60\x3a\x20\x54\x68\x65\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x20\x69\x6e\x20\x74\x68\x65\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x72\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x20\x77\x69\x6e\x64\x6f\x77\x2e\x0a\x20\x20\x2d\x20\x60\x58\x2d\x52\x61\x74\x65\x6c\x69\x6d\x69\x74\x2d\x52\x65\x73\x65\x74\x60\x3a\x20\x53\x65\x63\x6f\x6e\x64\x73\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x20\x75\x6e\x74\x69\x6c\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x72\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x20\x77\x69\x6e\x64\x6f\x77\x20\x72\x65\x73\x65\x74\x2e\x0a\x20\x20\x2d\x20\x60\x58\x2d\x52\x61\x74\x65\x6c\x69\x6d\x69\x74\x2d\x52\x65\x74\x72\x79\x60\x3a\x20\x53\x65\x63\x6f\x6e\x64\x73\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x20\x75\x6e\x74\x69\x6c\x20\x6e\x65\x77\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x61\x72\x65\x20\x70\x65\x72\x6d\x69\x74\x74\x65\x64\x20\x77\x68\x65\x6e\x20\x6c\x69\x6d\x69\x74\x20\x69\x73\x20\x72\x65\x61\x63\x68\x65\x64\x2e\x0a\x0a\x49\x66\x20\x60\x58\x2d\x52\x61\x74\x65\x6c\x69\x6d\x69\x74\x2d\x4c\x69\x6d\x69\x74\x60\x20\x68\x65\x61\x64\x65\x72\x20\x69\x73\x20\x61\x62\x73\x65\x6e\x74\x2c\x20\x6e\x6f\x20\x6c\x69\x6d\x69\x74\x20\x69\x73\x20\x65\x6e\x66\x6f\x72\x63\x65\x64\x2e\x0a\x0a\x57\x68\x65\x6e\x20\x72\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x20\x69\x73\x20\x72\x65\x61\x63\x68\x65\x64\x2c\x20\x61\x20\x5b\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x52\x65\x71\x75\x65\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x32\x39\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x2e\x0a\x0a\x0a\x23\x23\x20\x36\x2e\x20\x52\x65\x73\x6f\x75\x72\x63\x65\x73\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x61\x6e\x64\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x48\x54\x54\x50\x20\x62\x6f\x64\x69\x65\x73\x20\x61\x72\x65\x20\x4a\x53\x4f\x4e\x2d\x65\x6e\x63\x6f\x64\x65\x64\x20\x61\x73\x20\x4a\x53\x4f\x4e\x20\x6f\x62\x6a\x65\x63\x74\x73\x2e\x20\x49\x6e\x20\x74\x68\x69\x73\x20\x73\x65\x63\x74\x69\x6f\x6e\x2c\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x73\x20\x74\x68\x61\x74\x20\x72\x65\x70\x72\x65\x73\x65\x6e\x74\x20\x64\x61\x74\x61\x20\x74\x68\x61\x74\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x69\x73\x20\x6d\x61\x6e\x61\x67\x69\x6e\x67\x20\x61\x72\x65\x20\x64\x65\x73\x63\x72\x69\x62\x65\x64\x20\x61\x73\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x6f\x66\x20\x4a\x53\x4f\x4e\x20\x6f\x62\x6a\x65\x63\x74\x73\x20\x77\x69\x74\x68\x20\x74\x68\x65\x69\x72\x20\x74\x79\x70\x65\x73\x20\x61\x6e\x64\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x76\x61\x6c\x75\x65\x73\x20\x77\x68\x65\x72\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x6d\x61\x79\x20\x62\x65\x20\x6f\x6d\x69\x74\x74\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x36\x2e\x31\x2e\x20\x44\x6f\x6d\x61\x69\x6e\x0a\x0a\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x77\x6e\x65\x72\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x5f\x69\x67\x6e\x6f\x72\x65\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x66\x61\x6c\x73\x65\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x66\x61\x6c\x73\x65\x29\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x20\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x77\x79\x6e\x77\x34\x70\x37\x77\x6b\x6a\x31\x31\x72\x35\x71\x71\x6e\x7a\x68\x76\x72\x36\x79\x79\x31\x73\x79\x79\x32\x76\x78\x65\x64\x33\x63\x66\x76\x78\x33\x66\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x6f\x6b\x65\x6e\x73\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x36\x2e\x32\x2e\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x6f\x6b\x65\x6e\x73\x0a\x0a\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x74\x6f\x6b\x65\x6e\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x6f\x62\x6a\x65\x63\x74\x73\x29\x0a\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x74\x6f\x6b\x65\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x22\x3a\x20\x22\x71\x72\x6f\x79\x64\x70\x76\x72\x5f\x32\x38\x75\x49\x55\x37\x75\x70\x5f\x67\x69\x6b\x75\x49\x66\x30\x59\x6f\x3d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x22\x3a\x22\x50\x57\x37\x58\x58\x35\x64\x49\x75\x33\x38\x53\x50\x6f\x76\x48\x70\x59\x52\x49\x59\x70\x58\x64\x39\x6a\x6f\x3d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x73\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x36\x2e\x33\x2e\x20\x44\x6f\x6d\x61\x69\x6e\x20\x55\x73\x65\x72\x73\x0a\x0a\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x77\x6e\x65\x72\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x75\x73\x65\x72\x5f\x69\x64\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x73\x74\x72\x69\x6e\x67\x73\x29\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x75\x73\x65\x72\x5f\x69\x64\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6a\x6b\x6e\x64\x73\x69\x33\x33\x33\x65\x39\x64\x73\x6e\x37\x30\x31\x32\x6e\x34\x32\x33\x6a\x62\x33\x31\x22\x0a\x20\x20\x20\x20\x5d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x36\x2e\x34\x2e\x20\x50\x61\x63\x6b\x61\x67\x65\x0a\x0a\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x74\x68\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x76\x63\x73\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x67\x69\x74\x22\x2c\x20\x22\x68\x67\x22\x2c\x20\x22\x62\x7a\x72\x22\x2c\x20\x22\x73\x76\x6e\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x74\x79\x70\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x62\x72\x61\x6e\x63\x68\x22\x2c\x20\x22\x74\x61\x67\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x6e\x61\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x67\x6f\x5f\x73\x6f\x75\x72\x63\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x64\x69\x72\x65\x63\x74\x5f\x75\x72\x6c\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x66\x61\x6c\x73\x65\x29\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x64\x71\x6e\x35\x34\x70\x31\x6a\x77\x76\x66\x78\x68\x62\x65\x62\x64\x33\x35\x77\x35\x39\x67\x32\x68\x36\x30\x35\x74\x39\x77\x6d\x35\x65\x32\x65\x68\x32\x30\x36\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x22\x2c\x0a\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x0a\x23\x23\x20\x37\x2e\x20\x51\x75\x65\x72\x69\x65\x73\x0a\x0a\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x41\x50\x49\x20\x75\x73\x65\x73\x20\x48\x54\x54\x50\x20\x66\x6f\x72\x20\x63\x6f\x6d\x6d\x75\x6e\x69\x63\x61\x74\x69\x6f\x6e\x20\x61\x6e\x64\x20\x74\x68\x69\x73\x20\x73\x65\x63\x74\x69\x6f\x6e\x20\x64\x65\x73\x63\x72\x69\x62\x65\x73\x20\x48\x54\x54\x50\x20\x72\x65\x71\x75\x65\x73\x74\x73\x2c\x20\x74\x68\x65\x69\x72\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x20\x61\x6e\x64\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x41\x50\x49\x2e\x20\x42\x65\x73\x69\x64\x65\x20\x73\x70\x65\x63\x69\x66\x69\x65\x64\x20\x65\x72\x72\x6f\x72\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x20\x66\x6f\x72\x20\x65\x61\x63\x68\x20\x71\x75\x65\x72\x79\x2c\x20\x74\x68\x65\x20\x5b\x49\x6e\x74\x65\x72\x6e\x61\x6c\x20\x53\x65\x72\x76\x65\x72\x20\x45\x72\x72\x6f\x72\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x35\x30\x30\x29\x20\x6d\x61\x79\x20\x6f\x63\x63\x75\x72\x2e\x0a\x0a\x49\x66\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x20\x55\x52\x4c\x20\x70\x61\x74\x68\x20\x69\x73\x20\x6e\x6f\x74\x20\x76\x61\x6c\x69\x64\x2c\x20\x61\x20\x5b\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x34\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x2e\x0a\x0a\x49\x6e\x20\x63\x61\x73\x65\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x72\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x63\x61\x6e\x20\x6e\x6f\x74\x20\x62\x65\x20\x64\x65\x63\x6f\x64\x65\x64\x20\x66\x72\x6f\x6d\x20\x4a\x53\x4f\x4e\x2c\x20\x61\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x2e\x20\x41\x6c\x6c\x20\x50\x4f\x53\x54\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x6d\x75\x73\x74\x20\x68\x61\x76\x65\x20\x60\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x60\x20\x68\x65\x61\x64\x65\x72\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x74\x68\x73\x20\x6d\x61\x79\x20\x63\x6f\x6e\x74\x61\x69\x6e\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x20\x77\x68\x69\x63\x68\x20\x61\x72\x65\x20\x69\x6e\x64\x69\x63\x61\x74\x65\x64\x20\x77\x69\x74\x68\x20\x61\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x20\x6e\x61\x6d\x65\x20\x73\x75\x72\x72\x6f\x75\x6e\x64\x65\x64\x20\x77\x69\x74\x68\x20\x63\x75\x72\x6c\x79\x20\x62\x72\x61\x63\x6b\x65\x74\x73\x20\x2a\x7b\x7d\x2a\x2e\x0a\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x2e\x20\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x51\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x73\x74\x61\x72\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x20\x76\x61\x6c\x75\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x69\x6e\x20\x2a\x70\x72\x65\x76\x69\x6f\x75\x73\x2a\x20\x6f\x72\x20\x2a\x6e\x65\x78\x74\x2a\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x79\x0a\x20\x20\x2d\x20\x2a\x2a\x6c\x69\x6d\x69\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x31\x30\x30\x29\x20\x6d\x61\x78\x69\x6d\x61\x6c\x20\x65\x6c\x65\x6d\x65\x6e\x74\x73\x20\x69\x6e\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x29\x20\x0a\x20\x20\x2d\x20\x2a\x2a\x63\x6f\x75\x6e\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x72\x65\x76\x69\x6f\x75\x73\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6e\x65\x78\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x0a\x56\x61\x6c\x75\x65\x73\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x2a\x70\x72\x65\x76\x69\x6f\x75\x73\x2a\x20\x61\x6e\x64\x20\x2a\x6e\x65\x78\x74\x2a\x20\x66\x69\x65\x6c\x64\x73\x20\x63\x61\x6e\x20\x62\x65\x20\x70\x61\x73\x73\x65\x64\x20\x61\x73\x20\x2a\x73\x74\x61\x72\x74\x2a\x20\x71\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x20\x74\x6f\x20\x67\x65\x74\x20\x61\x20\x70\x72\x65\x76\x69\x6f\x75\x73\x20\x6f\x72\x20\x6e\x65\x78\x74\x20\x70\x61\x67\x65\x20\x69\x6e\x20\x74\x68\x65\x20\x6c\x69\x73\x74\x69\x6e\x67\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x77\x79\x6e\x77\x34\x70\x37\x77\x6b\x6a\x31\x31\x72\x35\x71\x71\x6e\x7a\x68\x76\x72\x36\x79\x79\x31\x73\x79\x79\x32\x76\x78\x65\x64\x33\x63\x66\x76\x78\x33\x66\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x75\x6e\x74\x22\x3a\x20\x31\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x3f\x73\x74\x61\x72\x74\x3d\x6d\x69\x73\x73\x69\x6e\x67\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3a\x20\x22\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x22\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x64\x65\x22\x3a\x20\x31\x30\x30\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x37\x2e\x32\x2e\x20\x47\x65\x74\x20\x44\x6f\x6d\x61\x69\x6e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x77\x79\x6e\x77\x34\x70\x37\x77\x6b\x6a\x31\x31\x72\x35\x71\x71\x6e\x7a\x68\x76\x72\x36\x79\x79\x31\x73\x79\x79\x32\x76\x78\x65\x64\x33\x63\x66\x76\x78\x33\x66\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x6d\x69\x73\x73\x69\x6e\x67\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3a\x20\x22\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x22\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x64\x65\x22\x3a\x20\x31\x30\x30\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x37\x2e\x33\x2e\x20\x41\x64\x64\x20\x44\x6f\x6d\x61\x69\x6e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x72\x65\x71\x75\x69\x72\x65\x64\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x77\x6e\x65\x72\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x5f\x69\x67\x6e\x6f\x72\x65\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x66\x71\x64\x6e\x22\x3a\x22\x65\x78\x61\x6d\x70\x6c\x65\x2e\x6c\x6f\x63\x61\x6c\x68\x6f\x73\x74\x22\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x66\x61\x6c\x73\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x31\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x30\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x31\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x41\x76\x61\x69\x6c\x61\x62\x6c\x65\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x32\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x57\x69\x74\x68\x20\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x53\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x65\x65\x64\x73\x20\x56\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x34\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x0a\x49\x66\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x65\x65\x64\x73\x20\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x2c\x20\x66\x6f\x6c\x6c\x6f\x77\x20\x69\x6e\x73\x74\x72\x75\x63\x74\x69\x6f\x6e\x73\x20\x64\x65\x73\x63\x72\x69\x62\x65\x64\x20\x69\x6e\x20\x5b\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x6f\x6b\x65\x6e\x73\x5d\x28\x23\x6c\x69\x73\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x6f\x6b\x65\x6e\x73\x29\x20\x73\x65\x63\x74\x69\x6f\x6e\x2e\x0a\x0a\x23\x23\x23\x20\x37\x2e\x34\x2e\x20\x55\x70\x64\x61\x74\x65\x20\x44\x6f\x6d\x61\x69\x6e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x77\x6e\x65\x72\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x5f\x69\x67\x6e\x6f\x72\x65\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x74\x72\x75\x65\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x31\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x31\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x41\x76\x61\x69\x6c\x61\x62\x6c\x65\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x32\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x57\x69\x74\x68\x20\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x53\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x65\x65\x64\x73\x20\x56\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x34\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x0a\x49\x66\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x65\x65\x64\x73\x20\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x2c\x20\x66\x6f\x6c\x6c\x6f\x77\x20\x69\x6e\x73\x74\x72\x75\x63\x74\x69\x6f\x6e\x73\x20\x64\x65\x73\x63\x72\x69\x62\x65\x64\x20\x69\x6e\x20\x5b\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x6f\x6b\x65\x6e\x73\x5d\x28\x23\x6c\x69\x73\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x6f\x6b\x65\x6e\x73\x29\x20\x73\x65\x63\x74\x69\x6f\x6e\x2e\x0a\x0a\x23\x23\x23\x20\x37\x2e\x35\x2e\x20\x44\x65\x6c\x65\x74\x65\x20\x44\x6f\x6d\x61\x69\x6e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x44\x45\x4c\x45\x54\x45\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x68\x61\x73\x20\x62\x65\x65\x6e\x20\x64\x65\x6c\x65\x74\x65\x64\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x58\x20\x44\x45\x4c\x45\x54\x45\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x6c\x69\x73\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x6f\x6b\x65\x6e\x73\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x36\x2e\x20\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x6f\x6b\x65\x6e\x73\x0a\x0a\x54\x68\x69\x73\x20\x41\x50\x49\x20\x63\x61\x6c\x6c\x20\x70\x72\x6f\x76\x69\x64\x65\x73\x20\x69\x6e\x66\x6f\x72\x6d\x61\x74\x69\x6f\x6e\x20\x74\x6f\x20\x76\x65\x72\x69\x66\x79\x20\x74\x68\x65\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x6f\x66\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x0a\x0a\x44\x6f\x6d\x61\x69\x6e\x20\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x20\x64\x6f\x6e\x65\x20\x62\x79\x20\x73\x65\x74\x74\x69\x6e\x67\x20\x61\x20\x54\x58\x54\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x66\x6f\x72\x20\x6f\x6e\x65\x20\x6f\x66\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x20\x54\x68\x65\x20\x76\x61\x6c\x75\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x72\x65\x63\x6f\x72\x64\x20\x69\x73\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x2a\x74\x6f\x6b\x65\x6e\x2a\x20\x66\x69\x65\x6c\x64\x2e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x66\x71\x64\x6e\x7d\x2f\x74\x6f\x6b\x65\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x66\x75\x6c\x6c\x79\x20\x71\x75\x61\x6c\x69\x66\x69\x65\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x6f\x6b\x65\x6e\x73\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x6f\x6b\x65\x6e\x73\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x74\x6f\x6b\x65\x6e\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x22\x3a\x20\x22\x37\x37\x65\x33\x45\x5a\x37\x55\x43\x51\x44\x63\x66\x66\x7a\x65\x6b\x53\x4b\x48\x71\x75\x58\x56\x79\x71\x55\x3d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x22\x3a\x20\x22\x35\x6a\x77\x4a\x32\x42\x70\x6d\x69\x5a\x6f\x34\x58\x48\x4a\x42\x6a\x41\x74\x54\x77\x74\x76\x7a\x50\x6b\x51\x3d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x65\x78\x61\x6d\x70\x6c\x65\x20\x66\x6f\x72\x20\x61\x6c\x6c\x20\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x3a\x0a\x0a\x60\x60\x60\x0a\x24\x4f\x52\x49\x47\x49\x4e\x20\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2e\x0a\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x20\x54\x58\x54\x20\x22\x37\x37\x65\x33\x45\x5a\x37\x55\x43\x51\x44\x63\x66\x66\x7a\x65\x6b\x53\x4b\x48\x71\x75\x58\x56\x79\x71\x55\x3d\x22\x0a\x60\x60\x60\x0a\x0a\x54\x6f\x20\x76\x65\x72\x69\x66\x79\x20\x6f\x6e\x6c\x79\x20\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x3a\x0a\x0a\x60\x60\x60\x0a\x24\x4f\x52\x49\x47\x49\x4e\x20\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2e\x0a\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x20\x54\x58\x54\x20\x22\x35\x6a\x77\x4a\x32\x42\x70\x6d\x69\x5a\x6f\x34\x58\x48\x4a\x42\x6a\x41\x74\x54\x77\x74\x76\x7a\x50\x6b\x51\x3d\x22\x0a\x60\x60\x60\x0a\x0a\x54\x6f\x6b\x65\x6e\x73\x20\x73\x70\x65\x63\x69\x66\x69\x63\x20\x66\x6f\x72\x20\x65\x61\x63\x68\x20\x75\x73\x65\x72\x2e\x20\x56\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x6f\x66\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x62\x79\x20\x6f\x6e\x65\x20\x75\x73\x65\x72\x20\x64\x6f\x65\x73\x20\x6e\x6f\x74\x20\x67\x69\x76\x65\x20\x70\x65\x72\x6d\x69\x73\x73\x69\x6f\x6e\x20\x6f\x74\x68\x65\x72\x20\x75\x73\x65\x72\x73\x20\x74\x6f\x20\x61\x64\x64\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x2e\x20\x45\x61\x63\x68\x20\x75\x73\x65\x72\x20\x6d\x75\x73\x74\x20\x68\x61\x76\x65\x20\x61\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x77\x69\x74\x68\x20\x61\x20\x73\x70\x65\x63\x69\x66\x69\x63\x20\x74\x6f\x6b\x65\x6e\x2e\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x31\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x37\x2e\x20\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x55\x73\x65\x72\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x75\x73\x65\x72\x73\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x55\x73\x65\x72\x73\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x73\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x75\x73\x65\x72\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x75\x73\x65\x72\x5f\x69\x64\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6a\x6b\x6e\x64\x73\x69\x33\x33\x33\x65\x39\x64\x73\x6e\x37\x30\x31\x32\x6e\x34\x32\x33\x6a\x62\x33\x31\x22\x0a\x20\x20\x20\x20\x5d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x38\x2e\x20\x47\x72\x61\x6e\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x55\x73\x65\x72\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x75\x73\x65\x72\x73\x2f\x7b\x75\x73\x65\x72\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x20\x20\x2d\x20\x2a\x2a\x75\x73\x65\x72\x2a\x2a\x3a\x20\x75\x73\x65\x72\x20\x69\x64\x65\x6e\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x4f\x4b\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x58\x20\x50\x4f\x53\x54\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x75\x73\x65\x72\x73\x2f\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3a\x20\x22\x4f\x4b\x22\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x64\x65\x22\x3a\x20\x32\x30\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x47\x72\x61\x6e\x74\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x31\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x39\x2e\x20\x52\x65\x76\x6f\x6b\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x55\x73\x65\x72\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x44\x45\x4c\x45\x54\x45\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x75\x73\x65\x72\x73\x2f\x7b\x75\x73\x65\x72\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x20\x20\x2d\x20\x2a\x2a\x75\x73\x65\x72\x2a\x2a\x3a\x20\x75\x73\x65\x72\x20\x69\x64\x65\x6e\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x4f\x4b\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x58\x20\x44\x45\x4c\x45\x54\x45\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x75\x73\x65\x72\x73\x2f\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3a\x20\x22\x4f\x4b\x22\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x64\x65\x22\x3a\x20\x32\x30\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x47\x72\x61\x6e\x74\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x32\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x30\x2e\x20\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x50\x61\x63\x6b\x61\x67\x65\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x51\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x73\x74\x61\x72\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x20\x76\x61\x6c\x75\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x69\x6e\x20\x2a\x70\x72\x65\x76\x69\x6f\x75\x73\x2a\x20\x6f\x72\x20\x2a\x6e\x65\x78\x74\x2a\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x79\x0a\x20\x20\x2d\x20\x2a\x2a\x6c\x69\x6d\x69\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x31\x30\x30\x29\x20\x6d\x61\x78\x69\x6d\x61\x6c\x20\x65\x6c\x65\x6d\x65\x6e\x74\x73\x20\x69\x6e\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x29\x20\x0a\x20\x20\x2d\x20\x2a\x2a\x63\x6f\x75\x6e\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x72\x65\x76\x69\x6f\x75\x73\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6e\x65\x78\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x0a\x56\x61\x6c\x75\x65\x73\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x2a\x70\x72\x65\x76\x69\x6f\x75\x73\x2a\x20\x61\x6e\x64\x20\x2a\x6e\x65\x78\x74\x2a\x20\x66\x69\x65\x6c\x64\x73\x20\x63\x61\x6e\x20\x62\x65\x20\x70\x61\x73\x73\x65\x64\x20\x61\x73\x20\x2a\x73\x74\x61\x72\x74\x2a\x20\x71\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x20\x74\x6f\x20\x67\x65\x74\x20\x61\x20\x70\x72\x65\x76\x69\x6f\x75\x73\x20\x6f\x72\x20\x6e\x65\x78\x74\x20\x70\x61\x67\x65\x20\x69\x6e\x20\x74\x68\x65\x20\x6c\x69\x73\x74\x69\x6e\x67\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x70\x61\x63\x6b\x61\x67\x65\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x64\x71\x6e\x35\x34\x70\x31\x6a\x77\x76\x66\x78\x68\x62\x65\x62\x64\x33\x35\x77\x35\x39\x67\x32\x68\x36\x30\x35\x74\x39\x77\x6d\x35\x65\x32\x65\x68\x32\x30\x36\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x72\x65\x66\x5f\x74\x79\x70\x65\x22\x3a\x20\x22\x62\x72\x61\x6e\x63\x68\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x72\x65\x66\x5f\x6e\x61\x6d\x65\x22\x3a\x20\x22\x73\x74\x61\x62\x6c\x65\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x64\x71\x6e\x35\x34\x70\x31\x6a\x77\x76\x66\x78\x68\x62\x65\x62\x64\x33\x35\x77\x35\x39\x67\x32\x68\x36\x30\x35\x74\x39\x77\x6d\x35\x65\x32\x65\x68\x32\x30\x36\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x6c\x69\x62\x72\x61\x72\x79\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x68\x67\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x73\x73\x68\x3a\x2f\x2f\x6d\x65\x72\x63\x75\x72\x69\x61\x6c\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x6d\x79\x2d\x6c\x69\x62\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x75\x6e\x74\x22\x3a\x20\x32\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x31\x2e\x20\x47\x65\x74\x20\x50\x61\x63\x6b\x61\x67\x65\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x7b\x69\x64\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x69\x64\x2a\x2a\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x69\x64\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x64\x71\x6e\x35\x34\x70\x31\x6a\x77\x76\x66\x78\x68\x62\x65\x62\x64\x33\x35\x77\x35\x39\x67\x32\x68\x36\x30\x35\x74\x39\x77\x6d\x35\x65\x32\x65\x68\x32\x30\x36\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x64\x71\x6e\x35\x34\x70\x31\x6a\x77\x76\x66\x78\x68\x62\x65\x62\x64\x33\x35\x77\x35\x39\x67\x32\x68\x36\x30\x35\x74\x39\x77\x6d\x35\x65\x32\x65\x68\x32\x30\x36\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x22\x2c\x0a\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x66\x5f\x74\x79\x70\x65\x22\x3a\x20\x22\x62\x72\x61\x6e\x63\x68\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x66\x5f\x6e\x61\x6d\x65\x22\x3a\x20\x22\x73\x74\x61\x62\x6c\x65\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x32\x2e\x20\x41\x64\x64\x20\x50\x61\x63\x6b\x61\x67\x65\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x60\x60\x60\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x74\x68\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x76\x63\x73\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x67\x69\x74\x22\x2c\x20\x22\x68\x67\x22\x2c\x20\x22\x62\x7a\x72\x22\x2c\x20\x22\x73\x76\x6e\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x74\x79\x70\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x62\x72\x61\x6e\x63\x68\x22\x2c\x20\x22\x74\x61\x67\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x6e\x61\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x67\x6f\x5f\x73\x6f\x75\x72\x63\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x64\x69\x72\x65\x63\x74\x5f\x75\x72\x6c\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x66\x61\x6c\x73\x65\x29\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x64\x6f\x6d\x61\x69\x6e\x22\x3a\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x22\x70\x61\x74\x68\x22\x3a\x22\x2f\x6d\x79\x2d\x61\x70\x70\x22\x2c\x22\x76\x63\x73\x22\x3a\x22\x67\x69\x74\x22\x2c\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x61\x70\x70\x22\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x6d\x79\x2d\x61\x70\x70\x22\x2c\x0a\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x31\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x31\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x50\x61\x74\x68\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x32\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x56\x43\x53\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x33\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x31\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x32\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x48\x6f\x73\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x34\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x54\x79\x70\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x35\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x4e\x61\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x36\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x43\x68\x61\x6e\x67\x65\x20\x52\x65\x6a\x65\x63\x74\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x37\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x38\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x33\x2e\x20\x55\x70\x64\x61\x74\x65\x20\x50\x61\x63\x6b\x61\x67\x65\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x7b\x69\x64\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x69\x64\x2a\x2a\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x69\x64\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x74\x68\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x76\x63\x73\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x67\x69\x74\x22\x2c\x20\x22\x68\x67\x22\x2c\x20\x22\x62\x7a\x72\x22\x2c\x20\x22\x73\x76\x6e\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x74\x79\x70\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x62\x72\x61\x6e\x63\x68\x22\x2c\x20\x22\x74\x61\x67\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x6e\x61\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x67\x6f\x5f\x73\x6f\x75\x72\x63\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x64\x69\x72\x65\x63\x74\x5f\x75\x72\x6c\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x66\x61\x6c\x73\x65\x29\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x70\x61\x74\x68\x22\x3a\x22\x2f\x6f\x75\x72\x2d\x61\x70\x70\x22\x2c\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x74\x72\x75\x65\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x6f\x75\x72\x2d\x61\x70\x70\x22\x2c\x0a\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x31\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x31\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x50\x61\x74\x68\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x32\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x56\x43\x53\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x33\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x31\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x32\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x48\x6f\x73\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x34\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x54\x79\x70\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x35\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x4e\x61\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x36\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x43\x68\x61\x6e\x67\x65\x20\x52\x65\x6a\x65\x63\x74\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x37\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x38\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x34\x2e\x20\x44\x65\x6c\x65\x74\x65\x20\x50\x61\x63\x6b\x61\x67\x65\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x44\x45\x4c\x45\x54\x45\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x7b\x69\x64\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x69\x64\x2a\x2a\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x69\x64\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x20\x74\x68\x61\x74\x20\x68\x61\x73\x20\x62\x65\x65\x6e\x20\x64\x65\x6c\x65\x74\x65\x64\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x58\x20\x44\x45\x4c\x45\x54\x45\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x6f\x75\x72\x2d\x61\x70\x70\x22\x2c\x0a\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x30\x29\x0a\x0a\x0a\x23\x23\x20\x38\x2e\x20\x41\x50\x49\x20\x53\x74\x61\x74\x75\x73\x20\x43\x6f\x64\x65\x73\x0a\x0a\x41\x50\x49\x20\x75\x74\x69\x6c\x69\x7a\x65\x73\x20\x48\x54\x54\x50\x20\x53\x74\x61\x74\x75\x73\x20\x63\x6f\x64\x65\x73\x20\x61\x73\x20\x77\x65\x6c\x6c\x20\x61\x73\x20\x73\x70\x65\x63\x69\x66\x69\x63\x20\x63\x6f\x64\x65\x73\x20\x66\x6f\x72\x20\x6d\x6f\x72\x65\x20\x67\x72\x61\x6e\x75\x6c\x61\x72\x20\x65\x72\x72\x6f\x72\x20\x72\x65\x70\x6f\x72\x74\x69\x6e\x67\x2e\x0a\x0a\x4d\x65\x73\x73\x61\x67\x65\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x20\x68\x61\x76\x65\x20\x74\x68\x65\x20\x66\x6f\x6c\x6c\x6f\x77\x69\x6e\x67\x20\x65\x78\x61\x6d\x70\x6c\x65\x20\x6f\x66\x20\x4a\x53\x4f\x4e\x2d\x65\x6e\x63\x6f\x64\x65\x64\x20\x62\x6f\x64\x79\x3a\x0a\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3a\x20\x22\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x22\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x64\x65\x22\x3a\x20\x31\x30\x30\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x7c\x20\x43\x6f\x64\x65\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x48\x54\x54\x50\x20\x53\x74\x61\x74\x75\x73\x20\x43\x6f\x64\x65\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4d\x65\x73\x73\x61\x67\x65\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x7c\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x7c\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x7c\x0a\x7c\x20\x32\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x32\x30\x30\x20\x4f\x4b\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4f\x4b\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x30\x33\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x34\x30\x31\x20\x55\x6e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x55\x6e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x30\x33\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x34\x30\x33\x20\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x30\x34\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x34\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x34\x30\x34\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x32\x39\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x32\x39\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x34\x32\x39\x20\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x52\x65\x71\x75\x65\x73\x74\x73\x20\x20\x20\x20\x20\x7c\x20\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x52\x65\x71\x75\x65\x73\x74\x73\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x35\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x35\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x35\x30\x30\x20\x49\x6e\x74\x65\x72\x6e\x61\x6c\x20\x53\x65\x72\x76\x65\x72\x20\x45\x72\x72\x6f\x72\x20\x7c\x20\x49\x6e\x74\x65\x72\x6e\x61\x6c\x20\x53\x65\x72\x76\x65\x72\x20\x45\x72\x72\x6f\x72\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x35\x30\x33\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x35\x30\x33\x20\x53\x65\x72\x76\x69\x63\x65\x20\x55\x6e\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x20\x20\x7c\x20\x4d\x61\x69\x6e\x74\x65\x6e\x61\x6e\x63\x65\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x30\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x32\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x32\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x41\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x33\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x33\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x57\x69\x74\x68\x20\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x53\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x34\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x34\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x65\x65\x64\x73\x20\x56\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x31\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x31\x30\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x55\x73\x65\x72\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x47\x72\x61\x6e\x74\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x31\x30\x32\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x32\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x55\x73\x65\x72\x20\x4e\x6f\x74\x20\x47\x72\x61\x6e\x74\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x30\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x31\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x31\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x32\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x32\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x50\x61\x74\x68\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x33\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x33\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x56\x43\x53\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x34\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x34\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x34\x32\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x32\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x7c\x0a\x7c\x20\x32\x30\x34\x33\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x33\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x7c\x0a\x7c\x20\x32\x30\x34\x34\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x34\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x48\x6f\x73\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x35\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x35\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x54\x79\x70\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x36\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x36\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x4e\x61\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x37\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x37\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x43\x68\x61\x6e\x67\x65\x20\x52\x65\x6a\x65\x63\x74\x65\x64\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x38\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x38\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a"
+var _fragmentsDocsApiMd = "\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x31\x22\x3e\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x41\x50\x49\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x3c\x2f\x68\x31\x3e\x0a\x0a\x23\x23\x20\x31\x2e\x20\x4f\x76\x65\x72\x76\x69\x65\x77\x0a\x0a\x54\x68\x69\x73\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x61\x74\x69\x6f\x6e\x20\x64\x65\x73\x63\x72\x69\x62\x65\x73\x20\x74\x68\x65\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x73\x20\x74\x68\x61\x74\x20\x6d\x61\x6b\x65\x20\x75\x70\x20\x74\x68\x65\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x41\x50\x49\x20\x76\x31\x2e\x0a\x0a\x47\x6f\x20\x41\x50\x49\x20\x63\x6c\x69\x65\x6e\x74\x20\x69\x73\x20\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x65\x64\x20\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x3a\x0a\x0a\x20\x20\x20\x20\x67\x6f\x20\x67\x65\x74\x20\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x61\x70\x69\x0a\x0a\x61\x6e\x64\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x65\x64\x20\x75\x73\x69\x6e\x67\x20\x47\x6f\x64\x6f\x63\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x64\x6f\x63\x2e\x6f\x72\x67\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x61\x70\x69\x2e\x0a\x0a\x54\x68\x65\x20\x60\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x60\x20\x65\x78\x65\x63\x75\x74\x61\x62\x6c\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x73\x20\x61\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x6c\x69\x6e\x65\x20\x63\x6c\x69\x65\x6e\x74\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x41\x50\x49\x20\x77\x69\x74\x68\x20\x60\x63\x6c\x69\x65\x6e\x74\x60\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x3a\x0a\x0a\x20\x20\x20\x20\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x20\x63\x6c\x69\x65\x6e\x74\x20\x2d\x65\x6e\x64\x70\x6f\x69\x6e\x74\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x20\x2d\x6b\x65\x79\x20\x54\x4f\x4b\x45\x4e\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x6c\x69\x73\x74\x0a\x0a\x45\x6e\x64\x70\x6f\x69\x6e\x74\x20\x61\x6e\x64\x20\x74\x6f\x6b\x65\x6e\x20\x63\x61\x6e\x20\x61\x6c\x73\x6f\x20\x62\x65\x20\x73\x65\x74\x20\x77\x69\x74\x68\x20\x60\x47\x4f\x50\x48\x45\x52\x50\x49\x54\x5f\x45\x4e\x44\x50\x4f\x49\x4e\x54\x60\x20\x61\x6e\x64\x20\x60\x47\x4f\x50\x48\x45\x52\x50\x49\x54\x5f\x54\x4f\x4b\x45\x4e\x60\x20\x65\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x2c\x20\x6f\x72\x20\x69\x6e\x20\x61\x20\x60\x7e\x2f\x2e\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2f\x63\x6c\x69\x65\x6e\x74\x2e\x79\x61\x6d\x6c\x60\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x66\x69\x6c\x65\x2e\x20\x45\x78\x65\x63\x75\x74\x65\x20\x60\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x20\x63\x6c\x69\x65\x6e\x74\x20\x2d\x68\x60\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x6c\x69\x73\x74\x20\x6f\x66\x20\x61\x6c\x6c\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x61\x6e\x64\x20\x6f\x70\x74\x69\x6f\x6e\x73\x2e\x0a\x0a\x4d\x61\x63\x68\x69\x6e\x65\x2d\x72\x65\x61\x64\x61\x62\x6c\x65\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x20\x6f\x66\x20\x61\x6c\x6c\x20\x41\x50\x49\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x73\x2c\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x73\x20\x61\x6e\x64\x20\x65\x72\x72\x6f\x72\x73\x20\x69\x6e\x20\x5b\x4f\x70\x65\x6e\x41\x50\x49\x20\x33\x5d\x28\x68\x74\x74\x70\x73\x3a\x2f\x2f\x77\x77\x77\x2e\x6f\x70\x65\x6e\x61\x70\x69\x73\x2e\x6f\x72\x67\x2f\x29\x20\x66\x6f\x72\x6d\x61\x74\x20\x69\x73\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x77\x69\x74\x68\x6f\x75\x74\x20\x61\x75\x74\x68\x65\x6e\x74\x69\x63\x61\x74\x69\x6f\x6e\x20\x61\x74\x3a\x0a\x0a\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x70\x65\x6e\x61\x70\x69\x2e\x6a\x73\x6f\x6e\x0a\x0a\x0a\x23\x23\x20\x32\x2e\x20\x52\x6f\x6f\x74\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x0a\x0a\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x64\x20\x61\x73\x20\x61\x20\x77\x65\x62\x20\x73\x65\x72\x76\x69\x63\x65\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x6f\x6e\x20\x60\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x60\x20\x61\x64\x64\x72\x65\x73\x73\x2c\x20\x6f\x72\x20\x63\x61\x6e\x20\x62\x65\x20\x69\x6e\x73\x74\x61\x6c\x6c\x65\x64\x20\x2a\x6f\x6e\x2d\x70\x72\x65\x6d\x69\x73\x65\x73\x2a\x20\x75\x6e\x64\x65\x72\x20\x61\x6e\x20\x61\x72\x62\x69\x74\x72\x61\x72\x79\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x20\x49\x6e\x20\x65\x69\x74\x68\x65\x72\x20\x63\x61\x73\x65\x20\x61\x6c\x6c\x20\x41\x50\x49\x20\x70\x61\x74\x68\x73\x20\x61\x72\x65\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x2c\x20\x62\x75\x74\x20\x74\x68\x65\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x20\x69\x73\x20\x64\x69\x66\x66\x65\x72\x65\x6e\x74\x2e\x0a\x0a\x54\x6f\x20\x75\x73\x65\x20\x70\x75\x62\x6c\x69\x63\x6c\x79\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x73\x65\x72\x76\x69\x63\x65\x2c\x20\x74\x68\x65\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x20\x69\x73\x3a\x0a\x0a\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x0a\x0a\x49\x66\x20\x2a\x6f\x6e\x2d\x70\x72\x65\x6d\x69\x73\x65\x73\x2a\x20\x69\x6e\x73\x74\x61\x6c\x6c\x61\x74\x69\x6f\x6e\x20\x69\x73\x20\x75\x73\x65\x64\x2c\x20\x66\x6f\x72\x20\x65\x78\x61\x6d\x70\x6c\x65\x20\x6f\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x20\x60\x67\x6f\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x60\x2c\x20\x74\x68\x65\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x20\x69\x73\x3a\x0a\x0a\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x0a\x0a\x49\x6e\x20\x74\x68\x65\x20\x72\x65\x73\x74\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x20\x60\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x60\x20\x64\x6f\x6d\x61\x69\x6e\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x75\x73\x65\x64\x20\x69\x6e\x20\x65\x78\x61\x6d\x70\x6c\x65\x73\x2e\x0a\x0a\x0a\x23\x23\x20\x33\x2e\x20\x41\x50\x49\x20\x56\x65\x72\x73\x69\x6f\x6e\x0a\x0a\x41\x6c\x6c\x20\x41\x50\x49\x20\x70\x61\x74\x68\x73\x20\x61\x72\x65\x20\x70\x72\x65\x66\x69\x78\x65\x64\x20\x77\x69\x74\x68\x20\x61\x20\x76\x65\x72\x73\x69\x6f\x6e\x20\x6e\x75\x6d\x62\x65\x72\x2c\x20\x61\x73\x20\x70\x61\x72\x74\x20\x6f\x66\x20\x74\x68\x65\x20\x72\x6f\x6f\x74\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x2e\x0a\x0a\x54\x68\x69\x73\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x20\x64\x65\x73\x63\x72\x69\x62\x65\x73\x20\x6f\x6e\x6c\x79\x20\x76\x31\x20\x76\x65\x72\x73\x69\x6f\x6e\x20\x6f\x66\x20\x74\x68\x65\x20\x41\x50\x49\x2e\x0a\x0a\x0a\x23\x23\x20\x34\x2e\x20\x41\x75\x74\x68\x65\x6e\x74\x69\x63\x61\x74\x69\x6f\x6e\x0a\x0a\x45\x61\x63\x68\x20\x48\x54\x54\x50\x20\x72\x65\x71\x75\x65\x73\x74\x20\x72\x65\x71\x75\x69\x72\x65\x73\x20\x60\x58\x2d\x4b\x65\x79\x60\x20\x68\x65\x61\x64\x65\x72\x20\x74\x6f\x20\x62\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x77\x69\x74\x68\x20\x61\x20\x50\x65\x72\x73\x6f\x6e\x61\x6c\x20\x41\x63\x63\x65\x73\x73\x20\x54\x6f\x6b\x65\x6e\x20\x61\x73\x20\x61\x20\x76\x61\x6c\x75\x65\x2e\x0a\x0a\x54\x6f\x6b\x65\x6e\x20\x69\x73\x20\x75\x6e\x69\x71\x75\x65\x20\x66\x6f\x72\x20\x65\x76\x65\x72\x79\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x75\x73\x65\x72\x20\x61\x63\x63\x6f\x75\x6e\x74\x20\x61\x6e\x64\x20\x63\x61\x6e\x20\x62\x65\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x64\x20\x6f\x6e\x20\x61\x20\x77\x65\x62\x73\x69\x74\x65\x20\x75\x6e\x64\x65\x72\x20\x2a\x53\x65\x74\x74\x69\x6e\x67\x73\x20\x2d\x3e\x20\x41\x50\x49\x20\x61\x63\x63\x65\x73\x73\x2a\x20\x70\x61\x67\x65\x2e\x20\x49\x74\x20\x69\x73\x20\x61\x6c\x73\x6f\x20\x66\x69\x6c\x74\x65\x72\x65\x64\x20\x62\x79\x20\x49\x50\x20\x73\x75\x62\x6e\x65\x74\x73\x20\x74\x68\x61\x74\x20\x75\x73\x65\x72\x20\x63\x61\x6e\x20\x73\x70\x65\x63\x69\x66\x79\x20\x6f\x6e\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x70\x61\x67\x65\x2e\x0a\x0a\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6f\x77\x6e\x65\x72\x73\x20\x63\x61\x6e\x20\x61\x6c\x73\x6f\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x61\x6e\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x74\x6f\x6b\x65\x6e\x20\x6f\x6e\x20\x74\x68\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x70\x61\x67\x65\x2e\x20\x52\x65\x71\x75\x65\x73\x74\x73\x20\x77\x69\x74\x68\x20\x61\x6e\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x74\x6f\x6b\x65\x6e\x20\x68\x61\x76\x65\x20\x74\x68\x65\x20\x60\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x60\x20\x72\x6f\x6c\x65\x20\x6f\x6e\x20\x61\x6c\x6c\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x6f\x77\x6e\x65\x64\x20\x62\x79\x20\x74\x68\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2c\x20\x61\x6e\x64\x20\x74\x68\x65\x79\x20\x63\x61\x6e\x20\x6e\x6f\x74\x20\x61\x64\x64\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x6f\x72\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2e\x0a\x0a\x49\x66\x20\x74\x68\x65\x20\x74\x6f\x6b\x65\x6e\x20\x69\x73\x20\x6d\x69\x73\x73\x69\x6e\x67\x20\x6f\x72\x20\x69\x6e\x76\x61\x6c\x69\x64\x2c\x20\x41\x50\x49\x20\x77\x69\x6c\x6c\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x20\x5b\x55\x6e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x31\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x30\x30\x33\x36\x43\x48\x41\x52\x41\x43\x54\x45\x52\x4c\x4f\x4e\x47\x50\x45\x52\x53\x4f\x4e\x41\x4c\x41\x43\x43\x45\x53\x53\x54\x4f\x4b\x45\x4e\x22\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x23\x23\x20\x35\x2e\x20\x52\x61\x74\x65\x20\x4c\x69\x6d\x69\x74\x69\x6e\x67\x0a\x0a\x52\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x69\x6e\x67\x20\x69\x73\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x62\x6c\x65\x20\x73\x65\x70\x61\x72\x61\x74\x65\x6c\x79\x20\x66\x6f\x72\x20\x47\x45\x54\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x61\x6e\x64\x20\x66\x6f\x72\x20\x61\x6c\x6c\x20\x6f\x74\x68\x65\x72\x20\x72\x65\x71\x75\x65\x73\x74\x73\x2e\x20\x4c\x69\x6d\x69\x74\x73\x20\x61\x72\x65\x20\x64\x65\x66\x69\x6e\x65\x64\x20\x70\x65\x72\x20\x75\x73\x65\x72\x20\x61\x6e\x64\x20\x70\x65\x72\x20\x68\x6f\x75\x72\x2c\x20\x61\x6e\x64\x20\x61\x64\x6d\x69\x6e\x69\x73\x74\x72\x61\x74\x6f\x72\x73\x20\x63\x61\x6e\x20\x6f\x76\x65\x72\x72\x69\x64\x65\x20\x74\x68\x65\x6d\x20\x66\x6f\x72\x20\x61\x20\x73\x70\x65\x63\x69\x66\x69\x63\x20\x75\x73\x65\x72\x2e\x20\x52\x65\x71\x75\x65\x73\x74\x73\x20\x74\x68\x61\x74\x20\x61\x64\x64\x20\x6f\x72\x20\x75\x70\x64\x61\x74\x65\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x61\x6e\x64\x20\x62\x61\x74\x63\x68\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x63\x61\x6e\x20\x62\x65\x20\x61\x64\x64\x69\x74\x69\x6f\x6e\x61\x6c\x6c\x79\x20\x6c\x69\x6d\x69\x74\x65\x64\x20\x62\x79\x20\x61\x20\x73\x65\x70\x61\x72\x61\x74\x65\x20\x68\x6f\x75\x72\x6c\x79\x20\x6c\x69\x6d\x69\x74\x20\x74\x68\x61\x74\x20\x69\x73\x20\x6e\x6f\x74\x20\x6f\x76\x65\x72\x72\x69\x64\x64\x65\x6e\x20\x66\x6f\x72\x20\x75\x73\x65\x72\x73\x2e\x20\x52\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x65\x64\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x20\x69\x6e\x63\x6c\x75\x64\x65\x20\x61\x64\x64\x69\x74\x69\x6f\x6e\x61\x6c\x20\x48\x54\x54\x50\x20\x68\x65\x61\x64\x65\x72\x73\x20\x77\x69\x74\x68\x20\x6d\x6f\x72\x65\x20\x69\x6e\x66\x6f\x72\x6d\x61\x74\x69\x6f\x6e\x3a\x0a\x0a\x20\x20\x2d\x20\x60\x52\x61\x74\x65\x4c\x69\x6d\x69\x74\x2d\x4c\x69\x6d\x69\x74\x60\x3a\x20\x54\x68\x65\x20\x6d\x61\x78\x69\x6d\x75\x6d\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x75\x73\x65\x72\x20\x69\x73\x20\x70\x65\x72\x6d\x69\x74\x74\x65\x64\x20\x74\x6f\x20\x6d\x61\x6b\x65\x20\x70\x65\x72\x20\x68\x6f\x75\x72\x2e\x0a\x20\x20\x2d\x20\x60\x52\x61\x74\x65\x4c\x69\x6d\x69\x74\x2d\x52\x65\x6d\x61\x69\x6e\x69\x6e\x67\x60\x3a\x20\x54\x68\x65\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x20\x69\x6e\x20\x74\x68\x65\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x72\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x20\x77\x69\x6e\x64\x6f\x77\x2e\x0a\x20\x20\x2d\x20\x60\x52\x61\x74\x65\x4c\x69\x6d\x69\x74\x2d\x52\x65\x73\x65\x74\x60\x3a\x20\x53\x65\x63\x6f\x6e\x64\x73\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x20\x75\x6e\x74\x69\x6c\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x72\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x20\x77\x69\x6e\x64\x6f\x77\x20\x72\x65\x73\x65\x74\x2e\x0a\x20\x20\x2d\x20\x60\x52\x65\x74\x72\x79\x2d\x41\x66\x74\x65\x72\x60\x3a\x20\x53\x65\x63\x6f\x6e\x64\x73\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x20\x75\x6e\x74\x69\x6c\x20\x6e\x65\x77\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x61\x72\x65\x20\x70\x65\x72\x6d\x69\x74\x74\x65\x64\x20\x77\x68\x65\x6e\x20\x6c\x69\x6d\x69\x74\x20\x69\x73\x20\x72\x65\x61\x63\x68\x65\x64\x2e\x0a\x0a\x48\x65\x61\x64\x65\x72\x73\x20\x60\x58\x2d\x52\x61\x74\x65\x6c\x69\x6d\x69\x74\x2d\x4c\x69\x6d\x69\x74\x60\x2c\x20\x60\x58\x2d\x52\x61\x74\x65\x6c\x69\x6d\x69\x74\x2d\x52\x65\x6d\x61\x69\x6e\x69\x6e\x67\x60\x2c\x20\x60\x58\x2d\x52\x61\x74\x65\x6c\x69\x6d\x69\x74\x2d\x52\x65\x73\x65\x74\x60\x20\x61\x6e\x64\x20\x60\x58\x2d\x52\x61\x74\x65\x6c\x69\x6d\x69\x74\x2d\x52\x65\x74\x72\x79\x60\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x6d\x65\x61\x6e\x69\x6e\x67\x20\x61\x72\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x66\x6f\x72\x20\x63\x6f\x6d\x70\x61\x74\x69\x62\x69\x6c\x69\x74\x79\x20\x77\x69\x74\x68\x20\x6f\x6c\x64\x65\x72\x20\x63\x6c\x69\x65\x6e\x74\x73\x2e\x0a\x0a\x49\x66\x20\x60\x52\x61\x74\x65\x4c\x69\x6d\x69\x74\x2d\x4c\x69\x6d\x69\x74\x60\x20\x68\x65\x61\x64\x65\x72\x20\x69\x73\x20\x61\x62\x73\x65\x6e\x74\x2c\x20\x6e\x6f\x20\x6c\x69\x6d\x69\x74\x20\x69\x73\x20\x65\x6e\x66\x6f\x72\x63\x65\x64\x2e\x0a\x0a\x57\x68\x65\x6e\x20\x72\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x20\x69\x73\x20\x72\x65\x61\x63\x68\x65\x64\x2c\x20\x61\x20\x5b\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x52\x65\x71\x75\x65\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x32\x39\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x2e\x20\x43\x6c\x69\x65\x6e\x74\x73\x20\x73\x68\x6f\x75\x6c\x64\x20\x77\x61\x69\x74\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x73\x65\x63\x6f\x6e\x64\x73\x20\x69\x6e\x20\x74\x68\x65\x20\x60\x52\x65\x74\x72\x79\x2d\x41\x66\x74\x65\x72\x60\x20\x68\x65\x61\x64\x65\x72\x20\x62\x65\x66\x6f\x72\x65\x20\x72\x65\x74\x72\x79\x69\x6e\x67\x20\x74\x68\x65\x20\x72\x65\x71\x75\x65\x73\x74\x2e\x0a\x0a\x47\x6f\x20\x41\x50\x49\x20\x63\x6c\x69\x65\x6e\x74\x20\x72\x65\x74\x72\x69\x65\x73\x20\x73\x75\x63\x68\x20\x72\x65\x71\x75\x65\x73\x74\x73\x2c\x20\x72\x65\x73\x70\x65\x63\x74\x69\x6e\x67\x20\x74\x68\x65\x20\x60\x52\x65\x74\x72\x79\x2d\x41\x66\x74\x65\x72\x60\x20\x68\x65\x61\x64\x65\x72\x2c\x20\x69\x66\x20\x60\x52\x65\x74\x72\x79\x60\x20\x66\x69\x65\x6c\x64\x20\x6f\x66\x20\x74\x68\x65\x20\x60\x43\x6c\x69\x65\x6e\x74\x60\x20\x69\x73\x20\x73\x65\x74\x2c\x20\x66\x6f\x72\x20\x65\x78\x61\x6d\x70\x6c\x65\x20\x74\x6f\x20\x60\x61\x70\x69\x2e\x44\x65\x66\x61\x75\x6c\x74\x52\x65\x74\x72\x79\x4f\x70\x74\x69\x6f\x6e\x73\x60\x2e\x20\x54\x68\x65\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x6c\x69\x6e\x65\x20\x63\x6c\x69\x65\x6e\x74\x20\x61\x6c\x77\x61\x79\x73\x20\x72\x65\x74\x72\x69\x65\x73\x20\x74\x68\x65\x6d\x2e\x0a\x0a\x43\x75\x72\x72\x65\x6e\x74\x20\x6c\x69\x6d\x69\x74\x73\x20\x61\x72\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x62\x79\x20\x5b\x47\x65\x74\x20\x52\x61\x74\x65\x20\x4c\x69\x6d\x69\x74\x73\x5d\x28\x23\x67\x65\x74\x2d\x72\x61\x74\x65\x2d\x6c\x69\x6d\x69\x74\x73\x29\x20\x71\x75\x65\x72\x79\x2e\x0a\x0a\x0a\x23\x23\x20\x36\x2e\x20\x52\x65\x73\x6f\x75\x72\x63\x65\x73\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x61\x6e\x64\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x48\x54\x54\x50\x20\x62\x6f\x64\x69\x65\x73\x20\x61\x72\x65\x20\x4a\x53\x4f\x4e\x2d\x65\x6e\x63\x6f\x64\x65\x64\x20\x61\x73\x20\x4a\x53\x4f\x4e\x20\x6f\x62\x6a\x65\x63\x74\x73\x2e\x20\x49\x6e\x20\x74\x68\x69\x73\x20\x73\x65\x63\x74\x69\x6f\x6e\x2c\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x73\x20\x74\x68\x61\x74\x20\x72\x65\x70\x72\x65\x73\x65\x6e\x74\x20\x64\x61\x74\x61\x20\x74\x68\x61\x74\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x69\x73\x20\x6d\x61\x6e\x61\x67\x69\x6e\x67\x20\x61\x72\x65\x20\x64\x65\x73\x63\x72\x69\x62\x65\x64\x20\x61\x73\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x6f\x66\x20\x4a\x53\x4f\x4e\x20\x6f\x62\x6a\x65\x63\x74\x73\x20\x77\x69\x74\x68\x20\x74\x68\x65\x69\x72\x20\x74\x79\x70\x65\x73\x20\x61\x6e\x64\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x76\x61\x6c\x75\x65\x73\x20\x77\x68\x65\x72\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x6d\x61\x79\x20\x62\x65\x20\x6f\x6d\x69\x74\x74\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x73\x20\x74\x68\x61\x74\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x20\x73\x69\x6e\x67\x6c\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x6f\x72\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x20\x69\x6e\x63\x6c\x75\x64\x65\x20\x61\x6e\x20\x60\x45\x54\x61\x67\x60\x20\x68\x65\x61\x64\x65\x72\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x72\x65\x76\x69\x73\x69\x6f\x6e\x20\x6f\x66\x20\x74\x68\x65\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x20\x45\x76\x65\x72\x79\x20\x63\x68\x61\x6e\x67\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x20\x72\x65\x73\x75\x6c\x74\x73\x20\x69\x6e\x20\x61\x20\x6e\x65\x77\x20\x72\x65\x76\x69\x73\x69\x6f\x6e\x2e\x20\x55\x70\x64\x61\x74\x65\x20\x61\x6e\x64\x20\x44\x65\x6c\x65\x74\x65\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x66\x6f\x72\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x61\x6e\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x63\x63\x65\x70\x74\x20\x61\x6e\x20\x60\x49\x66\x2d\x4d\x61\x74\x63\x68\x60\x20\x68\x65\x61\x64\x65\x72\x20\x77\x69\x74\x68\x20\x74\x68\x61\x74\x20\x76\x61\x6c\x75\x65\x2c\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x63\x68\x61\x6e\x67\x65\x20\x69\x73\x20\x61\x70\x70\x6c\x69\x65\x64\x20\x6f\x6e\x6c\x79\x20\x69\x66\x20\x74\x68\x65\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x20\x68\x61\x73\x20\x6e\x6f\x74\x20\x62\x65\x65\x6e\x20\x63\x68\x61\x6e\x67\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x6d\x65\x61\x6e\x74\x69\x6d\x65\x2e\x20\x4f\x74\x68\x65\x72\x77\x69\x73\x65\x20\x61\x20\x5b\x50\x72\x65\x63\x6f\x6e\x64\x69\x74\x69\x6f\x6e\x20\x46\x61\x69\x6c\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x31\x32\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x69\x73\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x49\x66\x2d\x4d\x61\x74\x63\x68\x3a\x20\x5c\x22\x33\x5c\x22\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x58\x20\x44\x45\x4c\x45\x54\x45\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x36\x2e\x31\x2e\x20\x44\x6f\x6d\x61\x69\x6e\x0a\x0a\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x77\x6e\x65\x72\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x20\x69\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x6f\x77\x6e\x65\x64\x20\x62\x79\x20\x61\x6e\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x5f\x69\x67\x6e\x6f\x72\x65\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x66\x61\x6c\x73\x65\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x66\x61\x6c\x73\x65\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x61\x6c\x69\x61\x73\x65\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x73\x74\x72\x69\x6e\x67\x73\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x5b\x5d\x29\x20\x61\x6c\x69\x61\x73\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x73\x20\x74\x68\x61\x74\x20\x72\x65\x73\x6f\x6c\x76\x65\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x73\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x0a\x20\x20\x2d\x20\x2a\x2a\x61\x6c\x69\x61\x73\x5f\x72\x65\x64\x69\x72\x65\x63\x74\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x66\x61\x6c\x73\x65\x29\x20\x72\x65\x64\x69\x72\x65\x63\x74\x20\x72\x65\x71\x75\x65\x73\x74\x73\x2c\x20\x69\x6e\x63\x6c\x75\x64\x69\x6e\x67\x20\x60\x67\x6f\x20\x67\x65\x74\x60\x2c\x20\x66\x72\x6f\x6d\x20\x61\x6c\x69\x61\x73\x65\x73\x20\x74\x6f\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x69\x6e\x73\x74\x65\x61\x64\x20\x6f\x66\x20\x73\x65\x72\x76\x69\x6e\x67\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x75\x6e\x64\x65\x72\x20\x74\x68\x65\x20\x61\x6c\x69\x61\x73\x20\x6e\x61\x6d\x65\x73\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x5f\x64\x65\x66\x61\x75\x6c\x74\x73\x2a\x2a\x3a\x20\x28\x6f\x62\x6a\x65\x63\x74\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x6e\x75\x6c\x6c\x29\x20\x76\x61\x6c\x75\x65\x73\x20\x74\x68\x61\x74\x20\x6e\x65\x77\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x75\x6e\x64\x65\x72\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x6e\x68\x65\x72\x69\x74\x20\x77\x68\x65\x6e\x20\x74\x68\x65\x79\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x0a\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x76\x63\x73\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x67\x69\x74\x22\x2c\x20\x22\x68\x67\x22\x2c\x20\x22\x62\x7a\x72\x22\x2c\x20\x22\x73\x76\x6e\x22\x29\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x74\x79\x70\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x62\x72\x61\x6e\x63\x68\x22\x2c\x20\x22\x74\x61\x67\x22\x29\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x6e\x61\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x67\x6f\x5f\x73\x6f\x75\x72\x63\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x72\x65\x64\x69\x72\x65\x63\x74\x5f\x75\x72\x6c\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x0a\x20\x20\x20\x20\x49\x6e\x20\x2a\x2a\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x2a\x2a\x2c\x20\x2a\x2a\x67\x6f\x5f\x73\x6f\x75\x72\x63\x65\x2a\x2a\x20\x61\x6e\x64\x20\x2a\x2a\x72\x65\x64\x69\x72\x65\x63\x74\x5f\x75\x72\x6c\x2a\x2a\x2c\x20\x60\x7b\x64\x6f\x6d\x61\x69\x6e\x7d\x60\x20\x69\x73\x20\x72\x65\x70\x6c\x61\x63\x65\x64\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x61\x6e\x64\x20\x60\x7b\x70\x61\x74\x68\x7d\x60\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x70\x61\x74\x68\x20\x77\x69\x74\x68\x6f\x75\x74\x20\x74\x68\x65\x20\x6c\x65\x61\x64\x69\x6e\x67\x20\x73\x6c\x61\x73\x68\x2c\x20\x66\x6f\x72\x20\x65\x78\x61\x6d\x70\x6c\x65\x20\x60\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x7b\x70\x61\x74\x68\x7d\x2e\x67\x69\x74\x60\x2e\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x20\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x77\x79\x6e\x77\x34\x70\x37\x77\x6b\x6a\x31\x31\x72\x35\x71\x71\x6e\x7a\x68\x76\x72\x36\x79\x79\x31\x73\x79\x79\x32\x76\x78\x65\x64\x33\x63\x66\x76\x78\x33\x66\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x6f\x6b\x65\x6e\x73\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x36\x2e\x32\x2e\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x6f\x6b\x65\x6e\x73\x0a\x0a\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x74\x6f\x6b\x65\x6e\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x6f\x62\x6a\x65\x63\x74\x73\x29\x0a\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x6d\x65\x74\x68\x6f\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x56\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x6d\x65\x74\x68\x6f\x64\x2c\x20\x6f\x6e\x65\x20\x6f\x66\x20\x60\x64\x6e\x73\x2d\x74\x78\x74\x60\x20\x61\x6e\x64\x20\x60\x68\x74\x74\x70\x2d\x66\x69\x6c\x65\x60\x2e\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x4e\x61\x6d\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x54\x58\x54\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x66\x6f\x72\x20\x60\x64\x6e\x73\x2d\x74\x78\x74\x60\x20\x6d\x65\x74\x68\x6f\x64\x2c\x20\x6f\x72\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x74\x68\x61\x74\x20\x73\x65\x72\x76\x65\x73\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x66\x6f\x72\x20\x60\x68\x74\x74\x70\x2d\x66\x69\x6c\x65\x60\x20\x6d\x65\x74\x68\x6f\x64\x2e\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x74\x6f\x6b\x65\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x75\x72\x6c\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x55\x52\x4c\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x66\x6f\x72\x20\x60\x68\x74\x74\x70\x2d\x66\x69\x6c\x65\x60\x20\x6d\x65\x74\x68\x6f\x64\x2e\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6d\x65\x74\x68\x6f\x64\x22\x3a\x20\x22\x64\x6e\x73\x2d\x74\x78\x74\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x22\x3a\x20\x22\x71\x72\x6f\x79\x64\x70\x76\x72\x5f\x32\x38\x75\x49\x55\x37\x75\x70\x5f\x67\x69\x6b\x75\x49\x66\x30\x59\x6f\x3d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6d\x65\x74\x68\x6f\x64\x22\x3a\x20\x22\x64\x6e\x73\x2d\x74\x78\x74\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x22\x3a\x22\x50\x57\x37\x58\x58\x35\x64\x49\x75\x33\x38\x53\x50\x6f\x76\x48\x70\x59\x52\x49\x59\x70\x58\x64\x39\x6a\x6f\x3d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6d\x65\x74\x68\x6f\x64\x22\x3a\x20\x22\x68\x74\x74\x70\x2d\x66\x69\x6c\x65\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x22\x3a\x20\x22\x50\x57\x37\x58\x58\x35\x64\x49\x75\x33\x38\x53\x50\x6f\x76\x48\x70\x59\x52\x49\x59\x70\x58\x64\x39\x6a\x6f\x3d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x75\x72\x6c\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x2e\x77\x65\x6c\x6c\x2d\x6b\x6e\x6f\x77\x6e\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2d\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x2f\x50\x57\x37\x58\x58\x35\x64\x49\x75\x33\x38\x53\x50\x6f\x76\x48\x70\x59\x52\x49\x59\x70\x58\x64\x39\x6a\x6f\x3d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x73\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x36\x2e\x33\x2e\x20\x44\x6f\x6d\x61\x69\x6e\x20\x55\x73\x65\x72\x73\x0a\x0a\x45\x76\x65\x72\x79\x20\x75\x73\x65\x72\x20\x74\x68\x61\x74\x20\x68\x61\x73\x20\x61\x63\x63\x65\x73\x73\x20\x74\x6f\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x20\x68\x61\x73\x20\x61\x20\x72\x6f\x6c\x65\x2e\x20\x54\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x20\x68\x61\x73\x20\x74\x68\x65\x20\x60\x6f\x77\x6e\x65\x72\x60\x20\x72\x6f\x6c\x65\x20\x61\x6e\x64\x20\x63\x61\x6e\x20\x63\x68\x61\x6e\x67\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x20\x61\x6e\x64\x20\x6d\x61\x6e\x61\x67\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x75\x73\x65\x72\x73\x2e\x20\x55\x73\x65\x72\x73\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x60\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x60\x20\x72\x6f\x6c\x65\x20\x63\x61\x6e\x20\x61\x64\x64\x2c\x20\x75\x70\x64\x61\x74\x65\x20\x61\x6e\x64\x20\x64\x65\x6c\x65\x74\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x2c\x20\x61\x6e\x64\x20\x75\x73\x65\x72\x73\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x60\x76\x69\x65\x77\x65\x72\x60\x20\x72\x6f\x6c\x65\x20\x63\x61\x6e\x20\x6f\x6e\x6c\x79\x20\x67\x65\x74\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x2c\x20\x69\x74\x73\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x6e\x64\x20\x63\x68\x61\x6e\x67\x65\x6c\x6f\x67\x2e\x0a\x0a\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x77\x6e\x65\x72\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x75\x73\x65\x72\x5f\x69\x64\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x73\x74\x72\x69\x6e\x67\x73\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x6f\x6c\x65\x73\x2a\x2a\x3a\x20\x28\x6f\x62\x6a\x65\x63\x74\x20\x77\x69\x74\x68\x20\x75\x73\x65\x72\x20\x49\x44\x73\x20\x66\x72\x6f\x6d\x20\x75\x73\x65\x72\x5f\x69\x64\x73\x20\x61\x73\x20\x6b\x65\x79\x73\x20\x61\x6e\x64\x20\x72\x6f\x6c\x65\x73\x20\x61\x73\x20\x76\x61\x6c\x75\x65\x73\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x22\x2c\x20\x22\x76\x69\x65\x77\x65\x72\x22\x29\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x75\x73\x65\x72\x5f\x69\x64\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6a\x6b\x6e\x64\x73\x69\x33\x33\x33\x65\x39\x64\x73\x6e\x37\x30\x31\x32\x6e\x34\x32\x33\x6a\x62\x33\x31\x22\x0a\x20\x20\x20\x20\x5d\x2c\x0a\x20\x20\x20\x20\x22\x72\x6f\x6c\x65\x73\x22\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x3a\x20\x22\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6a\x6b\x6e\x64\x73\x69\x33\x33\x33\x65\x39\x64\x73\x6e\x37\x30\x31\x32\x6e\x34\x32\x33\x6a\x62\x33\x31\x22\x3a\x20\x22\x76\x69\x65\x77\x65\x72\x22\x0a\x20\x20\x20\x20\x7d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x36\x2e\x34\x2e\x20\x50\x61\x63\x6b\x61\x67\x65\x0a\x0a\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x74\x68\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x76\x63\x73\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x67\x69\x74\x22\x2c\x20\x22\x68\x67\x22\x2c\x20\x22\x62\x7a\x72\x22\x2c\x20\x22\x73\x76\x6e\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x74\x79\x70\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x62\x72\x61\x6e\x63\x68\x22\x2c\x20\x22\x74\x61\x67\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x6e\x61\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x67\x6f\x5f\x73\x6f\x75\x72\x63\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x64\x69\x72\x65\x63\x74\x5f\x75\x72\x6c\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x66\x61\x6c\x73\x65\x29\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x64\x71\x6e\x35\x34\x70\x31\x6a\x77\x76\x66\x78\x68\x62\x65\x62\x64\x33\x35\x77\x35\x39\x67\x32\x68\x36\x30\x35\x74\x39\x77\x6d\x35\x65\x32\x65\x68\x32\x30\x36\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x22\x2c\x0a\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x72\x61\x6e\x73\x66\x65\x72\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x36\x2e\x35\x2e\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x0a\x0a\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x69\x73\x20\x61\x20\x70\x65\x6e\x64\x69\x6e\x67\x20\x72\x65\x71\x75\x65\x73\x74\x20\x74\x6f\x20\x63\x68\x61\x6e\x67\x65\x20\x74\x68\x65\x20\x6f\x77\x6e\x65\x72\x20\x6f\x66\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x20\x44\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x20\x69\x73\x20\x63\x68\x61\x6e\x67\x65\x64\x20\x6f\x6e\x6c\x79\x20\x77\x68\x65\x6e\x20\x74\x68\x65\x20\x75\x73\x65\x72\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x72\x65\x64\x20\x74\x6f\x20\x61\x63\x63\x65\x70\x74\x73\x20\x74\x68\x65\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x62\x65\x66\x6f\x72\x65\x20\x69\x74\x73\x20\x65\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x20\x74\x69\x6d\x65\x2e\x0a\x0a\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x72\x6f\x6d\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x74\x6f\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x74\x69\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x52\x46\x43\x20\x33\x33\x33\x39\x20\x64\x61\x74\x65\x20\x61\x6e\x64\x20\x74\x69\x6d\x65\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x65\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x5f\x74\x69\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x52\x46\x43\x20\x33\x33\x33\x39\x20\x64\x61\x74\x65\x20\x61\x6e\x64\x20\x74\x69\x6d\x65\x29\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x72\x6f\x6d\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x74\x6f\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x2c\x0a\x20\x20\x20\x20\x22\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x35\x2d\x30\x32\x54\x31\x31\x3a\x33\x30\x3a\x30\x33\x2e\x33\x38\x35\x39\x32\x36\x5a\x22\x2c\x0a\x20\x20\x20\x20\x22\x65\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x5f\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x35\x2d\x30\x39\x54\x31\x31\x3a\x33\x30\x3a\x30\x33\x2e\x33\x38\x35\x39\x32\x36\x5a\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x36\x2e\x36\x2e\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x0a\x0a\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x69\x73\x20\x61\x20\x67\x72\x6f\x75\x70\x20\x6f\x66\x20\x75\x73\x65\x72\x73\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x6f\x77\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x69\x6e\x73\x74\x65\x61\x64\x20\x6f\x66\x20\x61\x20\x73\x69\x6e\x67\x6c\x65\x20\x75\x73\x65\x72\x2e\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6d\x65\x6d\x62\x65\x72\x73\x20\x68\x61\x76\x65\x20\x74\x68\x65\x20\x60\x6f\x77\x6e\x65\x72\x60\x20\x6f\x72\x20\x60\x6d\x65\x6d\x62\x65\x72\x60\x20\x72\x6f\x6c\x65\x2e\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6f\x77\x6e\x65\x72\x73\x20\x68\x61\x76\x65\x20\x74\x68\x65\x20\x60\x6f\x77\x6e\x65\x72\x60\x20\x72\x6f\x6c\x65\x20\x6f\x6e\x20\x61\x6c\x6c\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x61\x6e\x64\x20\x74\x68\x65\x79\x20\x6d\x61\x6e\x61\x67\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6d\x65\x6d\x62\x65\x72\x73\x2c\x20\x77\x68\x69\x6c\x65\x20\x6d\x65\x6d\x62\x65\x72\x73\x20\x68\x61\x76\x65\x20\x74\x68\x65\x20\x60\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x60\x20\x72\x6f\x6c\x65\x2e\x0a\x0a\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6e\x61\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x66\x74\x77\x31\x72\x6e\x66\x70\x6d\x33\x78\x32\x63\x36\x7a\x71\x76\x66\x6e\x36\x6d\x38\x74\x35\x64\x72\x22\x2c\x0a\x20\x20\x20\x20\x22\x6e\x61\x6d\x65\x22\x3a\x20\x22\x6d\x79\x2d\x74\x65\x61\x6d\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x6d\x65\x6d\x62\x65\x72\x73\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x36\x2e\x37\x2e\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4d\x65\x6d\x62\x65\x72\x73\x0a\x0a\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x75\x73\x65\x72\x5f\x69\x64\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x73\x74\x72\x69\x6e\x67\x73\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x6f\x6c\x65\x73\x2a\x2a\x3a\x20\x28\x6f\x62\x6a\x65\x63\x74\x20\x77\x69\x74\x68\x20\x75\x73\x65\x72\x20\x49\x44\x73\x20\x66\x72\x6f\x6d\x20\x75\x73\x65\x72\x5f\x69\x64\x73\x20\x61\x73\x20\x6b\x65\x79\x73\x20\x61\x6e\x64\x20\x72\x6f\x6c\x65\x73\x20\x61\x73\x20\x76\x61\x6c\x75\x65\x73\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x6f\x77\x6e\x65\x72\x22\x2c\x20\x22\x6d\x65\x6d\x62\x65\x72\x22\x29\x0a\x0a\x45\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x75\x73\x65\x72\x5f\x69\x64\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x0a\x20\x20\x20\x20\x5d\x2c\x0a\x20\x20\x20\x20\x22\x72\x6f\x6c\x65\x73\x22\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x3a\x20\x22\x6f\x77\x6e\x65\x72\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x3a\x20\x22\x6d\x65\x6d\x62\x65\x72\x22\x0a\x20\x20\x20\x20\x7d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x0a\x23\x23\x20\x37\x2e\x20\x51\x75\x65\x72\x69\x65\x73\x0a\x0a\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x20\x41\x50\x49\x20\x75\x73\x65\x73\x20\x48\x54\x54\x50\x20\x66\x6f\x72\x20\x63\x6f\x6d\x6d\x75\x6e\x69\x63\x61\x74\x69\x6f\x6e\x20\x61\x6e\x64\x20\x74\x68\x69\x73\x20\x73\x65\x63\x74\x69\x6f\x6e\x20\x64\x65\x73\x63\x72\x69\x62\x65\x73\x20\x48\x54\x54\x50\x20\x72\x65\x71\x75\x65\x73\x74\x73\x2c\x20\x74\x68\x65\x69\x72\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x20\x61\x6e\x64\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x41\x50\x49\x2e\x20\x42\x65\x73\x69\x64\x65\x20\x73\x70\x65\x63\x69\x66\x69\x65\x64\x20\x65\x72\x72\x6f\x72\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x20\x66\x6f\x72\x20\x65\x61\x63\x68\x20\x71\x75\x65\x72\x79\x2c\x20\x74\x68\x65\x20\x5b\x49\x6e\x74\x65\x72\x6e\x61\x6c\x20\x53\x65\x72\x76\x65\x72\x20\x45\x72\x72\x6f\x72\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x35\x30\x30\x29\x20\x6d\x61\x79\x20\x6f\x63\x63\x75\x72\x2e\x0a\x0a\x49\x66\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x20\x55\x52\x4c\x20\x70\x61\x74\x68\x20\x69\x73\x20\x6e\x6f\x74\x20\x76\x61\x6c\x69\x64\x2c\x20\x61\x20\x5b\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x34\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x2e\x0a\x0a\x49\x6e\x20\x63\x61\x73\x65\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x72\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x63\x61\x6e\x20\x6e\x6f\x74\x20\x62\x65\x20\x64\x65\x63\x6f\x64\x65\x64\x20\x66\x72\x6f\x6d\x20\x4a\x53\x4f\x4e\x2c\x20\x61\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x2e\x20\x41\x6c\x6c\x20\x50\x4f\x53\x54\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x6d\x75\x73\x74\x20\x68\x61\x76\x65\x20\x60\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x60\x20\x68\x65\x61\x64\x65\x72\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x74\x68\x73\x20\x6d\x61\x79\x20\x63\x6f\x6e\x74\x61\x69\x6e\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x20\x77\x68\x69\x63\x68\x20\x61\x72\x65\x20\x69\x6e\x64\x69\x63\x61\x74\x65\x64\x20\x77\x69\x74\x68\x20\x61\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x20\x6e\x61\x6d\x65\x20\x73\x75\x72\x72\x6f\x75\x6e\x64\x65\x64\x20\x77\x69\x74\x68\x20\x63\x75\x72\x6c\x79\x20\x62\x72\x61\x63\x6b\x65\x74\x73\x20\x2a\x7b\x7d\x2a\x2e\x0a\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x6c\x69\x73\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x73\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x31\x2e\x20\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x51\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x73\x74\x61\x72\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x20\x76\x61\x6c\x75\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x69\x6e\x20\x2a\x70\x72\x65\x76\x69\x6f\x75\x73\x2a\x20\x6f\x72\x20\x2a\x6e\x65\x78\x74\x2a\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x79\x0a\x20\x20\x2d\x20\x2a\x2a\x6c\x69\x6d\x69\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x31\x30\x30\x29\x20\x6d\x61\x78\x69\x6d\x61\x6c\x20\x65\x6c\x65\x6d\x65\x6e\x74\x73\x20\x69\x6e\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x29\x20\x0a\x20\x20\x2d\x20\x2a\x2a\x63\x6f\x75\x6e\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x72\x65\x76\x69\x6f\x75\x73\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6e\x65\x78\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x0a\x56\x61\x6c\x75\x65\x73\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x2a\x70\x72\x65\x76\x69\x6f\x75\x73\x2a\x20\x61\x6e\x64\x20\x2a\x6e\x65\x78\x74\x2a\x20\x66\x69\x65\x6c\x64\x73\x20\x63\x61\x6e\x20\x62\x65\x20\x70\x61\x73\x73\x65\x64\x20\x61\x73\x20\x2a\x73\x74\x61\x72\x74\x2a\x20\x71\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x20\x74\x6f\x20\x67\x65\x74\x20\x61\x20\x70\x72\x65\x76\x69\x6f\x75\x73\x20\x6f\x72\x20\x6e\x65\x78\x74\x20\x70\x61\x67\x65\x20\x69\x6e\x20\x74\x68\x65\x20\x6c\x69\x73\x74\x69\x6e\x67\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x77\x79\x6e\x77\x34\x70\x37\x77\x6b\x6a\x31\x31\x72\x35\x71\x71\x6e\x7a\x68\x76\x72\x36\x79\x79\x31\x73\x79\x79\x32\x76\x78\x65\x64\x33\x63\x66\x76\x78\x33\x66\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x75\x6e\x74\x22\x3a\x20\x31\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x3f\x73\x74\x61\x72\x74\x3d\x6d\x69\x73\x73\x69\x6e\x67\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3a\x20\x22\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x22\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x64\x65\x22\x3a\x20\x31\x30\x30\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x37\x2e\x32\x2e\x20\x47\x65\x74\x20\x44\x6f\x6d\x61\x69\x6e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x77\x79\x6e\x77\x34\x70\x37\x77\x6b\x6a\x31\x31\x72\x35\x71\x71\x6e\x7a\x68\x76\x72\x36\x79\x79\x31\x73\x79\x79\x32\x76\x78\x65\x64\x33\x63\x66\x76\x78\x33\x66\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x6d\x69\x73\x73\x69\x6e\x67\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3a\x20\x22\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x22\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x64\x65\x22\x3a\x20\x31\x30\x30\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x37\x2e\x33\x2e\x20\x41\x64\x64\x20\x44\x6f\x6d\x61\x69\x6e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x72\x65\x71\x75\x69\x72\x65\x64\x2c\x20\x61\x20\x77\x69\x6c\x64\x63\x61\x72\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x6e\x20\x66\x6f\x72\x6d\x20\x60\x2a\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x60\x20\x73\x65\x72\x76\x65\x73\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x66\x6f\x72\x20\x61\x6c\x6c\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x20\x6f\x66\x20\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x20\x74\x68\x61\x74\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x72\x65\x67\x69\x73\x74\x65\x72\x65\x64\x20\x61\x73\x20\x64\x6f\x6d\x61\x69\x6e\x73\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x77\x6e\x65\x72\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x49\x44\x20\x6f\x72\x20\x6e\x61\x6d\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x74\x68\x61\x74\x20\x6f\x77\x6e\x73\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x2c\x20\x77\x68\x69\x63\x68\x20\x63\x6c\x65\x61\x72\x73\x20\x6f\x77\x6e\x65\x72\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x5f\x69\x67\x6e\x6f\x72\x65\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x61\x6c\x69\x61\x73\x65\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x73\x74\x72\x69\x6e\x67\x73\x2c\x20\x72\x65\x70\x6c\x61\x63\x65\x73\x20\x61\x6c\x6c\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x6c\x69\x61\x73\x65\x73\x2c\x20\x77\x68\x65\x72\x65\x20\x61\x6e\x20\x65\x6d\x70\x74\x79\x20\x61\x72\x72\x61\x79\x20\x72\x65\x6d\x6f\x76\x65\x73\x20\x74\x68\x65\x6d\x3b\x20\x65\x76\x65\x72\x79\x20\x61\x6c\x69\x61\x73\x20\x69\x73\x20\x76\x65\x72\x69\x66\x69\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x77\x61\x79\x20\x61\x73\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x61\x6c\x69\x61\x73\x5f\x72\x65\x64\x69\x72\x65\x63\x74\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x5f\x64\x65\x66\x61\x75\x6c\x74\x73\x2a\x2a\x3a\x20\x28\x6f\x62\x6a\x65\x63\x74\x2c\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x61\x73\x20\x69\x6e\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2c\x20\x77\x68\x65\x72\x65\x20\x61\x6e\x20\x65\x6d\x70\x74\x79\x20\x6f\x62\x6a\x65\x63\x74\x20\x72\x65\x6d\x6f\x76\x65\x73\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x73\x29\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x66\x71\x64\x6e\x22\x3a\x22\x65\x78\x61\x6d\x70\x6c\x65\x2e\x6c\x6f\x63\x61\x6c\x68\x6f\x73\x74\x22\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x66\x61\x6c\x73\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x31\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x30\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x31\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x41\x76\x61\x69\x6c\x61\x62\x6c\x65\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x32\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x57\x69\x74\x68\x20\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x53\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x65\x65\x64\x73\x20\x56\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x34\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x41\x6c\x69\x61\x73\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x35\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x41\x6c\x69\x61\x73\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x36\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x51\x75\x6f\x74\x61\x20\x45\x78\x63\x65\x65\x64\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x37\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x31\x29\x20\x69\x66\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x73\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x76\x61\x6c\x69\x64\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x32\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x48\x6f\x73\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x34\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x54\x79\x70\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x35\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x4e\x61\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x36\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x38\x30\x29\x0a\x0a\x49\x66\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x65\x65\x64\x73\x20\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x2c\x20\x66\x6f\x6c\x6c\x6f\x77\x20\x69\x6e\x73\x74\x72\x75\x63\x74\x69\x6f\x6e\x73\x20\x64\x65\x73\x63\x72\x69\x62\x65\x64\x20\x69\x6e\x20\x5b\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x6f\x6b\x65\x6e\x73\x5d\x28\x23\x6c\x69\x73\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x6f\x6b\x65\x6e\x73\x29\x20\x73\x65\x63\x74\x69\x6f\x6e\x2e\x0a\x0a\x23\x23\x23\x20\x37\x2e\x34\x2e\x20\x55\x70\x64\x61\x74\x65\x20\x44\x6f\x6d\x61\x69\x6e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x77\x6e\x65\x72\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x74\x68\x65\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x6f\x77\x6e\x65\x72\x2c\x20\x61\x73\x20\x74\x68\x65\x20\x6f\x77\x6e\x65\x72\x20\x69\x73\x20\x63\x68\x61\x6e\x67\x65\x64\x20\x6f\x6e\x6c\x79\x20\x62\x79\x20\x61\x6e\x20\x61\x63\x63\x65\x70\x74\x65\x64\x20\x5b\x64\x6f\x6d\x61\x69\x6e\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x72\x61\x6e\x73\x66\x65\x72\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x49\x44\x20\x6f\x72\x20\x6e\x61\x6d\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x74\x68\x61\x74\x20\x6f\x77\x6e\x73\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x2c\x20\x77\x68\x69\x63\x68\x20\x63\x6c\x65\x61\x72\x73\x20\x6f\x77\x6e\x65\x72\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x5f\x69\x67\x6e\x6f\x72\x65\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x61\x6c\x69\x61\x73\x65\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x73\x74\x72\x69\x6e\x67\x73\x2c\x20\x72\x65\x70\x6c\x61\x63\x65\x73\x20\x61\x6c\x6c\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x6c\x69\x61\x73\x65\x73\x2c\x20\x77\x68\x65\x72\x65\x20\x61\x6e\x20\x65\x6d\x70\x74\x79\x20\x61\x72\x72\x61\x79\x20\x72\x65\x6d\x6f\x76\x65\x73\x20\x74\x68\x65\x6d\x3b\x20\x65\x76\x65\x72\x79\x20\x61\x6c\x69\x61\x73\x20\x69\x73\x20\x76\x65\x72\x69\x66\x69\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x77\x61\x79\x20\x61\x73\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x61\x6c\x69\x61\x73\x5f\x72\x65\x64\x69\x72\x65\x63\x74\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x5f\x64\x65\x66\x61\x75\x6c\x74\x73\x2a\x2a\x3a\x20\x28\x6f\x62\x6a\x65\x63\x74\x2c\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x61\x73\x20\x69\x6e\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2c\x20\x77\x68\x65\x72\x65\x20\x61\x6e\x20\x65\x6d\x70\x74\x79\x20\x6f\x62\x6a\x65\x63\x74\x20\x72\x65\x6d\x6f\x76\x65\x73\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x73\x29\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x74\x72\x75\x65\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x31\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x31\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x41\x76\x61\x69\x6c\x61\x62\x6c\x65\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x32\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x57\x69\x74\x68\x20\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x53\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x65\x65\x64\x73\x20\x56\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x34\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x41\x6c\x69\x61\x73\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x35\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x41\x6c\x69\x61\x73\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x36\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x51\x75\x6f\x74\x61\x20\x45\x78\x63\x65\x65\x64\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x37\x29\x20\x69\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x67\x69\x76\x65\x6e\x20\x74\x6f\x20\x61\x6e\x20\x6f\x77\x6e\x65\x72\x20\x74\x68\x61\x74\x20\x68\x61\x73\x20\x72\x65\x61\x63\x68\x65\x64\x20\x74\x68\x65\x20\x71\x75\x6f\x74\x61\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x32\x34\x29\x20\x69\x66\x20\x2a\x2a\x6f\x77\x6e\x65\x72\x5c\x5f\x75\x73\x65\x72\x5c\x5f\x69\x64\x2a\x2a\x20\x69\x73\x20\x6e\x6f\x74\x20\x74\x68\x65\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x6f\x77\x6e\x65\x72\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x31\x29\x20\x69\x66\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x73\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x76\x61\x6c\x69\x64\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x32\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x48\x6f\x73\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x34\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x54\x79\x70\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x35\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x4e\x61\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x36\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x38\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x51\x75\x6f\x74\x61\x20\x45\x78\x63\x65\x65\x64\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x31\x31\x30\x29\x20\x69\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x67\x69\x76\x65\x6e\x20\x74\x6f\x20\x61\x6e\x20\x6f\x77\x6e\x65\x72\x20\x74\x68\x61\x74\x20\x77\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x6d\x6f\x72\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x74\x68\x61\x6e\x20\x74\x68\x65\x20\x71\x75\x6f\x74\x61\x20\x61\x6c\x6c\x6f\x77\x73\x0a\x20\x20\x2d\x20\x5b\x50\x72\x65\x63\x6f\x6e\x64\x69\x74\x69\x6f\x6e\x20\x46\x61\x69\x6c\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x31\x32\x29\x0a\x0a\x49\x66\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x65\x65\x64\x73\x20\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x2c\x20\x66\x6f\x6c\x6c\x6f\x77\x20\x69\x6e\x73\x74\x72\x75\x63\x74\x69\x6f\x6e\x73\x20\x64\x65\x73\x63\x72\x69\x62\x65\x64\x20\x69\x6e\x20\x5b\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x6f\x6b\x65\x6e\x73\x5d\x28\x23\x6c\x69\x73\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x6f\x6b\x65\x6e\x73\x29\x20\x73\x65\x63\x74\x69\x6f\x6e\x2e\x0a\x0a\x23\x23\x23\x20\x37\x2e\x35\x2e\x20\x44\x65\x6c\x65\x74\x65\x20\x44\x6f\x6d\x61\x69\x6e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x44\x45\x4c\x45\x54\x45\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x68\x61\x73\x20\x62\x65\x65\x6e\x20\x64\x65\x6c\x65\x74\x65\x64\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x58\x20\x44\x45\x4c\x45\x54\x45\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x72\x65\x63\x6f\x6e\x64\x69\x74\x69\x6f\x6e\x20\x46\x61\x69\x6c\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x31\x32\x29\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x6c\x69\x73\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x6f\x6b\x65\x6e\x73\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x36\x2e\x20\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x6f\x6b\x65\x6e\x73\x0a\x0a\x54\x68\x69\x73\x20\x41\x50\x49\x20\x63\x61\x6c\x6c\x20\x70\x72\x6f\x76\x69\x64\x65\x73\x20\x69\x6e\x66\x6f\x72\x6d\x61\x74\x69\x6f\x6e\x20\x74\x6f\x20\x76\x65\x72\x69\x66\x79\x20\x74\x68\x65\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x6f\x66\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x0a\x0a\x44\x6f\x6d\x61\x69\x6e\x20\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x20\x64\x6f\x6e\x65\x20\x62\x79\x20\x61\x6e\x79\x20\x6f\x66\x20\x74\x68\x65\x20\x6d\x65\x74\x68\x6f\x64\x73\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x3a\x0a\x0a\x20\x20\x2d\x20\x60\x64\x6e\x73\x2d\x74\x78\x74\x60\x3a\x20\x73\x65\x74\x74\x69\x6e\x67\x20\x61\x20\x54\x58\x54\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x6e\x61\x6d\x65\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x2a\x66\x71\x64\x6e\x2a\x20\x66\x69\x65\x6c\x64\x2e\x20\x54\x68\x65\x20\x76\x61\x6c\x75\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x72\x65\x63\x6f\x72\x64\x20\x69\x73\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x2a\x74\x6f\x6b\x65\x6e\x2a\x20\x66\x69\x65\x6c\x64\x2e\x0a\x20\x20\x2d\x20\x60\x68\x74\x74\x70\x2d\x66\x69\x6c\x65\x60\x3a\x20\x73\x65\x72\x76\x69\x6e\x67\x20\x61\x20\x66\x69\x6c\x65\x20\x6f\x76\x65\x72\x20\x48\x54\x54\x50\x53\x20\x6f\x6e\x20\x74\x68\x65\x20\x55\x52\x4c\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x2a\x75\x72\x6c\x2a\x20\x66\x69\x65\x6c\x64\x2c\x20\x77\x69\x74\x68\x20\x73\x74\x61\x74\x75\x73\x20\x32\x30\x30\x20\x4f\x4b\x20\x61\x6e\x64\x20\x77\x69\x74\x68\x6f\x75\x74\x20\x72\x65\x64\x69\x72\x65\x63\x74\x73\x2e\x20\x54\x68\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x69\x73\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x2a\x74\x6f\x6b\x65\x6e\x2a\x20\x66\x69\x65\x6c\x64\x2e\x20\x54\x68\x69\x73\x20\x6d\x65\x74\x68\x6f\x64\x20\x76\x65\x72\x69\x66\x69\x65\x73\x20\x6f\x6e\x6c\x79\x20\x74\x68\x65\x20\x65\x78\x61\x63\x74\x20\x64\x6f\x6d\x61\x69\x6e\x2c\x20\x61\x6e\x64\x20\x6e\x6f\x74\x20\x69\x74\x73\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x2c\x20\x73\x6f\x20\x69\x74\x20\x69\x73\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x6f\x6e\x6c\x79\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x55\x52\x4c\x2e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x66\x71\x64\x6e\x7d\x2f\x74\x6f\x6b\x65\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x66\x75\x6c\x6c\x79\x20\x71\x75\x61\x6c\x69\x66\x69\x65\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x2c\x20\x77\x69\x6c\x64\x63\x61\x72\x64\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x61\x72\x65\x20\x76\x65\x72\x69\x66\x69\x65\x64\x20\x62\x79\x20\x74\x68\x65\x69\x72\x20\x70\x61\x72\x65\x6e\x74\x20\x64\x6f\x6d\x61\x69\x6e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x6f\x6b\x65\x6e\x73\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x6f\x6b\x65\x6e\x73\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x74\x6f\x6b\x65\x6e\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6d\x65\x74\x68\x6f\x64\x22\x3a\x20\x22\x64\x6e\x73\x2d\x74\x78\x74\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x22\x3a\x20\x22\x37\x37\x65\x33\x45\x5a\x37\x55\x43\x51\x44\x63\x66\x66\x7a\x65\x6b\x53\x4b\x48\x71\x75\x58\x56\x79\x71\x55\x3d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6d\x65\x74\x68\x6f\x64\x22\x3a\x20\x22\x64\x6e\x73\x2d\x74\x78\x74\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x22\x3a\x20\x22\x35\x6a\x77\x4a\x32\x42\x70\x6d\x69\x5a\x6f\x34\x58\x48\x4a\x42\x6a\x41\x74\x54\x77\x74\x76\x7a\x50\x6b\x51\x3d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6d\x65\x74\x68\x6f\x64\x22\x3a\x20\x22\x68\x74\x74\x70\x2d\x66\x69\x6c\x65\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x22\x3a\x20\x22\x35\x6a\x77\x4a\x32\x42\x70\x6d\x69\x5a\x6f\x34\x58\x48\x4a\x42\x6a\x41\x74\x54\x77\x74\x76\x7a\x50\x6b\x51\x3d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x75\x72\x6c\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x2e\x77\x65\x6c\x6c\x2d\x6b\x6e\x6f\x77\x6e\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2d\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x2f\x35\x6a\x77\x4a\x32\x42\x70\x6d\x69\x5a\x6f\x34\x58\x48\x4a\x42\x6a\x41\x74\x54\x77\x74\x76\x7a\x50\x6b\x51\x3d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x65\x78\x61\x6d\x70\x6c\x65\x20\x66\x6f\x72\x20\x61\x6c\x6c\x20\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x3a\x0a\x0a\x60\x60\x60\x0a\x24\x4f\x52\x49\x47\x49\x4e\x20\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2e\x0a\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x20\x54\x58\x54\x20\x22\x37\x37\x65\x33\x45\x5a\x37\x55\x43\x51\x44\x63\x66\x66\x7a\x65\x6b\x53\x4b\x48\x71\x75\x58\x56\x79\x71\x55\x3d\x22\x0a\x60\x60\x60\x0a\x0a\x54\x6f\x20\x76\x65\x72\x69\x66\x79\x20\x6f\x6e\x6c\x79\x20\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x3a\x0a\x0a\x60\x60\x60\x0a\x24\x4f\x52\x49\x47\x49\x4e\x20\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2e\x0a\x5f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x20\x54\x58\x54\x20\x22\x35\x6a\x77\x4a\x32\x42\x70\x6d\x69\x5a\x6f\x34\x58\x48\x4a\x42\x6a\x41\x74\x54\x77\x74\x76\x7a\x50\x6b\x51\x3d\x22\x0a\x60\x60\x60\x0a\x0a\x48\x54\x54\x50\x53\x20\x66\x69\x6c\x65\x20\x65\x78\x61\x6d\x70\x6c\x65\x20\x66\x6f\x72\x20\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2c\x20\x73\x65\x72\x76\x65\x64\x20\x62\x79\x20\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x3a\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x2e\x77\x65\x6c\x6c\x2d\x6b\x6e\x6f\x77\x6e\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2d\x76\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x2f\x35\x6a\x77\x4a\x32\x42\x70\x6d\x69\x5a\x6f\x34\x58\x48\x4a\x42\x6a\x41\x74\x54\x77\x74\x76\x7a\x50\x6b\x51\x3d\x0a\x35\x6a\x77\x4a\x32\x42\x70\x6d\x69\x5a\x6f\x34\x58\x48\x4a\x42\x6a\x41\x74\x54\x77\x74\x76\x7a\x50\x6b\x51\x3d\x0a\x60\x60\x60\x0a\x0a\x54\x6f\x6b\x65\x6e\x73\x20\x73\x70\x65\x63\x69\x66\x69\x63\x20\x66\x6f\x72\x20\x65\x61\x63\x68\x20\x75\x73\x65\x72\x2e\x20\x56\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x6f\x66\x20\x6f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x62\x79\x20\x6f\x6e\x65\x20\x75\x73\x65\x72\x20\x64\x6f\x65\x73\x20\x6e\x6f\x74\x20\x67\x69\x76\x65\x20\x70\x65\x72\x6d\x69\x73\x73\x69\x6f\x6e\x20\x6f\x74\x68\x65\x72\x20\x75\x73\x65\x72\x73\x20\x74\x6f\x20\x61\x64\x64\x20\x73\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x2e\x20\x45\x61\x63\x68\x20\x75\x73\x65\x72\x20\x6d\x75\x73\x74\x20\x68\x61\x76\x65\x20\x61\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x6f\x72\x20\x48\x54\x54\x50\x53\x20\x66\x69\x6c\x65\x20\x77\x69\x74\x68\x20\x61\x20\x73\x70\x65\x63\x69\x66\x69\x63\x20\x74\x6f\x6b\x65\x6e\x2e\x0a\x0a\x54\x68\x65\x20\x44\x4e\x53\x20\x72\x65\x63\x6f\x72\x64\x20\x6f\x72\x20\x48\x54\x54\x50\x53\x20\x66\x69\x6c\x65\x20\x73\x68\x6f\x75\x6c\x64\x20\x62\x65\x20\x6b\x65\x70\x74\x20\x61\x66\x74\x65\x72\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x61\x64\x64\x65\x64\x2e\x20\x4f\x77\x6e\x65\x72\x73\x68\x69\x70\x20\x69\x73\x20\x70\x65\x72\x69\x6f\x64\x69\x63\x61\x6c\x6c\x79\x20\x76\x65\x72\x69\x66\x69\x65\x64\x20\x61\x67\x61\x69\x6e\x20\x77\x69\x74\x68\x20\x74\x6f\x6b\x65\x6e\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x20\x61\x6e\x64\x20\x69\x74\x73\x20\x75\x73\x65\x72\x73\x2e\x20\x49\x66\x20\x6e\x6f\x6e\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x74\x6f\x6b\x65\x6e\x73\x20\x69\x73\x20\x66\x6f\x75\x6e\x64\x2c\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x20\x69\x73\x20\x6e\x6f\x74\x69\x66\x69\x65\x64\x20\x62\x79\x20\x65\x6d\x61\x69\x6c\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x20\x69\x66\x20\x61\x20\x74\x6f\x6b\x65\x6e\x20\x69\x73\x20\x6e\x6f\x74\x20\x70\x75\x62\x6c\x69\x73\x68\x65\x64\x20\x61\x67\x61\x69\x6e\x20\x69\x6e\x20\x61\x20\x67\x72\x61\x63\x65\x20\x70\x65\x72\x69\x6f\x64\x2e\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x31\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x37\x2e\x20\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x55\x73\x65\x72\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x75\x73\x65\x72\x73\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x55\x73\x65\x72\x73\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x73\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x75\x73\x65\x72\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x75\x73\x65\x72\x5f\x69\x64\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6a\x6b\x6e\x64\x73\x69\x33\x33\x33\x65\x39\x64\x73\x6e\x37\x30\x31\x32\x6e\x34\x32\x33\x6a\x62\x33\x31\x22\x0a\x20\x20\x20\x20\x5d\x2c\x0a\x20\x20\x20\x20\x22\x72\x6f\x6c\x65\x73\x22\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x3a\x20\x22\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6a\x6b\x6e\x64\x73\x69\x33\x33\x33\x65\x39\x64\x73\x6e\x37\x30\x31\x32\x6e\x34\x32\x33\x6a\x62\x33\x31\x22\x3a\x20\x22\x76\x69\x65\x77\x65\x72\x22\x0a\x20\x20\x20\x20\x7d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x38\x2e\x20\x47\x72\x61\x6e\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x55\x73\x65\x72\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x75\x73\x65\x72\x73\x2f\x7b\x75\x73\x65\x72\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x20\x20\x2d\x20\x2a\x2a\x75\x73\x65\x72\x2a\x2a\x3a\x20\x75\x73\x65\x72\x20\x69\x64\x65\x6e\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x6f\x70\x74\x69\x6f\x6e\x61\x6c\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x75\x73\x65\x72\x20\x69\x73\x20\x67\x72\x61\x6e\x74\x65\x64\x20\x74\x68\x65\x20\x60\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x60\x20\x72\x6f\x6c\x65\x20\x69\x66\x20\x69\x74\x20\x69\x73\x20\x6f\x6d\x69\x74\x74\x65\x64\x2e\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x6f\x6c\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x22\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x22\x2c\x20\x22\x76\x69\x65\x77\x65\x72\x22\x29\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x4f\x4b\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x72\x6f\x6c\x65\x22\x3a\x22\x76\x69\x65\x77\x65\x72\x22\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x75\x73\x65\x72\x73\x2f\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3a\x20\x22\x4f\x4b\x22\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x64\x65\x22\x3a\x20\x32\x30\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x47\x72\x61\x6e\x74\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x31\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x52\x6f\x6c\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x34\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x39\x2e\x20\x52\x65\x76\x6f\x6b\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x55\x73\x65\x72\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x44\x45\x4c\x45\x54\x45\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x75\x73\x65\x72\x73\x2f\x7b\x75\x73\x65\x72\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x20\x20\x2d\x20\x2a\x2a\x75\x73\x65\x72\x2a\x2a\x3a\x20\x75\x73\x65\x72\x20\x69\x64\x65\x6e\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x4f\x4b\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x58\x20\x44\x45\x4c\x45\x54\x45\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x75\x73\x65\x72\x73\x2f\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3a\x20\x22\x4f\x4b\x22\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x64\x65\x22\x3a\x20\x32\x30\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x47\x72\x61\x6e\x74\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x32\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x30\x2e\x20\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x50\x61\x63\x6b\x61\x67\x65\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x51\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x73\x74\x61\x72\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x20\x76\x61\x6c\x75\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x69\x6e\x20\x2a\x70\x72\x65\x76\x69\x6f\x75\x73\x2a\x20\x6f\x72\x20\x2a\x6e\x65\x78\x74\x2a\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x79\x0a\x20\x20\x2d\x20\x2a\x2a\x6c\x69\x6d\x69\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x31\x30\x30\x29\x20\x6d\x61\x78\x69\x6d\x61\x6c\x20\x65\x6c\x65\x6d\x65\x6e\x74\x73\x20\x69\x6e\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x0a\x20\x20\x2d\x20\x2a\x2a\x76\x63\x73\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x6c\x69\x73\x74\x20\x6f\x6e\x6c\x79\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x77\x69\x74\x68\x20\x74\x68\x69\x73\x20\x76\x65\x72\x73\x69\x6f\x6e\x20\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x73\x79\x73\x74\x65\x6d\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x74\x79\x70\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x6c\x69\x73\x74\x20\x6f\x6e\x6c\x79\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x77\x69\x74\x68\x20\x74\x68\x69\x73\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x74\x79\x70\x65\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x5f\x68\x6f\x73\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x6c\x69\x73\x74\x20\x6f\x6e\x6c\x79\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x77\x69\x74\x68\x20\x72\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x72\x6f\x6f\x74\x20\x6f\x6e\x20\x74\x68\x69\x73\x20\x68\x6f\x73\x74\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x20\x6c\x69\x73\x74\x20\x6f\x6e\x6c\x79\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x20\x6f\x72\x20\x6f\x6e\x6c\x79\x20\x65\x6e\x61\x62\x6c\x65\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x20\x20\x2d\x20\x2a\x2a\x71\x75\x65\x72\x79\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x6c\x69\x73\x74\x20\x6f\x6e\x6c\x79\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x77\x69\x74\x68\x20\x70\x61\x74\x68\x73\x20\x74\x68\x61\x74\x20\x63\x6f\x6e\x74\x61\x69\x6e\x20\x74\x68\x69\x73\x20\x73\x75\x62\x73\x74\x72\x69\x6e\x67\x2c\x20\x63\x61\x73\x65\x20\x69\x6e\x73\x65\x6e\x73\x69\x74\x69\x76\x65\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x29\x20\x0a\x20\x20\x2d\x20\x2a\x2a\x63\x6f\x75\x6e\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x72\x65\x76\x69\x6f\x75\x73\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6e\x65\x78\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x0a\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x72\x65\x20\x6f\x72\x64\x65\x72\x65\x64\x20\x62\x79\x20\x70\x61\x74\x68\x2e\x20\x56\x61\x6c\x75\x65\x73\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x2a\x70\x72\x65\x76\x69\x6f\x75\x73\x2a\x20\x61\x6e\x64\x20\x2a\x6e\x65\x78\x74\x2a\x20\x66\x69\x65\x6c\x64\x73\x20\x63\x61\x6e\x20\x62\x65\x20\x70\x61\x73\x73\x65\x64\x20\x61\x73\x20\x2a\x73\x74\x61\x72\x74\x2a\x20\x71\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x20\x74\x6f\x20\x67\x65\x74\x20\x61\x20\x70\x72\x65\x76\x69\x6f\x75\x73\x20\x6f\x72\x20\x6e\x65\x78\x74\x20\x70\x61\x67\x65\x20\x69\x6e\x20\x74\x68\x65\x20\x6c\x69\x73\x74\x69\x6e\x67\x2e\x20\x46\x69\x6c\x74\x65\x72\x20\x71\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x66\x6f\x72\x20\x61\x6c\x6c\x20\x70\x61\x67\x65\x73\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x70\x61\x63\x6b\x61\x67\x65\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x64\x71\x6e\x35\x34\x70\x31\x6a\x77\x76\x66\x78\x68\x62\x65\x62\x64\x33\x35\x77\x35\x39\x67\x32\x68\x36\x30\x35\x74\x39\x77\x6d\x35\x65\x32\x65\x68\x32\x30\x36\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x72\x65\x66\x5f\x74\x79\x70\x65\x22\x3a\x20\x22\x62\x72\x61\x6e\x63\x68\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x72\x65\x66\x5f\x6e\x61\x6d\x65\x22\x3a\x20\x22\x73\x74\x61\x62\x6c\x65\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x64\x71\x6e\x35\x34\x70\x31\x6a\x77\x76\x66\x78\x68\x62\x65\x62\x64\x33\x35\x77\x35\x39\x67\x32\x68\x36\x30\x35\x74\x39\x77\x6d\x35\x65\x32\x65\x68\x32\x30\x36\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x6c\x69\x62\x72\x61\x72\x79\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x68\x67\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x73\x73\x68\x3a\x2f\x2f\x6d\x65\x72\x63\x75\x72\x69\x61\x6c\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x6d\x79\x2d\x6c\x69\x62\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x75\x6e\x74\x22\x3a\x20\x32\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x31\x2e\x20\x47\x65\x74\x20\x50\x61\x63\x6b\x61\x67\x65\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x7b\x69\x64\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x69\x64\x2a\x2a\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x69\x64\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x64\x71\x6e\x35\x34\x70\x31\x6a\x77\x76\x66\x78\x68\x62\x65\x62\x64\x33\x35\x77\x35\x39\x67\x32\x68\x36\x30\x35\x74\x39\x77\x6d\x35\x65\x32\x65\x68\x32\x30\x36\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x64\x71\x6e\x35\x34\x70\x31\x6a\x77\x76\x66\x78\x68\x62\x65\x62\x64\x33\x35\x77\x35\x39\x67\x32\x68\x36\x30\x35\x74\x39\x77\x6d\x35\x65\x32\x65\x68\x32\x30\x36\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x22\x2c\x0a\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x66\x5f\x74\x79\x70\x65\x22\x3a\x20\x22\x62\x72\x61\x6e\x63\x68\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x66\x5f\x6e\x61\x6d\x65\x22\x3a\x20\x22\x73\x74\x61\x62\x6c\x65\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x61\x64\x64\x2d\x70\x61\x63\x6b\x61\x67\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x31\x32\x2e\x20\x41\x64\x64\x20\x50\x61\x63\x6b\x61\x67\x65\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x60\x60\x60\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x74\x68\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x76\x63\x73\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x67\x69\x74\x22\x2c\x20\x22\x68\x67\x22\x2c\x20\x22\x62\x7a\x72\x22\x2c\x20\x22\x73\x76\x6e\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x74\x79\x70\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x62\x72\x61\x6e\x63\x68\x22\x2c\x20\x22\x74\x61\x67\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x6e\x61\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x67\x6f\x5f\x73\x6f\x75\x72\x63\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x64\x69\x72\x65\x63\x74\x5f\x75\x72\x6c\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x66\x61\x6c\x73\x65\x29\x0a\x0a\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x74\x68\x61\x74\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x61\x72\x65\x20\x73\x65\x74\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x5f\x64\x65\x66\x61\x75\x6c\x74\x73\x2a\x2a\x2e\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x74\x79\x70\x65\x20\x61\x6e\x64\x20\x6e\x61\x6d\x65\x20\x61\x72\x65\x20\x69\x6e\x68\x65\x72\x69\x74\x65\x64\x20\x6f\x6e\x6c\x79\x20\x69\x66\x20\x6e\x65\x69\x74\x68\x65\x72\x20\x6f\x66\x20\x74\x68\x65\x6d\x20\x69\x73\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x2e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x64\x6f\x6d\x61\x69\x6e\x22\x3a\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x22\x70\x61\x74\x68\x22\x3a\x22\x2f\x6d\x79\x2d\x61\x70\x70\x22\x2c\x22\x76\x63\x73\x22\x3a\x22\x67\x69\x74\x22\x2c\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x61\x70\x70\x22\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x6d\x79\x2d\x61\x70\x70\x22\x2c\x0a\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x31\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x31\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x50\x61\x74\x68\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x32\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x56\x43\x53\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x33\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x31\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x32\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x48\x6f\x73\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x34\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x54\x79\x70\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x35\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x4e\x61\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x36\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x43\x68\x61\x6e\x67\x65\x20\x52\x65\x6a\x65\x63\x74\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x37\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x38\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x51\x75\x6f\x74\x61\x20\x45\x78\x63\x65\x65\x64\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x31\x31\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x33\x2e\x20\x55\x70\x64\x61\x74\x65\x20\x50\x61\x63\x6b\x61\x67\x65\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x7b\x69\x64\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x69\x64\x2a\x2a\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x69\x64\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x74\x68\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x76\x63\x73\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x67\x69\x74\x22\x2c\x20\x22\x68\x67\x22\x2c\x20\x22\x62\x7a\x72\x22\x2c\x20\x22\x73\x76\x6e\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x74\x79\x70\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x62\x72\x61\x6e\x63\x68\x22\x2c\x20\x22\x74\x61\x67\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x5f\x6e\x61\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x67\x6f\x5f\x73\x6f\x75\x72\x63\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x64\x69\x72\x65\x63\x74\x5f\x75\x72\x6c\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x73\x61\x62\x6c\x65\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x66\x61\x6c\x73\x65\x29\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x70\x61\x74\x68\x22\x3a\x22\x2f\x6f\x75\x72\x2d\x61\x70\x70\x22\x2c\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x74\x72\x75\x65\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x6f\x75\x72\x2d\x61\x70\x70\x22\x2c\x0a\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x31\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x31\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x50\x61\x74\x68\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x32\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x56\x43\x53\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x33\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x31\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x32\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x48\x6f\x73\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x34\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x54\x79\x70\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x35\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x4e\x61\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x36\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x43\x68\x61\x6e\x67\x65\x20\x52\x65\x6a\x65\x63\x74\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x37\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x38\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x51\x75\x6f\x74\x61\x20\x45\x78\x63\x65\x65\x64\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x31\x31\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x72\x65\x63\x6f\x6e\x64\x69\x74\x69\x6f\x6e\x20\x46\x61\x69\x6c\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x31\x32\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x34\x2e\x20\x44\x65\x6c\x65\x74\x65\x20\x50\x61\x63\x6b\x61\x67\x65\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x44\x45\x4c\x45\x54\x45\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x7b\x69\x64\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x69\x64\x2a\x2a\x3a\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x69\x64\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x20\x74\x68\x61\x74\x20\x68\x61\x73\x20\x62\x65\x65\x6e\x20\x64\x65\x6c\x65\x74\x65\x64\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x58\x20\x44\x45\x4c\x45\x54\x45\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x6f\x75\x72\x2d\x61\x70\x70\x22\x2c\x0a\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x3a\x20\x74\x72\x75\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x72\x65\x63\x6f\x6e\x64\x69\x74\x69\x6f\x6e\x20\x46\x61\x69\x6c\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x31\x32\x29\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x73\x79\x6e\x63\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x70\x61\x63\x6b\x61\x67\x65\x73\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x31\x35\x2e\x20\x53\x79\x6e\x63\x20\x44\x6f\x6d\x61\x69\x6e\x20\x50\x61\x63\x6b\x61\x67\x65\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x73\x79\x6e\x63\x0a\x60\x60\x60\x0a\x0a\x41\x64\x64\x73\x2c\x20\x75\x70\x64\x61\x74\x65\x73\x20\x61\x6e\x64\x20\x64\x65\x6c\x65\x74\x65\x73\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x75\x6e\x64\x65\x72\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x20\x74\x6f\x20\x6d\x61\x74\x63\x68\x20\x74\x68\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x6d\x61\x6e\x69\x66\x65\x73\x74\x2e\x20\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x72\x65\x20\x69\x64\x65\x6e\x74\x69\x66\x69\x65\x64\x20\x62\x79\x20\x74\x68\x65\x69\x72\x20\x70\x61\x74\x68\x73\x2e\x20\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x74\x68\x61\x74\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x69\x6e\x20\x74\x68\x65\x20\x6d\x61\x6e\x69\x66\x65\x73\x74\x20\x61\x72\x65\x20\x64\x65\x6c\x65\x74\x65\x64\x2c\x20\x61\x6e\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x74\x68\x61\x74\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x73\x65\x74\x20\x69\x6e\x20\x74\x68\x65\x20\x6d\x61\x6e\x69\x66\x65\x73\x74\x20\x61\x72\x65\x20\x72\x65\x73\x65\x74\x20\x74\x6f\x20\x74\x68\x65\x69\x72\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x76\x61\x6c\x75\x65\x73\x2e\x20\x41\x6c\x6c\x20\x63\x68\x61\x6e\x67\x65\x73\x20\x61\x72\x65\x20\x61\x70\x70\x6c\x69\x65\x64\x20\x61\x74\x6f\x6d\x69\x63\x61\x6c\x6c\x79\x2c\x20\x61\x6e\x64\x20\x69\x66\x20\x61\x6e\x79\x20\x6f\x66\x20\x74\x68\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x69\x73\x20\x6e\x6f\x74\x20\x76\x61\x6c\x69\x64\x2c\x20\x6e\x6f\x20\x63\x68\x61\x6e\x67\x65\x73\x20\x61\x72\x65\x20\x6d\x61\x64\x65\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x51\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x72\x79\x5f\x72\x75\x6e\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x20\x69\x66\x20\x74\x72\x75\x65\x2c\x20\x63\x68\x61\x6e\x67\x65\x73\x20\x61\x72\x65\x20\x6f\x6e\x6c\x79\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x61\x6e\x64\x20\x6e\x6f\x74\x20\x61\x70\x70\x6c\x69\x65\x64\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x6f\x62\x6a\x65\x63\x74\x73\x29\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x61\x73\x20\x69\x6e\x20\x5b\x41\x64\x64\x20\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x61\x64\x64\x2d\x70\x61\x63\x6b\x61\x67\x65\x29\x20\x72\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x2c\x20\x65\x78\x63\x65\x70\x74\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x2a\x2a\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x63\x68\x61\x6e\x67\x65\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x6f\x62\x6a\x65\x63\x74\x73\x29\x0a\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x61\x63\x74\x69\x6f\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x6f\x6e\x65\x20\x6f\x66\x20\x60\x61\x64\x64\x60\x2c\x20\x60\x75\x70\x64\x61\x74\x65\x60\x20\x6f\x72\x20\x60\x64\x65\x6c\x65\x74\x65\x60\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x2a\x2a\x3a\x20\x28\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x29\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x63\x68\x61\x6e\x67\x65\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x6f\x62\x6a\x65\x63\x74\x73\x29\x20\x63\x68\x61\x6e\x67\x65\x64\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x2a\x2a\x66\x69\x65\x6c\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x2a\x2a\x66\x72\x6f\x6d\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x2a\x2a\x74\x6f\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x61\x70\x70\x6c\x69\x65\x64\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x70\x61\x63\x6b\x61\x67\x65\x73\x22\x3a\x5b\x7b\x22\x70\x61\x74\x68\x22\x3a\x22\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x22\x2c\x22\x76\x63\x73\x22\x3a\x22\x67\x69\x74\x22\x2c\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2e\x67\x69\x74\x22\x7d\x5d\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x73\x79\x6e\x63\x3f\x64\x72\x79\x5f\x72\x75\x6e\x3d\x74\x72\x75\x65\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x63\x68\x61\x6e\x67\x65\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x61\x63\x74\x69\x6f\x6e\x22\x3a\x20\x22\x61\x64\x64\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x70\x61\x63\x6b\x61\x67\x65\x22\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2e\x67\x69\x74\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x63\x68\x61\x6e\x67\x65\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x69\x65\x6c\x64\x22\x3a\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x22\x3a\x20\x22\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x69\x65\x6c\x64\x22\x3a\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x66\x71\x64\x6e\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x69\x65\x6c\x64\x22\x3a\x20\x22\x70\x61\x74\x68\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x22\x3a\x20\x22\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x69\x65\x6c\x64\x22\x3a\x20\x22\x76\x63\x73\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x22\x3a\x20\x22\x67\x69\x74\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x69\x65\x6c\x64\x22\x3a\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2e\x67\x69\x74\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x2c\x0a\x20\x20\x20\x20\x22\x61\x70\x70\x6c\x69\x65\x64\x22\x3a\x20\x66\x61\x6c\x73\x65\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x54\x68\x65\x20\x73\x61\x6d\x65\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x20\x69\x73\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x60\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x20\x63\x6c\x69\x65\x6e\x74\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x73\x79\x6e\x63\x60\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x74\x68\x61\x74\x20\x72\x65\x61\x64\x73\x20\x74\x68\x65\x20\x6d\x61\x6e\x69\x66\x65\x73\x74\x20\x66\x72\x6f\x6d\x20\x61\x20\x59\x41\x4d\x4c\x20\x6f\x72\x20\x4a\x53\x4f\x4e\x20\x66\x69\x6c\x65\x2e\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x31\x29\x20\x69\x66\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x70\x61\x74\x68\x20\x69\x73\x20\x6c\x69\x73\x74\x65\x64\x20\x6d\x6f\x72\x65\x20\x74\x68\x61\x6e\x20\x6f\x6e\x63\x65\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x50\x61\x74\x68\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x32\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x56\x43\x53\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x33\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x31\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x32\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x48\x6f\x73\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x34\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x54\x79\x70\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x35\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x4e\x61\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x36\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x43\x68\x61\x6e\x67\x65\x20\x52\x65\x6a\x65\x63\x74\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x37\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x38\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x51\x75\x6f\x74\x61\x20\x45\x78\x63\x65\x65\x64\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x31\x31\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x36\x2e\x20\x53\x65\x61\x72\x63\x68\x20\x50\x61\x63\x6b\x61\x67\x65\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x0a\x60\x60\x60\x0a\x0a\x53\x65\x61\x72\x63\x68\x65\x73\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x75\x6e\x64\x65\x72\x20\x61\x6c\x6c\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x75\x73\x65\x72\x20\x68\x61\x73\x20\x61\x63\x63\x65\x73\x73\x20\x74\x6f\x2e\x20\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x72\x65\x20\x6f\x72\x64\x65\x72\x65\x64\x20\x62\x79\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x6e\x64\x20\x70\x61\x74\x68\x2e\x0a\x0a\x51\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x71\x75\x65\x72\x79\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x20\x63\x61\x73\x65\x20\x69\x6e\x73\x65\x6e\x73\x69\x74\x69\x76\x65\x20\x73\x75\x62\x73\x74\x72\x69\x6e\x67\x20\x6f\x66\x20\x74\x68\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x69\x6d\x70\x6f\x72\x74\x20\x70\x72\x65\x66\x69\x78\x2c\x20\x72\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x72\x6f\x6f\x74\x20\x6f\x72\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x6e\x61\x6d\x65\x2c\x20\x61\x6c\x6c\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x72\x65\x20\x6c\x69\x73\x74\x65\x64\x20\x69\x66\x20\x69\x74\x20\x69\x73\x20\x62\x6c\x61\x6e\x6b\x0a\x20\x20\x2d\x20\x2a\x2a\x73\x74\x61\x72\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x20\x76\x61\x6c\x75\x65\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x69\x6e\x20\x2a\x6e\x65\x78\x74\x2a\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x79\x0a\x20\x20\x2d\x20\x2a\x2a\x6c\x69\x6d\x69\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x32\x30\x29\x20\x6d\x61\x78\x69\x6d\x61\x6c\x20\x65\x6c\x65\x6d\x65\x6e\x74\x73\x20\x69\x6e\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x63\x6f\x75\x6e\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6e\x65\x78\x74\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x3f\x71\x75\x65\x72\x79\x3d\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x6d\x79\x2d\x61\x70\x70\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x70\x61\x63\x6b\x61\x67\x65\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x64\x71\x6e\x35\x34\x70\x31\x6a\x77\x76\x66\x78\x68\x62\x65\x62\x64\x33\x35\x77\x35\x39\x67\x32\x68\x36\x30\x35\x74\x39\x77\x6d\x35\x65\x32\x65\x68\x32\x30\x36\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x6d\x79\x2d\x61\x70\x70\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x75\x6e\x74\x22\x3a\x20\x31\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x30\x29\x20\x69\x66\x20\x74\x68\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x64\x20\x62\x79\x20\x2a\x73\x74\x61\x72\x74\x2a\x20\x69\x73\x20\x6e\x6f\x74\x20\x66\x6f\x75\x6e\x64\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x37\x2e\x20\x42\x61\x74\x63\x68\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x62\x61\x74\x63\x68\x0a\x60\x60\x60\x0a\x0a\x41\x70\x70\x6c\x69\x65\x73\x20\x61\x20\x6c\x69\x73\x74\x20\x6f\x66\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x6e\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x73\x20\x61\x74\x6f\x6d\x69\x63\x61\x6c\x6c\x79\x2e\x20\x4f\x70\x65\x72\x61\x74\x69\x6f\x6e\x73\x20\x61\x72\x65\x20\x61\x70\x70\x6c\x69\x65\x64\x20\x69\x6e\x20\x6f\x72\x64\x65\x72\x20\x61\x6e\x64\x20\x6c\x61\x74\x65\x72\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x73\x20\x73\x65\x65\x20\x74\x68\x65\x20\x63\x68\x61\x6e\x67\x65\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x70\x72\x65\x76\x69\x6f\x75\x73\x20\x6f\x6e\x65\x73\x2e\x20\x49\x66\x20\x61\x6e\x79\x20\x6f\x66\x20\x74\x68\x65\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x73\x20\x66\x61\x69\x6c\x73\x2c\x20\x6e\x6f\x20\x63\x68\x61\x6e\x67\x65\x73\x20\x61\x72\x65\x20\x6d\x61\x64\x65\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x65\x72\x72\x6f\x72\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x63\x6f\x6e\x74\x61\x69\x6e\x73\x20\x74\x68\x65\x20\x69\x6e\x64\x65\x78\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x61\x69\x6c\x65\x64\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x20\x69\x6e\x20\x74\x68\x65\x20\x2a\x2a\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x2a\x2a\x20\x70\x72\x6f\x70\x65\x72\x74\x79\x2e\x20\x41\x74\x20\x6d\x6f\x73\x74\x20\x31\x30\x30\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x73\x20\x63\x61\x6e\x20\x62\x65\x20\x73\x65\x6e\x74\x20\x69\x6e\x20\x61\x20\x73\x69\x6e\x67\x6c\x65\x20\x72\x65\x71\x75\x65\x73\x74\x2e\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x6f\x62\x6a\x65\x63\x74\x73\x29\x0a\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x61\x63\x74\x69\x6f\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x6f\x6e\x65\x20\x6f\x66\x20\x60\x61\x64\x64\x5f\x64\x6f\x6d\x61\x69\x6e\x60\x2c\x20\x60\x75\x70\x64\x61\x74\x65\x5f\x64\x6f\x6d\x61\x69\x6e\x60\x2c\x20\x60\x64\x65\x6c\x65\x74\x65\x5f\x64\x6f\x6d\x61\x69\x6e\x60\x2c\x20\x60\x67\x72\x61\x6e\x74\x5f\x64\x6f\x6d\x61\x69\x6e\x5f\x75\x73\x65\x72\x60\x2c\x20\x60\x75\x70\x64\x61\x74\x65\x5f\x64\x6f\x6d\x61\x69\x6e\x5f\x75\x73\x65\x72\x60\x2c\x20\x60\x72\x65\x76\x6f\x6b\x65\x5f\x64\x6f\x6d\x61\x69\x6e\x5f\x75\x73\x65\x72\x60\x2c\x20\x60\x61\x64\x64\x5f\x70\x61\x63\x6b\x61\x67\x65\x60\x2c\x20\x60\x75\x70\x64\x61\x74\x65\x5f\x70\x61\x63\x6b\x61\x67\x65\x60\x20\x6f\x72\x20\x60\x64\x65\x6c\x65\x74\x65\x5f\x70\x61\x63\x6b\x61\x67\x65\x60\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x20\x66\x6f\x72\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x63\x74\x69\x6f\x6e\x73\x2c\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x49\x44\x20\x66\x6f\x72\x20\x60\x75\x70\x64\x61\x74\x65\x5f\x70\x61\x63\x6b\x61\x67\x65\x60\x20\x61\x6e\x64\x20\x60\x64\x65\x6c\x65\x74\x65\x5f\x70\x61\x63\x6b\x61\x67\x65\x60\x20\x61\x63\x74\x69\x6f\x6e\x73\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x75\x73\x65\x72\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x20\x66\x6f\x72\x20\x60\x67\x72\x61\x6e\x74\x5f\x64\x6f\x6d\x61\x69\x6e\x5f\x75\x73\x65\x72\x60\x2c\x20\x60\x75\x70\x64\x61\x74\x65\x5f\x64\x6f\x6d\x61\x69\x6e\x5f\x75\x73\x65\x72\x60\x20\x61\x6e\x64\x20\x60\x72\x65\x76\x6f\x6b\x65\x5f\x64\x6f\x6d\x61\x69\x6e\x5f\x75\x73\x65\x72\x60\x20\x61\x63\x74\x69\x6f\x6e\x73\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x72\x6f\x6c\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x72\x6f\x6c\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x75\x73\x65\x72\x20\x66\x6f\x72\x20\x60\x67\x72\x61\x6e\x74\x5f\x64\x6f\x6d\x61\x69\x6e\x5f\x75\x73\x65\x72\x60\x20\x61\x6e\x64\x20\x60\x75\x70\x64\x61\x74\x65\x5f\x64\x6f\x6d\x61\x69\x6e\x5f\x75\x73\x65\x72\x60\x20\x61\x63\x74\x69\x6f\x6e\x73\x2c\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x76\x61\x6c\x75\x65\x73\x20\x61\x73\x20\x69\x6e\x20\x5b\x55\x70\x64\x61\x74\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x55\x73\x65\x72\x20\x52\x6f\x6c\x65\x5d\x28\x23\x75\x70\x64\x61\x74\x65\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x2d\x72\x6f\x6c\x65\x29\x20\x72\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x2a\x2a\x3a\x20\x28\x6f\x62\x6a\x65\x63\x74\x29\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x61\x73\x20\x69\x6e\x20\x41\x64\x64\x20\x44\x6f\x6d\x61\x69\x6e\x20\x72\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x2c\x20\x66\x6f\x72\x20\x60\x61\x64\x64\x5f\x64\x6f\x6d\x61\x69\x6e\x60\x20\x61\x6e\x64\x20\x60\x75\x70\x64\x61\x74\x65\x5f\x64\x6f\x6d\x61\x69\x6e\x60\x20\x61\x63\x74\x69\x6f\x6e\x73\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x2a\x2a\x3a\x20\x28\x6f\x62\x6a\x65\x63\x74\x29\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x61\x73\x20\x69\x6e\x20\x5b\x41\x64\x64\x20\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x61\x64\x64\x2d\x70\x61\x63\x6b\x61\x67\x65\x29\x20\x72\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x2c\x20\x66\x6f\x72\x20\x60\x61\x64\x64\x5f\x70\x61\x63\x6b\x61\x67\x65\x60\x20\x61\x6e\x64\x20\x60\x75\x70\x64\x61\x74\x65\x5f\x70\x61\x63\x6b\x61\x67\x65\x60\x20\x61\x63\x74\x69\x6f\x6e\x73\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x69\x66\x5f\x6d\x61\x74\x63\x68\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x22\x29\x20\x65\x6e\x74\x69\x74\x79\x20\x74\x61\x67\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x72\x20\x74\x68\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x66\x6f\x72\x20\x75\x70\x64\x61\x74\x65\x20\x61\x6e\x64\x20\x64\x65\x6c\x65\x74\x65\x20\x61\x63\x74\x69\x6f\x6e\x73\x2c\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x6d\x65\x61\x6e\x69\x6e\x67\x20\x61\x73\x20\x74\x68\x65\x20\x60\x49\x66\x2d\x4d\x61\x74\x63\x68\x60\x20\x68\x65\x61\x64\x65\x72\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x73\x75\x6c\x74\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x6f\x62\x6a\x65\x63\x74\x73\x29\x20\x69\x6e\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x6f\x72\x64\x65\x72\x20\x61\x73\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x73\x0a\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x61\x63\x74\x69\x6f\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x2a\x2a\x3a\x20\x28\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x29\x20\x66\x6f\x72\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x63\x74\x69\x6f\x6e\x73\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x2a\x2a\x3a\x20\x28\x5b\x50\x61\x63\x6b\x61\x67\x65\x5d\x28\x23\x70\x61\x63\x6b\x61\x67\x65\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x29\x20\x66\x6f\x72\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x61\x63\x74\x69\x6f\x6e\x73\x0a\x20\x20\x20\x20\x2d\x20\x2a\x2a\x65\x74\x61\x67\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x65\x6e\x74\x69\x74\x79\x20\x74\x61\x67\x20\x6f\x66\x20\x74\x68\x65\x20\x72\x65\x73\x75\x6c\x74\x69\x6e\x67\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x72\x20\x70\x61\x63\x6b\x61\x67\x65\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x73\x22\x3a\x5b\x7b\x22\x61\x63\x74\x69\x6f\x6e\x22\x3a\x22\x61\x64\x64\x5f\x70\x61\x63\x6b\x61\x67\x65\x22\x2c\x22\x70\x61\x63\x6b\x61\x67\x65\x22\x3a\x7b\x22\x64\x6f\x6d\x61\x69\x6e\x22\x3a\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x22\x70\x61\x74\x68\x22\x3a\x22\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x22\x2c\x22\x76\x63\x73\x22\x3a\x22\x67\x69\x74\x22\x2c\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2e\x67\x69\x74\x22\x7d\x7d\x2c\x7b\x22\x61\x63\x74\x69\x6f\x6e\x22\x3a\x22\x67\x72\x61\x6e\x74\x5f\x64\x6f\x6d\x61\x69\x6e\x5f\x75\x73\x65\x72\x22\x2c\x22\x72\x65\x66\x22\x3a\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x22\x75\x73\x65\x72\x22\x3a\x22\x61\x6c\x69\x63\x65\x22\x7d\x5d\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x62\x61\x74\x63\x68\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x72\x65\x73\x75\x6c\x74\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x61\x63\x74\x69\x6f\x6e\x22\x3a\x20\x22\x61\x64\x64\x5f\x70\x61\x63\x6b\x61\x67\x65\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x70\x61\x63\x6b\x61\x67\x65\x22\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x64\x71\x6e\x35\x34\x70\x31\x6a\x77\x76\x66\x78\x68\x62\x65\x62\x64\x33\x35\x77\x35\x39\x67\x32\x68\x36\x30\x35\x74\x39\x77\x6d\x35\x65\x32\x65\x68\x32\x30\x36\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x70\x61\x74\x68\x22\x3a\x20\x22\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x76\x63\x73\x22\x3a\x20\x22\x67\x69\x74\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x6d\x65\x2f\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2e\x67\x69\x74\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x65\x74\x61\x67\x22\x3a\x20\x22\x5c\x22\x31\x5c\x22\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x61\x63\x74\x69\x6f\x6e\x22\x3a\x20\x22\x67\x72\x61\x6e\x74\x5f\x64\x6f\x6d\x61\x69\x6e\x5f\x75\x73\x65\x72\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x22\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x72\x76\x33\x6e\x70\x70\x33\x65\x39\x79\x72\x38\x6b\x67\x68\x72\x6a\x61\x78\x7a\x7a\x63\x39\x73\x68\x64\x32\x79\x61\x76\x32\x70\x61\x39\x32\x6e\x36\x6b\x39\x35\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x35\x70\x63\x39\x64\x66\x78\x68\x62\x73\x72\x35\x73\x32\x32\x63\x6b\x70\x79\x76\x30\x30\x68\x61\x30\x77\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x65\x74\x61\x67\x22\x3a\x20\x22\x5c\x22\x33\x5c\x22\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x65\x78\x61\x6d\x70\x6c\x65\x3a\x0a\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3a\x20\x22\x50\x61\x63\x6b\x61\x67\x65\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x22\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x64\x65\x22\x3a\x20\x32\x30\x30\x31\x2c\x0a\x20\x20\x20\x20\x22\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x22\x3a\x20\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x72\x65\x63\x6f\x6e\x64\x69\x74\x69\x6f\x6e\x20\x46\x61\x69\x6c\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x31\x32\x29\x20\x69\x66\x20\x2a\x2a\x69\x66\x5f\x6d\x61\x74\x63\x68\x2a\x2a\x20\x64\x6f\x65\x73\x20\x6e\x6f\x74\x20\x6d\x61\x74\x63\x68\x20\x74\x68\x65\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x65\x6e\x74\x69\x74\x79\x20\x74\x61\x67\x0a\x20\x20\x2d\x20\x5b\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x52\x65\x71\x75\x65\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x32\x39\x29\x0a\x20\x20\x2d\x20\x5b\x42\x61\x74\x63\x68\x20\x41\x63\x74\x69\x6f\x6e\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x33\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x61\x6c\x6c\x20\x65\x72\x72\x6f\x72\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x63\x6f\x72\x72\x65\x73\x70\x6f\x6e\x64\x69\x6e\x67\x20\x73\x69\x6e\x67\x6c\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x61\x6e\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x71\x75\x65\x72\x69\x65\x73\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x67\x65\x74\x2d\x72\x61\x74\x65\x2d\x6c\x69\x6d\x69\x74\x73\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x31\x38\x2e\x20\x47\x65\x74\x20\x52\x61\x74\x65\x20\x4c\x69\x6d\x69\x74\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x75\x73\x65\x72\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x72\x61\x74\x65\x2d\x6c\x69\x6d\x69\x74\x73\x0a\x60\x60\x60\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x68\x6f\x75\x72\x6c\x79\x20\x72\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x73\x20\x6f\x66\x20\x61\x20\x75\x73\x65\x72\x2e\x20\x4f\x6e\x6c\x79\x20\x61\x64\x6d\x69\x6e\x69\x73\x74\x72\x61\x74\x6f\x72\x73\x20\x63\x61\x6e\x20\x67\x65\x74\x20\x72\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x73\x20\x6f\x66\x20\x6f\x74\x68\x65\x72\x20\x75\x73\x65\x72\x73\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x75\x73\x65\x72\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x68\x6f\x75\x72\x6c\x79\x5f\x6c\x69\x6d\x69\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x20\x6c\x69\x6d\x69\x74\x20\x66\x6f\x72\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x6f\x74\x68\x65\x72\x20\x74\x68\x61\x6e\x20\x47\x45\x54\x2c\x20\x30\x20\x69\x66\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x6c\x69\x6d\x69\x74\x65\x64\x0a\x20\x20\x2d\x20\x2a\x2a\x68\x6f\x75\x72\x6c\x79\x5f\x72\x65\x61\x64\x5f\x6c\x69\x6d\x69\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x20\x6c\x69\x6d\x69\x74\x20\x66\x6f\x72\x20\x47\x45\x54\x20\x72\x65\x71\x75\x65\x73\x74\x73\x2c\x20\x30\x20\x69\x66\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x6c\x69\x6d\x69\x74\x65\x64\x0a\x20\x20\x2d\x20\x2a\x2a\x68\x6f\x75\x72\x6c\x79\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x30\x29\x20\x6f\x76\x65\x72\x72\x69\x64\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x6c\x69\x6d\x69\x74\x20\x66\x6f\x72\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x6f\x74\x68\x65\x72\x20\x74\x68\x61\x6e\x20\x47\x45\x54\x2c\x20\x2d\x31\x20\x69\x66\x20\x74\x68\x65\x20\x6c\x69\x6d\x69\x74\x20\x69\x73\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x0a\x20\x20\x2d\x20\x2a\x2a\x68\x6f\x75\x72\x6c\x79\x5f\x72\x65\x61\x64\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x30\x29\x20\x6f\x76\x65\x72\x72\x69\x64\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x6c\x69\x6d\x69\x74\x20\x66\x6f\x72\x20\x47\x45\x54\x20\x72\x65\x71\x75\x65\x73\x74\x73\x2c\x20\x2d\x31\x20\x69\x66\x20\x74\x68\x65\x20\x6c\x69\x6d\x69\x74\x20\x69\x73\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x75\x73\x65\x72\x73\x2f\x6d\x65\x40\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x72\x61\x74\x65\x2d\x6c\x69\x6d\x69\x74\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x68\x6f\x75\x72\x6c\x79\x5f\x6c\x69\x6d\x69\x74\x22\x3a\x20\x31\x30\x30\x2c\x0a\x20\x20\x20\x20\x22\x68\x6f\x75\x72\x6c\x79\x5f\x72\x65\x61\x64\x5f\x6c\x69\x6d\x69\x74\x22\x3a\x20\x33\x36\x30\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x31\x39\x2e\x20\x55\x70\x64\x61\x74\x65\x20\x52\x61\x74\x65\x20\x4c\x69\x6d\x69\x74\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x75\x73\x65\x72\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x72\x61\x74\x65\x2d\x6c\x69\x6d\x69\x74\x73\x0a\x60\x60\x60\x0a\x0a\x4f\x76\x65\x72\x72\x69\x64\x65\x73\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x72\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x73\x20\x6f\x66\x20\x61\x20\x75\x73\x65\x72\x2e\x20\x4f\x6e\x6c\x79\x20\x61\x64\x6d\x69\x6e\x69\x73\x74\x72\x61\x74\x6f\x72\x73\x20\x63\x61\x6e\x20\x63\x68\x61\x6e\x67\x65\x20\x72\x61\x74\x65\x20\x6c\x69\x6d\x69\x74\x73\x2e\x20\x4f\x76\x65\x72\x72\x69\x64\x65\x73\x20\x61\x72\x65\x20\x73\x74\x6f\x72\x65\x64\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x75\x73\x65\x72\x2c\x20\x61\x6e\x64\x20\x74\x68\x65\x79\x20\x61\x72\x65\x20\x6b\x65\x70\x74\x20\x69\x66\x20\x74\x68\x65\x20\x75\x73\x65\x72\x27\x73\x20\x50\x65\x72\x73\x6f\x6e\x61\x6c\x20\x41\x63\x63\x65\x73\x73\x20\x54\x6f\x6b\x65\x6e\x20\x69\x73\x20\x64\x65\x6c\x65\x74\x65\x64\x20\x6f\x72\x20\x63\x72\x65\x61\x74\x65\x64\x20\x61\x67\x61\x69\x6e\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x75\x73\x65\x72\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x68\x6f\x75\x72\x6c\x79\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x20\x6c\x69\x6d\x69\x74\x20\x66\x6f\x72\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x6f\x74\x68\x65\x72\x20\x74\x68\x61\x6e\x20\x47\x45\x54\x2c\x20\x30\x20\x74\x6f\x20\x75\x73\x65\x20\x74\x68\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x6c\x69\x6d\x69\x74\x20\x61\x6e\x64\x20\x2d\x31\x20\x74\x6f\x20\x64\x69\x73\x61\x62\x6c\x65\x20\x74\x68\x65\x20\x6c\x69\x6d\x69\x74\x0a\x20\x20\x2d\x20\x2a\x2a\x68\x6f\x75\x72\x6c\x79\x5f\x72\x65\x61\x64\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x20\x6c\x69\x6d\x69\x74\x20\x66\x6f\x72\x20\x47\x45\x54\x20\x72\x65\x71\x75\x65\x73\x74\x73\x2c\x20\x30\x20\x74\x6f\x20\x75\x73\x65\x20\x74\x68\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x6c\x69\x6d\x69\x74\x20\x61\x6e\x64\x20\x2d\x31\x20\x74\x6f\x20\x64\x69\x73\x61\x62\x6c\x65\x20\x74\x68\x65\x20\x6c\x69\x6d\x69\x74\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x62\x6f\x64\x79\x20\x61\x73\x20\x5b\x47\x65\x74\x20\x52\x61\x74\x65\x20\x4c\x69\x6d\x69\x74\x73\x5d\x28\x23\x67\x65\x74\x2d\x72\x61\x74\x65\x2d\x6c\x69\x6d\x69\x74\x73\x29\x20\x71\x75\x65\x72\x79\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x68\x6f\x75\x72\x6c\x79\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x22\x3a\x31\x30\x30\x30\x2c\x22\x68\x6f\x75\x72\x6c\x79\x5f\x72\x65\x61\x64\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x22\x3a\x2d\x31\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x75\x73\x65\x72\x73\x2f\x61\x75\x74\x6f\x6d\x61\x74\x69\x6f\x6e\x40\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x72\x61\x74\x65\x2d\x6c\x69\x6d\x69\x74\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x68\x6f\x75\x72\x6c\x79\x5f\x6c\x69\x6d\x69\x74\x22\x3a\x20\x31\x30\x30\x30\x2c\x0a\x20\x20\x20\x20\x22\x68\x6f\x75\x72\x6c\x79\x5f\x72\x65\x61\x64\x5f\x6c\x69\x6d\x69\x74\x22\x3a\x20\x30\x2c\x0a\x20\x20\x20\x20\x22\x68\x6f\x75\x72\x6c\x79\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x22\x3a\x20\x31\x30\x30\x30\x2c\x0a\x20\x20\x20\x20\x22\x68\x6f\x75\x72\x6c\x79\x5f\x72\x65\x61\x64\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x22\x3a\x20\x2d\x31\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x0a\x0a\x23\x23\x23\x20\x37\x2e\x32\x30\x2e\x20\x47\x65\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x70\x65\x6e\x64\x69\x6e\x67\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x20\x4f\x6e\x6c\x79\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x75\x73\x65\x72\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x72\x65\x64\x20\x74\x6f\x20\x63\x61\x6e\x20\x67\x65\x74\x20\x74\x68\x65\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x2e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x61\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x72\x61\x6e\x73\x66\x65\x72\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x72\x6f\x6d\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x74\x6f\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x2c\x0a\x20\x20\x20\x20\x22\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x35\x2d\x30\x32\x54\x31\x31\x3a\x33\x30\x3a\x30\x33\x2e\x33\x38\x35\x39\x32\x36\x5a\x22\x2c\x0a\x20\x20\x20\x20\x22\x65\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x5f\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x35\x2d\x30\x39\x54\x31\x31\x3a\x33\x30\x3a\x30\x33\x2e\x33\x38\x35\x39\x32\x36\x5a\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x32\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x32\x31\x2e\x20\x52\x65\x71\x75\x65\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x0a\x60\x60\x60\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x73\x20\x61\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x74\x6f\x20\x61\x6e\x6f\x74\x68\x65\x72\x20\x75\x73\x65\x72\x2e\x20\x4f\x6e\x6c\x79\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x20\x63\x61\x6e\x20\x72\x65\x71\x75\x65\x73\x74\x20\x74\x68\x65\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x2e\x20\x54\x68\x65\x20\x75\x73\x65\x72\x20\x69\x73\x20\x6e\x6f\x74\x69\x66\x69\x65\x64\x20\x62\x79\x20\x65\x2d\x6d\x61\x69\x6c\x20\x61\x6e\x64\x20\x62\x65\x63\x6f\x6d\x65\x73\x20\x74\x68\x65\x20\x6f\x77\x6e\x65\x72\x20\x6f\x6e\x6c\x79\x20\x61\x66\x74\x65\x72\x20\x61\x63\x63\x65\x70\x74\x69\x6e\x67\x20\x74\x68\x65\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x2e\x20\x41\x6e\x20\x65\x78\x70\x69\x72\x65\x64\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x69\x73\x20\x72\x65\x70\x6c\x61\x63\x65\x64\x20\x62\x79\x20\x74\x68\x65\x20\x6e\x65\x77\x20\x6f\x6e\x65\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x74\x6f\x5f\x75\x73\x65\x72\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x75\x73\x65\x72\x20\x69\x64\x65\x6e\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x61\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x72\x61\x6e\x73\x66\x65\x72\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x74\x6f\x5f\x75\x73\x65\x72\x22\x3a\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x72\x6f\x6d\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x74\x6f\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x2c\x0a\x20\x20\x20\x20\x22\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x35\x2d\x30\x32\x54\x31\x31\x3a\x33\x30\x3a\x30\x33\x2e\x33\x38\x35\x39\x32\x36\x5a\x22\x2c\x0a\x20\x20\x20\x20\x22\x65\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x5f\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x35\x2d\x30\x39\x54\x31\x31\x3a\x33\x30\x3a\x30\x33\x2e\x33\x38\x35\x39\x32\x36\x5a\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x32\x31\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x54\x6f\x20\x4f\x77\x6e\x65\x72\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x32\x32\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x32\x32\x2e\x20\x41\x63\x63\x65\x70\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x2f\x61\x63\x63\x65\x70\x74\x0a\x60\x60\x60\x0a\x0a\x41\x63\x63\x65\x70\x74\x73\x20\x74\x68\x65\x20\x70\x65\x6e\x64\x69\x6e\x67\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x20\x61\x6e\x64\x20\x6d\x61\x6b\x65\x73\x20\x74\x68\x65\x20\x61\x75\x74\x68\x65\x6e\x74\x69\x63\x61\x74\x65\x64\x20\x75\x73\x65\x72\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x2e\x20\x50\x72\x65\x76\x69\x6f\x75\x73\x20\x6f\x77\x6e\x65\x72\x20\x69\x73\x20\x6b\x65\x70\x74\x20\x61\x73\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x20\x75\x73\x65\x72\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x61\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x58\x20\x50\x4f\x53\x54\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x2f\x61\x63\x63\x65\x70\x74\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x6f\x77\x6e\x65\x72\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x20\x69\x66\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x6e\x6f\x74\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x72\x65\x64\x20\x74\x6f\x20\x74\x68\x65\x20\x61\x75\x74\x68\x65\x6e\x74\x69\x63\x61\x74\x65\x64\x20\x75\x73\x65\x72\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x32\x30\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x45\x78\x70\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x32\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x51\x75\x6f\x74\x61\x20\x45\x78\x63\x65\x65\x64\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x37\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x51\x75\x6f\x74\x61\x20\x45\x78\x63\x65\x65\x64\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x31\x31\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x32\x33\x2e\x20\x43\x61\x6e\x63\x65\x6c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x44\x45\x4c\x45\x54\x45\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x0a\x60\x60\x60\x0a\x0a\x43\x61\x6e\x63\x65\x6c\x73\x20\x74\x68\x65\x20\x70\x65\x6e\x64\x69\x6e\x67\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x2e\x20\x42\x6f\x74\x68\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x75\x73\x65\x72\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x69\x73\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x72\x65\x64\x20\x74\x6f\x20\x63\x61\x6e\x20\x63\x61\x6e\x63\x65\x6c\x20\x74\x68\x65\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x74\x68\x65\x20\x63\x61\x6e\x63\x65\x6c\x65\x64\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x72\x61\x6e\x73\x66\x65\x72\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x58\x20\x44\x45\x4c\x45\x54\x45\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x74\x72\x61\x6e\x73\x66\x65\x72\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x66\x72\x6f\x6d\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x22\x74\x6f\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x2c\x0a\x20\x20\x20\x20\x22\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x35\x2d\x30\x32\x54\x31\x31\x3a\x33\x30\x3a\x30\x33\x2e\x33\x38\x35\x39\x32\x36\x5a\x22\x2c\x0a\x20\x20\x20\x20\x22\x65\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x5f\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x35\x2d\x30\x39\x54\x31\x31\x3a\x33\x30\x3a\x30\x33\x2e\x33\x38\x35\x39\x32\x36\x5a\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x32\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x32\x34\x2e\x20\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x72\x61\x6e\x73\x66\x65\x72\x73\x0a\x60\x60\x60\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x61\x6c\x6c\x20\x70\x65\x6e\x64\x69\x6e\x67\x20\x61\x6e\x64\x20\x65\x78\x70\x69\x72\x65\x64\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x73\x20\x6f\x66\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x66\x72\x6f\x6d\x20\x6f\x72\x20\x74\x6f\x20\x74\x68\x65\x20\x61\x75\x74\x68\x65\x6e\x74\x69\x63\x61\x74\x65\x64\x20\x75\x73\x65\x72\x2e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x74\x72\x61\x6e\x73\x66\x65\x72\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x5d\x28\x23\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x72\x61\x6e\x73\x66\x65\x72\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x73\x29\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x2d\x74\x72\x61\x6e\x73\x66\x65\x72\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x74\x72\x61\x6e\x73\x66\x65\x72\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x69\x64\x22\x3a\x20\x22\x61\x68\x79\x34\x6d\x70\x30\x72\x76\x62\x73\x76\x70\x77\x34\x36\x39\x66\x6b\x35\x64\x65\x62\x77\x76\x65\x67\x72\x6d\x71\x76\x37\x36\x31\x67\x35\x6d\x61\x66\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x66\x72\x6f\x6d\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x78\x70\x76\x7a\x63\x6e\x79\x33\x34\x62\x35\x6a\x76\x36\x39\x65\x79\x66\x64\x37\x32\x62\x7a\x34\x66\x34\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x6f\x5f\x75\x73\x65\x72\x5f\x69\x64\x22\x3a\x20\x22\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x35\x2d\x30\x32\x54\x31\x31\x3a\x33\x30\x3a\x30\x33\x2e\x33\x38\x35\x39\x32\x36\x5a\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x65\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x5f\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x35\x2d\x30\x39\x54\x31\x31\x3a\x33\x30\x3a\x30\x33\x2e\x33\x38\x35\x39\x32\x36\x5a\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x75\x70\x64\x61\x74\x65\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x75\x73\x65\x72\x2d\x72\x6f\x6c\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x32\x35\x2e\x20\x55\x70\x64\x61\x74\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x55\x73\x65\x72\x20\x52\x6f\x6c\x65\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x75\x73\x65\x72\x73\x2f\x7b\x75\x73\x65\x72\x7d\x2f\x72\x6f\x6c\x65\x0a\x60\x60\x60\x0a\x0a\x43\x68\x61\x6e\x67\x65\x73\x20\x74\x68\x65\x20\x72\x6f\x6c\x65\x20\x6f\x66\x20\x61\x20\x75\x73\x65\x72\x20\x74\x68\x61\x74\x20\x68\x61\x73\x20\x61\x63\x63\x65\x73\x73\x20\x74\x6f\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x20\x4f\x6e\x6c\x79\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x20\x63\x61\x6e\x20\x63\x68\x61\x6e\x67\x65\x20\x72\x6f\x6c\x65\x73\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x20\x20\x2d\x20\x2a\x2a\x75\x73\x65\x72\x2a\x2a\x3a\x20\x75\x73\x65\x72\x20\x69\x64\x65\x6e\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x6f\x6c\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x22\x2c\x20\x22\x76\x69\x65\x77\x65\x72\x22\x29\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x4f\x4b\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x72\x6f\x6c\x65\x22\x3a\x22\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x22\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x75\x73\x65\x72\x73\x2f\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x2f\x72\x6f\x6c\x65\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3a\x20\x22\x4f\x4b\x22\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x64\x65\x22\x3a\x20\x32\x30\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x47\x72\x61\x6e\x74\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x32\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x52\x6f\x6c\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x34\x29\x0a\x0a\x0a\x23\x23\x23\x20\x37\x2e\x32\x36\x2e\x20\x4c\x69\x73\x74\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x61\x75\x74\x68\x65\x6e\x74\x69\x63\x61\x74\x65\x64\x20\x75\x73\x65\x72\x20\x69\x73\x20\x61\x20\x6d\x65\x6d\x62\x65\x72\x20\x6f\x66\x2c\x20\x73\x6f\x72\x74\x65\x64\x20\x62\x79\x20\x6e\x61\x6d\x65\x2e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x5d\x28\x23\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x73\x29\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x66\x74\x77\x31\x72\x6e\x66\x70\x6d\x33\x78\x32\x63\x36\x7a\x71\x76\x66\x6e\x36\x6d\x38\x74\x35\x64\x72\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6e\x61\x6d\x65\x22\x3a\x20\x22\x6d\x79\x2d\x74\x65\x61\x6d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x5d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x37\x2e\x32\x37\x2e\x20\x47\x65\x74\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x49\x44\x20\x6f\x72\x20\x6e\x61\x6d\x65\x0a\x0a\x4f\x6e\x6c\x79\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6d\x65\x6d\x62\x65\x72\x73\x20\x63\x61\x6e\x20\x67\x65\x74\x20\x74\x68\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x61\x6e\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x5d\x28\x23\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2f\x6d\x79\x2d\x74\x65\x61\x6d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x32\x38\x2e\x20\x41\x64\x64\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x43\x72\x65\x61\x74\x65\x73\x20\x61\x20\x6e\x65\x77\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x61\x75\x74\x68\x65\x6e\x74\x69\x63\x61\x74\x65\x64\x20\x75\x73\x65\x72\x20\x61\x73\x20\x69\x74\x73\x20\x6f\x77\x6e\x65\x72\x2e\x20\x4e\x61\x6d\x65\x20\x6d\x61\x79\x20\x63\x6f\x6e\x74\x61\x69\x6e\x20\x6c\x6f\x77\x65\x72\x63\x61\x73\x65\x20\x6c\x65\x74\x74\x65\x72\x73\x2c\x20\x6e\x75\x6d\x62\x65\x72\x73\x20\x61\x6e\x64\x20\x64\x61\x73\x68\x65\x73\x2e\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x6e\x61\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x74\x68\x65\x20\x6e\x65\x77\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x5d\x28\x23\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x6e\x61\x6d\x65\x22\x3a\x22\x6d\x79\x2d\x74\x65\x61\x6d\x22\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x31\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x61\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x31\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x61\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x31\x31\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x32\x39\x2e\x20\x55\x70\x64\x61\x74\x65\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x0a\x60\x60\x60\x0a\x0a\x43\x68\x61\x6e\x67\x65\x73\x20\x74\x68\x65\x20\x6e\x61\x6d\x65\x20\x6f\x66\x20\x61\x6e\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x20\x4f\x6e\x6c\x79\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6f\x77\x6e\x65\x72\x73\x20\x63\x61\x6e\x20\x75\x70\x64\x61\x74\x65\x20\x69\x74\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x49\x44\x20\x6f\x72\x20\x6e\x61\x6d\x65\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x6e\x61\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x74\x68\x65\x20\x75\x70\x64\x61\x74\x65\x64\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x5d\x28\x23\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x31\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x61\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x31\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x61\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x31\x31\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x33\x30\x2e\x20\x44\x65\x6c\x65\x74\x65\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x44\x45\x4c\x45\x54\x45\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x0a\x60\x60\x60\x0a\x0a\x44\x65\x6c\x65\x74\x65\x73\x20\x61\x6e\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x61\x6e\x64\x20\x69\x74\x73\x20\x41\x50\x49\x20\x6b\x65\x79\x2e\x20\x4f\x6e\x6c\x79\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6f\x77\x6e\x65\x72\x73\x20\x63\x61\x6e\x20\x64\x65\x6c\x65\x74\x65\x20\x69\x74\x20\x61\x6e\x64\x20\x6f\x6e\x6c\x79\x20\x69\x66\x20\x69\x74\x20\x64\x6f\x65\x73\x20\x6e\x6f\x74\x20\x6f\x77\x6e\x20\x61\x6e\x79\x20\x64\x6f\x6d\x61\x69\x6e\x73\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x49\x44\x20\x6f\x72\x20\x6e\x61\x6d\x65\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x74\x68\x65\x20\x64\x65\x6c\x65\x74\x65\x64\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x5d\x28\x23\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x48\x61\x73\x20\x44\x6f\x6d\x61\x69\x6e\x73\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x33\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x33\x31\x2e\x20\x4c\x69\x73\x74\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4d\x65\x6d\x62\x65\x72\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x6d\x65\x6d\x62\x65\x72\x73\x0a\x60\x60\x60\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x49\x44\x20\x6f\x72\x20\x6e\x61\x6d\x65\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x61\x6e\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4d\x65\x6d\x62\x65\x72\x73\x5d\x28\x23\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x6d\x65\x6d\x62\x65\x72\x73\x2d\x72\x65\x73\x6f\x75\x72\x63\x65\x29\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x2e\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x33\x32\x2e\x20\x53\x65\x74\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4d\x65\x6d\x62\x65\x72\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x6d\x65\x6d\x62\x65\x72\x73\x2f\x7b\x75\x73\x65\x72\x7d\x0a\x60\x60\x60\x0a\x0a\x41\x64\x64\x73\x20\x61\x20\x75\x73\x65\x72\x20\x74\x6f\x20\x61\x6e\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6f\x72\x20\x63\x68\x61\x6e\x67\x65\x73\x20\x74\x68\x65\x20\x72\x6f\x6c\x65\x20\x6f\x66\x20\x61\x20\x6d\x65\x6d\x62\x65\x72\x2e\x20\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x6f\x70\x74\x69\x6f\x6e\x61\x6c\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x60\x6d\x65\x6d\x62\x65\x72\x60\x20\x72\x6f\x6c\x65\x20\x69\x73\x20\x67\x69\x76\x65\x6e\x20\x69\x66\x20\x74\x68\x65\x20\x72\x6f\x6c\x65\x20\x69\x73\x20\x6e\x6f\x74\x20\x73\x70\x65\x63\x69\x66\x69\x65\x64\x2e\x20\x4f\x6e\x6c\x79\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6f\x77\x6e\x65\x72\x73\x20\x63\x61\x6e\x20\x6d\x61\x6e\x61\x67\x65\x20\x6d\x65\x6d\x62\x65\x72\x73\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x6c\x61\x73\x74\x20\x6f\x77\x6e\x65\x72\x20\x63\x61\x6e\x20\x6e\x6f\x74\x20\x62\x65\x63\x6f\x6d\x65\x20\x61\x20\x6d\x65\x6d\x62\x65\x72\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x49\x44\x20\x6f\x72\x20\x6e\x61\x6d\x65\x0a\x20\x20\x2d\x20\x2a\x2a\x75\x73\x65\x72\x2a\x2a\x3a\x20\x75\x73\x65\x72\x20\x69\x64\x65\x6e\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x6f\x6c\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x22\x6d\x65\x6d\x62\x65\x72\x22\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x6f\x77\x6e\x65\x72\x22\x2c\x20\x22\x6d\x65\x6d\x62\x65\x72\x22\x29\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x4f\x4b\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x72\x6f\x6c\x65\x22\x3a\x22\x6f\x77\x6e\x65\x72\x22\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2f\x6d\x79\x2d\x74\x65\x61\x6d\x2f\x6d\x65\x6d\x62\x65\x72\x73\x2f\x33\x34\x64\x64\x73\x64\x79\x63\x61\x34\x35\x36\x33\x34\x62\x35\x6a\x76\x36\x64\x73\x37\x32\x37\x61\x73\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x52\x6f\x6c\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x32\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4c\x61\x73\x74\x20\x4f\x77\x6e\x65\x72\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x32\x31\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x33\x33\x2e\x20\x52\x65\x6d\x6f\x76\x65\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4d\x65\x6d\x62\x65\x72\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x44\x45\x4c\x45\x54\x45\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x6d\x65\x6d\x62\x65\x72\x73\x2f\x7b\x75\x73\x65\x72\x7d\x0a\x60\x60\x60\x0a\x0a\x52\x65\x6d\x6f\x76\x65\x73\x20\x61\x20\x75\x73\x65\x72\x20\x66\x72\x6f\x6d\x20\x61\x6e\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x61\x6e\x64\x20\x72\x65\x76\x6f\x6b\x65\x73\x20\x69\x74\x73\x20\x61\x63\x63\x65\x73\x73\x20\x74\x6f\x20\x61\x6c\x6c\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x73\x2e\x20\x4f\x77\x6e\x65\x72\x73\x20\x63\x61\x6e\x20\x72\x65\x6d\x6f\x76\x65\x20\x61\x6e\x79\x20\x6d\x65\x6d\x62\x65\x72\x20\x61\x6e\x64\x20\x6d\x65\x6d\x62\x65\x72\x73\x20\x63\x61\x6e\x20\x6c\x65\x61\x76\x65\x20\x74\x68\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x20\x54\x68\x65\x20\x6c\x61\x73\x74\x20\x6f\x77\x6e\x65\x72\x20\x63\x61\x6e\x20\x6e\x6f\x74\x20\x62\x65\x20\x72\x65\x6d\x6f\x76\x65\x64\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x49\x44\x20\x6f\x72\x20\x6e\x61\x6d\x65\x0a\x20\x20\x2d\x20\x2a\x2a\x75\x73\x65\x72\x2a\x2a\x3a\x20\x75\x73\x65\x72\x20\x69\x64\x65\x6e\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x5b\x4f\x4b\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4c\x61\x73\x74\x20\x4f\x77\x6e\x65\x72\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x32\x31\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4d\x65\x6d\x62\x65\x72\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x32\x32\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x33\x34\x2e\x20\x4c\x69\x73\x74\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x44\x6f\x6d\x61\x69\x6e\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x70\x61\x67\x69\x6e\x61\x74\x65\x64\x20\x6c\x69\x73\x74\x20\x6f\x66\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x74\x68\x61\x74\x20\x61\x72\x65\x20\x6f\x77\x6e\x65\x64\x20\x62\x79\x20\x61\x6e\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x20\x51\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x20\x61\x6e\x64\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x61\x72\x65\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x61\x73\x20\x66\x6f\x72\x20\x5b\x4c\x69\x73\x74\x20\x44\x6f\x6d\x61\x69\x6e\x73\x5d\x28\x23\x6c\x69\x73\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x73\x29\x20\x71\x75\x65\x72\x79\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x49\x44\x20\x6f\x72\x20\x6e\x61\x6d\x65\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2f\x6d\x79\x2d\x74\x65\x61\x6d\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x33\x35\x2e\x20\x41\x70\x70\x6c\x79\x20\x44\x6f\x6d\x61\x69\x6e\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x44\x65\x66\x61\x75\x6c\x74\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x64\x65\x66\x61\x75\x6c\x74\x73\x0a\x60\x60\x60\x0a\x0a\x53\x65\x74\x73\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x6f\x66\x20\x61\x6c\x6c\x20\x65\x78\x69\x73\x74\x69\x6e\x67\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x75\x6e\x64\x65\x72\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x20\x74\x6f\x20\x74\x68\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x5f\x64\x65\x66\x61\x75\x6c\x74\x73\x2a\x2a\x2e\x20\x41\x6c\x6c\x20\x63\x68\x61\x6e\x67\x65\x73\x20\x61\x72\x65\x20\x61\x70\x70\x6c\x69\x65\x64\x20\x61\x74\x6f\x6d\x69\x63\x61\x6c\x6c\x79\x2c\x20\x61\x6e\x64\x20\x69\x66\x20\x61\x6e\x79\x20\x6f\x66\x20\x74\x68\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x77\x6f\x75\x6c\x64\x20\x6e\x6f\x74\x20\x62\x65\x20\x76\x61\x6c\x69\x64\x2c\x20\x6e\x6f\x20\x63\x68\x61\x6e\x67\x65\x73\x20\x61\x72\x65\x20\x6d\x61\x64\x65\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x64\x6f\x6d\x61\x69\x6e\x20\x49\x44\x20\x6f\x72\x20\x46\x51\x44\x4e\x0a\x0a\x51\x75\x65\x72\x79\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x72\x79\x5f\x72\x75\x6e\x2a\x2a\x3a\x20\x28\x62\x6f\x6f\x6c\x65\x61\x6e\x29\x20\x69\x66\x20\x74\x72\x75\x65\x2c\x20\x63\x68\x61\x6e\x67\x65\x73\x20\x61\x72\x65\x20\x6f\x6e\x6c\x79\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x61\x6e\x64\x20\x6e\x6f\x74\x20\x61\x70\x70\x6c\x69\x65\x64\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x69\x65\x6c\x64\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x73\x74\x72\x69\x6e\x67\x73\x2c\x20\x70\x6f\x73\x73\x69\x62\x6c\x65\x20\x76\x61\x6c\x75\x65\x73\x3a\x20\x22\x76\x63\x73\x22\x2c\x20\x22\x72\x65\x70\x6f\x5f\x72\x6f\x6f\x74\x22\x2c\x20\x22\x72\x65\x66\x22\x2c\x20\x22\x67\x6f\x5f\x73\x6f\x75\x72\x63\x65\x22\x2c\x20\x22\x72\x65\x64\x69\x72\x65\x63\x74\x5f\x75\x72\x6c\x22\x29\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x74\x6f\x20\x73\x65\x74\x2c\x20\x77\x68\x65\x72\x65\x20\x22\x72\x65\x66\x22\x20\x73\x65\x74\x73\x20\x62\x6f\x74\x68\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x74\x79\x70\x65\x20\x61\x6e\x64\x20\x6e\x61\x6d\x65\x3b\x20\x69\x66\x20\x65\x6d\x70\x74\x79\x2c\x20\x61\x6c\x6c\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x77\x69\x74\x68\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x76\x61\x6c\x75\x65\x73\x20\x61\x72\x65\x20\x73\x65\x74\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x68\x61\x73\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x61\x73\x20\x69\x6e\x20\x5b\x53\x79\x6e\x63\x20\x44\x6f\x6d\x61\x69\x6e\x20\x50\x61\x63\x6b\x61\x67\x65\x73\x5d\x28\x23\x73\x79\x6e\x63\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x70\x61\x63\x6b\x61\x67\x65\x73\x29\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2c\x20\x77\x69\x74\x68\x20\x6f\x6e\x6c\x79\x20\x60\x75\x70\x64\x61\x74\x65\x60\x20\x61\x63\x74\x69\x6f\x6e\x73\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x66\x69\x65\x6c\x64\x73\x22\x3a\x5b\x22\x67\x6f\x5f\x73\x6f\x75\x72\x63\x65\x22\x2c\x22\x72\x65\x64\x69\x72\x65\x63\x74\x5f\x75\x72\x6c\x22\x5d\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x70\x72\x6f\x6a\x65\x63\x74\x2e\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x70\x61\x63\x6b\x61\x67\x65\x73\x2f\x64\x65\x66\x61\x75\x6c\x74\x73\x3f\x64\x72\x79\x5f\x72\x75\x6e\x3d\x74\x72\x75\x65\x0a\x60\x60\x60\x0a\x0a\x54\x68\x65\x20\x73\x61\x6d\x65\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x20\x69\x73\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x60\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x20\x63\x6c\x69\x65\x6e\x74\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x70\x70\x6c\x79\x2d\x64\x65\x66\x61\x75\x6c\x74\x73\x60\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x2e\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x56\x43\x53\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x33\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x31\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x32\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x33\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x48\x6f\x73\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x34\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x43\x68\x61\x6e\x67\x65\x20\x52\x65\x6a\x65\x63\x74\x65\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x37\x30\x29\x0a\x20\x20\x2d\x20\x5b\x50\x61\x63\x6b\x61\x67\x65\x20\x44\x65\x66\x61\x75\x6c\x74\x73\x20\x46\x69\x65\x6c\x64\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x31\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x67\x65\x74\x2d\x71\x75\x6f\x74\x61\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x33\x36\x2e\x20\x47\x65\x74\x20\x51\x75\x6f\x74\x61\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x75\x73\x65\x72\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x71\x75\x6f\x74\x61\x0a\x60\x60\x60\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x6d\x61\x78\x69\x6d\x61\x6c\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x61\x6e\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x74\x68\x61\x74\x20\x61\x20\x75\x73\x65\x72\x20\x63\x61\x6e\x20\x6f\x77\x6e\x2c\x20\x61\x6e\x64\x20\x68\x6f\x77\x20\x6d\x61\x6e\x79\x20\x69\x74\x20\x6f\x77\x6e\x73\x2e\x20\x50\x61\x63\x6b\x61\x67\x65\x73\x20\x61\x72\x65\x20\x63\x6f\x75\x6e\x74\x65\x64\x20\x6f\x6e\x20\x61\x6c\x6c\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x75\x73\x65\x72\x20\x6f\x77\x6e\x73\x2e\x20\x4f\x6e\x6c\x79\x20\x61\x64\x6d\x69\x6e\x69\x73\x74\x72\x61\x74\x6f\x72\x73\x20\x63\x61\x6e\x20\x67\x65\x74\x20\x71\x75\x6f\x74\x61\x73\x20\x6f\x66\x20\x6f\x74\x68\x65\x72\x20\x75\x73\x65\x72\x73\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x75\x73\x65\x72\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x5f\x6c\x69\x6d\x69\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x20\x6d\x61\x78\x69\x6d\x61\x6c\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x64\x6f\x6d\x61\x69\x6e\x73\x2c\x20\x30\x20\x69\x66\x20\x74\x68\x65\x20\x6e\x75\x6d\x62\x65\x72\x20\x69\x73\x20\x6e\x6f\x74\x20\x6c\x69\x6d\x69\x74\x65\x64\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x5f\x6c\x69\x6d\x69\x74\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x20\x6d\x61\x78\x69\x6d\x61\x6c\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x2c\x20\x30\x20\x69\x66\x20\x74\x68\x65\x20\x6e\x75\x6d\x62\x65\x72\x20\x69\x73\x20\x6e\x6f\x74\x20\x6c\x69\x6d\x69\x74\x65\x64\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x30\x29\x20\x6f\x76\x65\x72\x72\x69\x64\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6c\x69\x6d\x69\x74\x2c\x20\x2d\x31\x20\x69\x66\x20\x74\x68\x65\x20\x6c\x69\x6d\x69\x74\x20\x69\x73\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x2c\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x20\x30\x29\x20\x6f\x76\x65\x72\x72\x69\x64\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x6c\x69\x6d\x69\x74\x2c\x20\x2d\x31\x20\x69\x66\x20\x74\x68\x65\x20\x6c\x69\x6d\x69\x74\x20\x69\x73\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x73\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x6f\x77\x6e\x65\x64\x20\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x73\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x6f\x6e\x20\x6f\x77\x6e\x65\x64\x20\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x75\x73\x65\x72\x73\x2f\x6d\x65\x40\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x71\x75\x6f\x74\x61\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x6c\x69\x6d\x69\x74\x22\x3a\x20\x31\x30\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x63\x6b\x61\x67\x65\x5f\x6c\x69\x6d\x69\x74\x22\x3a\x20\x35\x30\x30\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x73\x22\x3a\x20\x32\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x63\x6b\x61\x67\x65\x73\x22\x3a\x20\x33\x37\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x75\x70\x64\x61\x74\x65\x2d\x71\x75\x6f\x74\x61\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x33\x37\x2e\x20\x55\x70\x64\x61\x74\x65\x20\x51\x75\x6f\x74\x61\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x75\x73\x65\x72\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x71\x75\x6f\x74\x61\x0a\x60\x60\x60\x0a\x0a\x4f\x76\x65\x72\x72\x69\x64\x65\x73\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x71\x75\x6f\x74\x61\x20\x6f\x66\x20\x61\x20\x75\x73\x65\x72\x2e\x20\x4f\x6e\x6c\x79\x20\x61\x64\x6d\x69\x6e\x69\x73\x74\x72\x61\x74\x6f\x72\x73\x20\x63\x61\x6e\x20\x63\x68\x61\x6e\x67\x65\x20\x71\x75\x6f\x74\x61\x73\x2e\x20\x44\x6f\x6d\x61\x69\x6e\x73\x20\x61\x6e\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x75\x73\x65\x72\x20\x61\x6c\x72\x65\x61\x64\x79\x20\x6f\x77\x6e\x73\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x72\x65\x6d\x6f\x76\x65\x64\x20\x69\x66\x20\x74\x68\x65\x20\x71\x75\x6f\x74\x61\x20\x69\x73\x20\x6c\x6f\x77\x65\x72\x65\x64\x2c\x20\x62\x75\x74\x20\x6e\x65\x77\x20\x6f\x6e\x65\x73\x20\x63\x61\x6e\x20\x6e\x6f\x74\x20\x62\x65\x20\x61\x64\x64\x65\x64\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x75\x73\x65\x72\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x72\x20\x49\x44\x2c\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x20\x6f\x72\x20\x65\x6d\x61\x69\x6c\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6f\x6d\x61\x69\x6e\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x20\x6d\x61\x78\x69\x6d\x61\x6c\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x64\x6f\x6d\x61\x69\x6e\x73\x2c\x20\x30\x20\x74\x6f\x20\x75\x73\x65\x20\x74\x68\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x6c\x69\x6d\x69\x74\x20\x61\x6e\x64\x20\x2d\x31\x20\x74\x6f\x20\x64\x69\x73\x61\x62\x6c\x65\x20\x74\x68\x65\x20\x6c\x69\x6d\x69\x74\x0a\x20\x20\x2d\x20\x2a\x2a\x70\x61\x63\x6b\x61\x67\x65\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x20\x6d\x61\x78\x69\x6d\x61\x6c\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x2c\x20\x30\x20\x74\x6f\x20\x75\x73\x65\x20\x74\x68\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x6c\x69\x6d\x69\x74\x20\x61\x6e\x64\x20\x2d\x31\x20\x74\x6f\x20\x64\x69\x73\x61\x62\x6c\x65\x20\x74\x68\x65\x20\x6c\x69\x6d\x69\x74\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x62\x6f\x64\x79\x20\x61\x73\x20\x5b\x47\x65\x74\x20\x51\x75\x6f\x74\x61\x5d\x28\x23\x67\x65\x74\x2d\x71\x75\x6f\x74\x61\x29\x20\x71\x75\x65\x72\x79\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x48\x20\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x3a\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x22\x3a\x35\x30\x2c\x22\x70\x61\x63\x6b\x61\x67\x65\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x22\x3a\x2d\x31\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x75\x73\x65\x72\x73\x2f\x61\x75\x74\x6f\x6d\x61\x74\x69\x6f\x6e\x40\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x71\x75\x6f\x74\x61\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x6c\x69\x6d\x69\x74\x22\x3a\x20\x35\x30\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x63\x6b\x61\x67\x65\x5f\x6c\x69\x6d\x69\x74\x22\x3a\x20\x30\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x22\x3a\x20\x35\x30\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x63\x6b\x61\x67\x65\x5f\x6c\x69\x6d\x69\x74\x5f\x6f\x76\x65\x72\x72\x69\x64\x65\x22\x3a\x20\x2d\x31\x2c\x0a\x20\x20\x20\x20\x22\x64\x6f\x6d\x61\x69\x6e\x73\x22\x3a\x20\x32\x2c\x0a\x20\x20\x20\x20\x22\x70\x61\x63\x6b\x61\x67\x65\x73\x22\x3a\x20\x33\x37\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x33\x38\x2e\x20\x47\x65\x74\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x51\x75\x6f\x74\x61\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x71\x75\x6f\x74\x61\x0a\x60\x60\x60\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x71\x75\x6f\x74\x61\x20\x6f\x66\x20\x61\x6e\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x62\x6f\x64\x79\x20\x61\x73\x20\x5b\x47\x65\x74\x20\x51\x75\x6f\x74\x61\x5d\x28\x23\x67\x65\x74\x2d\x71\x75\x6f\x74\x61\x29\x20\x71\x75\x65\x72\x79\x2e\x20\x44\x6f\x6d\x61\x69\x6e\x73\x20\x74\x68\x61\x74\x20\x61\x6e\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6f\x77\x6e\x73\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x63\x6f\x75\x6e\x74\x65\x64\x20\x69\x6e\x20\x71\x75\x6f\x74\x61\x73\x20\x6f\x66\x20\x69\x74\x73\x20\x6d\x65\x6d\x62\x65\x72\x73\x2e\x20\x4f\x6e\x6c\x79\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6d\x65\x6d\x62\x65\x72\x73\x20\x61\x6e\x64\x20\x61\x64\x6d\x69\x6e\x69\x73\x74\x72\x61\x74\x6f\x72\x73\x20\x63\x61\x6e\x20\x67\x65\x74\x20\x69\x74\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x49\x44\x20\x6f\x72\x20\x6e\x61\x6d\x65\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x33\x39\x2e\x20\x55\x70\x64\x61\x74\x65\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x51\x75\x6f\x74\x61\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x73\x2f\x7b\x72\x65\x66\x7d\x2f\x71\x75\x6f\x74\x61\x0a\x60\x60\x60\x0a\x0a\x4f\x76\x65\x72\x72\x69\x64\x65\x73\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x71\x75\x6f\x74\x61\x20\x6f\x66\x20\x61\x6e\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x72\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x61\x73\x20\x5b\x55\x70\x64\x61\x74\x65\x20\x51\x75\x6f\x74\x61\x5d\x28\x23\x75\x70\x64\x61\x74\x65\x2d\x71\x75\x6f\x74\x61\x29\x20\x71\x75\x65\x72\x79\x2e\x20\x4f\x6e\x6c\x79\x20\x61\x64\x6d\x69\x6e\x69\x73\x74\x72\x61\x74\x6f\x72\x73\x20\x63\x61\x6e\x20\x63\x68\x61\x6e\x67\x65\x20\x71\x75\x6f\x74\x61\x73\x2e\x0a\x0a\x55\x52\x4c\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x66\x2a\x2a\x3a\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x2c\x20\x63\x61\x6e\x20\x62\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x49\x44\x20\x6f\x72\x20\x6e\x61\x6d\x65\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x67\x65\x74\x2d\x61\x63\x6d\x65\x2d\x75\x73\x65\x72\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x34\x30\x2e\x20\x47\x65\x74\x20\x41\x43\x4d\x45\x20\x55\x73\x65\x72\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x61\x63\x6d\x65\x2f\x75\x73\x65\x72\x0a\x60\x60\x60\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x41\x43\x4d\x45\x20\x61\x63\x63\x6f\x75\x6e\x74\x20\x74\x68\x61\x74\x20\x69\x73\x20\x75\x73\x65\x64\x20\x74\x6f\x20\x6f\x62\x74\x61\x69\x6e\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x73\x20\x66\x6f\x72\x20\x64\x6f\x6d\x61\x69\x6e\x73\x2e\x20\x4f\x6e\x6c\x79\x20\x61\x64\x6d\x69\x6e\x69\x73\x74\x72\x61\x74\x6f\x72\x73\x20\x63\x61\x6e\x20\x67\x65\x74\x20\x69\x74\x2e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x69\x64\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x20\x61\x63\x63\x6f\x75\x6e\x74\x20\x49\x44\x2c\x20\x69\x66\x20\x41\x43\x4d\x45\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x65\x78\x70\x6f\x73\x65\x73\x20\x69\x74\x20\x69\x6e\x20\x74\x68\x65\x20\x61\x63\x63\x6f\x75\x6e\x74\x20\x55\x52\x4c\x0a\x20\x20\x2d\x20\x2a\x2a\x65\x6d\x61\x69\x6c\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x63\x6f\x6e\x74\x61\x63\x74\x20\x65\x2d\x6d\x61\x69\x6c\x20\x61\x64\x64\x72\x65\x73\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x61\x63\x63\x6f\x75\x6e\x74\x0a\x20\x20\x2d\x20\x2a\x2a\x75\x72\x6c\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x61\x63\x63\x6f\x75\x6e\x74\x20\x55\x52\x4c\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x5f\x75\x72\x6c\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x41\x43\x4d\x45\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x55\x52\x4c\x0a\x20\x20\x2d\x20\x2a\x2a\x65\x78\x74\x65\x72\x6e\x61\x6c\x5f\x61\x63\x63\x6f\x75\x6e\x74\x5f\x6b\x65\x79\x5f\x69\x64\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x6b\x65\x79\x20\x69\x64\x65\x6e\x74\x69\x66\x69\x65\x72\x20\x6f\x66\x20\x74\x68\x65\x20\x65\x78\x74\x65\x72\x6e\x61\x6c\x20\x61\x63\x63\x6f\x75\x6e\x74\x20\x62\x69\x6e\x64\x69\x6e\x67\x2c\x20\x69\x66\x20\x74\x68\x65\x20\x61\x63\x63\x6f\x75\x6e\x74\x20\x69\x73\x20\x62\x6f\x75\x6e\x64\x20\x74\x6f\x20\x61\x6e\x20\x65\x78\x74\x65\x72\x6e\x61\x6c\x20\x61\x63\x63\x6f\x75\x6e\x74\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x61\x63\x6d\x65\x2f\x75\x73\x65\x72\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x32\x39\x38\x34\x31\x30\x36\x36\x2c\x0a\x20\x20\x20\x20\x22\x65\x6d\x61\x69\x6c\x22\x3a\x20\x22\x61\x64\x6d\x69\x6e\x40\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x75\x72\x6c\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x61\x63\x6d\x65\x2d\x76\x30\x32\x2e\x61\x70\x69\x2e\x6c\x65\x74\x73\x65\x6e\x63\x72\x79\x70\x74\x2e\x6f\x72\x67\x2f\x61\x63\x6d\x65\x2f\x61\x63\x63\x74\x2f\x32\x39\x38\x34\x31\x30\x36\x36\x22\x2c\x0a\x20\x20\x20\x20\x22\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x5f\x75\x72\x6c\x22\x3a\x20\x22\x68\x74\x74\x70\x73\x3a\x2f\x2f\x61\x63\x6d\x65\x2d\x76\x30\x32\x2e\x61\x70\x69\x2e\x6c\x65\x74\x73\x65\x6e\x63\x72\x79\x70\x74\x2e\x6f\x72\x67\x2f\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x41\x43\x4d\x45\x20\x55\x73\x65\x72\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x34\x31\x2e\x20\x52\x6f\x6c\x6c\x6f\x76\x65\x72\x20\x41\x43\x4d\x45\x20\x55\x73\x65\x72\x20\x4b\x65\x79\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x61\x63\x6d\x65\x2f\x75\x73\x65\x72\x2f\x6b\x65\x79\x2d\x72\x6f\x6c\x6c\x6f\x76\x65\x72\x0a\x60\x60\x60\x0a\x0a\x52\x65\x70\x6c\x61\x63\x65\x73\x20\x74\x68\x65\x20\x41\x43\x4d\x45\x20\x61\x63\x63\x6f\x75\x6e\x74\x20\x6b\x65\x79\x20\x77\x69\x74\x68\x20\x61\x20\x6e\x65\x77\x6c\x79\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x64\x20\x6f\x6e\x65\x20\x61\x6e\x64\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x61\x63\x63\x6f\x75\x6e\x74\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x61\x73\x20\x5b\x47\x65\x74\x20\x41\x43\x4d\x45\x20\x55\x73\x65\x72\x5d\x28\x23\x67\x65\x74\x2d\x61\x63\x6d\x65\x2d\x75\x73\x65\x72\x29\x20\x71\x75\x65\x72\x79\x2e\x20\x4f\x6e\x6c\x79\x20\x61\x64\x6d\x69\x6e\x69\x73\x74\x72\x61\x74\x6f\x72\x73\x20\x63\x61\x6e\x20\x64\x6f\x20\x69\x74\x2e\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x41\x43\x4d\x45\x20\x55\x73\x65\x72\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x67\x65\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x61\x63\x6d\x65\x2d\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x34\x32\x2e\x20\x47\x65\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x41\x43\x4d\x45\x20\x53\x65\x74\x74\x69\x6e\x67\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x69\x64\x7d\x2f\x61\x63\x6d\x65\x2d\x73\x65\x74\x74\x69\x6e\x67\x73\x0a\x60\x60\x60\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x20\x66\x6f\x72\x20\x6f\x62\x74\x61\x69\x6e\x69\x6e\x67\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x73\x20\x66\x6f\x72\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x64\x20\x62\x79\x20\x69\x74\x73\x20\x49\x44\x20\x6f\x72\x20\x66\x75\x6c\x6c\x79\x20\x71\x75\x61\x6c\x69\x66\x69\x65\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x2e\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x73\x20\x61\x72\x65\x20\x76\x61\x6c\x69\x64\x61\x74\x65\x64\x20\x77\x69\x74\x68\x20\x41\x43\x4d\x45\x20\x68\x74\x74\x70\x2d\x30\x31\x20\x63\x68\x61\x6c\x6c\x65\x6e\x67\x65\x73\x2c\x20\x75\x6e\x6c\x65\x73\x73\x20\x61\x20\x44\x4e\x53\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x69\x73\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x2c\x20\x77\x68\x65\x6e\x20\x64\x6e\x73\x2d\x30\x31\x20\x63\x68\x61\x6c\x6c\x65\x6e\x67\x65\x73\x20\x61\x72\x65\x20\x75\x73\x65\x64\x2e\x20\x4f\x6e\x6c\x79\x20\x64\x6e\x73\x2d\x30\x31\x20\x63\x68\x61\x6c\x6c\x65\x6e\x67\x65\x73\x20\x63\x61\x6e\x20\x62\x65\x20\x75\x73\x65\x64\x20\x74\x6f\x20\x6f\x62\x74\x61\x69\x6e\x20\x61\x20\x73\x69\x6e\x67\x6c\x65\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x66\x6f\x72\x20\x61\x20\x77\x69\x6c\x64\x63\x61\x72\x64\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6e\x73\x5f\x70\x72\x6f\x76\x69\x64\x65\x72\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x6e\x61\x6d\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x44\x4e\x53\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x74\x68\x61\x74\x20\x63\x72\x65\x61\x74\x65\x73\x20\x54\x58\x54\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x66\x6f\x72\x20\x64\x6e\x73\x2d\x30\x31\x20\x63\x68\x61\x6c\x6c\x65\x6e\x67\x65\x73\x0a\x20\x20\x2d\x20\x2a\x2a\x6b\x65\x79\x5f\x74\x79\x70\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x74\x79\x70\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x70\x72\x69\x76\x61\x74\x65\x20\x6b\x65\x79\x2c\x20\x6f\x6e\x65\x20\x6f\x66\x20\x60\x70\x32\x35\x36\x60\x2c\x20\x60\x70\x33\x38\x34\x60\x2c\x20\x60\x72\x73\x61\x32\x30\x34\x38\x60\x2c\x20\x60\x72\x73\x61\x34\x30\x39\x36\x60\x20\x6f\x72\x20\x60\x64\x75\x61\x6c\x60\x2c\x20\x6f\x72\x20\x65\x6d\x70\x74\x79\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x6b\x65\x79\x20\x74\x79\x70\x65\x3b\x20\x60\x64\x75\x61\x6c\x60\x20\x69\x73\x20\x61\x6e\x20\x45\x43\x44\x53\x41\x20\x50\x2d\x32\x35\x36\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x77\x69\x74\x68\x20\x61\x6e\x20\x61\x6c\x74\x65\x72\x6e\x61\x74\x69\x76\x65\x20\x52\x53\x41\x20\x32\x30\x34\x38\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x66\x6f\x72\x20\x63\x6c\x69\x65\x6e\x74\x73\x20\x74\x68\x61\x74\x20\x64\x6f\x20\x6e\x6f\x74\x20\x73\x75\x70\x70\x6f\x72\x74\x20\x45\x43\x44\x53\x41\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x61\x63\x6d\x65\x2d\x73\x65\x74\x74\x69\x6e\x67\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x64\x6e\x73\x5f\x70\x72\x6f\x76\x69\x64\x65\x72\x22\x3a\x20\x22\x6e\x73\x31\x22\x2c\x0a\x20\x20\x20\x20\x22\x6b\x65\x79\x5f\x74\x79\x70\x65\x22\x3a\x20\x22\x70\x32\x35\x36\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x34\x33\x2e\x20\x55\x70\x64\x61\x74\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x41\x43\x4d\x45\x20\x53\x65\x74\x74\x69\x6e\x67\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x69\x64\x7d\x2f\x61\x63\x6d\x65\x2d\x73\x65\x74\x74\x69\x6e\x67\x73\x0a\x60\x60\x60\x0a\x0a\x43\x68\x61\x6e\x67\x65\x73\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x20\x66\x6f\x72\x20\x6f\x62\x74\x61\x69\x6e\x69\x6e\x67\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x73\x20\x66\x6f\x72\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x2e\x20\x4f\x6e\x6c\x79\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x73\x20\x63\x61\x6e\x20\x63\x68\x61\x6e\x67\x65\x20\x74\x68\x65\x6d\x2e\x20\x45\x6d\x70\x74\x79\x20\x2a\x2a\x64\x6e\x73\x5f\x70\x72\x6f\x76\x69\x64\x65\x72\x2a\x2a\x20\x73\x65\x6c\x65\x63\x74\x73\x20\x68\x74\x74\x70\x2d\x30\x31\x20\x63\x68\x61\x6c\x6c\x65\x6e\x67\x65\x73\x20\x61\x6e\x64\x20\x65\x6d\x70\x74\x79\x20\x2a\x2a\x6b\x65\x79\x5f\x74\x79\x70\x65\x2a\x2a\x20\x74\x68\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x6b\x65\x79\x20\x74\x79\x70\x65\x2e\x20\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x20\x74\x68\x61\x74\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x73\x65\x6e\x74\x20\x61\x72\x65\x20\x6e\x6f\x74\x20\x63\x68\x61\x6e\x67\x65\x64\x2e\x20\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x61\x73\x20\x66\x6f\x72\x20\x5b\x47\x65\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x41\x43\x4d\x45\x20\x53\x65\x74\x74\x69\x6e\x67\x73\x5d\x28\x23\x67\x65\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x61\x63\x6d\x65\x2d\x73\x65\x74\x74\x69\x6e\x67\x73\x29\x20\x71\x75\x65\x72\x79\x2e\x20\x49\x66\x20\x61\x20\x44\x4e\x53\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x69\x73\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x20\x66\x6f\x72\x20\x61\x20\x77\x69\x6c\x64\x63\x61\x72\x64\x20\x64\x6f\x6d\x61\x69\x6e\x2c\x20\x74\x68\x65\x20\x77\x69\x6c\x64\x63\x61\x72\x64\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x6f\x62\x74\x61\x69\x6e\x65\x64\x2e\x20\x49\x66\x20\x74\x68\x65\x20\x6b\x65\x79\x20\x74\x79\x70\x65\x20\x69\x73\x20\x63\x68\x61\x6e\x67\x65\x64\x2c\x20\x74\x68\x65\x20\x65\x78\x69\x73\x74\x69\x6e\x67\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x6f\x62\x74\x61\x69\x6e\x65\x64\x20\x61\x67\x61\x69\x6e\x2e\x0a\x0a\x52\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x64\x6e\x73\x5f\x70\x72\x6f\x76\x69\x64\x65\x72\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x6e\x61\x6d\x65\x20\x6f\x66\x20\x6f\x6e\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x5b\x44\x4e\x53\x20\x50\x72\x6f\x76\x69\x64\x65\x72\x73\x5d\x28\x23\x67\x65\x74\x2d\x64\x6e\x73\x2d\x70\x72\x6f\x76\x69\x64\x65\x72\x73\x29\x0a\x20\x20\x2d\x20\x2a\x2a\x6b\x65\x79\x5f\x74\x79\x70\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x74\x79\x70\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x70\x72\x69\x76\x61\x74\x65\x20\x6b\x65\x79\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x58\x20\x50\x4f\x53\x54\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x64\x20\x27\x7b\x22\x64\x6e\x73\x5f\x70\x72\x6f\x76\x69\x64\x65\x72\x22\x3a\x20\x22\x6e\x73\x31\x22\x2c\x20\x22\x6b\x65\x79\x5f\x74\x79\x70\x65\x22\x3a\x20\x22\x70\x32\x35\x36\x22\x7d\x27\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x61\x63\x6d\x65\x2d\x73\x65\x74\x74\x69\x6e\x67\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x64\x6e\x73\x5f\x70\x72\x6f\x76\x69\x64\x65\x72\x22\x3a\x20\x22\x6e\x73\x31\x22\x2c\x0a\x20\x20\x20\x20\x22\x6b\x65\x79\x5f\x74\x79\x70\x65\x22\x3a\x20\x22\x70\x32\x35\x36\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x44\x4e\x53\x20\x50\x72\x6f\x76\x69\x64\x65\x72\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x31\x30\x29\x0a\x20\x20\x2d\x20\x5b\x4b\x65\x79\x20\x54\x79\x70\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x32\x30\x29\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x67\x65\x74\x2d\x64\x6e\x73\x2d\x70\x72\x6f\x76\x69\x64\x65\x72\x73\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x34\x34\x2e\x20\x4c\x69\x73\x74\x20\x44\x4e\x53\x20\x50\x72\x6f\x76\x69\x64\x65\x72\x73\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x61\x63\x6d\x65\x2f\x64\x6e\x73\x2d\x70\x72\x6f\x76\x69\x64\x65\x72\x73\x0a\x60\x60\x60\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x6e\x61\x6d\x65\x73\x20\x6f\x66\x20\x44\x4e\x53\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x73\x20\x74\x68\x61\x74\x20\x61\x72\x65\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x65\x64\x20\x62\x79\x20\x61\x64\x6d\x69\x6e\x69\x73\x74\x72\x61\x74\x6f\x72\x73\x20\x61\x6e\x64\x20\x63\x61\x6e\x20\x62\x65\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x20\x66\x6f\x72\x20\x64\x6f\x6d\x61\x69\x6e\x73\x2e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x6e\x61\x6d\x65\x73\x2a\x2a\x3a\x20\x28\x61\x72\x72\x61\x79\x20\x6f\x66\x20\x73\x74\x72\x69\x6e\x67\x73\x29\x20\x44\x4e\x53\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x6e\x61\x6d\x65\x73\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x61\x63\x6d\x65\x2f\x64\x6e\x73\x2d\x70\x72\x6f\x76\x69\x64\x65\x72\x73\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6e\x61\x6d\x65\x73\x22\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x22\x6e\x73\x31\x22\x0a\x20\x20\x20\x20\x5d\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x23\x23\x23\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x67\x65\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x22\x3e\x3c\x2f\x61\x3e\x20\x37\x2e\x34\x35\x2e\x20\x47\x65\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x47\x45\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x69\x64\x7d\x2f\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x0a\x60\x60\x60\x0a\x0a\x52\x65\x74\x75\x72\x6e\x73\x20\x69\x6e\x66\x6f\x72\x6d\x61\x74\x69\x6f\x6e\x20\x61\x62\x6f\x75\x74\x20\x74\x68\x65\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x6f\x66\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x20\x72\x65\x66\x65\x72\x65\x6e\x63\x65\x64\x20\x62\x79\x20\x69\x74\x73\x20\x49\x44\x20\x6f\x72\x20\x66\x75\x6c\x6c\x79\x20\x71\x75\x61\x6c\x69\x66\x69\x65\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x2e\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x73\x20\x61\x72\x65\x20\x72\x65\x6e\x65\x77\x65\x64\x20\x62\x65\x66\x6f\x72\x65\x20\x74\x68\x65\x79\x20\x65\x78\x70\x69\x72\x65\x2e\x20\x49\x66\x20\x61\x20\x72\x65\x6e\x65\x77\x61\x6c\x20\x66\x61\x69\x6c\x73\x2c\x20\x69\x74\x20\x69\x73\x20\x72\x65\x74\x72\x69\x65\x64\x20\x61\x66\x74\x65\x72\x20\x6f\x6e\x65\x20\x68\x6f\x75\x72\x20\x62\x79\x20\x64\x65\x66\x61\x75\x6c\x74\x2c\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x77\x61\x69\x74\x69\x6e\x67\x20\x74\x69\x6d\x65\x20\x64\x6f\x75\x62\x6c\x65\x73\x20\x77\x69\x74\x68\x20\x65\x76\x65\x72\x79\x20\x63\x6f\x6e\x73\x65\x63\x75\x74\x69\x76\x65\x20\x66\x61\x69\x6c\x75\x72\x65\x20\x75\x70\x20\x74\x6f\x20\x6f\x6e\x65\x20\x64\x61\x79\x2e\x0a\x0a\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x2a\x2a\x66\x71\x64\x6e\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x66\x75\x6c\x6c\x79\x20\x71\x75\x61\x6c\x69\x66\x69\x65\x64\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6e\x61\x6d\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x0a\x20\x20\x2d\x20\x2a\x2a\x65\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x5f\x74\x69\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x74\x69\x6d\x65\x20\x77\x68\x65\x6e\x20\x74\x68\x65\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x65\x78\x70\x69\x72\x65\x73\x20\x69\x6e\x20\x52\x46\x43\x33\x33\x33\x39\x20\x66\x6f\x72\x6d\x61\x74\x0a\x20\x20\x2d\x20\x2a\x2a\x6b\x65\x79\x5f\x74\x79\x70\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x74\x79\x70\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x70\x72\x69\x76\x61\x74\x65\x20\x6b\x65\x79\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x6e\x65\x77\x61\x6c\x5f\x61\x74\x74\x65\x6d\x70\x74\x5f\x74\x69\x6d\x65\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x74\x69\x6d\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x6c\x61\x73\x74\x20\x72\x65\x6e\x65\x77\x61\x6c\x20\x61\x74\x74\x65\x6d\x70\x74\x20\x69\x6e\x20\x52\x46\x43\x33\x33\x33\x39\x20\x66\x6f\x72\x6d\x61\x74\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x6e\x65\x77\x61\x6c\x5f\x65\x72\x72\x6f\x72\x2a\x2a\x3a\x20\x28\x73\x74\x72\x69\x6e\x67\x29\x20\x65\x72\x72\x6f\x72\x20\x6f\x66\x20\x74\x68\x65\x20\x6c\x61\x73\x74\x20\x72\x65\x6e\x65\x77\x61\x6c\x20\x61\x74\x74\x65\x6d\x70\x74\x2c\x20\x65\x6d\x70\x74\x79\x20\x69\x66\x20\x69\x74\x20\x73\x75\x63\x63\x65\x65\x64\x65\x64\x0a\x20\x20\x2d\x20\x2a\x2a\x72\x65\x6e\x65\x77\x61\x6c\x5f\x66\x61\x69\x6c\x75\x72\x65\x73\x2a\x2a\x3a\x20\x28\x69\x6e\x74\x65\x67\x65\x72\x29\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x63\x6f\x6e\x73\x65\x63\x75\x74\x69\x76\x65\x20\x66\x61\x69\x6c\x65\x64\x20\x72\x65\x6e\x65\x77\x61\x6c\x73\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x65\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x5f\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x39\x2d\x32\x30\x54\x30\x38\x3a\x31\x32\x3a\x32\x33\x5a\x22\x2c\x0a\x20\x20\x20\x20\x22\x6b\x65\x79\x5f\x74\x79\x70\x65\x22\x3a\x20\x22\x72\x73\x61\x32\x30\x34\x38\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x6e\x65\x77\x61\x6c\x5f\x61\x74\x74\x65\x6d\x70\x74\x5f\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x38\x2d\x33\x30\x54\x31\x30\x3a\x30\x31\x3a\x34\x35\x2e\x31\x39\x33\x30\x31\x31\x38\x33\x34\x5a\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x6e\x65\x77\x61\x6c\x5f\x65\x72\x72\x6f\x72\x22\x3a\x20\x22\x6c\x6f\x6f\x6b\x75\x70\x20\x64\x6e\x73\x20\x68\x6f\x73\x74\x3a\x20\x6c\x6f\x6f\x6b\x75\x70\x20\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x3a\x20\x6e\x6f\x20\x73\x75\x63\x68\x20\x68\x6f\x73\x74\x22\x2c\x0a\x20\x20\x20\x20\x22\x72\x65\x6e\x65\x77\x61\x6c\x5f\x66\x61\x69\x6c\x75\x72\x65\x73\x22\x3a\x20\x31\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x30\x29\x0a\x0a\x23\x23\x23\x20\x37\x2e\x34\x36\x2e\x20\x52\x65\x6e\x65\x77\x20\x44\x6f\x6d\x61\x69\x6e\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x0a\x0a\x60\x60\x60\x68\x74\x74\x70\x0a\x50\x4f\x53\x54\x20\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x7b\x69\x64\x7d\x2f\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2f\x72\x65\x6e\x65\x77\x0a\x60\x60\x60\x0a\x0a\x51\x75\x65\x75\x65\x73\x20\x74\x68\x65\x20\x54\x4c\x53\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x6f\x66\x20\x61\x20\x64\x6f\x6d\x61\x69\x6e\x20\x66\x6f\x72\x20\x72\x65\x6e\x65\x77\x61\x6c\x2c\x20\x72\x65\x67\x61\x72\x64\x6c\x65\x73\x73\x20\x6f\x66\x20\x69\x74\x73\x20\x65\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x20\x74\x69\x6d\x65\x20\x61\x6e\x64\x20\x6f\x66\x20\x74\x68\x65\x20\x77\x61\x69\x74\x69\x6e\x67\x20\x74\x69\x6d\x65\x20\x61\x66\x74\x65\x72\x20\x66\x61\x69\x6c\x65\x64\x20\x72\x65\x6e\x65\x77\x61\x6c\x73\x2e\x20\x4f\x6e\x6c\x79\x20\x64\x6f\x6d\x61\x69\x6e\x20\x6f\x77\x6e\x65\x72\x73\x20\x63\x61\x6e\x20\x72\x65\x6e\x65\x77\x20\x69\x74\x2e\x20\x54\x68\x65\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x69\x73\x20\x72\x65\x6e\x65\x77\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x2c\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x72\x65\x73\x75\x6c\x74\x20\x6f\x66\x20\x74\x68\x65\x20\x72\x65\x6e\x65\x77\x61\x6c\x20\x69\x73\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x77\x69\x74\x68\x20\x5b\x47\x65\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x5d\x28\x23\x67\x65\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x29\x20\x71\x75\x65\x72\x79\x2e\x20\x52\x65\x73\x70\x6f\x6e\x73\x65\x20\x62\x6f\x64\x79\x20\x69\x73\x20\x74\x68\x65\x20\x73\x61\x6d\x65\x20\x61\x73\x20\x66\x6f\x72\x20\x5b\x47\x65\x74\x20\x44\x6f\x6d\x61\x69\x6e\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x5d\x28\x23\x67\x65\x74\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x29\x20\x71\x75\x65\x72\x79\x2c\x20\x77\x69\x74\x68\x20\x69\x6e\x66\x6f\x72\x6d\x61\x74\x69\x6f\x6e\x20\x62\x65\x66\x6f\x72\x65\x20\x74\x68\x65\x20\x72\x65\x6e\x65\x77\x61\x6c\x2e\x0a\x0a\x60\x60\x60\x73\x68\x0a\x63\x75\x72\x6c\x20\x2d\x48\x20\x22\x58\x2d\x4b\x65\x79\x3a\x20\x54\x4f\x4b\x45\x4e\x22\x20\x5c\x0a\x20\x20\x20\x20\x20\x2d\x58\x20\x50\x4f\x53\x54\x20\x5c\x0a\x20\x20\x20\x20\x20\x68\x74\x74\x70\x73\x3a\x2f\x2f\x67\x6f\x70\x68\x65\x72\x70\x69\x74\x2e\x63\x6f\x6d\x2f\x61\x70\x69\x2f\x76\x31\x2f\x64\x6f\x6d\x61\x69\x6e\x73\x2f\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x2f\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2f\x72\x65\x6e\x65\x77\x0a\x60\x60\x60\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x66\x71\x64\x6e\x22\x3a\x20\x22\x65\x78\x61\x6d\x70\x6c\x65\x2e\x63\x6f\x6d\x22\x2c\x0a\x20\x20\x20\x20\x22\x65\x78\x70\x69\x72\x61\x74\x69\x6f\x6e\x5f\x74\x69\x6d\x65\x22\x3a\x20\x22\x32\x30\x31\x37\x2d\x30\x39\x2d\x32\x30\x54\x30\x38\x3a\x31\x32\x3a\x32\x33\x5a\x22\x2c\x0a\x20\x20\x20\x20\x22\x6b\x65\x79\x5f\x74\x79\x70\x65\x22\x3a\x20\x22\x72\x73\x61\x32\x30\x34\x38\x22\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x45\x72\x72\x6f\x72\x73\x3a\x0a\x0a\x20\x20\x2d\x20\x5b\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x29\x0a\x20\x20\x2d\x20\x5b\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x29\x0a\x20\x20\x2d\x20\x5b\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x5d\x28\x23\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x30\x29\x0a\x0a\x23\x23\x20\x38\x2e\x20\x41\x50\x49\x20\x53\x74\x61\x74\x75\x73\x20\x43\x6f\x64\x65\x73\x0a\x0a\x41\x50\x49\x20\x75\x74\x69\x6c\x69\x7a\x65\x73\x20\x48\x54\x54\x50\x20\x53\x74\x61\x74\x75\x73\x20\x63\x6f\x64\x65\x73\x20\x61\x73\x20\x77\x65\x6c\x6c\x20\x61\x73\x20\x73\x70\x65\x63\x69\x66\x69\x63\x20\x63\x6f\x64\x65\x73\x20\x66\x6f\x72\x20\x6d\x6f\x72\x65\x20\x67\x72\x61\x6e\x75\x6c\x61\x72\x20\x65\x72\x72\x6f\x72\x20\x72\x65\x70\x6f\x72\x74\x69\x6e\x67\x2e\x0a\x0a\x4d\x65\x73\x73\x61\x67\x65\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x20\x68\x61\x76\x65\x20\x74\x68\x65\x20\x66\x6f\x6c\x6c\x6f\x77\x69\x6e\x67\x20\x65\x78\x61\x6d\x70\x6c\x65\x20\x6f\x66\x20\x4a\x53\x4f\x4e\x2d\x65\x6e\x63\x6f\x64\x65\x64\x20\x62\x6f\x64\x79\x3a\x0a\x0a\x60\x60\x60\x6a\x73\x6f\x6e\x0a\x7b\x0a\x20\x20\x20\x20\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x3a\x20\x22\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x22\x2c\x0a\x20\x20\x20\x20\x22\x63\x6f\x64\x65\x22\x3a\x20\x31\x30\x30\x30\x0a\x7d\x0a\x60\x60\x60\x0a\x0a\x7c\x20\x43\x6f\x64\x65\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x48\x54\x54\x50\x20\x53\x74\x61\x74\x75\x73\x20\x43\x6f\x64\x65\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4d\x65\x73\x73\x61\x67\x65\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x7c\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x7c\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x2d\x7c\x0a\x7c\x20\x32\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x32\x30\x30\x20\x4f\x4b\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4f\x4b\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x30\x33\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x34\x30\x31\x20\x55\x6e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x55\x6e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x30\x33\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x34\x30\x33\x20\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x46\x6f\x72\x62\x69\x64\x64\x65\x6e\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x30\x34\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x34\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x34\x30\x34\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x31\x32\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x31\x32\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x34\x31\x32\x20\x50\x72\x65\x63\x6f\x6e\x64\x69\x74\x69\x6f\x6e\x20\x46\x61\x69\x6c\x65\x64\x20\x20\x20\x7c\x20\x50\x72\x65\x63\x6f\x6e\x64\x69\x74\x69\x6f\x6e\x20\x46\x61\x69\x6c\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x32\x39\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x32\x39\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x34\x32\x39\x20\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x52\x65\x71\x75\x65\x73\x74\x73\x20\x20\x20\x20\x20\x7c\x20\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x52\x65\x71\x75\x65\x73\x74\x73\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x35\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x35\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x35\x30\x30\x20\x49\x6e\x74\x65\x72\x6e\x61\x6c\x20\x53\x65\x72\x76\x65\x72\x20\x45\x72\x72\x6f\x72\x20\x7c\x20\x49\x6e\x74\x65\x72\x6e\x61\x6c\x20\x53\x65\x72\x76\x65\x72\x20\x45\x72\x72\x6f\x72\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x35\x30\x33\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x22\x3e\x3c\x2f\x61\x3e\x20\x20\x20\x7c\x20\x35\x30\x33\x20\x53\x65\x72\x76\x69\x63\x65\x20\x55\x6e\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x20\x20\x7c\x20\x4d\x61\x69\x6e\x74\x65\x6e\x61\x6e\x63\x65\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x30\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x30\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x46\x51\x44\x4e\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x32\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x32\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x6f\x74\x20\x41\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x33\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x33\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x57\x69\x74\x68\x20\x54\x6f\x6f\x20\x4d\x61\x6e\x79\x20\x53\x75\x62\x64\x6f\x6d\x61\x69\x6e\x73\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x34\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x34\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x4e\x65\x65\x64\x73\x20\x56\x65\x72\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x35\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x35\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x41\x6c\x69\x61\x73\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x36\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x36\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x41\x6c\x69\x61\x73\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x31\x37\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x31\x37\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x51\x75\x6f\x74\x61\x20\x45\x78\x63\x65\x65\x64\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x32\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x32\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x32\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x32\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x32\x32\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x32\x32\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x54\x6f\x20\x4f\x77\x6e\x65\x72\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x32\x33\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x32\x33\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x45\x78\x70\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x30\x32\x34\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x30\x32\x34\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x54\x72\x61\x6e\x73\x66\x65\x72\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x31\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x55\x73\x65\x72\x20\x44\x6f\x65\x73\x20\x4e\x6f\x74\x20\x45\x78\x69\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x31\x30\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x55\x73\x65\x72\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x47\x72\x61\x6e\x74\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x31\x30\x32\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x32\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x55\x73\x65\x72\x20\x4e\x6f\x74\x20\x47\x72\x61\x6e\x74\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x31\x30\x34\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x31\x30\x34\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x6f\x6d\x61\x69\x6e\x20\x52\x6f\x6c\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x32\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x32\x30\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x30\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x32\x31\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x31\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x61\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x32\x31\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x31\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4e\x61\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x32\x32\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x32\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x52\x6f\x6c\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x32\x32\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x32\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4c\x61\x73\x74\x20\x4f\x77\x6e\x65\x72\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x32\x32\x32\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x32\x32\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x4d\x65\x6d\x62\x65\x72\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x31\x32\x33\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x31\x32\x33\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x48\x61\x73\x20\x44\x6f\x6d\x61\x69\x6e\x73\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x30\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x30\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x41\x6c\x72\x65\x61\x64\x79\x20\x45\x78\x69\x73\x74\x73\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x31\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x31\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x44\x6f\x6d\x61\x69\x6e\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x32\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x32\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x50\x61\x74\x68\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x33\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x33\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x56\x43\x53\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x34\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x34\x31\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x31\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x34\x32\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x32\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x7c\x0a\x7c\x20\x32\x30\x34\x33\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x33\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x53\x63\x68\x65\x6d\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x7c\x0a\x7c\x20\x32\x30\x34\x34\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x34\x34\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x70\x6f\x73\x69\x74\x6f\x72\x79\x20\x52\x6f\x6f\x74\x20\x48\x6f\x73\x74\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x35\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x35\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x54\x79\x70\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x36\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x36\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x4e\x61\x6d\x65\x20\x52\x65\x71\x75\x69\x72\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x37\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x37\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x20\x43\x68\x61\x6e\x67\x65\x20\x52\x65\x6a\x65\x63\x74\x65\x64\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x30\x38\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x30\x38\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x52\x65\x64\x69\x72\x65\x63\x74\x20\x55\x52\x4c\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x31\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x31\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x44\x65\x66\x61\x75\x6c\x74\x73\x20\x46\x69\x65\x6c\x64\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x32\x31\x31\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x32\x31\x31\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x51\x75\x6f\x74\x61\x20\x45\x78\x63\x65\x65\x64\x65\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x33\x30\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x33\x30\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x42\x61\x74\x63\x68\x20\x41\x63\x74\x69\x6f\x6e\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x30\x30\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x30\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x41\x43\x4d\x45\x20\x55\x73\x65\x72\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x30\x31\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x31\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x44\x4e\x53\x20\x50\x72\x6f\x76\x69\x64\x65\x72\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x30\x32\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x32\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x4b\x65\x79\x20\x54\x79\x70\x65\x20\x49\x6e\x76\x61\x6c\x69\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a\x7c\x20\x34\x30\x33\x30\x20\x3c\x61\x20\x6e\x61\x6d\x65\x3d\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x2d\x34\x30\x33\x30\x22\x3e\x3c\x2f\x61\x3e\x20\x7c\x20\x34\x30\x30\x20\x42\x61\x64\x20\x52\x65\x71\x75\x65\x73\x74\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x20\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x4e\x6f\x74\x20\x46\x6f\x75\x6e\x64\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7c\x0a"
 
 func fragmentsDocsApiMdBytes() ([]byte, error) {
 	return bindataRead(
@@ -721,7 +765,7 @@ func fragmentsDocsApiMd() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "fragments/docs-api.md", size: 23563, mode: os.FileMode(420), modTime: time.Unix(1491087424, 0)}
+	info := bindataFileInfo{name: "fragments/docs-api.md", size: 71224, mode: os.FileMode(420), modTime: time.Unix(1792341972, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -806,6 +850,46 @@ func loginHtml() (*asset, error) {
 	return a, nil
 }
 
+var _organizationAddHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x37\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x4e\x65\x77\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x22\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x28\x22\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2f\x22\x2b\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x5b\x22\x6e\x61\x6d\x65\x22\x5d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x31\x22\x3e\x4e\x65\x77\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x20\x20\x3c\x70\x3e\x41\x6e\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x69\x73\x20\x61\x20\x67\x72\x6f\x75\x70\x20\x6f\x66\x20\x75\x73\x65\x72\x73\x20\x74\x68\x61\x74\x20\x6f\x77\x6e\x73\x20\x64\x6f\x6d\x61\x69\x6e\x73\x2e\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6f\x77\x6e\x65\x72\x73\x20\x6d\x61\x6e\x61\x67\x65\x20\x69\x74\x73\x20\x6d\x65\x6d\x62\x65\x72\x73\x20\x61\x6e\x64\x20\x64\x6f\x6d\x61\x69\x6e\x73\x2c\x20\x77\x68\x69\x6c\x65\x20\x61\x6c\x6c\x20\x6d\x65\x6d\x62\x65\x72\x73\x20\x63\x61\x6e\x20\x6d\x61\x69\x6e\x74\x61\x69\x6e\x20\x70\x61\x63\x6b\x61\x67\x65\x73\x20\x6f\x6e\x20\x69\x74\x73\x20\x64\x6f\x6d\x61\x69\x6e\x73\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x20\x69\x73\x2d\x6f\x66\x66\x73\x65\x74\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x66\x6f\x72\x6d\x22\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x6e\x61\x6d\x65\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x6e\x61\x6d\x65\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x6e\x61\x6d\x65\x7d\x22\x20\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x6e\x61\x6d\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x69\x20\x62\x75\x74\x74\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x22\x3e\x43\x61\x6e\x63\x65\x6c\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x74\x61\x62\x69\x6e\x64\x65\x78\x3d\x22\x30\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x41\x64\x64\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a"
+
+func organizationAddHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_organizationAddHtml,
+		"organization-add.html",
+	)
+}
+
+func organizationAddHtml() (*asset, error) {
+	bytes, err := organizationAddHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "organization-add.html", size: 2247, mode: os.FileMode(420), modTime: time.Unix(1792333402, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _organizationHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x37\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x5b\x5b\x20\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x2e\x52\x6f\x6c\x65\x20\x22\x6f\x77\x6e\x65\x72\x22\x20\x5d\x5d\x0a\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x6d\x65\x6d\x62\x65\x72\x20\x3a\x3d\x20\x2e\x4d\x65\x6d\x62\x65\x72\x73\x20\x5d\x5d\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x6d\x65\x6d\x62\x65\x72\x2d\x5b\x5b\x20\x24\x6d\x65\x6d\x62\x65\x72\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x64\x3a\x20\x22\x5b\x5b\x20\x24\x6d\x65\x6d\x62\x65\x72\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x6f\x6c\x65\x3a\x20\x22\x5b\x5b\x20\x24\x6d\x65\x6d\x62\x65\x72\x2e\x52\x6f\x6c\x65\x20\x5d\x5d\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2f\x5b\x5b\x20\x24\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x49\x44\x20\x5d\x5d\x2f\x6d\x65\x6d\x62\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x6d\x6f\x76\x65\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x44\x65\x6c\x65\x74\x65\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2f\x5b\x5b\x20\x24\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x49\x44\x20\x5d\x5d\x2f\x6d\x65\x6d\x62\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x72\x65\x6c\x6f\x61\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x6d\x65\x6d\x62\x65\x72\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x64\x3a\x20\x22\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x6f\x6c\x65\x3a\x20\x22\x6d\x65\x6d\x62\x65\x72\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2f\x5b\x5b\x20\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x49\x44\x20\x5d\x5d\x2f\x6d\x65\x6d\x62\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x72\x65\x6c\x6f\x61\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x64\x65\x6c\x65\x74\x65\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x44\x65\x6c\x65\x74\x65\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2f\x5b\x5b\x20\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x49\x44\x20\x5d\x5d\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x22\x2f\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x6c\x65\x61\x76\x65\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x64\x3a\x20\x22\x5b\x5b\x20\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x44\x65\x6c\x65\x74\x65\x28\x74\x68\x69\x73\x2c\x20\x27\x2f\x69\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2f\x5b\x5b\x20\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x49\x44\x20\x5d\x5d\x2f\x6d\x65\x6d\x62\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x22\x2f\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x69\x66\x20\x2e\x4b\x65\x79\x20\x5d\x5d\x0a\x5b\x5b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x61\x70\x69\x2d\x6b\x65\x79\x2d\x73\x63\x72\x69\x70\x74\x22\x20\x6d\x61\x70\x20\x22\x4b\x65\x79\x22\x20\x2e\x4b\x65\x79\x20\x22\x55\x52\x4c\x22\x20\x28\x70\x72\x69\x6e\x74\x20\x22\x2f\x69\x2f\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2f\x22\x20\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x49\x44\x20\x22\x2f\x61\x70\x69\x22\x29\x20\x5d\x5d\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x75\x62\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x33\x22\x3e\x0a\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x73\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x6f\x6d\x61\x69\x6e\x20\x3a\x3d\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x62\x6c\x6f\x63\x6b\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x6d\x61\x69\x6e\x2f\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x64\x6f\x6d\x61\x69\x6e\x2e\x46\x51\x44\x4e\x20\x5d\x5d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6e\x6f\x74\x20\x2e\x44\x6f\x6d\x61\x69\x6e\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x54\x68\x69\x73\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x64\x6f\x65\x73\x20\x6e\x6f\x74\x20\x6f\x77\x6e\x20\x61\x6e\x79\x20\x64\x6f\x6d\x61\x69\x6e\x73\x2e\x20\x4f\x77\x6e\x65\x72\x73\x20\x63\x61\x6e\x20\x67\x69\x76\x65\x20\x74\x68\x65\x69\x72\x20\x64\x6f\x6d\x61\x69\x6e\x73\x20\x74\x6f\x20\x74\x68\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x69\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x36\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x34\x22\x3e\x4d\x65\x6d\x62\x65\x72\x73\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x61\x62\x6c\x65\x20\x63\x61\x72\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x4e\x61\x6d\x65\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x49\x44\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x52\x6f\x6c\x65\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x2e\x52\x6f\x6c\x65\x20\x22\x6f\x77\x6e\x65\x72\x22\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x20\x63\x6c\x61\x73\x73\x3d\x22\x72\x69\x67\x68\x74\x20\x61\x6c\x69\x67\x6e\x65\x64\x22\x3e\x52\x65\x6d\x6f\x76\x65\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x72\x61\x6e\x67\x65\x20\x24\x6d\x65\x6d\x62\x65\x72\x20\x3a\x3d\x20\x2e\x4d\x65\x6d\x62\x65\x72\x73\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x20\x69\x64\x3d\x22\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x6d\x65\x6d\x62\x65\x72\x2d\x5b\x5b\x20\x24\x6d\x65\x6d\x62\x65\x72\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x75\x73\x65\x72\x2f\x5b\x5b\x20\x24\x6d\x65\x6d\x62\x65\x72\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x24\x6d\x65\x6d\x62\x65\x72\x2e\x55\x73\x65\x72\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3c\x2f\x61\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x63\x6f\x64\x65\x3e\x5b\x5b\x20\x24\x6d\x65\x6d\x62\x65\x72\x2e\x55\x73\x65\x72\x2e\x49\x44\x20\x5d\x5d\x3c\x2f\x63\x6f\x64\x65\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x24\x2e\x52\x6f\x6c\x65\x20\x22\x6f\x77\x6e\x65\x72\x22\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x72\x6f\x6c\x65\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x68\x61\x6e\x67\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x6f\x77\x6e\x65\x72\x22\x3e\x4f\x77\x6e\x65\x72\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x6d\x65\x6d\x62\x65\x72\x22\x3e\x4d\x65\x6d\x62\x65\x72\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6c\x61\x73\x73\x3d\x22\x72\x69\x67\x68\x74\x20\x61\x6c\x69\x67\x6e\x65\x64\x22\x3e\x3c\x61\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x72\x65\x6d\x6f\x76\x65\x22\x3e\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x72\x65\x6d\x6f\x76\x65\x22\x3e\x3c\x2f\x69\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x61\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6c\x73\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x5b\x5b\x20\x24\x6d\x65\x6d\x62\x65\x72\x2e\x52\x6f\x6c\x65\x20\x5d\x5d\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x2e\x52\x6f\x6c\x65\x20\x22\x6f\x77\x6e\x65\x72\x22\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x6d\x65\x6d\x62\x65\x72\x2d\x66\x6f\x72\x6d\x22\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x20\x68\x61\x73\x2d\x61\x64\x64\x6f\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x69\x73\x2d\x65\x78\x70\x61\x6e\x64\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x69\x64\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x55\x73\x65\x72\x6e\x61\x6d\x65\x2c\x20\x65\x2d\x6d\x61\x69\x6c\x20\x61\x64\x64\x72\x65\x73\x73\x20\x6f\x72\x20\x49\x44\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x69\x64\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x72\x6f\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x6d\x65\x6d\x62\x65\x72\x22\x3e\x4d\x65\x6d\x62\x65\x72\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x6f\x77\x6e\x65\x72\x22\x3e\x4f\x77\x6e\x65\x72\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x41\x64\x64\x20\x6d\x65\x6d\x62\x65\x72\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x69\x64\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x4b\x65\x79\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x20\x69\x64\x3d\x22\x61\x70\x69\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x34\x22\x3e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x41\x63\x63\x65\x73\x73\x20\x54\x6f\x6b\x65\x6e\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x52\x65\x71\x75\x65\x73\x74\x73\x20\x77\x69\x74\x68\x20\x74\x68\x69\x73\x20\x41\x50\x49\x20\x6b\x65\x79\x20\x68\x61\x76\x65\x20\x74\x68\x65\x20\x6d\x61\x69\x6e\x74\x61\x69\x6e\x65\x72\x20\x72\x6f\x6c\x65\x20\x6f\x6e\x20\x61\x6c\x6c\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x64\x6f\x6d\x61\x69\x6e\x73\x2e\x20\x43\x68\x65\x63\x6b\x20\x6f\x75\x72\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x63\x73\x2f\x61\x70\x69\x22\x3e\x41\x50\x49\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x61\x74\x69\x6f\x6e\x3c\x2f\x61\x3e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x61\x70\x69\x2d\x6b\x65\x79\x22\x20\x6d\x61\x70\x20\x22\x53\x75\x62\x6a\x65\x63\x74\x22\x20\x22\x74\x68\x69\x73\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x22\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x62\x72\x3e\x0a\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x65\x71\x20\x2e\x52\x6f\x6c\x65\x20\x22\x6f\x77\x6e\x65\x72\x22\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x20\x69\x64\x3d\x22\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x64\x65\x6c\x65\x74\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x74\x72\x75\x65\x22\x3e\x44\x65\x6c\x65\x74\x65\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x27\x3a\x20\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x7d\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x20\x62\x6f\x78\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x44\x65\x6c\x65\x74\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x20\x5b\x5b\x20\x2e\x4f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2e\x4e\x61\x6d\x65\x20\x5d\x5d\x3f\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x6d\x6f\x64\x61\x6c\x2d\x63\x6c\x6f\x73\x65\x2d\x62\x75\x74\x74\x6f\x6e\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x4e\x6f\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x59\x65\x73\x2c\x20\x64\x65\x6c\x65\x74\x65\x20\x69\x74\x21\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x6f\x64\x61\x6c\x2d\x63\x6c\x6f\x73\x65\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x69\x73\x4d\x6f\x64\x61\x6c\x41\x63\x74\x69\x76\x65\x20\x3d\x20\x66\x61\x6c\x73\x65\x22\x3e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x20\x69\x64\x3d\x22\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x2d\x6c\x65\x61\x76\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x6f\x75\x74\x6c\x69\x6e\x65\x64\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x4c\x65\x61\x76\x65\x20\x6f\x72\x67\x61\x6e\x69\x7a\x61\x74\x69\x6f\x6e\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a"
+
+func organizationHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_organizationHtml,
+		"organization.html",
+	)
+}
+
+func organizationHtml() (*asset, error) {
+	bytes, err := organizationHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "organization.html", size: 7632, mode: os.FileMode(420), modTime: time.Unix(1792333402, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _packageResolutionHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x2d\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x62\x61\x73\x65\x22\x20\x2e\x20\x2d\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x62\x61\x73\x65\x22\x20\x2d\x5d\x5d\x0a\x3c\x21\x64\x6f\x63\x74\x79\x70\x65\x20\x68\x74\x6d\x6c\x3e\x0a\x3c\x68\x74\x6d\x6c\x3e\x0a\x20\x20\x20\x20\x3c\x68\x65\x61\x64\x3e\x5b\x5b\x20\x69\x66\x20\x2e\x47\x6f\x49\x6d\x70\x6f\x72\x74\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6d\x65\x74\x61\x20\x6e\x61\x6d\x65\x3d\x22\x67\x6f\x2d\x69\x6d\x70\x6f\x72\x74\x22\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x5b\x5b\x20\x2e\x47\x6f\x49\x6d\x70\x6f\x72\x74\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x2e\x47\x6f\x53\x6f\x75\x72\x63\x65\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6d\x65\x74\x61\x20\x6e\x61\x6d\x65\x3d\x22\x67\x6f\x2d\x73\x6f\x75\x72\x63\x65\x22\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x5b\x5b\x20\x2e\x47\x6f\x53\x6f\x75\x72\x63\x65\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x5b\x5b\x20\x69\x66\x20\x2e\x52\x65\x64\x69\x72\x65\x63\x74\x55\x52\x4c\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6d\x65\x74\x61\x20\x68\x74\x74\x70\x2d\x65\x71\x75\x69\x76\x3d\x22\x72\x65\x66\x72\x65\x73\x68\x22\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x30\x3b\x20\x75\x72\x6c\x3d\x5b\x5b\x20\x2e\x52\x65\x64\x69\x72\x65\x63\x74\x55\x52\x4c\x20\x5d\x5d\x22\x3e\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x3c\x2f\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x3c\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x3c\x63\x6f\x64\x65\x3e\x5b\x5b\x20\x2e\x47\x6f\x49\x6d\x70\x6f\x72\x74\x20\x5d\x5d\x3c\x2f\x63\x6f\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x62\x6f\x64\x79\x3e\x0a\x3c\x2f\x68\x74\x6d\x6c\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
 
 func packageResolutionHtmlBytes() ([]byte, error) {
@@ -906,7 +990,7 @@ func publicHtml() (*asset, error) {
 	return a, nil
 }
 
-var _registerAcmeUserHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x41\x43\x4d\x45\x20\x55\x73\x65\x72\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x72\x65\x67\x69\x73\x74\x65\x72\x2d\x61\x63\x6d\x65\x2d\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6d\x61\x69\x6c\x3a\x20\x22\x5b\x5b\x20\x2e\x55\x73\x65\x72\x2e\x45\x6d\x61\x69\x6c\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x3a\x20\x22\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x44\x6f\x6e\x65\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2c\x20\x27\x2f\x69\x2f\x72\x65\x67\x69\x73\x74\x65\x72\x2d\x61\x63\x6d\x65\x2d\x75\x73\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x69\x73\x44\x6f\x6e\x65\x20\x3d\x20\x74\x72\x75\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x34\x20\x69\x73\x2d\x6f\x66\x66\x73\x65\x74\x2d\x34\x22\x20\x69\x64\x3d\x22\x72\x65\x67\x69\x73\x74\x65\x72\x2d\x61\x63\x6d\x65\x2d\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x3e\x0a\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x31\x22\x3e\x41\x43\x4d\x45\x20\x55\x73\x65\x72\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x76\x2d\x69\x66\x3d\x22\x69\x73\x44\x6f\x6e\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x73\x75\x63\x63\x65\x73\x73\x22\x3e\x41\x43\x4d\x45\x20\x55\x73\x65\x72\x20\x69\x73\x20\x73\x75\x63\x63\x65\x73\x73\x66\x75\x6c\x6c\x79\x20\x72\x65\x67\x69\x73\x74\x65\x72\x65\x64\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x75\x6c\x6c\x65\x64\x2d\x72\x69\x67\x68\x74\x22\x3e\x43\x6f\x6e\x74\x69\x6e\x75\x65\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x66\x6f\x72\x6d\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x20\x76\x2d\x69\x66\x3d\x22\x21\x69\x73\x44\x6f\x6e\x65\x22\x3e\x0a\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x52\x65\x67\x69\x73\x74\x72\x61\x74\x69\x6f\x6e\x20\x6f\x66\x20\x41\x43\x4d\x45\x20\x75\x73\x65\x72\x20\x69\x73\x20\x72\x65\x71\x75\x69\x72\x65\x64\x20\x74\x6f\x20\x72\x65\x71\x75\x65\x73\x74\x20\x6e\x65\x77\x20\x54\x4c\x53\x2f\x53\x53\x4c\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x73\x2e\x20\x45\x2d\x6d\x61\x69\x6c\x20\x61\x64\x64\x72\x65\x73\x73\x20\x69\x73\x20\x6f\x70\x74\x69\x6f\x6e\x61\x6c\x2c\x20\x62\x75\x74\x20\x69\x66\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x2c\x20\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x73\x20\x66\x72\x6f\x6d\x20\x41\x43\x4d\x45\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x73\x65\x6e\x74\x20\x74\x6f\x20\x69\x74\x2e\x20\x50\x72\x6f\x64\x75\x63\x74\x69\x6f\x6e\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x73\x20\x76\x61\x6c\x69\x64\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x73\x2c\x20\x77\x68\x69\x6c\x65\x20\x53\x74\x61\x67\x69\x6e\x67\x20\x69\x73\x20\x6d\x65\x61\x6e\x74\x20\x66\x6f\x72\x20\x74\x65\x73\x74\x69\x6e\x67\x20\x61\x6e\x64\x20\x64\x65\x76\x65\x6c\x6f\x70\x6d\x65\x6e\x74\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x45\x2d\x6d\x61\x69\x6c\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x68\x61\x73\x2d\x69\x63\x6f\x6e\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x65\x6d\x61\x69\x6c\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x65\x6d\x61\x69\x6c\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x55\x73\x65\x72\x20\x65\x6d\x61\x69\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x65\x6d\x61\x69\x6c\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x3e\x3c\x2f\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x65\x6d\x61\x69\x6c\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x44\x69\x72\x65\x63\x74\x6f\x72\x79\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x70\x72\x6f\x64\x75\x63\x74\x69\x6f\x6e\x22\x3e\x50\x72\x6f\x64\x75\x63\x74\x69\x6f\x6e\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x73\x74\x61\x67\x69\x6e\x67\x22\x3e\x53\x74\x61\x67\x69\x6e\x67\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x50\x72\x6f\x64\x75\x63\x74\x69\x6f\x6e\x3a\x20\x26\x6c\x74\x3b\x5b\x5b\x20\x2e\x50\x72\x6f\x64\x75\x63\x74\x69\x6f\x6e\x44\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x5d\x5d\x26\x67\x74\x3b\x20\x3c\x62\x72\x3e\x53\x74\x61\x67\x69\x6e\x67\x3a\x20\x26\x6c\x74\x3b\x5b\x5b\x20\x2e\x53\x74\x61\x67\x69\x6e\x67\x44\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x5d\x5d\x26\x67\x74\x3b\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x52\x65\x67\x69\x73\x74\x65\x72\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
+var _registerAcmeUserHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x36\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x41\x43\x4d\x45\x20\x55\x73\x65\x72\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x72\x65\x67\x69\x73\x74\x65\x72\x2d\x61\x63\x6d\x65\x2d\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6d\x61\x69\x6c\x3a\x20\x22\x5b\x5b\x20\x2e\x55\x73\x65\x72\x2e\x45\x6d\x61\x69\x6c\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x3a\x20\x22\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x61\x62\x5f\x6b\x65\x79\x5f\x69\x64\x3a\x20\x22\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x61\x62\x5f\x68\x6d\x61\x63\x5f\x6b\x65\x79\x3a\x20\x22\x22\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x73\x44\x6f\x6e\x65\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x62\x6d\x69\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2c\x20\x27\x2f\x69\x2f\x72\x65\x67\x69\x73\x74\x65\x72\x2d\x61\x63\x6d\x65\x2d\x75\x73\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x69\x73\x44\x6f\x6e\x65\x20\x3d\x20\x74\x72\x75\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x34\x20\x69\x73\x2d\x6f\x66\x66\x73\x65\x74\x2d\x34\x22\x20\x69\x64\x3d\x22\x72\x65\x67\x69\x73\x74\x65\x72\x2d\x61\x63\x6d\x65\x2d\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x3e\x0a\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x20\x69\x73\x2d\x31\x22\x3e\x41\x43\x4d\x45\x20\x55\x73\x65\x72\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x76\x2d\x69\x66\x3d\x22\x69\x73\x44\x6f\x6e\x65\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x73\x75\x63\x63\x65\x73\x73\x22\x3e\x41\x43\x4d\x45\x20\x55\x73\x65\x72\x20\x69\x73\x20\x73\x75\x63\x63\x65\x73\x73\x66\x75\x6c\x6c\x79\x20\x72\x65\x67\x69\x73\x74\x65\x72\x65\x64\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x75\x6c\x6c\x65\x64\x2d\x72\x69\x67\x68\x74\x22\x3e\x43\x6f\x6e\x74\x69\x6e\x75\x65\x3c\x2f\x61\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x66\x6f\x72\x6d\x20\x6d\x65\x74\x68\x6f\x64\x3d\x22\x70\x6f\x73\x74\x22\x20\x76\x2d\x6f\x6e\x3a\x73\x75\x62\x6d\x69\x74\x2e\x70\x72\x65\x76\x65\x6e\x74\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x20\x76\x2d\x69\x66\x3d\x22\x21\x69\x73\x44\x6f\x6e\x65\x22\x3e\x0a\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3e\x52\x65\x67\x69\x73\x74\x72\x61\x74\x69\x6f\x6e\x20\x6f\x66\x20\x41\x43\x4d\x45\x20\x75\x73\x65\x72\x20\x69\x73\x20\x72\x65\x71\x75\x69\x72\x65\x64\x20\x74\x6f\x20\x72\x65\x71\x75\x65\x73\x74\x20\x6e\x65\x77\x20\x54\x4c\x53\x2f\x53\x53\x4c\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x73\x2e\x20\x45\x2d\x6d\x61\x69\x6c\x20\x61\x64\x64\x72\x65\x73\x73\x20\x69\x73\x20\x6f\x70\x74\x69\x6f\x6e\x61\x6c\x2c\x20\x62\x75\x74\x20\x69\x66\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x2c\x20\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x73\x20\x66\x72\x6f\x6d\x20\x41\x43\x4d\x45\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x73\x65\x6e\x74\x20\x74\x6f\x20\x69\x74\x2e\x20\x50\x72\x6f\x64\x75\x63\x74\x69\x6f\x6e\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x73\x20\x76\x61\x6c\x69\x64\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x73\x2c\x20\x77\x68\x69\x6c\x65\x20\x53\x74\x61\x67\x69\x6e\x67\x20\x69\x73\x20\x6d\x65\x61\x6e\x74\x20\x66\x6f\x72\x20\x74\x65\x73\x74\x69\x6e\x67\x20\x61\x6e\x64\x20\x64\x65\x76\x65\x6c\x6f\x70\x6d\x65\x6e\x74\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x45\x2d\x6d\x61\x69\x6c\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x68\x61\x73\x2d\x69\x63\x6f\x6e\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x65\x6d\x61\x69\x6c\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x65\x6d\x61\x69\x6c\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x55\x73\x65\x72\x20\x65\x6d\x61\x69\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x65\x6d\x61\x69\x6c\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x3e\x3c\x2f\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x65\x6d\x61\x69\x6c\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x44\x69\x72\x65\x63\x74\x6f\x72\x79\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x65\x6c\x65\x63\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x6c\x65\x63\x74\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x70\x72\x6f\x64\x75\x63\x74\x69\x6f\x6e\x22\x3e\x50\x72\x6f\x64\x75\x63\x74\x69\x6f\x6e\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x76\x61\x6c\x75\x65\x3d\x22\x73\x74\x61\x67\x69\x6e\x67\x22\x3e\x53\x74\x61\x67\x69\x6e\x67\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x50\x72\x6f\x64\x75\x63\x74\x69\x6f\x6e\x3a\x20\x26\x6c\x74\x3b\x5b\x5b\x20\x2e\x50\x72\x6f\x64\x75\x63\x74\x69\x6f\x6e\x44\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x5d\x5d\x26\x67\x74\x3b\x20\x3c\x62\x72\x3e\x53\x74\x61\x67\x69\x6e\x67\x3a\x20\x26\x6c\x74\x3b\x5b\x5b\x20\x2e\x53\x74\x61\x67\x69\x6e\x67\x44\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x5d\x5d\x26\x67\x74\x3b\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x45\x78\x74\x65\x72\x6e\x61\x6c\x20\x41\x63\x63\x6f\x75\x6e\x74\x20\x4b\x65\x79\x20\x49\x44\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x65\x61\x62\x5f\x6b\x65\x79\x5f\x69\x64\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x4f\x70\x74\x69\x6f\x6e\x61\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x65\x61\x62\x5f\x6b\x65\x79\x5f\x69\x64\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x65\x61\x62\x5f\x6b\x65\x79\x5f\x69\x64\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x61\x62\x65\x6c\x22\x3e\x45\x78\x74\x65\x72\x6e\x61\x6c\x20\x41\x63\x63\x6f\x75\x6e\x74\x20\x48\x4d\x41\x43\x20\x4b\x65\x79\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x70\x61\x73\x73\x77\x6f\x72\x64\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x66\x69\x65\x6c\x64\x73\x2e\x65\x61\x62\x5f\x68\x6d\x61\x63\x5f\x6b\x65\x79\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x4f\x70\x74\x69\x6f\x6e\x61\x6c\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x27\x3a\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x65\x61\x62\x5f\x68\x6d\x61\x63\x5f\x6b\x65\x79\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x2e\x65\x61\x62\x5f\x68\x6d\x61\x63\x5f\x6b\x65\x79\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x6c\x70\x22\x3e\x45\x78\x74\x65\x72\x6e\x61\x6c\x20\x61\x63\x63\x6f\x75\x6e\x74\x20\x62\x69\x6e\x64\x69\x6e\x67\x20\x69\x73\x20\x72\x65\x71\x75\x69\x72\x65\x64\x20\x6f\x6e\x6c\x79\x20\x62\x79\x20\x73\x6f\x6d\x65\x20\x41\x43\x4d\x45\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x73\x5b\x5b\x20\x69\x66\x20\x2e\x45\x41\x42\x43\x6f\x6e\x66\x69\x67\x75\x72\x65\x64\x20\x5d\x5d\x20\x61\x6e\x64\x20\x69\x73\x20\x61\x6c\x72\x65\x61\x64\x79\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x65\x64\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x2e\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x69\x65\x6c\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6e\x61\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x6c\x65\x66\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x72\x69\x67\x68\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x65\x76\x65\x6c\x2d\x69\x74\x65\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x52\x65\x67\x69\x73\x74\x65\x72\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x6e\x61\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d"
 
 func registerAcmeUserHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -921,7 +1005,7 @@ func registerAcmeUserHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "register-acme-user.html", size: 3035, mode: os.FileMode(420), modTime: time.Unix(1490556242, 0)}
+	info := bindataFileInfo{name: "register-acme-user.html", size: 3999, mode: os.FileMode(420), modTime: time.Unix(1792335738, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -946,7 +1030,7 @@ func registrationHtml() (*asset, error) {
 	return a, nil
 }
 
-var _settingsApiAccessHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x37\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x53\x65\x74\x74\x69\x6e\x67\x73\x20\x2d\x20\x41\x50\x49\x20\x61\x63\x63\x65\x73\x73\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x2d\x63\x6c\x61\x73\x73\x22\x20\x5d\x5d\x69\x73\x2d\x64\x61\x72\x6b\x20\x69\x73\x2d\x62\x6f\x6c\x64\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x3c\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x76\x61\x72\x20\x63\x69\x64\x72\x76\x34\x20\x3d\x20\x2f\x5e\x28\x28\x5b\x30\x2d\x39\x5d\x7c\x5b\x31\x2d\x39\x5d\x5b\x30\x2d\x39\x5d\x7c\x31\x5b\x30\x2d\x39\x5d\x7b\x32\x7d\x7c\x32\x5b\x30\x2d\x34\x5d\x5b\x30\x2d\x39\x5d\x7c\x32\x35\x5b\x30\x2d\x35\x5d\x29\x5c\x2e\x29\x7b\x33\x7d\x28\x5b\x30\x2d\x39\x5d\x7c\x5b\x31\x2d\x39\x5d\x5b\x30\x2d\x39\x5d\x7c\x31\x5b\x30\x2d\x39\x5d\x7b\x32\x7d\x7c\x32\x5b\x30\x2d\x34\x5d\x5b\x30\x2d\x39\x5d\x7c\x32\x35\x5b\x30\x2d\x35\x5d\x29\x28\x5c\x2f\x28\x5b\x30\x2d\x39\x5d\x7c\x5b\x31\x2d\x32\x5d\x5b\x30\x2d\x39\x5d\x7c\x33\x5b\x30\x2d\x32\x5d\x29\x29\x24\x2f\x3b\x0a\x20\x20\x76\x61\x72\x20\x63\x69\x64\x72\x76\x36\x20\x3d\x20\x2f\x5e\x73\x2a\x28\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x37\x7d\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x7c\x3a\x29\x29\x7c\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x36\x7d\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x7c\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x7c\x3a\x29\x29\x7c\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x35\x7d\x28\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x31\x2c\x32\x7d\x29\x7c\x3a\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x7c\x3a\x29\x29\x7c\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x34\x7d\x28\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x31\x2c\x33\x7d\x29\x7c\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x3f\x3a\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x29\x7c\x3a\x29\x29\x7c\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x33\x7d\x28\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x31\x2c\x34\x7d\x29\x7c\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x30\x2c\x32\x7d\x3a\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x29\x7c\x3a\x29\x29\x7c\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x32\x7d\x28\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x31\x2c\x35\x7d\x29\x7c\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x30\x2c\x33\x7d\x3a\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x29\x7c\x3a\x29\x29\x7c\x28\x28\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x3a\x29\x7b\x31\x7d\x28\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x31\x2c\x36\x7d\x29\x7c\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x30\x2c\x34\x7d\x3a\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x29\x7c\x3a\x29\x29\x7c\x28\x3a\x28\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x31\x2c\x37\x7d\x29\x7c\x28\x28\x3a\x5b\x30\x2d\x39\x41\x2d\x46\x61\x2d\x66\x5d\x7b\x31\x2c\x34\x7d\x29\x7b\x30\x2c\x35\x7d\x3a\x28\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x28\x2e\x28\x32\x35\x5b\x30\x2d\x35\x5d\x7c\x32\x5b\x30\x2d\x34\x5d\x64\x7c\x31\x64\x64\x7c\x5b\x31\x2d\x39\x5d\x3f\x64\x29\x29\x7b\x33\x7d\x29\x29\x7c\x3a\x29\x29\x29\x28\x25\x2e\x2b\x29\x3f\x73\x2a\x28\x5c\x2f\x28\x5b\x30\x2d\x39\x5d\x7c\x5b\x31\x2d\x39\x5d\x5b\x30\x2d\x39\x5d\x7c\x31\x5b\x30\x2d\x31\x5d\x5b\x30\x2d\x39\x5d\x7c\x31\x32\x5b\x30\x2d\x38\x5d\x29\x29\x3f\x24\x2f\x3b\x0a\x20\x20\x76\x61\x72\x20\x61\x6c\x6c\x49\x50\x76\x34\x20\x3d\x20\x27\x30\x2e\x30\x2e\x30\x2e\x30\x2f\x30\x27\x3b\x0a\x20\x20\x76\x61\x72\x20\x61\x6c\x6c\x49\x50\x76\x36\x20\x3d\x20\x27\x3a\x3a\x2f\x30\x27\x3b\x0a\x0a\x20\x20\x6e\x65\x77\x20\x56\x75\x65\x28\x7b\x0a\x20\x20\x20\x20\x65\x6c\x3a\x20\x22\x23\x61\x70\x69\x22\x2c\x0a\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x65\x63\x72\x65\x74\x3a\x20\x22\x5b\x5b\x20\x2e\x4b\x65\x79\x2e\x53\x65\x63\x72\x65\x74\x20\x5d\x5d\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x6b\x65\x79\x46\x6f\x72\x6d\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x46\x6f\x72\x6d\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x65\x77\x3a\x20\x22\x22\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x3a\x20\x5b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x2d\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x2c\x20\x24\x6e\x65\x74\x77\x6f\x72\x6b\x20\x3a\x3d\x20\x2e\x4b\x65\x79\x2e\x41\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x4e\x65\x74\x77\x6f\x72\x6b\x73\x20\x2d\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x6e\x65\x20\x24\x69\x20\x30\x20\x5d\x5d\x2c\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x22\x5b\x5b\x20\x24\x6e\x65\x74\x77\x6f\x72\x6b\x2e\x53\x74\x72\x69\x6e\x67\x20\x5d\x5d\x22\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x2d\x20\x65\x6e\x64\x20\x2d\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x3a\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x3a\x20\x7b\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x3a\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x6d\x65\x74\x68\x6f\x64\x73\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x65\x6e\x61\x62\x6c\x65\x41\x70\x69\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2e\x6b\x65\x79\x46\x6f\x72\x6d\x2c\x20\x27\x2f\x69\x2f\x61\x70\x69\x2f\x6b\x65\x79\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x20\x21\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x73\x65\x63\x72\x65\x74\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x2e\x73\x65\x63\x72\x65\x74\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x20\x7c\x7c\x20\x5b\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x64\x69\x73\x61\x62\x6c\x65\x41\x70\x69\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x44\x65\x6c\x65\x74\x65\x28\x61\x70\x70\x2e\x6b\x65\x79\x46\x6f\x72\x6d\x2c\x20\x27\x2f\x69\x2f\x61\x70\x69\x2f\x6b\x65\x79\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x73\x65\x63\x72\x65\x74\x20\x3d\x20\x22\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x20\x3d\x20\x5b\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x53\x65\x63\x72\x65\x74\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2e\x72\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x46\x6f\x72\x6d\x2c\x20\x27\x2f\x69\x2f\x61\x70\x69\x2f\x73\x65\x63\x72\x65\x74\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x73\x65\x63\x72\x65\x74\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x2e\x73\x65\x63\x72\x65\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x73\x61\x76\x65\x4e\x65\x74\x77\x6f\x72\x6b\x73\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x50\x6f\x73\x74\x28\x61\x70\x70\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2c\x20\x27\x2f\x69\x2f\x61\x70\x69\x2f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x70\x6f\x6e\x73\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x20\x3d\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x2e\x64\x61\x74\x61\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x20\x7c\x7c\x20\x5b\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x61\x64\x64\x4e\x65\x74\x77\x6f\x72\x6b\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x76\x61\x6c\x75\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x76\x61\x6c\x75\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x6c\x75\x65\x20\x3d\x20\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x20\x26\x26\x20\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x2e\x74\x72\x69\x6d\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x76\x61\x6c\x75\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x4f\x72\x49\x6e\x76\x61\x6c\x69\x64\x28\x76\x61\x6c\x75\x65\x29\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x2e\x70\x75\x73\x68\x28\x76\x61\x6c\x75\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x20\x3d\x20\x27\x27\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x73\x61\x76\x65\x4e\x65\x74\x77\x6f\x72\x6b\x73\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x6d\x6f\x76\x65\x4e\x65\x74\x77\x6f\x72\x6b\x3a\x20\x5f\x2e\x74\x68\x72\x6f\x74\x74\x6c\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x70\x70\x20\x3d\x20\x74\x68\x69\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x2e\x73\x70\x6c\x69\x63\x65\x28\x61\x70\x70\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x2c\x20\x31\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x73\x61\x76\x65\x4e\x65\x74\x77\x6f\x72\x6b\x73\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x35\x30\x30\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x61\x64\x64\x49\x50\x76\x34\x4e\x65\x74\x77\x6f\x72\x6b\x73\x3a\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x61\x64\x64\x4e\x65\x74\x77\x6f\x72\x6b\x28\x61\x6c\x6c\x49\x50\x76\x34\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x61\x64\x64\x49\x50\x76\x36\x4e\x65\x74\x77\x6f\x72\x6b\x73\x3a\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x61\x64\x64\x4e\x65\x74\x77\x6f\x72\x6b\x28\x61\x6c\x6c\x49\x50\x76\x36\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x6d\x61\x74\x43\x49\x44\x52\x3a\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x69\x64\x72\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x63\x69\x64\x72\x20\x3d\x3d\x20\x61\x6c\x6c\x49\x50\x76\x34\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x27\x41\x6c\x6c\x20\x49\x50\x76\x34\x20\x61\x64\x64\x72\x65\x73\x73\x65\x73\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x63\x69\x64\x72\x20\x3d\x3d\x20\x61\x6c\x6c\x49\x50\x76\x36\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x27\x41\x6c\x6c\x20\x49\x50\x76\x36\x20\x61\x64\x64\x72\x65\x73\x73\x65\x73\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x63\x69\x64\x72\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x3a\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x5f\x2e\x69\x6e\x63\x6c\x75\x64\x65\x73\x28\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x2c\x20\x6e\x65\x74\x77\x6f\x72\x6b\x29\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x4f\x72\x49\x6e\x76\x61\x6c\x69\x64\x3a\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x74\x68\x69\x73\x2e\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x74\x72\x75\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x63\x69\x64\x72\x76\x34\x2e\x74\x65\x73\x74\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x63\x69\x64\x72\x76\x36\x2e\x74\x65\x73\x74\x28\x6e\x65\x74\x77\x6f\x72\x6b\x29\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x74\x72\x75\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x0a\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x53\x65\x74\x74\x69\x6e\x67\x73\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x75\x62\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x41\x50\x49\x20\x61\x63\x63\x65\x73\x73\x0a\x20\x20\x20\x20\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x38\x20\x69\x73\x2d\x6f\x66\x66\x73\x65\x74\x2d\x32\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x6f\x6e\x65\x2d\x71\x75\x61\x72\x74\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x53\x65\x74\x74\x69\x6e\x67\x73\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x42\x61\x73\x69\x63\x20\x69\x6e\x66\x6f\x72\x6d\x61\x74\x69\x6f\x6e\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x41\x50\x49\x45\x6e\x61\x62\x6c\x65\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x2f\x61\x70\x69\x22\x3e\x41\x50\x49\x20\x61\x63\x63\x65\x73\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x2f\x65\x6d\x61\x69\x6c\x22\x3e\x45\x2d\x6d\x61\x69\x6c\x20\x61\x64\x64\x72\x65\x73\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x2f\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x73\x22\x3e\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x2f\x70\x61\x73\x73\x77\x6f\x72\x64\x22\x3e\x50\x61\x73\x73\x77\x6f\x72\x64\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x2f\x64\x65\x6c\x65\x74\x65\x2d\x61\x63\x63\x6f\x75\x6e\x74\x22\x3e\x44\x65\x6c\x65\x74\x65\x20\x61\x63\x63\x6f\x75\x6e\x74\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x20\x69\x64\x3d\x22\x61\x70\x69\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x50\x65\x72\x73\x6f\x6e\x61\x6c\x20\x41\x63\x63\x65\x73\x73\x20\x54\x6f\x6b\x65\x6e\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x43\x68\x65\x63\x6b\x20\x6f\x75\x72\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x63\x73\x2f\x61\x70\x69\x22\x3e\x41\x50\x49\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x61\x74\x69\x6f\x6e\x3c\x2f\x61\x3e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x6b\x65\x79\x46\x6f\x72\x6d\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x72\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x46\x6f\x72\x6d\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x76\x2d\x69\x66\x3d\x22\x73\x65\x63\x72\x65\x74\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x72\x74\x69\x63\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x20\x69\x73\x2d\x64\x61\x72\x6b\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x73\x73\x61\x67\x65\x2d\x62\x6f\x64\x79\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x59\x6f\x75\x72\x20\x41\x50\x49\x20\x6b\x65\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x74\x72\x6f\x6e\x67\x20\x76\x2d\x74\x65\x78\x74\x3d\x22\x73\x65\x63\x72\x65\x74\x22\x3e\x3c\x2f\x73\x74\x72\x6f\x6e\x67\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x20\x70\x75\x6c\x6c\x2d\x72\x69\x67\x68\x74\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x52\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x74\x6f\x6b\x65\x6e\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x72\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x53\x65\x63\x72\x65\x74\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x72\x65\x67\x65\x6e\x65\x72\x61\x74\x65\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x63\x6f\x6e\x20\x69\x73\x2d\x73\x6d\x61\x6c\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x20\x66\x61\x2d\x72\x65\x66\x72\x65\x73\x68\x22\x3e\x3c\x2f\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x72\x74\x69\x63\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x64\x69\x73\x61\x62\x6c\x65\x41\x70\x69\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x6b\x65\x79\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x44\x69\x73\x61\x62\x6c\x65\x20\x41\x50\x49\x20\x61\x63\x63\x65\x73\x73\x20\x66\x6f\x72\x20\x79\x6f\x75\x72\x20\x61\x63\x63\x6f\x75\x6e\x74\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x76\x2d\x65\x6c\x73\x65\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x65\x6e\x61\x62\x6c\x65\x41\x70\x69\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x6c\x6f\x61\x64\x69\x6e\x67\x27\x3a\x20\x6b\x65\x79\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x45\x6e\x61\x62\x6c\x65\x20\x41\x50\x49\x20\x61\x63\x63\x65\x73\x73\x20\x66\x6f\x72\x20\x79\x6f\x75\x72\x20\x61\x63\x63\x6f\x75\x6e\x74\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x76\x2d\x69\x66\x3d\x22\x73\x65\x63\x72\x65\x74\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x41\x63\x63\x65\x73\x73\x20\x43\x6f\x6e\x74\x72\x6f\x6c\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x54\x68\x65\x20\x66\x6f\x6c\x6c\x6f\x77\x69\x6e\x67\x20\x73\x75\x62\x6e\x65\x74\x73\x20\x61\x72\x65\x20\x61\x6c\x6c\x6f\x77\x65\x64\x20\x74\x6f\x20\x75\x73\x65\x20\x74\x68\x69\x73\x20\x41\x50\x49\x20\x6b\x65\x79\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x77\x61\x72\x6e\x69\x6e\x67\x22\x20\x76\x2d\x69\x66\x3d\x22\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3d\x3d\x20\x30\x20\x26\x26\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x65\x72\x72\x6f\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3d\x3d\x20\x30\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x50\x6c\x65\x61\x73\x65\x2c\x20\x61\x64\x64\x20\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x20\x73\x75\x62\x6e\x65\x74\x73\x20\x62\x65\x6c\x6c\x6f\x77\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x65\x72\x72\x6f\x72\x73\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x20\x76\x2d\x66\x6f\x72\x3d\x22\x6e\x20\x69\x6e\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x73\x2e\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x6d\x69\x64\x64\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x63\x6f\x64\x65\x20\x76\x2d\x74\x65\x78\x74\x3d\x22\x66\x6f\x72\x6d\x61\x74\x43\x49\x44\x52\x28\x6e\x29\x22\x3e\x3c\x2f\x63\x6f\x64\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x72\x6f\x6c\x20\x68\x65\x6c\x70\x20\x69\x73\x2d\x64\x61\x6e\x67\x65\x72\x22\x20\x76\x2d\x66\x6f\x72\x3d\x22\x65\x72\x72\x20\x69\x6e\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x66\x69\x65\x6c\x64\x45\x72\x72\x6f\x72\x73\x5b\x27\x61\x75\x74\x68\x6f\x72\x69\x7a\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x5f\x27\x2b\x6e\x5d\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x20\x76\x2d\x68\x74\x6d\x6c\x3d\x22\x65\x72\x72\x22\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x72\x65\x6d\x6f\x76\x65\x4e\x65\x74\x77\x6f\x72\x6b\x28\x6e\x29\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x52\x65\x6d\x6f\x76\x65\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x69\x6e\x70\x75\x74\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x70\x75\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x49\x50\x2f\x4d\x61\x73\x6b\x22\x20\x76\x2d\x6d\x6f\x64\x65\x6c\x3d\x22\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x22\x20\x76\x2d\x6f\x6e\x3a\x6b\x65\x79\x75\x70\x2e\x65\x6e\x74\x65\x72\x3d\x22\x61\x64\x64\x4e\x65\x74\x77\x6f\x72\x6b\x28\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x29\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x61\x64\x64\x4e\x65\x74\x77\x6f\x72\x6b\x28\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x29\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x21\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x20\x7c\x7c\x20\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x4f\x72\x49\x6e\x76\x61\x6c\x69\x64\x28\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x6e\x65\x77\x29\x20\x7c\x7c\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x3e\x41\x64\x64\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6f\x6c\x73\x70\x61\x6e\x3d\x22\x32\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x61\x64\x64\x49\x50\x76\x34\x4e\x65\x74\x77\x6f\x72\x6b\x73\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x20\x76\x2d\x69\x66\x3d\x22\x21\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x28\x61\x6c\x6c\x49\x50\x76\x34\x29\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x41\x6c\x6c\x6f\x77\x20\x41\x6c\x6c\x20\x49\x50\x76\x34\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x62\x75\x74\x74\x6f\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x62\x75\x74\x74\x6f\x6e\x20\x69\x73\x2d\x70\x72\x69\x6d\x61\x72\x79\x22\x20\x76\x2d\x6f\x6e\x3a\x63\x6c\x69\x63\x6b\x3d\x22\x61\x64\x64\x49\x50\x76\x36\x4e\x65\x74\x77\x6f\x72\x6b\x73\x22\x20\x76\x2d\x62\x69\x6e\x64\x3a\x63\x6c\x61\x73\x73\x3d\x22\x7b\x27\x69\x73\x2d\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3a\x20\x6e\x65\x74\x77\x6f\x72\x6b\x73\x46\x6f\x72\x6d\x2e\x69\x73\x4c\x6f\x61\x64\x69\x6e\x67\x7d\x22\x20\x76\x2d\x69\x66\x3d\x22\x21\x6e\x65\x74\x77\x6f\x72\x6b\x45\x78\x69\x73\x74\x73\x28\x61\x6c\x6c\x49\x50\x76\x36\x29\x22\x20\x76\x2d\x63\x6c\x6f\x61\x6b\x3e\x41\x6c\x6c\x6f\x77\x20\x41\x6c\x6c\x20\x49\x50\x76\x36\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a"
+var _settingsApiAccessHtml = "\x5b\x5b\x2f\x2a\x0a\x20\x20\x43\x6f\x70\x79\x72\x69\x67\x68\x74\x20\x28\x63\x29\x20\x32\x30\x31\x37\x2c\x20\x4a\x61\x6e\x6f\xc5\xa1\x20\x47\x75\x6c\x6a\x61\xc5\xa1\x20\x3c\x6a\x61\x6e\x6f\x73\x40\x72\x65\x73\x65\x6e\x6a\x65\x2e\x6f\x72\x67\x3e\x0a\x20\x20\x41\x6c\x6c\x20\x72\x69\x67\x68\x74\x73\x20\x72\x65\x73\x65\x72\x76\x65\x64\x2e\x0a\x20\x20\x55\x73\x65\x20\x6f\x66\x20\x74\x68\x69\x73\x20\x73\x6f\x75\x72\x63\x65\x20\x63\x6f\x64\x65\x20\x69\x73\x20\x67\x6f\x76\x65\x72\x6e\x65\x64\x20\x62\x79\x20\x61\x20\x42\x53\x44\x2d\x73\x74\x79\x6c\x65\x0a\x20\x20\x6c\x69\x63\x65\x6e\x73\x65\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x49\x43\x45\x4e\x53\x45\x20\x66\x69\x6c\x65\x2e\x0a\x2a\x2f\x5d\x5d\x0a\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x74\x69\x74\x6c\x65\x22\x20\x5d\x5d\x53\x65\x74\x74\x69\x6e\x67\x73\x20\x2d\x20\x41\x50\x49\x20\x61\x63\x63\x65\x73\x73\x20\x2d\x20\x47\x6f\x70\x68\x65\x72\x50\x69\x74\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x2d\x63\x6c\x61\x73\x73\x22\x20\x5d\x5d\x69\x73\x2d\x64\x61\x72\x6b\x20\x69\x73\x2d\x62\x6f\x6c\x64\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x73\x63\x72\x69\x70\x74\x22\x20\x5d\x5d\x0a\x5b\x5b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x61\x70\x69\x2d\x6b\x65\x79\x2d\x73\x63\x72\x69\x70\x74\x22\x20\x6d\x61\x70\x20\x22\x4b\x65\x79\x22\x20\x2e\x4b\x65\x79\x20\x22\x55\x52\x4c\x22\x20\x22\x2f\x69\x2f\x61\x70\x69\x22\x20\x5d\x5d\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x68\x65\x72\x6f\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x68\x65\x72\x6f\x2d\x62\x6f\x64\x79\x20\x68\x61\x73\x2d\x74\x65\x78\x74\x2d\x63\x65\x6e\x74\x65\x72\x65\x64\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x53\x65\x74\x74\x69\x6e\x67\x73\x0a\x20\x20\x20\x20\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x75\x62\x74\x69\x74\x6c\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x41\x50\x49\x20\x61\x63\x63\x65\x73\x73\x0a\x20\x20\x20\x20\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x0a\x5b\x5b\x20\x64\x65\x66\x69\x6e\x65\x20\x22\x6d\x61\x69\x6e\x22\x20\x5d\x5d\x0a\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x38\x20\x69\x73\x2d\x6f\x66\x66\x73\x65\x74\x2d\x32\x22\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x69\x73\x2d\x6f\x6e\x65\x2d\x71\x75\x61\x72\x74\x65\x72\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x61\x73\x69\x64\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x61\x62\x65\x6c\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x53\x65\x74\x74\x69\x6e\x67\x73\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x63\x6c\x61\x73\x73\x3d\x22\x6d\x65\x6e\x75\x2d\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x22\x3e\x42\x61\x73\x69\x63\x20\x69\x6e\x66\x6f\x72\x6d\x61\x74\x69\x6f\x6e\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x69\x66\x20\x2e\x41\x50\x49\x45\x6e\x61\x62\x6c\x65\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x73\x2d\x61\x63\x74\x69\x76\x65\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x2f\x61\x70\x69\x22\x3e\x41\x50\x49\x20\x61\x63\x63\x65\x73\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x2f\x65\x6d\x61\x69\x6c\x22\x3e\x45\x2d\x6d\x61\x69\x6c\x20\x61\x64\x64\x72\x65\x73\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x2f\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x73\x22\x3e\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x73\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x2f\x70\x61\x73\x73\x77\x6f\x72\x64\x22\x3e\x50\x61\x73\x73\x77\x6f\x72\x64\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x69\x3e\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x2f\x64\x65\x6c\x65\x74\x65\x2d\x61\x63\x63\x6f\x75\x6e\x74\x22\x3e\x44\x65\x6c\x65\x74\x65\x20\x61\x63\x63\x6f\x75\x6e\x74\x3c\x2f\x61\x3e\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x61\x73\x69\x64\x65\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x6f\x6c\x75\x6d\x6e\x20\x63\x6f\x6e\x74\x65\x6e\x74\x22\x20\x69\x64\x3d\x22\x61\x70\x69\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x20\x63\x6c\x61\x73\x73\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x50\x65\x72\x73\x6f\x6e\x61\x6c\x20\x41\x63\x63\x65\x73\x73\x20\x54\x6f\x6b\x65\x6e\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x70\x3e\x43\x68\x65\x63\x6b\x20\x6f\x75\x72\x20\x3c\x61\x20\x68\x72\x65\x66\x3d\x22\x2f\x64\x6f\x63\x73\x2f\x61\x70\x69\x22\x3e\x41\x50\x49\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x61\x74\x69\x6f\x6e\x3c\x2f\x61\x3e\x2e\x3c\x2f\x70\x3e\x0a\x20\x20\x20\x20\x20\x20\x5b\x5b\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x22\x61\x70\x69\x2d\x6b\x65\x79\x22\x20\x6d\x61\x70\x20\x22\x53\x75\x62\x6a\x65\x63\x74\x22\x20\x22\x79\x6f\x75\x72\x20\x61\x63\x63\x6f\x75\x6e\x74\x22\x20\x5d\x5d\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x3c\x2f\x64\x69\x76\x3e\x0a\x5b\x5b\x20\x65\x6e\x64\x20\x5d\x5d\x0a"
 
 func settingsApiAccessHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -961,7 +1045,7 @@ func settingsApiAccessHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "settings/api-access.html", size: 9175, mode: os.FileMode(420), modTime: time.Unix(1490556242, 0)}
+	info := bindataFileInfo{name: "settings/api-access.html", size: 1616, mode: os.FileMode(420), modTime: time.Unix(1792333402, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1139,6 +1223,7 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"about.html": aboutHtml,
+	"api-key.html": apiKeyHtml,
 	"app.html": appHtml,
 	"base.html": baseHtml,
 	"changelog-record.html": changelogRecordHtml,
@@ -1153,6 +1238,7 @@ var _bindata = map[string]func() (*asset, error){
 	"domain-packages.html": domainPackagesHtml,
 	"domain-settings.html": domainSettingsHtml,
 	"domain-team.html": domainTeamHtml,
+	"domain-transfer.html": domainTransferHtml,
 	"domain-user-grant.html": domainUserGrantHtml,
 	"domain-user-revoke.html": domainUserRevokeHtml,
 	"email-unvalidated.html": emailUnvalidatedHtml,
@@ -1173,6 +1259,8 @@ var _bindata = map[string]func() (*asset, error){
 	"landing-page.html": landingPageHtml,
 	"license.html": licenseHtml,
 	"login.html": loginHtml,
+	"organization-add.html": organizationAddHtml,
+	"organization.html": organizationHtml,
 	"package-resolution.html": packageResolutionHtml,
 	"password-reset-token.html": passwordResetTokenHtml,
 	"password-reset.html": passwordResetHtml,
@@ -1230,6 +1318,7 @@ type bintree struct {
 }
 var _bintree = &bintree{nil, map[string]*bintree{
 	"about.html": &bintree{aboutHtml, map[string]*bintree{}},
+	"api-key.html": &bintree{apiKeyHtml, map[string]*bintree{}},
 	"app.html": &bintree{appHtml, map[string]*bintree{}},
 	"base.html": &bintree{baseHtml, map[string]*bintree{}},
 	"changelog-record.html": &bintree{changelogRecordHtml, map[string]*bintree{}},
@@ -1244,6 +1333,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"domain-packages.html": &bintree{domainPackagesHtml, map[string]*bintree{}},
 	"domain-settings.html": &bintree{domainSettingsHtml, map[string]*bintree{}},
 	"domain-team.html": &bintree{domainTeamHtml, map[string]*bintree{}},
+	"domain-transfer.html": &bintree{domainTransferHtml, map[string]*bintree{}},
 	"domain-user-grant.html": &bintree{domainUserGrantHtml, map[string]*bintree{}},
 	"domain-user-revoke.html": &bintree{domainUserRevokeHtml, map[string]*bintree{}},
 	"email-unvalidated.html": &bintree{emailUnvalidatedHtml, map[string]*bintree{}},
@@ -1268,6 +1358,8 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"landing-page.html": &bintree{landingPageHtml, map[string]*bintree{}},
 	"license.html": &bintree{licenseHtml, map[string]*bintree{}},
 	"login.html": &bintree{loginHtml, map[string]*bintree{}},
+	"organization-add.html": &bintree{organizationAddHtml, map[string]*bintree{}},
+	"organization.html": &bintree{organizationHtml, map[string]*bintree{}},
 	"package-resolution.html": &bintree{packageResolutionHtml, map[string]*bintree{}},
 	"password-reset-token.html": &bintree{passwordResetTokenHtml, map[string]*bintree{}},
 	"password-reset.html": &bintree{passwordResetHtml, map[string]*bintree{}},
@@ -0,0 +1,194 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+func TestDomainAliases(t *testing.T) {
+	s, err := newTestServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	username := "alice"
+	email := "alice@localhost.loc"
+	u, err := s.UserService.CreateUser(&user.Options{
+		Email:    &email,
+		Username: &username,
+	})
+	if err != nil {
+		t.Fatalf("create user: %s", err)
+	}
+	_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+	if err != nil {
+		t.Fatalf("parse IPv4 net: %s", err)
+	}
+	_, ipV6Net, err := net.ParseCIDR("::/0")
+	if err != nil {
+		t.Fatalf("parse IPv6 net: %s", err)
+	}
+	k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+		AuthorizedNetworks: &[]net.IPNet{
+			*ipV4Net,
+			*ipV6Net,
+		},
+	})
+	if err != nil {
+		t.Fatalf("create key: %s", err)
+	}
+	port := strconv.Itoa(s.servers.Addr("HTTP").Port)
+	c := api.NewClientWithEndpoint("localhost:"+port+"/api/v1", k.Secret)
+
+	fqdn := "go.trusted.com"
+	other := "other.trusted.com"
+	for _, f := range []string{fqdn, other} {
+		f := f
+		if _, err := c.AddDomain(&api.DomainOptions{FQDN: &f}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	vcs := api.VCSGit
+	repoRoot := "https://github.com/gopherpit/lib.git"
+	path := "/lib"
+	if _, err := c.AddPackage(&api.PackageOptions{
+		Domain:   &fqdn,
+		Path:     &path,
+		VCS:      &vcs,
+		RepoRoot: &repoRoot,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("set aliases", func(t *testing.T) {
+		for _, tc := range []struct {
+			aliases []string
+			err     error
+		}{
+			{aliases: []string{fqdn}, err: api.ErrDomainAliasInvalid},
+			{aliases: []string{"*.trusted.com"}, err: api.ErrDomainAliasInvalid},
+			{aliases: []string{"golang..trusted.com"}, err: api.ErrDomainAliasInvalid},
+			{aliases: []string{other}, err: api.ErrDomainAliasAlreadyExists},
+			{aliases: []string{"golang.forbidden.com"}, err: api.ErrDomainNotAvailable},
+			{aliases: []string{"golang.example.com"}, err: api.ErrDomainNeedsVerification},
+		} {
+			aliases := tc.aliases
+			if _, err := c.UpdateDomain(fqdn, &api.DomainOptions{Aliases: &aliases}); err != tc.err {
+				t.Errorf("%v: expected error %v, got %v", tc.aliases, tc.err, err)
+			}
+		}
+		aliases := []string{"Golang.Trusted.com"}
+		d, err := c.UpdateDomain(fqdn, &api.DomainOptions{Aliases: &aliases})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(d.Aliases) != 1 || d.Aliases[0] != "golang.trusted.com" {
+			t.Errorf("expected aliases %v, got %v", []string{"golang.trusted.com"}, d.Aliases)
+		}
+		alias := "golang.trusted.com"
+		if _, err := c.AddDomain(&api.DomainOptions{FQDN: &alias}); err != api.ErrDomainAlreadyExists {
+			t.Errorf("expected error %v, got %v", api.ErrDomainAlreadyExists, err)
+		}
+		if _, err := c.UpdateDomain(other, &api.DomainOptions{Aliases: &[]string{alias}}); err != api.ErrDomainAliasAlreadyExists {
+			t.Errorf("expected error %v, got %v", api.ErrDomainAliasAlreadyExists, err)
+		}
+	})
+
+	t.Run("resolve package", func(t *testing.T) {
+		resolution, err := s.PackagesService.ResolvePackage("golang.trusted.com/lib/sub")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resolution.ImportPrefix != "golang.trusted.com/lib" {
+			t.Errorf("expected import prefix %q, got %q", "golang.trusted.com/lib", resolution.ImportPrefix)
+		}
+		if resolution.CanonicalImportPrefix != "go.trusted.com/lib" {
+			t.Errorf("expected canonical import prefix %q, got %q", "go.trusted.com/lib", resolution.CanonicalImportPrefix)
+		}
+		if resolution.RepoRoot != repoRoot {
+			t.Errorf("expected repo root %q, got %q", repoRoot, resolution.RepoRoot)
+		}
+	})
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	goGet := func(t *testing.T) *http.Response {
+		req, err := http.NewRequest("GET", "http://localhost:"+port+"/lib?go-get=1", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = "golang.trusted.com"
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	t.Run("serve alias", func(t *testing.T) {
+		resp := goGet(t)
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "golang.trusted.com/lib git https://github.com/gopherpit/lib.git"
+		if !strings.Contains(string(body), want) {
+			t.Errorf("expected go-import %q in response %q", want, string(body))
+		}
+	})
+
+	t.Run("redirect alias", func(t *testing.T) {
+		aliasRedirect := true
+		d, err := c.UpdateDomain(fqdn, &api.DomainOptions{AliasRedirect: &aliasRedirect})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !d.AliasRedirect {
+			t.Error("expected alias redirect")
+		}
+		resp := goGet(t)
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusMovedPermanently {
+			t.Fatalf("expected status %d, got %d", http.StatusMovedPermanently, resp.StatusCode)
+		}
+		want := "http://go.trusted.com/lib?go-get=1"
+		if got := resp.Header.Get("Location"); got != want {
+			t.Errorf("expected location %q, got %q", want, got)
+		}
+	})
+
+	t.Run("remove aliases", func(t *testing.T) {
+		d, err := c.UpdateDomain(fqdn, &api.DomainOptions{Aliases: &[]string{}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(d.Aliases) != 0 {
+			t.Errorf("expected no aliases, got %v", d.Aliases)
+		}
+		alias := "golang.trusted.com"
+		if _, err := c.AddDomain(&api.DomainOptions{FQDN: &alias}); err != nil {
+			t.Error(err)
+		}
+	})
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"golang.org/x/net/publicsuffix"
+
+	"gopherpit.com/gopherpit/services/notification"
+	"gopherpit.com/gopherpit/services/packages"
+)
+
+// PeriodicReverifyDomains checks on ReverifyPeriod if verification
+// tokens are still published for all domains that require verification.
+// Owners of domains without published tokens are warned by email and
+// domains are disabled if tokens are not published again in
+// ReverifyGracePeriod.
+func (s *Server) PeriodicReverifyDomains() error {
+	if s.ReverifyPeriod <= 0 || s.SkipDomainVerification {
+		return nil
+	}
+	s.Logger.Info("domain reverification: initialized")
+	go func() {
+		defer s.RecoveryService.Recover()
+
+		ticker := time.NewTicker(s.ReverifyPeriod)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := s.reverifyDomains(); err != nil {
+				s.Logger.Errorf("domain reverification: %s", err)
+			}
+		}
+	}()
+	return nil
+}
+
+// reverifyDomains checks verification tokens for all domains.
+func (s *Server) reverifyDomains() error {
+	s.Logger.Debug("domain reverification: started")
+	defer s.Logger.Debug("domain reverification: ended")
+
+	var start string
+	for {
+		page, err := s.PackagesService.Domains(start, 100)
+		if err != nil {
+			return fmt.Errorf("get domains: %s", err)
+		}
+		for _, domain := range page.Domains {
+			if err := s.reverifyDomain(domain, time.Now()); err != nil {
+				s.Logger.Errorf("domain reverification: %s: %s", domain.FQDN, err)
+			}
+		}
+		if page.Next == "" {
+			return nil
+		}
+		start = page.Next
+	}
+}
+
+// reverifyDomain checks if the verification token is published for the
+// domain by its owners or maintainers. The time of the first failed
+// verification is saved to the domain and the owner is warned. If the
+// token is not published in ReverifyGracePeriod, the domain is disabled.
+//
+// Verification is inconclusive and it does not start the grace period
+// only if name servers did not respond for some of the domain levels, as
+// a domain with a broken DNS is not resolved to this service anyway.
+// Non-existent names and missing records are verification failures.
+func (s *Server) reverifyDomain(domain packages.Domain, now time.Time) error {
+	if domain.Disabled || !s.requiresDomainVerification(domain.FQDN) {
+		return nil
+	}
+
+	// Wildcard domains are verified by their parent domain, only with
+	// DNS TXT records.
+	verificationFQDN := strings.TrimPrefix(domain.FQDN, "*.")
+	domains, err := domainVerificationLevels(verificationFQDN)
+	if err != nil {
+		return err
+	}
+	users, err := s.PackagesService.DomainUsers(domain.ID)
+	if err != nil {
+		return fmt.Errorf("get domain users: %s", err)
+	}
+	ownerIDs, err := s.domainOwnerUserIDs(domain)
+	if err != nil {
+		return fmt.Errorf("get domain owners: %s", err)
+	}
+	if len(ownerIDs) == 0 {
+		return fmt.Errorf("domain without owners")
+	}
+	// Domain changes are made on behalf of the first owner.
+	byUserID := ownerIDs[0]
+
+	// Tokens of viewers do not verify the domain, as they can not change
+	// it.
+	userIDs := ownerIDs
+	for _, id := range users.UserIDs {
+		switch users.Role(id) {
+		case packages.DomainRoleOwner, packages.DomainRoleMaintainer:
+			userIDs = append(userIDs, id)
+		}
+	}
+
+	var verifyErr error
+	for _, d := range domains {
+		tokens := make([]string, 0, len(userIDs))
+		for _, userID := range userIDs {
+			tokens = append(tokens, s.domainVerificationToken(userID, d))
+		}
+		method, err := s.verifyDomainTokens(d, tokens, d == domain.FQDN)
+		if method != "" {
+			if domain.VerificationFailed != nil {
+				if _, err := s.PackagesService.UpdateDomain(domain.ID, &packages.DomainOptions{
+					VerificationFailed: &time.Time{},
+				}, byUserID); err != nil {
+					return fmt.Errorf("update domain: %s", err)
+				}
+				s.Logger.Infof("domain reverification: %s: verified again by %s", domain.FQDN, method)
+			}
+			return nil
+		}
+		if err != nil {
+			verifyErr = err
+		}
+	}
+
+	if domain.VerificationFailed == nil {
+		if verifyErr != nil {
+			s.Logger.Warningf("domain reverification: %s: inconclusive: %s", domain.FQDN, verifyErr)
+			return nil
+		}
+		if _, err := s.PackagesService.UpdateDomain(domain.ID, &packages.DomainOptions{
+			VerificationFailed: &now,
+		}, byUserID); err != nil {
+			return fmt.Errorf("update domain: %s", err)
+		}
+		s.Logger.Infof("domain reverification: %s: not verified", domain.FQDN)
+		return s.sendDomainVerificationEmail(domain, emailTemplateDomainVerificationWarningText, "Domain ownership verification failed", now.Add(s.ReverifyGracePeriod))
+	}
+
+	if now.Before(domain.VerificationFailed.Add(s.ReverifyGracePeriod)) {
+		return nil
+	}
+	if _, err := s.PackagesService.DisableUnverifiedDomain(domain.ID); err != nil {
+		return fmt.Errorf("disable domain: %s", err)
+	}
+	s.Logger.Infof("domain reverification: %s: disabled", domain.FQDN)
+	s.AuditLogger.Infof("domain reverification: %s: domain %s disabled", domain.ID, domain.FQDN)
+	return s.sendDomainVerificationEmail(domain, emailTemplateDomainVerificationDisabledText, "Domain disabled", now)
+}
+
+// requiresDomainVerification returns false for domains that are not
+// verified when they are added.
+func (s *Server) requiresDomainVerification(fqdn string) bool {
+	if s.SkipDomainVerification || s.Domain == "" {
+		return false
+	}
+	if fqdn == s.Domain || strings.HasSuffix(fqdn, "."+s.Domain) {
+		return false
+	}
+	for _, d := range s.TrustedDomains {
+		if fqdn == d || strings.HasSuffix(fqdn, "."+d) {
+			return false
+		}
+	}
+	return true
+}
+
+// domainVerificationToken returns the verification token for domain d
+// and the user.
+func (s *Server) domainVerificationToken(userID, d string) string {
+	x := sha1.Sum(append(s.salt, []byte(userID+d)...))
+	return base64.URLEncoding.EncodeToString(x[:])
+}
+
+// domainVerificationLevels returns the domain and all its parent domains
+// under the public suffix, which verification also verifies the domain.
+func domainVerificationLevels(fqdn string) (domains []string, err error) {
+	publicSuffix, icann := publicsuffix.PublicSuffix(fqdn)
+	if !icann || fqdn == publicSuffix {
+		return nil, fmt.Errorf("domain not icann or public suffix: %s", fqdn)
+	}
+	domainParts := strings.Split(fqdn, ".")
+	startIndex := len(domainParts) - strings.Count(publicSuffix, ".") - 2
+	if startIndex < 0 {
+		return nil, fmt.Errorf("domain is invalid: %s", fqdn)
+	}
+	d := publicSuffix
+	for i := startIndex; i >= 0; i-- {
+		d = domainParts[i] + "." + d
+		domains = append(domains, d)
+	}
+	return
+}
+
+// domainOwnerUserIDs returns IDs of users that have the owner role on
+// the domain. Those are the owner user or owners of the organization that
+// owns the domain.
+func (s *Server) domainOwnerUserIDs(domain packages.Domain) ([]string, error) {
+	if domain.OrganizationID == "" {
+		return []string{domain.OwnerUserID}, nil
+	}
+	members, err := s.PackagesService.OrganizationMembers(domain.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("get organization members: %s: %s", domain.OrganizationID, err)
+	}
+	ids := []string{}
+	for _, id := range members.UserIDs {
+		if members.Role(id) == packages.OrganizationRoleOwner {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// sendDomainVerificationEmail sends the email with the domain verification
+// tokens to the domain owners.
+func (s *Server) sendDomainVerificationEmail(domain packages.Domain, tmpl *template.Template, subject string, deadline time.Time) error {
+	ownerIDs, err := s.domainOwnerUserIDs(domain)
+	if err != nil {
+		return err
+	}
+	for _, id := range ownerIDs {
+		if err := s.sendDomainVerificationEmailToOwner(domain, id, tmpl, subject, deadline); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) sendDomainVerificationEmailToOwner(domain packages.Domain, ownerUserID string, tmpl *template.Template, subject string, deadline time.Time) error {
+	owner, err := s.UserService.UserByID(ownerUserID)
+	if err != nil {
+		return fmt.Errorf("get owner: %s: %s", ownerUserID, err)
+	}
+	if owner.Email == "" {
+		return nil
+	}
+
+	emailSettingsToken, err := s.tokenFromEmail(owner.Email)
+	if err != nil {
+		return fmt.Errorf("email settings token from email: %s", err)
+	}
+
+	// Wildcard domains are verified by their parent domain, only with
+	// DNS TXT records.
+	verificationFQDN := strings.TrimPrefix(domain.FQDN, "*.")
+
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, map[string]interface{}{
+		"Brand":              s.Brand,
+		"Host":               "https://" + s.Domain,
+		"Domain":             domain,
+		"Tokens":             s.domainVerificationTokens(verificationFQDN, s.domainVerificationToken(owner.ID, verificationFQDN), !domain.IsWildcard()),
+		"Deadline":           deadline.UTC().Format(time.RFC1123),
+		"EmailSettingsToken": string(emailSettingsToken),
+	}); err != nil {
+		return fmt.Errorf("email template execute: %s", err)
+	}
+
+	id, err := s.NotificationService.SendEmail(notification.Email{
+		To:      []string{owner.Email},
+		From:    s.DefaultFrom,
+		Subject: s.Brand + " - " + subject + ": " + domain.FQDN,
+		Body:    body.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("notifier api send email: %s", err)
+	}
+	s.Logger.Infof("domain reverification email for %s sent to %s notifier id %s", domain.FQDN, owner.Email, id)
+	return nil
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+// requestDomainTransfer requests the transfer of the domain from the
+// owner to the user and sends the e-mail message with the link to the
+// page where the transfer can be accepted.
+func (s *Server) requestDomainTransfer(r *http.Request, domainRef string, to, from *user.User) (*packages.DomainTransfer, error) {
+	transfer, err := s.PackagesService.RequestDomainTransfer(domainRef, to.ID, time.Now().Add(s.DomainTransferPeriod), from.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sendDomainTransferEmail(r, *transfer, to, from); err != nil {
+		s.Logger.Errorf("request domain transfer: %s: send email to %s: %s", transfer.FQDN, to.ID, err)
+	}
+	return transfer, nil
+}
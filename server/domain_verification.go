@@ -6,85 +6,155 @@
 package server
 
 import (
-	"net"
-	"runtime"
-	"strings"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
 
-	"github.com/miekg/dns"
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/pkg/resolver"
 )
 
-func verifyDomain(domain, token string) (found bool, err error) {
-	txt, _ := net.LookupTXT(domain)
-	for _, r := range txt {
-		if r == token {
-			found = true
-			return
-		}
+// domainVerificationPath is the URL path under which verification
+// tokens are served for HTTP file domain verification.
+const domainVerificationPath = "/.well-known/gopherpit-verification/"
+
+// domainVerificationHTTPClient is used to fetch verification files.
+// Redirects are not followed as the file must be served by the
+// verified domain itself.
+var domainVerificationHTTPClient = &http.Client{
+	Timeout: 10 * time.Second,
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// domainVerificationTokens returns tokens for domain d, one for every
+// supported verification method. HTTP file token is returned only if
+// httpFile is true, as it verifies only the exact domain that serves the
+// file, and not its subdomains.
+func (s *Server) domainVerificationTokens(d, token string, httpFile bool) []api.DomainToken {
+	tokens := []api.DomainToken{
+		{
+			Method: api.DomainVerificationDNSTXT,
+			FQDN:   s.VerificationSubdomain + "." + d,
+			Token:  token,
+		},
 	}
+	if httpFile {
+		tokens = append(tokens, api.DomainToken{
+			Method: api.DomainVerificationHTTPFile,
+			FQDN:   d,
+			Token:  token,
+			URL:    domainVerificationURL(d, token),
+		})
+	}
+	return tokens
+}
 
-	if runtime.GOOS == "windows" {
-		return
+// verifyDomain checks if the token is published for domain d by any of
+// the supported verification methods. HTTP file is checked only if
+// httpFile is true, for the exact domain that is verified, as a file
+// served by a parent domain does not prove the ownership of its
+// subdomains. Returned error is related only to the DNS TXT
+// verification, as HTTP file verification fails regularly for domains
+// without HTTPS servers.
+func (s *Server) verifyDomain(d, token string, httpFile bool) (method api.DomainVerificationMethod, err error) {
+	return s.verifyDomainTokens(d, []string{token}, httpFile)
+}
+
+// verifyDomainTokens checks if any of the tokens is published for domain
+// d in the same way as verifyDomain.
+func (s *Server) verifyDomainTokens(d string, tokens []string, httpFile bool) (method api.DomainVerificationMethod, err error) {
+	found, err := s.verifyDomainTXT(s.VerificationSubdomain+"."+d, tokens)
+	if found {
+		return api.DomainVerificationDNSTXT, nil
+	}
+	if !httpFile {
+		return "", err
+	}
+	for _, token := range tokens {
+		found, httpErr := verifyDomainHTTP(domainVerificationHTTPClient, d, token)
+		if httpErr != nil {
+			s.Logger.Debugf("verify domain: %s: http file: %s", d, httpErr)
+		}
+		if found {
+			return api.DomainVerificationHTTPFile, nil
+		}
 	}
+	return "", err
+}
+
+// domainVerificationURL returns the URL of the HTTP file verification
+// token for domain d.
+func domainVerificationURL(d, token string) string {
+	return "https://" + d + domainVerificationPath + token
+}
 
-	resolv, err := dns.ClientConfigFromFile("/etc/resolv.conf")
+// verifyDomainHTTP fetches the verification file for domain d and checks
+// if its content is the token. Leading and trailing white space in the
+// file is ignored.
+func verifyDomainHTTP(client *http.Client, d, token string) (found bool, err error) {
+	resp, err := client.Get(domainVerificationURL(d, token))
 	if err != nil {
 		return
 	}
+	defer func() {
+		io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+	}()
 
-	if len(resolv.Servers) == 0 {
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("http status %d", resp.StatusCode)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
 		return
 	}
-	var index int
-	d := domain
-	c := &dns.Client{}
-	m := &dns.Msg{}
-	var in *dns.Msg
-	for {
-		index = strings.Index(d, ".")
-		if index < 0 || index+1 >= len(d) {
-			break
-		}
-		d = d[index+1:]
+	if !bytes.Equal(bytes.TrimSpace(data), []byte(token)) {
+		return false, errors.New("invalid token")
+	}
+	return true, nil
+}
 
-		m.SetQuestion(dns.Fqdn(d), dns.TypeNS)
+// verifyDomainTXT checks if any of the tokens is a value of a TXT record
+// with the name. If the record is not found by the verification resolver
+// and name server lookup is enabled, authoritative name servers are
+// queried directly, as recursive resolvers may cache the absence of the
+// record. Non-existent name or a name without the token is not an error,
+// and the error is returned only if none of the resolvers responded.
+func (s *Server) verifyDomainTXT(name string, tokens []string) (found bool, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
 
-		for _, ns := range resolv.Servers {
-			in, _, err = c.Exchange(m, ns+":53")
-			if err == nil {
-				break
-			}
-		}
-		if err != nil {
-			return
+	resolvers := []resolver.Resolver{s.VerificationResolver}
+	if s.VerificationNSLookup {
+		resolvers = append(resolvers, resolver.Authoritative{
+			Resolver: s.VerificationResolver,
+		})
+	}
+	var responded bool
+	for _, r := range resolvers {
+		txts, lookupErr := resolver.LookupTXT(ctx, r, name)
+		if lookupErr != nil {
+			err = lookupErr
+			continue
 		}
-
-		for _, a := range in.Answer {
-			ns, ok := a.(*dns.NS)
-			if !ok {
-				continue
-			}
-			m.SetQuestion(dns.Fqdn(domain), dns.TypeTXT)
-			in, _, err = c.Exchange(m, ns.Ns+":53")
-			if err != nil {
-				return
-			}
-			for _, a := range in.Answer {
-				txt, ok := a.(*dns.TXT)
-				if !ok {
-					continue
-				}
-				for _, r := range txt.Txt {
-					if r == token {
-						return true, nil
-					}
+		responded = true
+		for _, txt := range txts {
+			for _, token := range tokens {
+				if txt == token {
+					return true, nil
 				}
-
 			}
 		}
-
-		if len(in.Answer) > 0 {
-			break
-		}
+	}
+	if responded {
+		return false, nil
 	}
 	return
 }
@@ -0,0 +1,608 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/pkg/resolver"
+	"gopherpit.com/gopherpit/pkg/resolver/resolvertest"
+	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/notification"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+func TestDomainVerificationTXT(t *testing.T) {
+	dnsServer, err := resolvertest.NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dnsServer.Close()
+
+	s, err := newTestServer(map[string]interface{}{
+		"VerificationResolver": resolver.DNS{
+			Servers: []string{dnsServer.Addr()},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	username := "alice"
+	email := username + "@localhost.loc"
+	u, err := s.UserService.CreateUser(&user.Options{
+		Email:    &email,
+		Username: &username,
+	})
+	if err != nil {
+		t.Fatalf("create user: %s", err)
+	}
+	_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+	if err != nil {
+		t.Fatalf("parse IPv4 net: %s", err)
+	}
+	k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+		AuthorizedNetworks: &[]net.IPNet{*ipV4Net},
+	})
+	if err != nil {
+		t.Fatalf("create key: %s", err)
+	}
+	c := api.NewClientWithEndpoint(
+		"localhost:"+strconv.Itoa(s.servers.Addr("HTTP").Port)+"/api/v1",
+		k.Secret,
+	)
+	c.UserAgent = username + "-gopherpit-test-client"
+
+	tokens, err := c.DomainTokens("project.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens.Tokens) == 0 || tokens.Tokens[0].Method != api.DomainVerificationDNSTXT {
+		t.Fatalf("expected first token with method %q, got %v", api.DomainVerificationDNSTXT, tokens.Tokens)
+	}
+	token := tokens.Tokens[0]
+
+	fqdn := "project.example.com"
+	t.Run("tokens", func(t *testing.T) {
+		var dnsTXT int
+		for _, tkn := range tokens.Tokens {
+			switch tkn.Method {
+			case api.DomainVerificationDNSTXT:
+				dnsTXT++
+			case api.DomainVerificationHTTPFile:
+				if tkn.FQDN != fqdn {
+					t.Errorf("expected http file token only for %q, got %q", fqdn, tkn.FQDN)
+				}
+			}
+		}
+		if dnsTXT != 2 {
+			t.Errorf("expected 2 dns txt tokens, got %d", dnsTXT)
+		}
+		if len(tokens.Tokens) != 3 {
+			t.Errorf("expected 3 tokens, got %d", len(tokens.Tokens))
+		}
+	})
+	t.Run("not verified", func(t *testing.T) {
+		dnsServer.SetTXT(token.FQDN, "invalid token")
+
+		_, err := c.AddDomain(&api.DomainOptions{
+			FQDN: &fqdn,
+		})
+		if err != api.ErrDomainNeedsVerification {
+			t.Errorf("expected %q, got %q", api.ErrDomainNeedsVerification, err)
+		}
+	})
+	t.Run("verified", func(t *testing.T) {
+		dnsServer.SetTXT(token.FQDN, "invalid token", token.Token)
+
+		d, err := c.AddDomain(&api.DomainOptions{
+			FQDN: &fqdn,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.FQDN != fqdn {
+			t.Errorf("expected %q, got %q", fqdn, d.FQDN)
+		}
+	})
+}
+
+func TestVerifyDomainHTTP(t *testing.T) {
+	token := "77e3EZ7UCQDcffzekSKHquXVyqU="
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case domainVerificationPath + token:
+			fmt.Fprintln(w, token)
+		case domainVerificationPath + "redirect":
+			http.Redirect(w, r, domainVerificationPath+token, http.StatusFound)
+		case domainVerificationPath + "invalid":
+			fmt.Fprintln(w, "invalid token")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	// Certificate of the test server is valid for example.com and all
+	// connections are directed to the test server.
+	client := ts.Client()
+	client.CheckRedirect = domainVerificationHTTPClient.CheckRedirect
+	client.Transport.(*http.Transport).DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, ts.Listener.Addr().String())
+	}
+
+	for _, tc := range []struct {
+		name  string
+		token string
+		found bool
+	}{
+		{"valid", token, true},
+		{"redirect", "redirect", false},
+		{"invalid", "invalid", false},
+		{"not found", "missing", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			found, err := verifyDomainHTTP(client, "example.com", tc.token)
+			if found != tc.found {
+				t.Errorf("expected %v, got %v (%v)", tc.found, found, err)
+			}
+			if !found && err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+
+	t.Run("parent domain", func(t *testing.T) {
+		dnsServer, err := resolvertest.NewServer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer dnsServer.Close()
+
+		s, err := newTestServer(map[string]interface{}{
+			"VerificationResolver": resolver.DNS{
+				Servers: []string{dnsServer.Addr()},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer s.stopTestServer()
+
+		defer func(c *http.Client) { domainVerificationHTTPClient = c }(domainVerificationHTTPClient)
+		domainVerificationHTTPClient = client
+
+		method, _ := s.verifyDomain("example.com", token, true)
+		if method != api.DomainVerificationHTTPFile {
+			t.Errorf("expected method %q, got %q", api.DomainVerificationHTTPFile, method)
+		}
+		method, _ = s.verifyDomain("example.com", token, false)
+		if method != "" {
+			t.Errorf("expected no method for parent domain, got %q", method)
+		}
+	})
+}
+
+func TestWildcardDomainVerificationHTTP(t *testing.T) {
+	dnsServer, err := resolvertest.NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dnsServer.Close()
+
+	notificationService := &testNotificationService{}
+	s, err := newTestServer(map[string]interface{}{
+		"VerificationResolver": resolver.DNS{
+			Servers: []string{dnsServer.Addr()},
+		},
+		"ReverifyGracePeriod": time.Hour,
+		"NotificationService": notificationService,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	username := "alice"
+	email := username + "@localhost.loc"
+	u, err := s.UserService.CreateUser(&user.Options{
+		Email:    &email,
+		Username: &username,
+	})
+	if err != nil {
+		t.Fatalf("create user: %s", err)
+	}
+	_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+	if err != nil {
+		t.Fatalf("parse IPv4 net: %s", err)
+	}
+	k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+		AuthorizedNetworks: &[]net.IPNet{*ipV4Net},
+	})
+	if err != nil {
+		t.Fatalf("create key: %s", err)
+	}
+	c := api.NewClientWithEndpoint(
+		"localhost:"+strconv.Itoa(s.servers.Addr("HTTP").Port)+"/api/v1",
+		k.Secret,
+	)
+	c.UserAgent = username + "-gopherpit-test-client"
+
+	// Verification file for example.com is served for the user.
+	token := s.domainVerificationToken(u.ID, "example.com")
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != domainVerificationPath+token {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintln(w, token)
+	}))
+	defer ts.Close()
+
+	client := ts.Client()
+	client.CheckRedirect = domainVerificationHTTPClient.CheckRedirect
+	client.Transport.(*http.Transport).DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, ts.Listener.Addr().String())
+	}
+	defer func(c *http.Client) { domainVerificationHTTPClient = c }(domainVerificationHTTPClient)
+	domainVerificationHTTPClient = client
+
+	wildcardFQDN := "*.example.com"
+	t.Run("tokens", func(t *testing.T) {
+		tokens, err := c.DomainTokens(wildcardFQDN)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tkn := range tokens.Tokens {
+			if tkn.Method != api.DomainVerificationDNSTXT {
+				t.Errorf("expected only %q tokens, got %q for %q", api.DomainVerificationDNSTXT, tkn.Method, tkn.FQDN)
+			}
+		}
+	})
+	t.Run("add domain", func(t *testing.T) {
+		_, err := c.AddDomain(&api.DomainOptions{
+			FQDN: &wildcardFQDN,
+		})
+		if err != api.ErrDomainNeedsVerification {
+			t.Errorf("expected %q, got %q", api.ErrDomainNeedsVerification, err)
+		}
+	})
+	t.Run("reverify domain", func(t *testing.T) {
+		domain, err := s.PackagesService.AddDomain(&packages.DomainOptions{
+			FQDN:        &wildcardFQDN,
+			OwnerUserID: &u.ID,
+		}, u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.reverifyDomain(*domain, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+		d, err := s.PackagesService.Domain(domain.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.VerificationFailed == nil {
+			t.Error("expected verification failed time")
+		}
+		emails := notificationService.Emails()
+		if len(emails) != 1 {
+			t.Fatalf("expected 1 email, got %d", len(emails))
+		}
+		if strings.Contains(emails[0].Body, domainVerificationPath) {
+			t.Errorf("expected no http file token in email body %q", emails[0].Body)
+		}
+	})
+	t.Run("exact domain", func(t *testing.T) {
+		fqdn := "example.com"
+		if _, err := c.AddDomain(&api.DomainOptions{
+			FQDN: &fqdn,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+type testNotificationService struct {
+	mu     sync.Mutex
+	emails []notification.Email
+}
+
+func (s *testNotificationService) SendEmail(email notification.Email) (id string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.emails = append(s.emails, email)
+	return strconv.Itoa(len(s.emails)), nil
+}
+
+func (s *testNotificationService) IsEmailOptedOut(string) (bool, error) { return false, nil }
+func (s *testNotificationService) OptOutEmail(string) error             { return nil }
+func (s *testNotificationService) RemoveOptedOutEmail(string) error     { return nil }
+
+func (s *testNotificationService) Emails() []notification.Email {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]notification.Email{}, s.emails...)
+}
+
+func TestDomainReverification(t *testing.T) {
+	dnsServer, err := resolvertest.NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dnsServer.Close()
+
+	notificationService := &testNotificationService{}
+	s, err := newTestServer(map[string]interface{}{
+		"VerificationResolver": resolver.DNS{
+			Servers: []string{dnsServer.Addr()},
+		},
+		"ReverifyGracePeriod": time.Hour,
+		"NotificationService": notificationService,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	username := "alice"
+	email := username + "@localhost.loc"
+	u, err := s.UserService.CreateUser(&user.Options{
+		Email:    &email,
+		Username: &username,
+	})
+	if err != nil {
+		t.Fatalf("create user: %s", err)
+	}
+
+	fqdn := "project.example.com"
+	domain, err := s.PackagesService.AddDomain(&packages.DomainOptions{
+		FQDN:        &fqdn,
+		OwnerUserID: &u.ID,
+	}, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trustedFQDN := "project.trusted.com"
+	if _, err := s.PackagesService.AddDomain(&packages.DomainOptions{
+		FQDN:        &trustedFQDN,
+		OwnerUserID: &u.ID,
+	}, u.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Token for the parent domain verifies the domain.
+	tokenName := s.VerificationSubdomain + ".example.com"
+	token := s.domainVerificationToken(u.ID, "example.com")
+
+	getDomain := func(t *testing.T, ref string) *packages.Domain {
+		d, err := s.PackagesService.Domain(ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	t.Run("verified", func(t *testing.T) {
+		dnsServer.SetTXT(tokenName, token)
+
+		if err := s.reverifyDomains(); err != nil {
+			t.Fatal(err)
+		}
+		if d := getDomain(t, fqdn); d.VerificationFailed != nil || d.Disabled {
+			t.Errorf("expected verified domain, got %#v", d)
+		}
+		if emails := notificationService.Emails(); len(emails) != 0 {
+			t.Errorf("expected no emails, got %d", len(emails))
+		}
+	})
+	t.Run("not verified", func(t *testing.T) {
+		dnsServer.SetTXT(tokenName)
+
+		if err := s.reverifyDomains(); err != nil {
+			t.Fatal(err)
+		}
+		d := getDomain(t, fqdn)
+		if d.VerificationFailed == nil {
+			t.Fatal("expected verification failed time")
+		}
+		if d.Disabled {
+			t.Error("expected domain not to be disabled in grace period")
+		}
+		emails := notificationService.Emails()
+		if len(emails) != 1 {
+			t.Fatalf("expected 1 email, got %d", len(emails))
+		}
+		if emails[0].To[0] != email {
+			t.Errorf("expected email to %q, got %q", email, emails[0].To)
+		}
+		if !strings.Contains(emails[0].Body, s.domainVerificationToken(u.ID, fqdn)) {
+			t.Errorf("expected token in email body %q", emails[0].Body)
+		}
+
+		// Second check in grace period does not warn again.
+		if err := s.reverifyDomains(); err != nil {
+			t.Fatal(err)
+		}
+		if emails := notificationService.Emails(); len(emails) != 1 {
+			t.Errorf("expected 1 email, got %d", len(emails))
+		}
+	})
+	t.Run("verified again", func(t *testing.T) {
+		dnsServer.SetTXT(tokenName, token)
+
+		if err := s.reverifyDomains(); err != nil {
+			t.Fatal(err)
+		}
+		if d := getDomain(t, fqdn); d.VerificationFailed != nil || d.Disabled {
+			t.Errorf("expected verified domain, got %#v", d)
+		}
+	})
+	t.Run("disabled", func(t *testing.T) {
+		dnsServer.SetTXT(tokenName)
+
+		failed := time.Now().Add(-2 * time.Hour)
+		if _, err := s.PackagesService.UpdateDomain(domain.ID, &packages.DomainOptions{
+			VerificationFailed: &failed,
+		}, u.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := s.reverifyDomains(); err != nil {
+			t.Fatal(err)
+		}
+		if d := getDomain(t, fqdn); !d.Disabled {
+			t.Error("expected disabled domain")
+		}
+		if emails := notificationService.Emails(); len(emails) != 2 {
+			t.Errorf("expected 2 emails, got %d", len(emails))
+		}
+
+		changelog, err := s.PackagesService.ChangelogForDomain(domain.ID, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		record := changelog.Records[0]
+		if record.Action != packages.ActionDisableUnverifiedDomain {
+			t.Errorf("expected action %q, got %q", packages.ActionDisableUnverifiedDomain, record.Action)
+		}
+		if record.UserID != "" {
+			t.Errorf("expected no user, got %q", record.UserID)
+		}
+	})
+	t.Run("trusted", func(t *testing.T) {
+		if d := getDomain(t, trustedFQDN); d.VerificationFailed != nil || d.Disabled {
+			t.Errorf("expected trusted domain not to be verified, got %#v", d)
+		}
+	})
+}
+
+func TestDomainReverificationTokens(t *testing.T) {
+	dnsServer, err := resolvertest.NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dnsServer.Close()
+
+	s, err := newTestServer(map[string]interface{}{
+		"VerificationResolver": resolver.DNS{
+			Servers: []string{dnsServer.Addr()},
+		},
+		"ReverifyGracePeriod": time.Hour,
+		"NotificationService": &testNotificationService{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	users := map[string]*user.User{}
+	for _, username := range []string{"alice", "bob", "chuck"} {
+		email := username + "@localhost.loc"
+		u, err := s.UserService.CreateUser(&user.Options{
+			Email:    &email,
+			Username: &username,
+		})
+		if err != nil {
+			t.Fatalf("create user: %s", err)
+		}
+		users[username] = u
+	}
+
+	fqdn := "project.example.com"
+	domain, err := s.PackagesService.AddDomain(&packages.DomainOptions{
+		FQDN:        &fqdn,
+		OwnerUserID: &users["alice"].ID,
+	}, users["alice"].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.PackagesService.AddUserToDomain(domain.ID, users["bob"].ID, packages.DomainRoleMaintainer, users["alice"].ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.PackagesService.AddUserToDomain(domain.ID, users["chuck"].ID, packages.DomainRoleViewer, users["alice"].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	tokenName := s.VerificationSubdomain + ".example.com"
+	reverify := func(t *testing.T) *packages.Domain {
+		d, err := s.PackagesService.Domain(domain.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.reverifyDomain(*d, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+		d, err = s.PackagesService.Domain(domain.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	resetVerificationFailed := func(t *testing.T) {
+		if _, err := s.PackagesService.UpdateDomain(domain.ID, &packages.DomainOptions{
+			VerificationFailed: &time.Time{},
+		}, users["alice"].ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("viewer", func(t *testing.T) {
+		dnsServer.SetTXT(tokenName, s.domainVerificationToken(users["chuck"].ID, "example.com"))
+
+		if d := reverify(t); d.VerificationFailed == nil {
+			t.Error("expected verification failed time")
+		}
+	})
+	t.Run("maintainer", func(t *testing.T) {
+		dnsServer.SetTXT(tokenName, s.domainVerificationToken(users["bob"].ID, "example.com"))
+
+		if d := reverify(t); d.VerificationFailed != nil {
+			t.Errorf("expected verified domain, got %#v", d)
+		}
+	})
+	t.Run("missing name servers", func(t *testing.T) {
+		// Authoritative name servers are not found for names that do not
+		// exist, but the verification resolver responds without records.
+		dnsServer.SetTXT(tokenName)
+		s.VerificationNSLookup = true
+		defer func() { s.VerificationNSLookup = false }()
+
+		if d := reverify(t); d.VerificationFailed == nil {
+			t.Error("expected verification failed time")
+		}
+	})
+	t.Run("resolver error", func(t *testing.T) {
+		resetVerificationFailed(t)
+
+		unavailable, err := resolvertest.NewServer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		unavailable.Close()
+		defer func(r resolver.Resolver) { s.VerificationResolver = r }(s.VerificationResolver)
+		s.VerificationResolver = resolver.DNS{
+			Servers: []string{unavailable.Addr()},
+			Timeout: 100 * time.Millisecond,
+		}
+
+		if d := reverify(t); d.VerificationFailed != nil {
+			t.Errorf("expected inconclusive verification, got %#v", d)
+		}
+	})
+}
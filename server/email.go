@@ -12,10 +12,13 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"time"
 
 	"resenje.org/web"
 
 	"gopherpit.com/gopherpit/services/notification"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
 )
 
 var emailRegex = regexp.MustCompile(`^[^@]+@[^@]+\.[^@]+$`)
@@ -99,6 +102,42 @@ func (s *Server) sendEmailPasswordResetEmail(r *http.Request, to, token string)
 	return nil
 }
 
+func (s *Server) sendDomainTransferEmail(r *http.Request, transfer packages.DomainTransfer, to, from *user.User) error {
+	if to.Email == "" {
+		return nil
+	}
+
+	var textBody bytes.Buffer
+
+	emailSettingsToken, err := s.tokenFromEmail(to.Email)
+	if err != nil {
+		return fmt.Errorf("email settings token from email: %s", err)
+	}
+
+	if err := emailTemplateDomainTransferText.Execute(&textBody, map[string]interface{}{
+		"Brand":              s.Brand,
+		"Host":               web.GetRequestEndpoint(r),
+		"Transfer":           transfer,
+		"From":               from,
+		"ExpirationTime":     transfer.ExpirationTime.UTC().Format(time.RFC1123),
+		"EmailSettingsToken": string(emailSettingsToken),
+	}); err != nil {
+		return fmt.Errorf("emailTemplateDomainTransferText.Execute: %s", err)
+	}
+
+	id, err := s.NotificationService.SendEmail(notification.Email{
+		To:      []string{to.Email},
+		From:    s.DefaultFrom,
+		Subject: s.Brand + " - Domain transfer: " + transfer.FQDN,
+		Body:    textBody.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("notifier api send email: %s", err)
+	}
+	s.Logger.Infof("domain transfer email for %s sent to %s notifier id %s", transfer.FQDN, to.Email, id)
+	return nil
+}
+
 func (s *Server) sendEmailContactEmail(replyTo, subject, message string) error {
 	id, err := s.NotificationService.SendEmail(notification.Email{
 		To:      []string{s.ContactRecipientEmail},
@@ -211,5 +211,77 @@ background-color: #f6f6f6;
         <td style="font-family: 'Helvetica Neue',Helvetica,Arial,sans-serif; box-sizing: border-box; font-size: 14px; vertical-align: top; margin: 0;" valign="top"></td>
     </tr></table></body>
 </html>
+`))
+
+	emailTemplateDomainVerificationWarningText = template.Must(template.New("email").Parse(`
+Hello,
+
+Ownership of domain {{.Domain.FQDN}} could not be verified, as the verification token is no longer published.
+
+Please publish the token by one of the following methods:
+{{range .Tokens}}{{if eq .Method "dns-txt"}}
+ - DNS TXT record {{.FQDN}} with value {{.Token}}{{else if eq .Method "http-file"}}
+ - HTTPS file {{.URL}} with content {{.Token}}{{end}}{{end}}
+
+If the token is not published by {{.Deadline}}, the domain will be disabled and its packages will not be served anymore.
+
+In case that you did not expect to receive this message you can block any further messages on page {{.Host}}/email/{{.EmailSettingsToken}}.
+
+Best regards,
+{{.Brand}}
+`))
+
+	emailTemplateDomainVerificationDisabledText = template.Must(template.New("email").Parse(`
+Hello,
+
+Domain {{.Domain.FQDN}} is disabled, as its ownership could not be verified.
+
+Please publish the verification token by one of the following methods and enable the domain on page {{.Host}}/domain/{{.Domain.ID}}/settings:
+{{range .Tokens}}{{if eq .Method "dns-txt"}}
+ - DNS TXT record {{.FQDN}} with value {{.Token}}{{else if eq .Method "http-file"}}
+ - HTTPS file {{.URL}} with content {{.Token}}{{end}}{{end}}
+
+In case that you did not expect to receive this message you can block any further messages on page {{.Host}}/email/{{.EmailSettingsToken}}.
+
+Best regards,
+{{.Brand}}
+`))
+
+	emailTemplateDomainTransferText = template.Must(template.New("email").Parse(`
+Hello,
+
+{{if .From.Name}}{{.From.Name}}{{else}}{{.From.ID}}{{end}} requested to transfer the ownership of domain {{.Transfer.FQDN}} to you.
+
+You can accept or decline the transfer on page {{.Host}}/domain-transfer/{{.Transfer.DomainID}} until {{.ExpirationTime}}. You have to be logged in to site in order to accept the transfer.
+
+In case that you did not expect to receive this message you can block any further messages on page {{.Host}}/email/{{.EmailSettingsToken}}.
+
+Best regards,
+{{.Brand}}
+`))
+
+	emailTemplateCertificateExpiryText = template.Must(template.New("email").Parse(`
+Hello,
+
+TLS certificate for domain {{.FQDN}} expires in {{.Days}} days, on {{.ExpirationTime}}, and it could not be renewed.
+
+The last renewal attempt on {{.RenewalAttemptTime}} failed with error:
+
+    {{.RenewalError}}
+
+Please check that the domain points to this service and its DNS provider settings on page {{.Host}}/domain/{{.Domain.ID}}/settings. Renewal is retried automatically, and it can also be requested with the API.
+
+You can disable notifications on page {{.Host}}/settings/notifications. In case that you did not expect to receive this message you can block any further messages on page {{.Host}}/email/{{.EmailSettingsToken}}.
+
+Best regards,
+{{.Brand}}
+`))
+
+	emailTemplateCertificateExpiryAdminText = template.Must(template.New("email").Parse(`
+TLS certificate for domain {{.FQDN}} expires in {{.Days}} days, on {{.ExpirationTime}}, and it could not be renewed.
+
+Renewal failures: {{.RenewalFailures}}
+Last renewal attempt: {{.RenewalAttemptTime}}
+Last renewal error: {{.RenewalError}}
 `))
 )
@@ -8,6 +8,7 @@ package server
 import (
 	"net/http"
 	"sort"
+	"strings"
 
 	"gopherpit.com/gopherpit/services/packages"
 	"gopherpit.com/gopherpit/services/user"
@@ -39,11 +40,41 @@ func (s *Server) dashboardHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if len(domains) == 1 {
+	organizations, err := s.PackagesService.OrganizationsByUser(u.ID)
+	if err != nil {
+		s.Logger.Errorf("dashboard: user organizations %s: %s", u.ID, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+
+	search := strings.TrimSpace(r.URL.Query().Get("query"))
+
+	if len(domains) == 1 && len(organizations) == 0 && search == "" {
 		http.Redirect(w, r, "/domain/"+domains[0].FQDN, http.StatusTemporaryRedirect)
 		return
 	}
 
+	var searchResults packages.PackagesPage
+	if search != "" {
+		// Administrators search packages under all domains.
+		userID := u.ID
+		if u.Admin {
+			userID = ""
+		}
+		start := r.URL.Query().Get("start")
+		searchResults, err = s.PackagesService.SearchPackages(userID, search, start, 0)
+		if err != nil {
+			if err == packages.ErrPackageNotFound {
+				s.Logger.Warningf("dashboard: search packages %q start %s: %s", search, start, err)
+				s.htmlNotFoundHandler(w, r)
+				return
+			}
+			s.Logger.Errorf("dashboard: search packages %q: %s", search, err)
+			s.htmlServerError(w, r, err)
+			return
+		}
+	}
+
 	var changelogLimit int
 	switch {
 	case len(domains) > 5:
@@ -84,10 +115,14 @@ func (s *Server) dashboardHandler(w http.ResponseWriter, r *http.Request) {
 
 	sort.Sort(cls)
 	s.html.Respond(w, "Dashboard", map[string]interface{}{
-		"User":       u,
-		"Domains":    domains,
-		"Users":      users,
-		"Changelogs": cls,
+		"User":          u,
+		"Domains":       domains,
+		"Organizations": organizations,
+		"Users":         users,
+		"Changelogs":    cls,
+		"Search":        search,
+		"SearchResults": searchResults.Packages,
+		"SearchNext":    searchResults.Next,
 	})
 }
 
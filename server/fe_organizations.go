@@ -0,0 +1,144 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+// ownedOrganizations returns organizations in which the user has
+// the owner role.
+func (s *Server) ownedOrganizations(userID string) (packages.Organizations, error) {
+	orgs, err := s.PackagesService.OrganizationsByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	owned := packages.Organizations{}
+	for _, o := range orgs {
+		members, err := s.PackagesService.OrganizationMembers(o.ID)
+		if err != nil {
+			return nil, err
+		}
+		if members.Role(userID) == packages.OrganizationRoleOwner {
+			owned = append(owned, o)
+		}
+	}
+	return owned, nil
+}
+
+type organizationMember struct {
+	User *user.User
+	Role packages.OrganizationRole
+}
+
+func (s *Server) organizationAddHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	s.html.Respond(w, "OrganizationAdd", map[string]interface{}{
+		"User": u,
+	})
+}
+
+func (s *Server) organizationHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	org, err := s.PackagesService.Organization(id)
+	if err != nil {
+		if err == packages.ErrOrganizationNotFound {
+			s.Logger.Warningf("organization: organization %s: %s", id, err)
+			s.htmlNotFoundHandler(w, r)
+			return
+		}
+		s.Logger.Errorf("organization: organization %s: %s", id, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+
+	orgMembers, err := s.PackagesService.OrganizationMembers(org.ID)
+	if err != nil {
+		s.Logger.Errorf("organization: organization %s members: %s", org.ID, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+
+	role := orgMembers.Role(u.ID)
+	if role == "" {
+		s.htmlNotFoundHandler(w, r)
+		return
+	}
+
+	members := []organizationMember{}
+	for _, userID := range orgMembers.UserIDs {
+		mu, err := s.UserService.UserByID(userID)
+		if err != nil {
+			if err == user.ErrUserNotFound {
+				s.Logger.Warningf("organization: organization %s: member %s: %s", org.ID, userID, err)
+				continue
+			}
+			s.Logger.Errorf("organization: organization %s: member %s: %s", org.ID, userID, err)
+			s.htmlServerError(w, r, err)
+			return
+		}
+		members = append(members, organizationMember{
+			User: mu,
+			Role: orgMembers.Roles[userID],
+		})
+	}
+
+	token := ""
+	domains := packages.Domains{}
+	for {
+		response, err := s.PackagesService.DomainsByOrganization(org.ID, token, 0)
+		if err != nil {
+			s.Logger.Errorf("organization: organization domains %s: %s", org.ID, err)
+			s.htmlServerError(w, r, err)
+			return
+		}
+		domains = append(domains, response.Domains...)
+		token = response.Next
+		if token == "" {
+			break
+		}
+	}
+
+	var k *key.Key
+	if s.APIEnabled && role == packages.OrganizationRoleOwner {
+		k, err = s.KeyService.KeyByRef(org.ID)
+		switch err {
+		case nil:
+		case key.ErrKeyNotFound:
+			k = &key.Key{}
+		default:
+			s.Logger.Errorf("organization: %s: get key by ref: %s", org.ID, err)
+			s.htmlServerError(w, r, err)
+			return
+		}
+	}
+
+	s.html.Respond(w, "Organization", map[string]interface{}{
+		"User":         u,
+		"Organization": org,
+		"Role":         role,
+		"Members":      members,
+		"Domains":      domains,
+		"Key":          k,
+		"APIEnabled":   s.APIEnabled,
+	})
+}
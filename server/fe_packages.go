@@ -7,7 +7,9 @@ package server
 
 import (
 	"encoding/base32"
+	"html/template"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -41,7 +43,25 @@ func (s *Server) domainPackagesHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
-	pkgs, err := s.PackagesService.PackagesByDomain(id, start, 0)
+	filter, err := packagesFilterFromQuery(r.URL.Query())
+	if err != nil {
+		s.Logger.Warningf("domain packages: packages filter %s: %s", id, err)
+		filter = nil
+	}
+	// Filter values for the form and the query string that preserves
+	// the filter in pagination links.
+	filterValues := map[string]string{}
+	filterQuery := url.Values{}
+	if filter != nil {
+		for _, name := range []string{"vcs", "ref_type", "repo_root_host", "disabled", "query"} {
+			if v := strings.TrimSpace(r.URL.Query().Get(name)); v != "" {
+				filterValues[name] = v
+				filterQuery.Set(name, v)
+			}
+		}
+	}
+
+	pkgs, err := s.PackagesService.PackagesByDomain(id, start, 0, filter)
 	if err != nil {
 		if err == packages.ErrDomainNotFound {
 			s.Logger.Warningf("domain packages: packages by domain %s: %s", id, err)
@@ -88,13 +108,24 @@ func (s *Server) domainPackagesHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	role, err := s.domainUserRole(pkgs.Domain.ID, u.ID)
+	if err != nil {
+		s.Logger.Errorf("domain packages: domain user role %s: %s", pkgs.Domain.ID, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+
 	s.html.Respond(w, "DomainPackages", map[string]interface{}{
-		"User":     u,
-		"Domain":   pkgs.Domain,
-		"Domains":  domains,
-		"Packages": pkgs.Packages,
-		"Previous": pkgs.Previous,
-		"Next":     pkgs.Next,
+		"CanEdit":     role.CanEditPackages(),
+		"User":        u,
+		"Domain":      pkgs.Domain,
+		"Domains":     domains,
+		"Packages":    pkgs.Packages,
+		"Previous":    pkgs.Previous,
+		"Next":        pkgs.Next,
+		"VCSInfos":    vcsInfos,
+		"Filter":      filterValues,
+		"FilterQuery": template.URL(filterQuery.Encode()),
 	})
 }
 
@@ -120,7 +151,13 @@ func (s *Server) domainAddHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		domain = *d
-		if domain.OwnerUserID != u.ID {
+		role, err := s.domainUserRole(domain.ID, u.ID)
+		if err != nil {
+			s.Logger.Errorf("domain add: domain %s: user role %s: %s", id, u.ID, err)
+			s.htmlServerError(w, r, err)
+			return
+		}
+		if role != packages.DomainRoleOwner {
 			s.htmlNotFoundHandler(w, r)
 			return
 		}
@@ -276,7 +313,14 @@ func (s *Server) domainTeamHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if domain.OwnerUserID != u.ID {
+	role, err := s.domainUserRole(domain.ID, u.ID)
+	if err != nil {
+		s.Logger.Errorf("domain team: domain %s: user role %s: %s", id, u.ID, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+
+	if role != packages.DomainRoleOwner {
 		s.html.Respond(w, "DomainTeam", map[string]interface{}{
 			"Forbidden": true,
 			"User":      u,
@@ -316,12 +360,34 @@ func (s *Server) domainTeamHandler(w http.ResponseWriter, r *http.Request) {
 		users = append(users, *domainUser)
 	}
 
+	var transfer *packages.DomainTransfer
+	var transferUser *user.User
+	transfer, err = s.PackagesService.DomainTransfer(domain.ID)
+	switch err {
+	case packages.ErrDomainTransferNotFound:
+		transfer = nil
+	case nil:
+		transferUser, err = s.UserService.UserByID(transfer.ToUserID)
+		if err != nil {
+			s.Logger.Errorf("domain team: transfer user %s: %s", transfer.ToUserID, err)
+			s.htmlServerError(w, r, err)
+			return
+		}
+	default:
+		s.Logger.Errorf("domain team: domain transfer %s: %s", domain.ID, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+
 	s.html.Respond(w, "DomainTeam", map[string]interface{}{
-		"Forbidden": false,
-		"User":      u,
-		"Users":     users,
-		"Domain":    domain,
-		"Domains":   domains,
+		"Forbidden":    false,
+		"User":         u,
+		"Users":        users,
+		"Roles":        domainUsers.Roles,
+		"Domain":       domain,
+		"Domains":      domains,
+		"Transfer":     transfer,
+		"TransferUser": transferUser,
 	})
 }
 
@@ -392,13 +458,51 @@ func (s *Server) domainSettingsHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	role, err := s.domainUserRole(domain.ID, u.ID)
+	if err != nil {
+		s.Logger.Errorf("domain settings: domain %s: user role %s: %s", domain.ID, u.ID, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+
+	organizations, err := s.ownedOrganizations(u.ID)
+	if err != nil {
+		s.Logger.Errorf("domain settings: owned organizations %s: %s", u.ID, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+
+	dnsProviders, err := s.CertificateService.ACMEDNSProviders()
+	if err != nil {
+		s.Logger.Errorf("domain settings: dns providers: %s", err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+	settings, err := s.CertificateService.ACMESettings(domain.FQDN)
+	switch err {
+	case certificate.ErrACMESettingsNotFound:
+		settings = &certificate.ACMESettings{FQDN: domain.FQDN}
+	case nil:
+	default:
+		s.Logger.Errorf("domain settings: acme settings %s: %s", domain.FQDN, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+
 	s.html.Respond(w, "DomainSettings", map[string]interface{}{
-		"Forbidden":                  domain.OwnerUserID != u.ID,
+		"Forbidden":                  role != packages.DomainRoleOwner,
+		"Organizations":              organizations,
 		"User":                       u,
 		"Domain":                     domain,
 		"Domains":                    domains,
 		"CertificateExpirationTime":  certificateExpirationTime,
 		"IsCertificateBeingObtained": isCertificateBeingObtained,
+		"VCSInfos":                   vcsInfos,
+		"PackageDefaultsFields":      packageDefaultsFieldInfos,
+		"DNSProviders":               dnsProviders,
+		"DNSProvider":                settings.DNSProvider,
+		"KeyTypeInfos":               keyTypeInfos,
+		"KeyType":                    settings.KeyType,
 	})
 }
 
@@ -627,6 +731,52 @@ func (s *Server) domainDomainOwnerChangeHandler(w http.ResponseWriter, r *http.R
 	})
 }
 
+func (s *Server) domainTransferHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	transfer, err := s.PackagesService.DomainTransfer(id)
+	if err != nil {
+		if err == packages.ErrDomainNotFound || err == packages.ErrDomainTransferNotFound {
+			s.Logger.Warningf("domain transfer: domain %s: %s", id, err)
+			s.htmlNotFoundHandler(w, r)
+			return
+		}
+		s.Logger.Errorf("domain transfer: domain %s: %s", id, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+
+	if transfer.FromUserID != u.ID && transfer.ToUserID != u.ID {
+		s.htmlNotFoundHandler(w, r)
+		return
+	}
+
+	from, err := s.UserService.UserByID(transfer.FromUserID)
+	if err != nil {
+		s.Logger.Errorf("domain transfer: domain %s: from user %s: %s", id, transfer.FromUserID, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+	to, err := s.UserService.UserByID(transfer.ToUserID)
+	if err != nil {
+		s.Logger.Errorf("domain transfer: domain %s: to user %s: %s", id, transfer.ToUserID, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+
+	s.html.Respond(w, "DomainTransfer", map[string]interface{}{
+		"User":     u,
+		"Transfer": transfer,
+		"From":     from,
+		"To":       to,
+	})
+}
+
 type vcsInfo struct {
 	VCS  packages.VCS
 	Name string
@@ -639,6 +789,32 @@ var vcsInfos = []vcsInfo{
 	{packages.VCSSubversion, "Subversion"},
 }
 
+type keyTypeInfo struct {
+	KeyType string
+	Name    string
+}
+
+var keyTypeInfos = []keyTypeInfo{
+	{certificate.KeyTypeP256, "ECDSA P-256"},
+	{certificate.KeyTypeP384, "ECDSA P-384"},
+	{certificate.KeyTypeRSA2048, "RSA 2048"},
+	{certificate.KeyTypeRSA4096, "RSA 4096"},
+	{certificate.KeyTypeDual, "ECDSA P-256 and RSA 2048"},
+}
+
+type packageDefaultsFieldInfo struct {
+	Field packages.PackageDefaultsField
+	Name  string
+}
+
+var packageDefaultsFieldInfos = []packageDefaultsFieldInfo{
+	{packages.PackageDefaultsFieldVCS, "VCS"},
+	{packages.PackageDefaultsFieldRepoRoot, "Repository"},
+	{packages.PackageDefaultsFieldRef, "Reference"},
+	{packages.PackageDefaultsFieldGoSource, "Go Source"},
+	{packages.PackageDefaultsFieldRedirectURL, "Redirect URL"},
+}
+
 func (s *Server) domainPackageEditHandler(w http.ResponseWriter, r *http.Request) {
 	u, r, err := s.getRequestUser(r)
 	if err != nil {
@@ -715,15 +891,46 @@ func (s *Server) domainPackageEditHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	role, err := s.domainUserRole(domain.ID, u.ID)
+	if err != nil {
+		s.Logger.Errorf("domain package edit: domain user role %s: %s", domain.ID, err)
+		s.htmlServerError(w, r, err)
+		return
+	}
+	// Viewers can see existing packages, but not add new ones.
+	if !role.CanEditPackages() && pkg.ID == "" {
+		s.htmlForbiddenHandler(w, r)
+		return
+	}
+
+	// New packages are prefilled with domain package defaults. Templated
+	// defaults are shown as placeholders and are set by the server.
+	var packageDefaults *packages.PackageDefaults
+	if pkg.ID == "" && domain.PackageDefaults != nil {
+		packageDefaults = domain.PackageDefaults
+		if packageDefaults.VCS != "" {
+			pkg.VCS = packageDefaults.VCS
+		}
+		pkg.RefType = packageDefaults.RefType
+		pkg.RefName = packageDefaults.RefName
+	}
+
 	s.html.Respond(w, "DomainPackageEdit", map[string]interface{}{
-		"User":     u,
-		"Domain":   domain,
-		"Domains":  domains,
-		"Package":  pkg,
-		"VCSInfos": vcsInfos,
+		"ReadOnly":        !role.CanEditPackages(),
+		"User":            u,
+		"Domain":          domain,
+		"Domains":         domains,
+		"Package":         pkg,
+		"PackageDefaults": packageDefaults,
+		"VCSInfos":        vcsInfos,
 	})
 }
 
+// domainUserRole returns the role of the user on the domain.
+func (s *Server) domainUserRole(domainID, userID string) (packages.DomainRole, error) {
+	return s.PackagesService.DomainUserRole(domainID, userID)
+}
+
 func (s *Server) userPageHandler(w http.ResponseWriter, r *http.Request) {
 	u, r, err := s.getRequestUser(r)
 	if err != nil {
@@ -16,8 +16,10 @@ import (
 )
 
 type registerACMEUserRequest struct {
-	Email     string `json:"email"`
-	Directory string `json:"directory"`
+	Email      string `json:"email"`
+	Directory  string `json:"directory"`
+	EABKeyID   string `json:"eab_key_id"`
+	EABHMACKey string `json:"eab_hmac_key"`
 }
 
 func (s *Server) registerACMEUserFEAPIHandler(w http.ResponseWriter, r *http.Request) {
@@ -65,12 +67,36 @@ func (s *Server) registerACMEUserFEAPIHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	au, err = s.CertificateService.RegisterACMEUser(directoryURL, request.Email)
+	// External account binding from the request overrides the configured one.
+	var eab *certificate.ExternalAccountBinding
+	switch {
+	case request.EABKeyID != "" || request.EABHMACKey != "":
+		eab = &certificate.ExternalAccountBinding{
+			KeyID:   request.EABKeyID,
+			HMACKey: request.EABHMACKey,
+		}
+	case s.ACMEEABKeyID != "":
+		eab = &certificate.ExternalAccountBinding{
+			KeyID:   s.ACMEEABKeyID,
+			HMACKey: s.ACMEEABHMACKey,
+		}
+	}
+
+	au, err = s.CertificateService.RegisterACMEUser(directoryURL, request.Email, eab)
 	if err != nil {
-		if err == certificate.ErrACMEUserEmailInvalid {
+		switch err {
+		case certificate.ErrACMEUserEmailInvalid:
 			errors.AddFieldError("email", "E-mail address is invalid.")
 			jsonresponse.BadRequest(w, errors)
 			return
+		case certificate.ErrACMEUserEABRequired:
+			errors.AddFieldError("eab_key_id", "External account binding is required by the ACME provider.")
+			jsonresponse.BadRequest(w, errors)
+			return
+		case certificate.ErrACMEUserEABInvalid:
+			errors.AddFieldError("eab_key_id", "External account binding is invalid.")
+			jsonresponse.BadRequest(w, errors)
+			return
 		}
 		s.Logger.Warningf("register acme user fe api: register acme user: %s", err)
 		jsonServerError(w, err)
@@ -10,12 +10,49 @@ import (
 	"net"
 	"net/http"
 
+	"github.com/gorilla/mux"
+
 	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
 
 	"resenje.org/jsonresponse"
 	"resenje.org/web"
 )
 
+// apiKeyRef returns the reference of the API key that is managed by the
+// request. It is the user ID for personal keys, or the organization ID
+// if the request is for an organization key, in which case the user must
+// be an owner of the organization. If ok is false, the response is
+// already written.
+func (s *Server) apiKeyRef(w http.ResponseWriter, r *http.Request, u *user.User) (ref string, ok bool) {
+	id := mux.Vars(r)["id"]
+	if id == "" {
+		return u.ID, true
+	}
+	org, err := s.PackagesService.Organization(id)
+	if err != nil {
+		if err == packages.ErrOrganizationNotFound {
+			jsonresponse.BadRequest(w, web.NewError("Unknown organization."))
+			return "", false
+		}
+		s.Logger.Errorf("api key fe api: organization %s: %s", id, err)
+		jsonServerError(w, err)
+		return "", false
+	}
+	members, err := s.PackagesService.OrganizationMembers(org.ID)
+	if err != nil {
+		s.Logger.Errorf("api key fe api: organization %s members: %s", org.ID, err)
+		jsonServerError(w, err)
+		return "", false
+	}
+	if members.Role(u.ID) != packages.OrganizationRoleOwner {
+		jsonresponse.Forbidden(w, web.NewError("Only organization owners can manage its API access."))
+		return "", false
+	}
+	return org.ID, true
+}
+
 type apiKeyFEAPIResponse struct {
 	Secret             string   `json:"secret"`
 	AuthorizedNetworks []string `json:"authorized_networks"`
@@ -27,9 +64,14 @@ func (s *Server) apiKeyFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 		panic(err)
 	}
 
-	k, err := s.KeyService.CreateKey(u.ID, nil)
+	ref, ok := s.apiKeyRef(w, r, u)
+	if !ok {
+		return
+	}
+
+	k, err := s.KeyService.CreateKey(ref, nil)
 	if err != nil {
-		s.Logger.Errorf("api key fe api: create key %s: %s", u.ID, err)
+		s.Logger.Errorf("api key fe api: create key %s: %s", ref, err)
 		jsonServerError(w, err)
 		return
 	}
@@ -42,7 +84,7 @@ func (s *Server) apiKeyFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 		response.AuthorizedNetworks = append(response.AuthorizedNetworks, n.String())
 	}
 
-	s.audit(r, nil, "enable api", "")
+	s.audit(r, nil, "enable api", apiKeyAuditMessage(ref, u))
 
 	jsonresponse.OK(w, response)
 }
@@ -53,14 +95,19 @@ func (s *Server) apiKeyDeleteFEAPIHandler(w http.ResponseWriter, r *http.Request
 		panic(err)
 	}
 
-	err = s.KeyService.DeleteKey(u.ID)
+	ref, ok := s.apiKeyRef(w, r, u)
+	if !ok {
+		return
+	}
+
+	err = s.KeyService.DeleteKey(ref)
 	if err != nil {
-		s.Logger.Errorf("api key delete fe api: delete key: %s: %s", u.ID, err)
+		s.Logger.Errorf("api key delete fe api: delete key: %s: %s", ref, err)
 		jsonServerError(w, err)
 		return
 	}
 
-	s.audit(r, nil, "disable api", "")
+	s.audit(r, nil, "disable api", apiKeyAuditMessage(ref, u))
 
 	jsonresponse.OK(w, nil)
 }
@@ -75,14 +122,19 @@ func (s *Server) apiRegenerateSecretFEAPIHandler(w http.ResponseWriter, r *http.
 		panic(err)
 	}
 
-	secret, err := s.KeyService.RegenerateSecret(u.ID)
+	ref, ok := s.apiKeyRef(w, r, u)
+	if !ok {
+		return
+	}
+
+	secret, err := s.KeyService.RegenerateSecret(ref)
 	if err != nil {
-		s.Logger.Errorf("api regenerate secret fe api: regenerate secret %s: %s", u.ID, err)
+		s.Logger.Errorf("api regenerate secret fe api: regenerate secret %s: %s", ref, err)
 		jsonServerError(w, err)
 		return
 	}
 
-	s.audit(r, nil, "regenerate api secret", "")
+	s.audit(r, nil, "regenerate api secret", apiKeyAuditMessage(ref, u))
 
 	jsonresponse.OK(w, apiRegenerateSecretFEAPIResponse{
 		Secret: secret,
@@ -103,6 +155,11 @@ func (s *Server) apiNetworksFEAPIHandler(w http.ResponseWriter, r *http.Request)
 		panic(err)
 	}
 
+	ref, ok := s.apiKeyRef(w, r, u)
+	if !ok {
+		return
+	}
+
 	request := apiNetworksFEAPIRequest{}
 	errors := web.FormErrors{}
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
@@ -138,16 +195,16 @@ func (s *Server) apiNetworksFEAPIHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	k, err := s.KeyService.UpdateKey(u.ID, &key.Options{
+	k, err := s.KeyService.UpdateKey(ref, &key.Options{
 		AuthorizedNetworks: &authorizedNetworks,
 	})
 	if err != nil {
 		if err == key.ErrKeyNotFound {
-			s.Logger.Warningf("api networks fe api: update key %s: %s", u.ID, err)
+			s.Logger.Warningf("api networks fe api: update key %s: %s", ref, err)
 			jsonresponse.NotFound(w, jsonresponse.NewMessage("API key not found"))
 			return
 		}
-		s.Logger.Errorf("api networks fe api: update key %s: %s", u.ID, err)
+		s.Logger.Errorf("api networks fe api: update key %s: %s", ref, err)
 		jsonServerError(w, err)
 		return
 	}
@@ -158,7 +215,16 @@ func (s *Server) apiNetworksFEAPIHandler(w http.ResponseWriter, r *http.Request)
 		response.AuthorizedNetworks = append(response.AuthorizedNetworks, n.String())
 	}
 
-	s.audit(r, response, "update api networks", "")
+	s.audit(r, response, "update api networks", apiKeyAuditMessage(ref, u))
 
 	jsonresponse.OK(w, response)
 }
+
+// apiKeyAuditMessage returns the audit message that references the
+// organization if the key is not a personal key.
+func apiKeyAuditMessage(ref string, u *user.User) string {
+	if ref == u.ID {
+		return ""
+	}
+	return "organization " + ref
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"resenje.org/jsonresponse"
+	"resenje.org/web"
+
+	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+type organizationFEAPIRequest struct {
+	Name string `json:"name"`
+}
+
+func (s *Server) organizationFEAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	request := organizationFEAPIRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.Logger.Warningf("organization fe api: request decode: %s", err)
+		jsonresponse.BadRequest(w, web.NewError("Invalid data."))
+		return
+	}
+
+	o := &packages.OrganizationOptions{
+		Name: &request.Name,
+	}
+
+	var org *packages.Organization
+	if id == "" {
+		org, err = s.PackagesService.AddOrganization(o, u.ID)
+	} else {
+		org, err = s.PackagesService.UpdateOrganization(id, o, u.ID)
+	}
+	switch err {
+	case packages.ErrOrganizationNotFound:
+		jsonresponse.BadRequest(w, web.NewError("Unknown organization."))
+		return
+	case packages.ErrOrganizationNameRequired:
+		jsonresponse.BadRequest(w, web.NewFieldError("name", "Organization name is required."))
+		return
+	case packages.ErrOrganizationNameInvalid:
+		jsonresponse.BadRequest(w, web.NewFieldError("name", "Organization name may contain only lowercase letters, numbers and dashes."))
+		return
+	case packages.ErrOrganizationAlreadyExists:
+		jsonresponse.BadRequest(w, web.NewFieldError("name", "Organization name is already taken."))
+		return
+	case packages.ErrForbidden:
+		jsonresponse.BadRequest(w, web.NewError("Only organization owners can change its name."))
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("organization fe api: add/update organization %q: %s", id, err)
+		jsonServerError(w, err)
+		return
+	}
+
+	action := "organization update"
+	if id == "" {
+		action = "organization add"
+	}
+	s.auditf(r, request, action, "%s: %s", org.ID, org.Name)
+
+	jsonresponse.OK(w, org)
+}
+
+func (s *Server) organizationDeleteFEAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	org, err := s.PackagesService.DeleteOrganization(id, u.ID)
+	switch err {
+	case packages.ErrOrganizationNotFound:
+		jsonresponse.BadRequest(w, web.NewError("Unknown organization."))
+		return
+	case packages.ErrOrganizationHasDomains:
+		jsonresponse.BadRequest(w, web.NewError("Organization owns domains. Delete them or give them to other owners first."))
+		return
+	case packages.ErrForbidden:
+		jsonresponse.BadRequest(w, web.NewError("Only organization owners can delete it."))
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("organization delete fe api: organization %s: %s", id, err)
+		jsonServerError(w, err)
+		return
+	}
+
+	if err := s.KeyService.DeleteKey(org.ID); err != nil && err != key.ErrKeyNotFound {
+		s.Logger.Errorf("organization delete fe api: organization %s: delete key: %s", org.ID, err)
+	}
+
+	s.auditf(r, nil, "organization delete", "%s: %s", org.ID, org.Name)
+
+	jsonresponse.OK(w, nil)
+}
+
+type organizationMemberFEAPIRequest struct {
+	ID   string `json:"id"`
+	Role string `json:"role"`
+}
+
+func (s *Server) organizationMemberFEAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	id := mux.Vars(r)["id"]
+
+	request := organizationMemberFEAPIRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.Logger.Warningf("organization member fe api: request decode: %s", err)
+		jsonresponse.BadRequest(w, web.NewError("Invalid data."))
+		return
+	}
+
+	if request.ID == "" {
+		jsonresponse.BadRequest(w, web.NewFieldError("id", "User is required."))
+		return
+	}
+
+	memberUser, err := s.UserService.User(request.ID)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			s.Logger.Warningf("organization member fe api: user %s: %s", request.ID, err)
+			jsonresponse.BadRequest(w, web.NewFieldError("id", "Unknown user."))
+			return
+		}
+		s.Logger.Errorf("organization member fe api: user %s: %s", request.ID, err)
+		jsonServerError(w, err)
+		return
+	}
+
+	role := packages.OrganizationRole(request.Role)
+	if role == "" {
+		role = packages.OrganizationRoleMember
+	}
+
+	if r.Method == "DELETE" {
+		err = s.PackagesService.RemoveOrganizationMember(id, memberUser.ID, u.ID)
+	} else {
+		err = s.PackagesService.SetOrganizationMember(id, memberUser.ID, role, u.ID)
+	}
+	switch err {
+	case packages.ErrOrganizationNotFound:
+		jsonresponse.BadRequest(w, web.NewError("Unknown organization."))
+		return
+	case packages.ErrOrganizationRoleInvalid:
+		jsonresponse.BadRequest(w, web.NewFieldError("role", "Invalid role."))
+		return
+	case packages.ErrOrganizationLastOwner:
+		jsonresponse.BadRequest(w, web.NewError("Organization must have at least one owner."))
+		return
+	case packages.ErrUserDoesNotExist:
+		jsonresponse.BadRequest(w, web.NewFieldError("id", "This user is not a member."))
+		return
+	case packages.ErrForbidden:
+		jsonresponse.BadRequest(w, web.NewError("Only organization owners can manage members."))
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("organization member fe api: organization %s: member %s: %s", id, memberUser.ID, err)
+		jsonServerError(w, err)
+		return
+	}
+
+	if r.Method == "DELETE" {
+		s.auditf(r, nil, "organization member remove", "%s: %s", id, memberUser.ID)
+	} else {
+		s.auditf(r, request, "organization member set", "%s: %s: %s", id, memberUser.ID, role)
+	}
+
+	jsonresponse.OK(w, nil)
+}
@@ -6,8 +6,6 @@
 package server
 
 import (
-	"crypto/sha1"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -22,6 +20,8 @@ import (
 	"resenje.org/marshal"
 	"resenje.org/web"
 
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/certificate"
 	"gopherpit.com/gopherpit/services/packages"
 	"gopherpit.com/gopherpit/services/user"
 )
@@ -32,6 +32,22 @@ var (
 	urlRegex         = regexp.MustCompile(`^((([A-Za-z]{3,9}:(?:\/\/)?)(?:[\-;:&=\+\$,\w]+@)?[A-Za-z0-9\.\-]+|(?:www\.|[\-;:&=\+\$,\w]+@)[A-Za-z0-9\.\-]+)((?:\/[\+~%\/\.\w\-_]*)?\??(?:[\-\+=&;%@\.\w_]*)#?(?:[\.\!\/\\\w]*))?)$`)
 )
 
+// isValidFQDN returns true if fqdn is a valid domain name, a wildcard
+// domain name in form *.example.com or the service domain.
+func (s *Server) isValidFQDN(fqdn string) bool {
+	return fqdn == s.Domain || fqdnRegex.MatchString(strings.TrimPrefix(fqdn, "*."))
+}
+
+// isServiceWildcardFQDN returns true if fqdn is a wildcard domain that
+// would cover subdomains of the service domain which are reserved for
+// users.
+func (s *Server) isServiceWildcardFQDN(fqdn string) bool {
+	if s.Domain == "" || !packages.IsWildcardFQDN(fqdn) {
+		return false
+	}
+	return fqdn == "*."+s.Domain || strings.HasSuffix(fqdn, "."+s.Domain)
+}
+
 func (s *Server) certificateFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 	u, r, err := s.getRequestUser(r)
 	if err != nil {
@@ -53,7 +69,14 @@ func (s *Server) certificateFEAPIHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if domain.OwnerUserID != u.ID {
+	role, err := s.domainUserRole(domain.ID, u.ID)
+	if err != nil {
+		s.Logger.Errorf("certificate fe api: domain %s: user role %s: %s", id, u.ID, err)
+		jsonServerError(w, err)
+		return
+	}
+
+	if role != packages.DomainRoleOwner {
 		jsonresponse.Forbidden(w, nil)
 		return
 	}
@@ -82,6 +105,8 @@ func (s *Server) certificateFEAPIHandler(w http.ResponseWriter, r *http.Request)
 	}
 	s.Logger.Infof("certificate api: obtain certificate: success for %s: expiration time: %s", certificate.FQDN, certificate.ExpirationTime)
 
+	s.obtainDomainAliasCertificates(domain.Aliases, u.ID)
+
 	s.auditf(r, nil, "obtain certificate", "%s: %s", certificate.FQDN, certificate.ExpirationTime)
 
 	jsonresponse.OK(w, nil)
@@ -91,16 +116,16 @@ type domainFEAPIRequest struct {
 	FQDN              string           `json:"fqdn"`
 	CertificateIgnore marshal.Checkbox `json:"certificateIgnore"`
 	Disabled          marshal.Checkbox `json:"disabled"`
-}
-
-type domainToken struct {
-	FQDN  string `json:"fqdn"`
-	Token string `json:"token"`
+	OrganizationID    string           `json:"organizationId"`
+	Aliases           string           `json:"aliases"`
+	AliasRedirect     marshal.Checkbox `json:"aliasRedirect"`
+	DNSProvider       *string          `json:"dnsProvider"`
+	KeyType           *string          `json:"keyType"`
 }
 
 type validationFormErrorResponse struct {
 	web.FormErrors
-	Tokens []domainToken `json:"tokens"`
+	Tokens []api.DomainToken `json:"tokens"`
 }
 
 func (s *Server) domainFEAPIHandler(w http.ResponseWriter, r *http.Request) {
@@ -126,7 +151,7 @@ func (s *Server) domainFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !fqdnRegex.MatchString(fqdn) && fqdn != s.Domain {
+	if !s.isValidFQDN(fqdn) {
 		jsonresponse.BadRequest(w, web.NewFieldError("fqdn", "Fully qualified domain name is invalid."))
 		return
 	}
@@ -154,6 +179,10 @@ func (s *Server) domainFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
+	if s.isServiceWildcardFQDN(fqdn) {
+		jsonresponse.BadRequest(w, web.NewFieldError("fqdn", "Domain is not available"))
+		return
+	}
 
 	skipDomainVerification := s.SkipDomainVerification
 	if !skipDomainVerification {
@@ -166,34 +195,36 @@ func (s *Server) domainFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// New or changed domain fqdn verification.
+	// Wildcard domains are verified by their parent domain, only with
+	// DNS TXT records.
 	if (domain == nil || domain.FQDN != fqdn) && s.Domain != "" && !skipDomainVerification {
+		verificationFQDN := strings.TrimPrefix(fqdn, "*.")
 		switch {
-		case fqdn == s.Domain, strings.HasSuffix(fqdn, "."+s.Domain):
-			if strings.Count(fqdn, ".") > strings.Count(s.Domain, ".")+1 {
+		case verificationFQDN == s.Domain, strings.HasSuffix(verificationFQDN, "."+s.Domain):
+			if strings.Count(verificationFQDN, ".") > strings.Count(s.Domain, ".")+1 {
 				jsonresponse.BadRequest(w, web.NewFieldError("fqdn", fmt.Sprintf("Only one subdomain is allowed for domain %s", s.Domain)))
 				return
 			}
 		default:
-			publicSuffix, icann := publicsuffix.PublicSuffix(fqdn)
+			publicSuffix, icann := publicsuffix.PublicSuffix(verificationFQDN)
 			if !icann {
 				jsonresponse.BadRequest(w, web.NewFieldError("fqdn", fmt.Sprintf("Top level domain %s is not an ICANN domain.", publicSuffix)))
 				return
 			}
-			if fqdn == publicSuffix {
+			if verificationFQDN == publicSuffix {
 				jsonresponse.BadRequest(w, web.NewFieldError("fqdn", fmt.Sprintf("The domain %s is an ICANN domain.", publicSuffix)))
 				return
 			}
 
-			tokens := []domainToken{}
-			domainParts := strings.Split(fqdn, ".")
+			tokens := []api.DomainToken{}
+			domainParts := strings.Split(verificationFQDN, ".")
 			startIndex := len(domainParts) - strings.Count(publicSuffix, ".") - 2
 			if startIndex < 0 {
 				jsonresponse.BadRequest(w, web.NewFieldError("fqdn", "Fully qualified domain name is invalid."))
 				return
 			}
 
-			domain, err = s.PackagesService.Domain(fqdn)
-			if err != nil {
+			if _, err = s.PackagesService.Domain(fqdn); err != nil {
 				if err != packages.ErrDomainNotFound {
 					s.Logger.Errorf("domain fe api: domain %s: %s", fqdn, err)
 					jsonServerError(w, err)
@@ -205,32 +236,25 @@ func (s *Server) domainFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 			}
 
 			d := publicSuffix
-			var token, verificationDomain string
-			var verified bool
-			var x [20]byte
+			var token string
+			var method api.DomainVerificationMethod
 			for i := startIndex; i >= 0; i-- {
 				d = fmt.Sprintf("%s.%s", domainParts[i], d)
 
-				x = sha1.Sum(append(s.salt, []byte(u.ID+d)...))
-				token = base64.URLEncoding.EncodeToString(x[:])
-
-				verificationDomain = s.VerificationSubdomain + "." + d
-
-				verified, err = verifyDomain(verificationDomain, token)
+				token = s.domainVerificationToken(u.ID, d)
+				method, err = s.verifyDomain(d, token, d == fqdn)
 				if err != nil {
-					s.Logger.Errorf("domain fe api: verify domain: %s: %s", verificationDomain, err)
+					s.Logger.Errorf("domain fe api: verify domain: %s: %s", d, err)
 				}
-				if verified {
+				if method != "" {
+					s.Logger.Debugf("domain fe api: domain %s verified by %s", d, method)
 					break
 				}
 
-				tokens = append(tokens, domainToken{
-					FQDN:  verificationDomain,
-					Token: token,
-				})
+				tokens = append(tokens, s.domainVerificationTokens(d, token, d == fqdn)...)
 			}
 
-			if !verified {
+			if method == "" {
 				jsonresponse.BadRequest(w, validationFormErrorResponse{
 					FormErrors: web.NewFieldError("fqdn", "Domain is not verified."),
 					Tokens:     tokens,
@@ -240,25 +264,103 @@ func (s *Server) domainFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Aliases are set only on existing domains.
+	var aliases []string
+	if domain != nil {
+		aliases = []string{}
+		for _, a := range strings.FieldsFunc(strings.ToLower(request.Aliases), func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\n' || r == '\r' || r == '\t'
+		}) {
+			if !s.isValidFQDN(a) || packages.IsWildcardFQDN(a) || a == fqdn || packages.IsWildcardFQDN(fqdn) {
+				jsonresponse.BadRequest(w, web.NewFieldError("aliases", fmt.Sprintf("Alias %s is invalid.", a)))
+				return
+			}
+			apiErr, err := s.checkDomainFQDN(a, !domain.HasAlias(a), u.ID)
+			switch apiErr {
+			case nil:
+			case api.ErrDomainNeedsVerification:
+				jsonresponse.BadRequest(w, validationFormErrorResponse{
+					FormErrors: web.NewFieldError("aliases", fmt.Sprintf("Alias %s is not verified.", a)),
+					Tokens:     s.domainVerificationTokens(a, s.domainVerificationToken(u.ID, a), true),
+				})
+				return
+			case api.ErrDomainAlreadyExists:
+				jsonresponse.BadRequest(w, web.NewFieldError("aliases", fmt.Sprintf("Alias %s is already registered as a domain.", a)))
+				return
+			default:
+				jsonresponse.BadRequest(w, web.NewFieldError("aliases", fmt.Sprintf("Alias %s is not available.", a)))
+				return
+			}
+			if err != nil {
+				s.Logger.Errorf("domain fe api: alias %s: %s", a, err)
+				jsonServerError(w, err)
+				return
+			}
+			aliases = append(aliases, a)
+		}
+	}
+
+	if request.DNSProvider != nil && *request.DNSProvider != "" {
+		names, err := s.CertificateService.ACMEDNSProviders()
+		if err != nil {
+			s.Logger.Errorf("domain fe api: dns providers: %s", err)
+			jsonServerError(w, err)
+			return
+		}
+		found := false
+		for _, name := range names {
+			if name == *request.DNSProvider {
+				found = true
+				break
+			}
+		}
+		if !found {
+			jsonresponse.BadRequest(w, web.NewFieldError("dnsProvider", "Unknown DNS provider."))
+			return
+		}
+	}
+	if request.KeyType != nil && *request.KeyType != "" && !certificate.IsValidKeyType(*request.KeyType) {
+		jsonresponse.BadRequest(w, web.NewFieldError("keyType", "Unknown key type."))
+		return
+	}
+
 	disabled := request.Disabled.Bool()
 
 	var editedDomain *packages.Domain
 	if id == "" {
-		t := true
-		editedDomain, err = s.PackagesService.AddDomain(&packages.DomainOptions{
-			FQDN:        &request.FQDN,
-			OwnerUserID: &u.ID,
-			Disabled:    &disabled,
-
-			CertificateIgnoreMissing: &t,
-		}, u.ID)
+		o := &packages.DomainOptions{
+			FQDN:     &request.FQDN,
+			Disabled: &disabled,
+		}
+		if !packages.IsWildcardFQDN(fqdn) {
+			t := true
+			o.CertificateIgnoreMissing = &t
+		}
+		if request.OrganizationID != "" {
+			o.OrganizationID = &request.OrganizationID
+		} else {
+			o.OwnerUserID = &u.ID
+		}
+		editedDomain, err = s.PackagesService.AddDomain(o, u.ID)
 	} else {
 		certificateIgnore := request.CertificateIgnore.Bool()
-		editedDomain, err = s.PackagesService.UpdateDomain(id, &packages.DomainOptions{
+		aliasRedirect := request.AliasRedirect.Bool()
+		o := &packages.DomainOptions{
 			FQDN:              &request.FQDN,
 			CertificateIgnore: &certificateIgnore,
 			Disabled:          &disabled,
-		}, u.ID)
+			Aliases:           &aliases,
+			AliasRedirect:     &aliasRedirect,
+		}
+		switch {
+		case request.OrganizationID == domain.OrganizationID:
+		case request.OrganizationID != "":
+			o.OrganizationID = &request.OrganizationID
+		default:
+			// Organization owner takes the ownership of the domain.
+			o.OwnerUserID = &u.ID
+		}
+		editedDomain, err = s.PackagesService.UpdateDomain(id, o, u.ID)
 	}
 	if err != nil {
 		switch err {
@@ -274,6 +376,24 @@ func (s *Server) domainFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 		case packages.ErrDomainAlreadyExists:
 			jsonresponse.BadRequest(w, web.NewFieldError("fqdn", "Domain is already registered."))
 			return
+		case packages.ErrDomainAliasInvalid:
+			jsonresponse.BadRequest(w, web.NewFieldError("aliases", "Aliases are invalid."))
+			return
+		case packages.ErrDomainAliasAlreadyExists:
+			jsonresponse.BadRequest(w, web.NewFieldError("aliases", "Alias is already used by another domain."))
+			return
+		case packages.ErrDomainQuotaExceeded:
+			jsonresponse.BadRequest(w, web.NewError("Domain quota is exceeded."))
+			return
+		case packages.ErrPackageQuotaExceeded:
+			jsonresponse.BadRequest(w, web.NewError("Package quota is exceeded."))
+			return
+		case packages.ErrOrganizationNotFound:
+			jsonresponse.BadRequest(w, web.NewFieldError("organizationId", "Unknown organization."))
+			return
+		case packages.ErrForbidden:
+			jsonresponse.BadRequest(w, web.NewFieldError("organizationId", "Only organization owners can give domains to the organization."))
+			return
 		case nil:
 		default:
 			s.Logger.Errorf("domain fe api: add/update domain %s: %s", id, err)
@@ -286,7 +406,9 @@ func (s *Server) domainFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 	// - it is the new domain (id == "")
 	// - TLS server is active (s.tlsEnabled == true)
 	// - the domain as actually created (editedDomain != nil)
-	if id == "" && s.tlsEnabled && editedDomain != nil {
+	// - it is not a wildcard domain, as certificates for its hosts
+	//   are obtained on their first request
+	if id == "" && s.tlsEnabled && editedDomain != nil && !editedDomain.IsWildcard() {
 		go func() {
 			defer s.RecoveryService.Recover()
 			defer func() {
@@ -311,6 +433,47 @@ func (s *Server) domainFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 		}()
 	}
 
+	if domain != nil && editedDomain != nil {
+		s.moveDomainACMESettings(domain.FQDN, editedDomain.FQDN)
+
+		if request.DNSProvider != nil || request.KeyType != nil {
+			previous, err := s.CertificateService.ACMESettings(editedDomain.FQDN)
+			switch err {
+			case nil:
+			case certificate.ErrACMESettingsNotFound:
+				previous = &certificate.ACMESettings{FQDN: editedDomain.FQDN}
+			default:
+				s.Logger.Errorf("domain fe api: acme settings %s: %s", editedDomain.FQDN, err)
+				jsonServerError(w, err)
+				return
+			}
+			settings, err := s.CertificateService.UpdateACMESettings(editedDomain.FQDN, &certificate.ACMESettingsOptions{
+				DNSProvider: request.DNSProvider,
+				KeyType:     request.KeyType,
+			})
+			if err != nil {
+				s.Logger.Errorf("domain fe api: update acme settings %s: %s", editedDomain.FQDN, err)
+				jsonServerError(w, err)
+				return
+			}
+			s.obtainWildcardDomainCertificate(editedDomain, settings, u.ID)
+			if settings.KeyType != previous.KeyType {
+				s.reissueDomainCertificate(editedDomain, u.ID)
+			}
+		}
+	}
+
+	// Obtain certificates for new aliases.
+	if id != "" && editedDomain != nil && !editedDomain.CertificateIgnore {
+		newAliases := []string{}
+		for _, a := range editedDomain.Aliases {
+			if !domain.HasAlias(a) {
+				newAliases = append(newAliases, a)
+			}
+		}
+		s.obtainDomainAliasCertificates(newAliases, u.ID)
+	}
+
 	action := "domain update"
 	if id == "" {
 		action = "domain add"
@@ -329,7 +492,7 @@ func (s *Server) domainDeleteFEAPIHandler(w http.ResponseWriter, r *http.Request
 	vars := mux.Vars(r)
 	id := vars["id"]
 
-	deletedDomain, err := s.PackagesService.DeleteDomain(id, u.ID)
+	deletedDomain, err := s.PackagesService.DeleteDomain(id, nil, u.ID)
 	if err != nil {
 		switch err {
 		case packages.ErrDomainNotFound:
@@ -343,6 +506,8 @@ func (s *Server) domainDeleteFEAPIHandler(w http.ResponseWriter, r *http.Request
 		}
 	}
 
+	s.moveDomainACMESettings(deletedDomain.FQDN, "")
+
 	s.Logger.Debugf("domain delete fe api: %s deleted by %s", deletedDomain.ID, u.ID)
 
 	s.auditf(r, nil, "domain delete", "%s: %s", deletedDomain.ID, deletedDomain.FQDN)
@@ -354,13 +519,18 @@ type userIDRequest struct {
 	ID string `json:"id"`
 }
 
+type domainUserRoleRequest struct {
+	ID   string `json:"id"`
+	Role string `json:"role"`
+}
+
 func (s *Server) domainUserGrantFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 	u, r, err := s.getRequestUser(r)
 	if err != nil {
 		panic(err)
 	}
 
-	request := userIDRequest{}
+	request := domainUserRoleRequest{}
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		s.Logger.Warningf("domain user grant fe api: request decode: %s", err)
 		jsonresponse.BadRequest(w, web.NewError("Invalid data."))
@@ -390,7 +560,7 @@ func (s *Server) domainUserGrantFEAPIHandler(w http.ResponseWriter, r *http.Requ
 		jsonServerError(w, err)
 		return
 	}
-	err = s.PackagesService.AddUserToDomain(domainID, grantUser.ID, u.ID)
+	err = s.PackagesService.AddUserToDomain(domainID, grantUser.ID, packages.DomainRole(request.Role), u.ID)
 	switch err {
 	case packages.ErrDomainNotFound:
 		jsonresponse.BadRequest(w, web.NewError("Unknown domain."))
@@ -398,6 +568,9 @@ func (s *Server) domainUserGrantFEAPIHandler(w http.ResponseWriter, r *http.Requ
 	case packages.ErrUserExists:
 		jsonresponse.BadRequest(w, web.NewFieldError("id", "This user is already granted."))
 		return
+	case packages.ErrDomainRoleInvalid:
+		jsonresponse.BadRequest(w, web.NewFieldError("role", "Invalid role."))
+		return
 	case packages.ErrForbidden:
 		jsonresponse.BadRequest(w, web.NewError("You do not have permission to revoke user."))
 		return
@@ -413,6 +586,63 @@ func (s *Server) domainUserGrantFEAPIHandler(w http.ResponseWriter, r *http.Requ
 	jsonresponse.OK(w, nil)
 }
 
+func (s *Server) domainUserRoleFEAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	request := domainUserRoleRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.Logger.Warningf("domain user role fe api: request decode: %s", err)
+		jsonresponse.BadRequest(w, web.NewError("Invalid data."))
+		return
+	}
+
+	if request.ID == "" {
+		jsonresponse.BadRequest(w, web.NewFieldError("id", "User is required."))
+		return
+	}
+
+	domainID := mux.Vars(r)["id"]
+
+	roleUser, err := s.UserService.User(request.ID)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			s.Logger.Warningf("domain user role fe api: user %s: %s", request.ID, err)
+			jsonresponse.BadRequest(w, web.NewFieldError("id", "Unknown user."))
+			return
+		}
+		s.Logger.Errorf("domain user role fe api: user %s: %s", request.ID, err)
+		jsonServerError(w, err)
+		return
+	}
+	err = s.PackagesService.SetDomainUserRole(domainID, roleUser.ID, packages.DomainRole(request.Role), u.ID)
+	switch err {
+	case packages.ErrDomainNotFound:
+		jsonresponse.BadRequest(w, web.NewError("Unknown domain."))
+		return
+	case packages.ErrUserDoesNotExist:
+		jsonresponse.BadRequest(w, web.NewFieldError("id", "This user is not granted."))
+		return
+	case packages.ErrDomainRoleInvalid:
+		jsonresponse.BadRequest(w, web.NewFieldError("role", "Invalid role."))
+		return
+	case packages.ErrForbidden:
+		jsonresponse.BadRequest(w, web.NewError("You do not have permission to change user role."))
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("domain user role fe api: set user role on domain %s: %s", domainID, err)
+		jsonServerError(w, err)
+		return
+	}
+
+	s.audit(r, request, "domain user role", domainID)
+
+	jsonresponse.OK(w, nil)
+}
+
 func (s *Server) domainUserRevokeFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 	u, r, err := s.getRequestUser(r)
 	if err != nil {
@@ -511,12 +741,19 @@ func (s *Server) domainOwnerChangeFEAPIHandler(w http.ResponseWriter, r *http.Re
 		}
 	}
 
-	if domain.OwnerUserID != u.ID {
+	role, err := s.domainUserRole(domain.ID, u.ID)
+	if err != nil {
+		s.Logger.Errorf("domain owner change fe api: domain %s: user role %s: %s", domainID, u.ID, err)
+		jsonServerError(w, err)
+		return
+	}
+
+	if role != packages.DomainRoleOwner {
 		jsonresponse.BadRequest(w, web.NewError("You do not have permission to change the owner of this domain."))
 		return
 	}
 
-	if request.ID == u.Username || request.ID == u.Email || request.ID == u.ID {
+	if domain.OwnerUserID == u.ID && (request.ID == u.Username || request.ID == u.Email || request.ID == u.ID) {
 		jsonresponse.BadRequest(w, web.NewFieldError("id", "You are already the owner."))
 		return
 	}
@@ -532,25 +769,230 @@ func (s *Server) domainOwnerChangeFEAPIHandler(w http.ResponseWriter, r *http.Re
 		jsonServerError(w, err)
 		return
 	}
-	domain, err = s.PackagesService.UpdateDomain(domainID, &packages.DomainOptions{
-		OwnerUserID: &owner.ID,
-	}, u.ID)
+	transfer, err := s.requestDomainTransfer(r, domainID, owner, u)
 	switch err {
 	case packages.ErrDomainNotFound:
 		jsonresponse.BadRequest(w, web.NewError("Unknown domain."))
 		return
+	case packages.ErrDomainTransferAlreadyExists:
+		jsonresponse.BadRequest(w, web.NewError("Transfer of this domain is already requested."))
+		return
+	case packages.ErrDomainTransferToOwner:
+		jsonresponse.BadRequest(w, web.NewFieldError("id", "You are already the owner."))
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("domain owner change fe api: request domain transfer %s: %s", domainID, err)
+		jsonServerError(w, err)
+		return
+	}
+
+	s.auditf(r, request, "domain transfer request", "%s: %s to %s: %s", transfer.DomainID, transfer.FQDN, owner.ID, owner.Email)
+
+	jsonresponse.OK(w, transfer)
+}
+
+func (s *Server) domainTransferAcceptFEAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	domainID := mux.Vars(r)["id"]
+
+	domain, err := s.PackagesService.AcceptDomainTransfer(domainID, u.ID)
+	switch err {
+	case packages.ErrDomainNotFound, packages.ErrDomainTransferNotFound, packages.ErrForbidden:
+		s.Logger.Warningf("domain transfer accept fe api: domain %s: user %s: %s", domainID, u.ID, err)
+		jsonresponse.BadRequest(w, web.NewError("Unknown domain transfer."))
+		return
+	case packages.ErrDomainTransferExpired:
+		jsonresponse.BadRequest(w, web.NewError("Domain transfer has expired."))
+		return
+	case packages.ErrDomainQuotaExceeded:
+		jsonresponse.BadRequest(w, web.NewError("Domain quota is exceeded."))
+		return
+	case packages.ErrPackageQuotaExceeded:
+		jsonresponse.BadRequest(w, web.NewError("Package quota is exceeded."))
+		return
 	case nil:
 	default:
-		s.Logger.Errorf("domain owner change fe api: update domain %s: %s", domainID, err)
+		s.Logger.Errorf("domain transfer accept fe api: domain %s: %s", domainID, err)
 		jsonServerError(w, err)
 		return
 	}
 
-	s.auditf(r, request, "domain owner change", "%s: %s to %s: %s", domain.ID, domain.FQDN, owner.ID, owner.Email)
+	s.auditf(r, nil, "domain transfer accept", "%s: %s", domain.ID, domain.FQDN)
 
 	jsonresponse.OK(w, domain)
 }
 
+func (s *Server) domainTransferCancelFEAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	domainID := mux.Vars(r)["id"]
+
+	transfer, err := s.PackagesService.CancelDomainTransfer(domainID, u.ID)
+	switch err {
+	case packages.ErrDomainNotFound, packages.ErrDomainTransferNotFound, packages.ErrForbidden:
+		s.Logger.Warningf("domain transfer cancel fe api: domain %s: user %s: %s", domainID, u.ID, err)
+		jsonresponse.BadRequest(w, web.NewError("Unknown domain transfer."))
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("domain transfer cancel fe api: domain %s: %s", domainID, err)
+		jsonServerError(w, err)
+		return
+	}
+
+	s.auditf(r, nil, "domain transfer cancel", "%s: %s to %s", transfer.DomainID, transfer.FQDN, transfer.ToUserID)
+
+	jsonresponse.OK(w, transfer)
+}
+
+type domainPackageDefaultsFEAPIRequest struct {
+	VCS         packages.VCS     `json:"vcs"`
+	RepoRoot    string           `json:"repoRoot"`
+	RefType     packages.RefType `json:"refType"`
+	RefName     string           `json:"refName"`
+	GoSource    string           `json:"goSource"`
+	RedirectURL string           `json:"redirectUrl"`
+}
+
+func (s *Server) domainPackageDefaultsFEAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	domainID := mux.Vars(r)["id"]
+
+	request := domainPackageDefaultsFEAPIRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.Logger.Warningf("domain package defaults fe api: request decode: %s", err)
+		jsonresponse.BadRequest(w, web.NewError("Invalid data."))
+		return
+	}
+
+	domain, err := s.PackagesService.Domain(domainID)
+	if err != nil {
+		if err == packages.ErrDomainNotFound {
+			jsonresponse.BadRequest(w, web.NewError("Unknown domain."))
+			return
+		}
+		s.Logger.Errorf("domain package defaults fe api: domain %s: %s", domainID, err)
+		jsonServerError(w, err)
+		return
+	}
+
+	defaults, apiErr, err := apiPackageDefaults(api.PackageDefaults{
+		VCS:         api.VCS(request.VCS),
+		RepoRoot:    request.RepoRoot,
+		RefType:     api.RefType(request.RefType),
+		RefName:     request.RefName,
+		GoSource:    request.GoSource,
+		RedirectURL: request.RedirectURL,
+	}, domain.FQDN)
+	if err != nil {
+		s.Logger.Warningf("domain package defaults fe api: domain %s: %s", domainID, err)
+		switch apiErr {
+		case api.ErrPackageRepoRootInvalid, api.ErrPackageRepoRootSchemeRequired, api.ErrPackageRepoRootSchemeInvalid, api.ErrPackageRepoRootHostInvalid:
+			jsonresponse.BadRequest(w, web.NewFieldError("repoRoot", "Invalid Repository URL."))
+		case api.ErrPackageRefTypeInvalid:
+			jsonresponse.BadRequest(w, web.NewFieldError("refType", "Reference type must be branch or tag."))
+		case api.ErrPackageRefNameRequired:
+			jsonresponse.BadRequest(w, web.NewFieldError("refName", "Reference name is required if reference type is selected."))
+		case api.ErrPackageRedirectURLInvalid:
+			jsonresponse.BadRequest(w, web.NewFieldError("redirectUrl", "Invalid URL."))
+		default:
+			jsonresponse.BadRequest(w, web.NewError("Invalid package defaults."))
+		}
+		return
+	}
+
+	_, err = s.PackagesService.UpdateDomain(domain.ID, &packages.DomainOptions{
+		PackageDefaults: defaults,
+	}, u.ID)
+	switch err {
+	case packages.ErrDomainNotFound:
+		jsonresponse.BadRequest(w, web.NewError("Unknown domain."))
+		return
+	case packages.ErrForbidden:
+		jsonresponse.BadRequest(w, web.NewError("You do not have permission to change package defaults."))
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("domain package defaults fe api: update domain %s: %s", domain.ID, err)
+		jsonServerError(w, err)
+		return
+	}
+
+	s.audit(r, request, "domain package defaults", domain.ID)
+
+	jsonresponse.OK(w, nil)
+}
+
+type domainPackageDefaultsApplyFEAPIRequest struct {
+	Fields []packages.PackageDefaultsField `json:"fields"`
+}
+
+func (s *Server) domainPackageDefaultsApplyFEAPIHandler(w http.ResponseWriter, r *http.Request) {
+	u, r, err := s.getRequestUser(r)
+	if err != nil {
+		panic(err)
+	}
+
+	domainID := mux.Vars(r)["id"]
+
+	request := domainPackageDefaultsApplyFEAPIRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.Logger.Warningf("domain package defaults apply fe api: request decode: %s", err)
+		jsonresponse.BadRequest(w, web.NewError("Invalid data."))
+		return
+	}
+
+	if len(request.Fields) == 0 {
+		jsonresponse.BadRequest(w, web.NewFieldError("fields", "Select at least one field."))
+		return
+	}
+
+	plan, err := s.PackagesService.ApplyPackageDefaults(domainID, request.Fields, false, u.ID)
+	switch err {
+	case packages.ErrDomainNotFound:
+		jsonresponse.BadRequest(w, web.NewError("Unknown domain."))
+		return
+	case packages.ErrForbidden:
+		jsonresponse.BadRequest(w, web.NewError("You do not have permission to change packages of this domain."))
+		return
+	case packages.ErrPackageDefaultsFieldInvalid:
+		jsonresponse.BadRequest(w, web.NewFieldError("fields", "Invalid field."))
+		return
+	case packages.ErrPackageVCSRequired, packages.ErrPackageRepoRootRequired:
+		jsonresponse.BadRequest(w, web.NewError("VCS and Repository defaults are required for the selected fields."))
+		return
+	case packages.ErrPackageRepoRootInvalid, packages.ErrPackageRepoRootSchemeRequired, packages.ErrPackageRepoRootSchemeInvalid, packages.ErrPackageRepoRootHostInvalid:
+		jsonresponse.BadRequest(w, web.NewError("Package defaults produce an invalid Repository URL for some packages."))
+		return
+	case packages.ErrPackageRefChangeRejected:
+		jsonresponse.BadRequest(w, web.NewError("Reference change is allowed only for Git HTTP and HTTPS repositeries."))
+		return
+	case nil:
+	default:
+		s.Logger.Errorf("domain package defaults apply fe api: domain %s: %s", domainID, err)
+		jsonServerError(w, err)
+		return
+	}
+
+	s.auditf(r, request, "package defaults apply", "%s: %d changes", plan.Domain.ID, len(plan.Changes))
+
+	jsonresponse.OK(w, map[string]int{
+		"changes": len(plan.Changes),
+	})
+}
+
 type packageFEAPIRequest struct {
 	DomainID    string           `json:"domainId"`
 	Path        string           `json:"path"`
@@ -590,6 +1032,33 @@ func (s *Server) packageFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 		errors.AddFieldError("path", "Path is required.")
 	}
 
+	if id == "" && request.DomainID != "" && request.Path != "" {
+		domain, err := s.PackagesService.Domain(request.DomainID)
+		switch err {
+		case packages.ErrDomainNotFound:
+		case nil:
+			if domain.PackageDefaults != nil {
+				d := domain.PackageDefaults.ForPackage(domain.FQDN, request.Path)
+				if request.VCS == "" {
+					request.VCS = d.VCS
+				}
+				if strings.TrimSpace(request.RepoRoot) == "" {
+					request.RepoRoot = d.RepoRoot
+				}
+				if request.GoSource == "" {
+					request.GoSource = d.GoSource
+				}
+				if request.RedirectURL == "" {
+					request.RedirectURL = d.RedirectURL
+				}
+			}
+		default:
+			s.Logger.Errorf("package fe api: domain %s: %s", request.DomainID, err)
+			jsonServerError(w, err)
+			return
+		}
+	}
+
 	if request.VCS == "" {
 		errors.AddFieldError("vcs", "VCS is required.")
 	}
@@ -728,6 +1197,9 @@ func (s *Server) packageFEAPIHandler(w http.ResponseWriter, r *http.Request) {
 	case packages.ErrPackageAlreadyExists:
 		jsonresponse.BadRequest(w, web.NewFieldError("path", "Package already exists."))
 		return
+	case packages.ErrPackageQuotaExceeded:
+		jsonresponse.BadRequest(w, web.NewError("Package quota is exceeded."))
+		return
 	case nil:
 	default:
 		s.Logger.Errorf("package fe api: add/update package %s: %s", id, err)
@@ -753,7 +1225,7 @@ func (s *Server) packageDeleteFEAPIHandler(w http.ResponseWriter, r *http.Reques
 	id := mux.Vars(r)["id"]
 
 	// Delete package checks permissions.
-	p, err := s.PackagesService.DeletePackage(id, u.ID)
+	p, err := s.PackagesService.DeletePackage(id, nil, u.ID)
 	switch err {
 	case packages.ErrForbidden:
 		jsonresponse.BadRequest(w, web.NewError("You do not have permission to add packages to this domain."))
@@ -773,7 +1245,7 @@ func (s *Server) packageDeleteFEAPIHandler(w http.ResponseWriter, r *http.Reques
 
 	s.Logger.Debugf("package delete fe api: %s deleted by %s", p.ID, u.ID)
 
-	s.auditf(r, nil, "package delete", "%s: %s", p.ID, p.ImportPrefix)
+	s.auditf(r, nil, "package delete", "%s: %s", p.ID, p.ImportPrefix())
 
 	jsonresponse.OK(w, p)
 }
@@ -20,6 +20,7 @@ import (
 	"resenje.org/web/recovery"
 
 	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/packages"
 	"gopherpit.com/gopherpit/services/user"
 )
 
@@ -427,9 +428,13 @@ func (s *Server) apiKeyAuthHandler(h http.Handler, body, contentType string) htt
 			}
 
 			entity, err = s.UserService.UserByID(k.Ref)
+			if err == user.ErrUserNotFound {
+				// Organization keys are referenced by organization IDs.
+				entity, err = s.PackagesService.Organization(k.Ref)
+			}
 			if err != nil {
-				err = nil
 				s.Logger.Errorf("api key auth: get user by id %s: %s", k.Ref, err)
+				err = nil
 				return
 			}
 			valid = true
@@ -437,6 +442,11 @@ func (s *Server) apiKeyAuthHandler(h http.Handler, body, contentType string) htt
 		},
 		PostAuthFunc: func(_ http.ResponseWriter, r *http.Request, valid bool, entity interface{}) (rr *http.Request, err error) {
 			if valid && entity != nil {
+				if o, ok := entity.(*packages.Organization); ok {
+					ctx := context.WithValue(r.Context(), contextKeyOrganization, o)
+					rr = r.WithContext(context.WithValue(ctx, contextKeyUser, organizationKeyUser(o)))
+					return
+				}
 				rr = r.WithContext(context.WithValue(r.Context(), contextKeyUser, entity))
 			}
 			return
@@ -101,12 +101,14 @@ func (s *Server) acmeUserHandler(h http.Handler) http.Handler {
 							"User":                u,
 							"ProductionDirectory": s.ACMEDirectoryURL,
 							"StagingDirectory":    s.ACMEDirectoryURLStaging,
+							"EABConfigured":       s.ACMEEABKeyID != "",
 						})
 						return
 					}
 					s.html.Respond(w, "RegisterACMEUser", map[string]interface{}{
 						"ProductionDirectory": s.ACMEDirectoryURL,
 						"StagingDirectory":    s.ACMEDirectoryURLStaging,
+						"EABConfigured":       s.ACMEEABKeyID != "",
 					})
 					return
 				}
@@ -109,6 +109,24 @@ func (s *Server) packageResolverHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// Redirect requests to domain aliases to the canonical domain.
+	if resolution.AliasRedirect && resolution.CanonicalImportPrefix != "" {
+		canonicalDomain := resolution.CanonicalImportPrefix
+		if i := strings.IndexRune(canonicalDomain, '/'); i >= 0 {
+			canonicalDomain = canonicalDomain[:i]
+		}
+		if _, port, err := net.SplitHostPort(r.Host); err == nil {
+			canonicalDomain = net.JoinHostPort(canonicalDomain, port)
+		}
+		scheme := "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+		code = http.StatusMovedPermanently
+		http.Redirect(w, r, scheme+"://"+canonicalDomain+r.URL.RequestURI(), code)
+		return
+	}
+
 	code = 200
 
 	repoRoot := resolution.RepoRoot
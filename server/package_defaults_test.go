@@ -0,0 +1,207 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+func TestPackageDefaults(t *testing.T) {
+	s, err := newTestServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	username := "alice"
+	email := "alice@localhost.loc"
+	u, err := s.UserService.CreateUser(&user.Options{
+		Email:    &email,
+		Username: &username,
+	})
+	if err != nil {
+		t.Fatalf("create user: %s", err)
+	}
+	_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+	if err != nil {
+		t.Fatalf("parse IPv4 net: %s", err)
+	}
+	_, ipV6Net, err := net.ParseCIDR("::/0")
+	if err != nil {
+		t.Fatalf("parse IPv6 net: %s", err)
+	}
+	k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+		AuthorizedNetworks: &[]net.IPNet{
+			*ipV4Net,
+			*ipV6Net,
+		},
+	})
+	if err != nil {
+		t.Fatalf("create key: %s", err)
+	}
+	c := api.NewClientWithEndpoint("localhost:"+strconv.Itoa(s.servers.Addr("HTTP").Port)+"/api/v1", k.Secret)
+
+	fqdn := "defaults.trusted.com"
+	if _, err := c.AddDomain(&api.DomainOptions{FQDN: &fqdn}); err != nil {
+		t.Fatal(err)
+	}
+
+	vcs := api.VCSGit
+	oldRepoRoot := "https://github.com/other/old.git"
+	path := "/old"
+	if _, err := c.AddPackage(&api.PackageOptions{
+		Domain:   &fqdn,
+		Path:     &path,
+		VCS:      &vcs,
+		RepoRoot: &oldRepoRoot,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("set defaults", func(t *testing.T) {
+		if _, err := c.UpdateDomain(fqdn, &api.DomainOptions{
+			PackageDefaults: &api.PackageDefaults{
+				VCS:      api.VCSGit,
+				RepoRoot: "github.com/me/{path}",
+			},
+		}); err != api.ErrPackageRepoRootSchemeRequired {
+			t.Errorf("expected error %v, got %v", api.ErrPackageRepoRootSchemeRequired, err)
+		}
+		d, err := c.UpdateDomain(fqdn, &api.DomainOptions{
+			PackageDefaults: &api.PackageDefaults{
+				VCS:         api.VCSGit,
+				RepoRoot:    "https://github.com/me/{path}.git",
+				RefType:     api.RefTypeBranch,
+				RefName:     "develop",
+				RedirectURL: "https://{domain}/docs/{path}",
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.PackageDefaults == nil || d.PackageDefaults.RepoRoot != "https://github.com/me/{path}.git" {
+			t.Errorf("expected package defaults, got %#v", d.PackageDefaults)
+		}
+	})
+
+	t.Run("add package", func(t *testing.T) {
+		path := "/lib/sub"
+		p, err := c.AddPackage(&api.PackageOptions{
+			Domain: &fqdn,
+			Path:   &path,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.VCS != api.VCSGit {
+			t.Errorf("expected vcs %q, got %q", api.VCSGit, p.VCS)
+		}
+		if p.RepoRoot != "https://github.com/me/lib/sub.git" {
+			t.Errorf("expected repo root %q, got %q", "https://github.com/me/lib/sub.git", p.RepoRoot)
+		}
+		if p.RefType != api.RefTypeBranch || p.RefName != "develop" {
+			t.Errorf("expected reference branch develop, got %s %s", p.RefType, p.RefName)
+		}
+		if p.RedirectURL != "https://defaults.trusted.com/docs/lib/sub" {
+			t.Errorf("expected redirect url %q, got %q", "https://defaults.trusted.com/docs/lib/sub", p.RedirectURL)
+		}
+
+		path = "/explicit"
+		repoRoot := "https://github.com/other/explicit.git"
+		refType := api.RefTypeTag
+		refName := "v1.0.0"
+		p, err = c.AddPackage(&api.PackageOptions{
+			Domain:   &fqdn,
+			Path:     &path,
+			RepoRoot: &repoRoot,
+			RefType:  &refType,
+			RefName:  &refName,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.RepoRoot != repoRoot {
+			t.Errorf("expected repo root %q, got %q", repoRoot, p.RepoRoot)
+		}
+		if p.RefType != refType || p.RefName != refName {
+			t.Errorf("expected reference %s %s, got %s %s", refType, refName, p.RefType, p.RefName)
+		}
+	})
+
+	t.Run("apply defaults", func(t *testing.T) {
+		if _, err := c.ApplyDomainPackageDefaults(fqdn, []api.PackageDefaultsField{"unknown"}, true); err != api.ErrPackageDefaultsFieldInvalid {
+			t.Errorf("expected error %v, got %v", api.ErrPackageDefaultsFieldInvalid, err)
+		}
+
+		fields := []api.PackageDefaultsField{api.PackageDefaultsFieldRedirectURL}
+		plan, err := c.ApplyDomainPackageDefaults(fqdn, fields, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if plan.Applied {
+			t.Error("expected dry run plan not to be applied")
+		}
+		if len(plan.Changes) != 1 || plan.Changes[0].Package.Path != "/old" {
+			t.Fatalf("expected change of package /old, got %v", plan.Changes)
+		}
+		page, err := c.DomainPackages(fqdn, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range page.Packages {
+			if p.Path == "/old" && p.RedirectURL != "" {
+				t.Errorf("expected dry run not to change redirect url, got %q", p.RedirectURL)
+			}
+		}
+
+		plan, err = c.ApplyDomainPackageDefaults(fqdn, fields, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !plan.Applied {
+			t.Error("expected plan to be applied")
+		}
+		page, err = c.DomainPackages(fqdn, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range page.Packages {
+			want := "https://defaults.trusted.com/docs" + p.Path
+			if p.RedirectURL != want {
+				t.Errorf("%s: expected redirect url %q, got %q", p.Path, want, p.RedirectURL)
+			}
+			if p.Path == "/old" && p.RepoRoot != oldRepoRoot {
+				t.Errorf("expected repo root %q, got %q", oldRepoRoot, p.RepoRoot)
+			}
+		}
+
+		plan, err = c.ApplyDomainPackageDefaults(fqdn, fields, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(plan.Changes) != 0 {
+			t.Errorf("expected no changes, got %d", len(plan.Changes))
+		}
+	})
+
+	t.Run("remove defaults", func(t *testing.T) {
+		d, err := c.UpdateDomain(fqdn, &api.DomainOptions{
+			PackageDefaults: &api.PackageDefaults{},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.PackageDefaults != nil {
+			t.Errorf("expected no package defaults, got %#v", d.PackageDefaults)
+		}
+	})
+}
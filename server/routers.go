@@ -54,8 +54,20 @@ func newRouter(s *Server, assetsServer *fileServer.Server) http.Handler {
 		jsonMaxBodyBytesHandler,
 		s.jsonAPIKeyAuthHandler,
 		s.jsonValidatedEmailRequiredHandler,
+		s.jsonAPIRateLimiterHandler,
 		web.FinalHandler(newAPIRouter(s)),
 	))
+	// OpenAPI description is publicly available without API key.
+	baseRouter.Handle("/api/v1/openapi.json", web.ChainHandlers(
+		handlers.CompressHandler,
+		s.jsonRecoveryHandler,
+		s.accessLogHandler,
+		s.apiDisabledHandler,
+		s.MaintenanceService.JSONHandler,
+		web.FinalHandler(jsonMethodHandler{
+			"GET": http.HandlerFunc(s.openAPIHandler),
+		}),
+	))
 
 	// Final handler
 	return web.ChainHandlers(
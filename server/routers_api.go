@@ -20,14 +20,14 @@ func newAPIRouter(s *Server) http.Handler {
 	apiRouter.Handle("/api/v1/domains", jsonMethodHandler{
 		"GET": http.HandlerFunc(s.domainsAPIHandler),
 		"POST": web.ChainHandlers(
-			s.jsonAPIRateLimiterHandler,
+			s.jsonAPIDomainRateLimiterHandler,
 			web.FinalHandlerFunc(s.updateDomainAPIHandler),
 		),
 	})
 	apiRouter.Handle("/api/v1/domains/{id}", jsonMethodHandler{
 		"GET": http.HandlerFunc(s.domainAPIHandler),
 		"POST": web.ChainHandlers(
-			s.jsonAPIRateLimiterHandler,
+			s.jsonAPIDomainRateLimiterHandler,
 			web.FinalHandlerFunc(s.updateDomainAPIHandler),
 		),
 		"DELETE": http.HandlerFunc(s.deleteDomainAPIHandler),
@@ -42,10 +42,60 @@ func newAPIRouter(s *Server) http.Handler {
 		"POST":   http.HandlerFunc(s.grantDomainUserAPIHandler),
 		"DELETE": http.HandlerFunc(s.revokeDomainUserAPIHandler),
 	})
+	apiRouter.Handle("/api/v1/domains/{id}/users/{user-id}/role", jsonMethodHandler{
+		"POST": http.HandlerFunc(s.updateDomainUserRoleAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/domains/{id}/transfer", jsonMethodHandler{
+		"GET":    http.HandlerFunc(s.domainTransferAPIHandler),
+		"POST":   http.HandlerFunc(s.requestDomainTransferAPIHandler),
+		"DELETE": http.HandlerFunc(s.cancelDomainTransferAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/domains/{id}/transfer/accept", jsonMethodHandler{
+		"POST": http.HandlerFunc(s.acceptDomainTransferAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/domains/{id}/acme-settings", jsonMethodHandler{
+		"GET":  http.HandlerFunc(s.domainACMESettingsAPIHandler),
+		"POST": http.HandlerFunc(s.updateDomainACMESettingsAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/domains/{id}/certificate", jsonMethodHandler{
+		"GET": http.HandlerFunc(s.domainCertificateAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/domains/{id}/certificate/renew", jsonMethodHandler{
+		"POST": http.HandlerFunc(s.renewDomainCertificateAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/domain-transfers", jsonMethodHandler{
+		"GET": http.HandlerFunc(s.domainTransfersAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/organizations", jsonMethodHandler{
+		"GET":  http.HandlerFunc(s.organizationsAPIHandler),
+		"POST": http.HandlerFunc(s.updateOrganizationAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/organizations/{id}", jsonMethodHandler{
+		"GET":    http.HandlerFunc(s.organizationAPIHandler),
+		"POST":   http.HandlerFunc(s.updateOrganizationAPIHandler),
+		"DELETE": http.HandlerFunc(s.deleteOrganizationAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/organizations/{id}/members", jsonMethodHandler{
+		"GET": http.HandlerFunc(s.organizationMembersAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/organizations/{id}/members/{user-id}", jsonMethodHandler{
+		"POST":   http.HandlerFunc(s.setOrganizationMemberAPIHandler),
+		"DELETE": http.HandlerFunc(s.removeOrganizationMemberAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/organizations/{id}/domains", jsonMethodHandler{
+		"GET": http.HandlerFunc(s.organizationDomainsAPIHandler),
+	})
 	apiRouter.Handle("/api/v1/domains/{id}/packages", jsonMethodHandler{
 		"GET": http.HandlerFunc(s.domainPackagesAPIHandler),
 	})
+	apiRouter.Handle("/api/v1/domains/{id}/packages/sync", jsonMethodHandler{
+		"POST": http.HandlerFunc(s.syncDomainPackagesAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/domains/{id}/packages/defaults", jsonMethodHandler{
+		"POST": http.HandlerFunc(s.applyDomainPackageDefaultsAPIHandler),
+	})
 	apiRouter.Handle("/api/v1/packages", jsonMethodHandler{
+		"GET":  http.HandlerFunc(s.searchPackagesAPIHandler),
 		"POST": http.HandlerFunc(s.updatePackageAPIHandler),
 	})
 	apiRouter.Handle("/api/v1/packages/{id}", jsonMethodHandler{
@@ -53,6 +103,33 @@ func newAPIRouter(s *Server) http.Handler {
 		"POST":   http.HandlerFunc(s.updatePackageAPIHandler),
 		"DELETE": http.HandlerFunc(s.deletePackageAPIHandler),
 	})
+	apiRouter.Handle("/api/v1/batch", jsonMethodHandler{
+		"POST": web.ChainHandlers(
+			s.jsonAPIDomainRateLimiterHandler,
+			web.FinalHandlerFunc(s.batchAPIHandler),
+		),
+	})
+	apiRouter.Handle("/api/v1/users/{id}/rate-limits", jsonMethodHandler{
+		"GET":  http.HandlerFunc(s.rateLimitsAPIHandler),
+		"POST": http.HandlerFunc(s.updateRateLimitsAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/users/{id}/quota", jsonMethodHandler{
+		"GET":  http.HandlerFunc(s.quotaAPIHandler),
+		"POST": http.HandlerFunc(s.updateQuotaAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/organizations/{id}/quota", jsonMethodHandler{
+		"GET":  http.HandlerFunc(s.organizationQuotaAPIHandler),
+		"POST": http.HandlerFunc(s.updateOrganizationQuotaAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/acme/user", jsonMethodHandler{
+		"GET": http.HandlerFunc(s.acmeUserAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/acme/user/key-rollover", jsonMethodHandler{
+		"POST": http.HandlerFunc(s.rolloverACMEUserKeyAPIHandler),
+	})
+	apiRouter.Handle("/api/v1/acme/dns-providers", jsonMethodHandler{
+		"GET": http.HandlerFunc(s.dnsProvidersAPIHandler),
+	})
 	// API routes end
 	return apiRouter
 }
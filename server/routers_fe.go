@@ -169,6 +169,12 @@ func newFrontendRouter(s *Server) http.Handler {
 		antixsrf.GenerateHandler,
 		web.FinalHandlerFunc(s.domainDomainOwnerChangeHandler),
 	))
+	frontendRouter.Handle("/domain-transfer/{id}", web.ChainHandlers(
+		s.htmlLoginRequiredHandler,
+		s.htmlValidatedEmailRequiredHandler,
+		antixsrf.GenerateHandler,
+		web.FinalHandlerFunc(s.domainTransferHandler),
+	))
 	frontendRouter.Handle("/domain/{domain-id}/package", web.ChainHandlers(
 		s.htmlLoginRequiredHandler,
 		s.htmlValidatedEmailRequiredHandler,
@@ -181,6 +187,18 @@ func newFrontendRouter(s *Server) http.Handler {
 		antixsrf.GenerateHandler,
 		web.FinalHandlerFunc(s.domainPackageEditHandler),
 	))
+	frontendRouter.Handle("/organization", web.ChainHandlers(
+		s.htmlLoginRequiredHandler,
+		s.htmlValidatedEmailRequiredHandler,
+		antixsrf.GenerateHandler,
+		web.FinalHandlerFunc(s.organizationAddHandler),
+	))
+	frontendRouter.Handle("/organization/{id}", web.ChainHandlers(
+		s.htmlLoginRequiredHandler,
+		s.htmlValidatedEmailRequiredHandler,
+		antixsrf.GenerateHandler,
+		web.FinalHandlerFunc(s.organizationHandler),
+	))
 	frontendRouter.Handle("/user/{id}", web.ChainHandlers(
 		s.htmlLoginRequiredHandler,
 		web.FinalHandlerFunc(s.userPageHandler),
@@ -106,6 +106,52 @@ func newFrontendAPIRouter(s *Server) http.Handler {
 			"POST": http.HandlerFunc(s.apiRegenerateSecretFEAPIHandler),
 		}),
 	))
+	frontendAPIRouter.Handle(`/i/organization/{id}/api/key`, web.ChainHandlers(
+		s.apiDisabledHandler,
+		s.jsonLoginRequiredHandler,
+		web.FinalHandler(jsonMethodHandler{
+			"POST":   http.HandlerFunc(s.apiKeyFEAPIHandler),
+			"DELETE": http.HandlerFunc(s.apiKeyDeleteFEAPIHandler),
+		}),
+	))
+	frontendAPIRouter.Handle(`/i/organization/{id}/api/networks`, web.ChainHandlers(
+		s.apiDisabledHandler,
+		s.jsonLoginRequiredHandler,
+		web.FinalHandler(jsonMethodHandler{
+			"POST": http.HandlerFunc(s.apiNetworksFEAPIHandler),
+		}),
+	))
+	frontendAPIRouter.Handle(`/i/organization/{id}/api/secret`, web.ChainHandlers(
+		s.apiDisabledHandler,
+		s.jsonLoginRequiredHandler,
+		web.FinalHandler(jsonMethodHandler{
+			"POST": http.HandlerFunc(s.apiRegenerateSecretFEAPIHandler),
+		}),
+	))
+	// Organization
+	frontendAPIRouter.Handle(`/i/organization`, web.ChainHandlers(
+		s.jsonLoginRequiredHandler,
+		s.jsonValidatedEmailRequiredHandler,
+		web.FinalHandler(jsonMethodHandler{
+			"POST": http.HandlerFunc(s.organizationFEAPIHandler),
+		}),
+	))
+	frontendAPIRouter.Handle(`/i/organization/{id}`, web.ChainHandlers(
+		s.jsonLoginRequiredHandler,
+		s.jsonValidatedEmailRequiredHandler,
+		web.FinalHandler(jsonMethodHandler{
+			"POST":   http.HandlerFunc(s.organizationFEAPIHandler),
+			"DELETE": http.HandlerFunc(s.organizationDeleteFEAPIHandler),
+		}),
+	))
+	frontendAPIRouter.Handle(`/i/organization/{id}/member`, web.ChainHandlers(
+		s.jsonLoginRequiredHandler,
+		s.jsonValidatedEmailRequiredHandler,
+		web.FinalHandler(jsonMethodHandler{
+			"POST":   http.HandlerFunc(s.organizationMemberFEAPIHandler),
+			"DELETE": http.HandlerFunc(s.organizationMemberFEAPIHandler),
+		}),
+	))
 	// SSL Certificate
 	frontendAPIRouter.Handle(`/i/certificate/{id}`, web.ChainHandlers(
 		s.jsonLoginRequiredHandler,
@@ -138,6 +184,13 @@ func newFrontendAPIRouter(s *Server) http.Handler {
 			"DELETE": http.HandlerFunc(s.domainUserRevokeFEAPIHandler),
 		}),
 	))
+	frontendAPIRouter.Handle(`/i/domain/{id}/user/role`, web.ChainHandlers(
+		s.jsonLoginRequiredHandler,
+		s.jsonValidatedEmailRequiredHandler,
+		web.FinalHandler(jsonMethodHandler{
+			"POST": http.HandlerFunc(s.domainUserRoleFEAPIHandler),
+		}),
+	))
 	frontendAPIRouter.Handle(`/i/domain/{id}/owner`, web.ChainHandlers(
 		s.jsonLoginRequiredHandler,
 		s.jsonValidatedEmailRequiredHandler,
@@ -145,6 +198,28 @@ func newFrontendAPIRouter(s *Server) http.Handler {
 			"POST": http.HandlerFunc(s.domainOwnerChangeFEAPIHandler),
 		}),
 	))
+	frontendAPIRouter.Handle(`/i/domain/{id}/transfer`, web.ChainHandlers(
+		s.jsonLoginRequiredHandler,
+		s.jsonValidatedEmailRequiredHandler,
+		web.FinalHandler(jsonMethodHandler{
+			"POST":   http.HandlerFunc(s.domainTransferAcceptFEAPIHandler),
+			"DELETE": http.HandlerFunc(s.domainTransferCancelFEAPIHandler),
+		}),
+	))
+	frontendAPIRouter.Handle(`/i/domain/{id}/package-defaults`, web.ChainHandlers(
+		s.jsonLoginRequiredHandler,
+		s.jsonValidatedEmailRequiredHandler,
+		web.FinalHandler(jsonMethodHandler{
+			"POST": http.HandlerFunc(s.domainPackageDefaultsFEAPIHandler),
+		}),
+	))
+	frontendAPIRouter.Handle(`/i/domain/{id}/package-defaults/apply`, web.ChainHandlers(
+		s.jsonLoginRequiredHandler,
+		s.jsonValidatedEmailRequiredHandler,
+		web.FinalHandler(jsonMethodHandler{
+			"POST": http.HandlerFunc(s.domainPackageDefaultsApplyFEAPIHandler),
+		}),
+	))
 	// Package
 	frontendAPIRouter.Handle(`/i/package`, web.ChainHandlers(
 		s.jsonLoginRequiredHandler,
@@ -16,9 +16,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	assetfs "github.com/elazarl/go-bindata-assetfs"
+	"golang.org/x/crypto/acme"
 	throttled "gopkg.in/throttled/throttled.v2"
 	"resenje.org/logging"
 	"resenje.org/recovery"
@@ -29,6 +31,7 @@ import (
 	"resenje.org/web/templates"
 
 	"gopherpit.com/gopherpit/pkg/certificate-cache"
+	"gopherpit.com/gopherpit/pkg/resolver"
 	"gopherpit.com/gopherpit/server/data/assets"
 	dataTemplates "gopherpit.com/gopherpit/server/data/templates"
 	"gopherpit.com/gopherpit/services/certificate"
@@ -59,7 +62,8 @@ type Server struct {
 
 	html *templates.Templates
 
-	apiRateLimiter *throttled.GCRARateLimiter
+	apiRateLimiters   map[int]*throttled.GCRARateLimiter
+	apiRateLimitersMu sync.Mutex
 }
 
 // EmailService defines interface for sending email messages.
@@ -93,13 +97,26 @@ type Options struct {
 	ContactRecipientEmail   string
 	ACMEDirectoryURL        string
 	ACMEDirectoryURLStaging string
+	ACMEEABKeyID            string
+	ACMEEABHMACKey          string
 	SkipDomainVerification  bool
 	VerificationSubdomain   string
+	VerificationResolver    resolver.Resolver
+	VerificationNSLookup    bool
+	ReverifyPeriod          time.Duration
+	ReverifyGracePeriod     time.Duration
+	CertExpiryNotifyPeriod  time.Duration
+	CertExpiryCheckPeriod   time.Duration
+	CertExpiryRepeatPeriod  time.Duration
+	NotifyAddresses         []string
+	DomainTransferPeriod    time.Duration
 	TrustedDomains          []string
 	ForbiddenDomains        []string
 	APITrustedProxyCIDRs    []string
 	APIProxyRealIPHeader    string
 	APIHourlyRateLimit      int
+	APIHourlyReadRateLimit  int
+	APIHourlyWriteRateLimit int
 	APIEnabled              bool
 
 	Logger              *logging.Logger
@@ -138,6 +155,12 @@ func New(o Options) (s *Server, err error) {
 	if o.VerificationSubdomain == "" {
 		o.VerificationSubdomain = "_" + o.Name
 	}
+	if o.VerificationResolver == nil {
+		o.VerificationResolver = resolver.DNS{}
+	}
+	if o.DomainTransferPeriod <= 0 {
+		o.DomainTransferPeriod = 7 * 24 * time.Hour
+	}
 	s = &Server{
 		options:          o,
 		certificateCache: certificateCache.NewCache(o.CertificateService, 15*time.Minute, time.Minute),
@@ -255,19 +278,8 @@ func New(o Options) (s *Server, err error) {
 		fmt.Fprintln(w, m)
 	})
 
-	// API rate limiter
-	if s.APIHourlyRateLimit > 0 {
-		s.apiRateLimiter, err = throttled.NewGCRARateLimiter(
-			s.GCRAStoreService,
-			throttled.RateQuota{
-				MaxRate:  throttled.PerHour(1),
-				MaxBurst: s.APIHourlyRateLimit - 1,
-			},
-		)
-		if err != nil {
-			return nil, fmt.Errorf("api rate limiter: %s", err)
-		}
-	}
+	// API rate limiters are created on demand for every distinct limit.
+	s.apiRateLimiters = map[int]*throttled.GCRARateLimiter{}
 
 	tlsConfig, err := newTLSConfig(s)
 	if err != nil {
@@ -310,55 +322,62 @@ func New(o Options) (s *Server, err error) {
 func newTLSConfig(s *Server) (tlsConfig *tls.Config, err error) {
 	tlsConfig = &tls.Config{
 		MinVersion:         tls.VersionTLS10,
-		NextProtos:         []string{"h2"},
+		NextProtos:         []string{"h2", acme.ALPNProto},
 		ClientSessionCache: tls.NewLRUClientSessionCache(-1),
 	}
 	tlsConfig.GetCertificate = func(clientHello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		// ACME provider validates tls-alpn-01 challenges with handshakes
+		// that offer only acme-tls/1 protocol.
+		if len(clientHello.SupportedProtos) == 1 && clientHello.SupportedProtos[0] == acme.ALPNProto {
+			return s.tlsALPN01Certificate(clientHello.ServerName)
+		}
 		// If ServerName is defined in Options as Domain and there is TLSCert in Options
 		// use static configuration by returning nil or both cert and err
 		if clientHello.ServerName == s.Domain && s.TLSCert != "" {
 			return nil, nil
 		}
 		// Get certificate for this ServerName
-		c, err := s.certificateCache.Certificate(clientHello.ServerName)
+		c, err := s.certificateCache.ClientCertificate(clientHello, clientHello.ServerName)
 		switch err {
 		case certificateCache.ErrCertificateNotFound, certificate.ErrCertificateNotFound:
 			// If ServerName is the same as configured domain or it's www subdomain
 			// and tls listener is on https port 443, try to obtain the certificate.
 			if strings.HasSuffix(s.ListenTLS, ":443") && (clientHello.ServerName == s.Domain || clientHello.ServerName == "www."+s.Domain) {
-				obtainCertificate := false
-				// Check if there is not already a request for new certificate active.
-				for i := 0; i < 50; i++ {
-					yes, err := s.CertificateService.IsCertificateBeingObtained(clientHello.ServerName)
-					if err != nil {
-						return nil, fmt.Errorf("get certificate %s: is certificate being obtained: %s", clientHello.ServerName, err)
-					}
-					if yes {
-						time.Sleep(100 * time.Millisecond)
-						continue
-					}
-					obtainCertificate = i == 0
-					break
+				c, err = s.obtainTLSCertificate(clientHello, clientHello.ServerName)
+				if err != nil {
+					return nil, err
 				}
-
-				if obtainCertificate {
-					s.Logger.Debugf("get certificate: %s: obtaining certificate for domain", clientHello.ServerName)
-					cert, err := s.CertificateService.ObtainCertificate(clientHello.ServerName)
-					if err != nil {
-						return nil, fmt.Errorf("get certificate %s: obtain certificate: %s", clientHello.ServerName, err)
-					}
-					c = &tls.Certificate{}
-					*c, err = tls.X509KeyPair([]byte(cert.Cert), []byte(cert.Key))
-					if err != nil {
-						return nil, fmt.Errorf("get certificate: %s: tls X509KeyPair: %s", clientHello.ServerName, err)
-					}
-					// Clean cached empty certificate.
-					s.certificateCache.InvalidateCertificate(clientHello.ServerName)
-				} else {
-					c, err = s.certificateCache.Certificate(clientHello.ServerName)
-					if err != nil {
-						return nil, fmt.Errorf("get certificate: %s: certificate cache: %s", clientHello.ServerName, err)
+				break
+			}
+			// Hosts that are covered by a wildcard domain use the single
+			// wildcard certificate. It is obtained on the first request
+			// if the wildcard domain has a DNS provider selected, as hosts
+			// do not get their own certificates, so that requests with
+			// arbitrary names could not exhaust ACME provider rate limits.
+			if s.isWildcardDomainHost(clientHello.ServerName) {
+				wildcard := packages.WildcardFQDN(clientHello.ServerName)
+				c, err = s.certificateCache.ClientCertificate(clientHello, wildcard)
+				switch err {
+				case certificateCache.ErrCertificateNotFound, certificate.ErrCertificateNotFound:
+					if strings.HasSuffix(s.ListenTLS, ":443") {
+						settings, err := s.CertificateService.ACMESettings(wildcard)
+						if err == certificate.ErrACMESettingsNotFound {
+							break
+						}
+						if err != nil {
+							return nil, fmt.Errorf("get certificate: %s: acme settings: %s", wildcard, err)
+						}
+						if settings.DNSProvider == "" {
+							break
+						}
+						c, err = s.obtainTLSCertificate(clientHello, wildcard)
+						if err != nil {
+							return nil, err
+						}
 					}
+				case nil:
+				default:
+					return nil, fmt.Errorf("get certificate: %s: certificate cache: wildcard: %s", clientHello.ServerName, err)
 				}
 			}
 		case nil:
@@ -402,6 +421,100 @@ func newTLSConfig(s *Server) (tlsConfig *tls.Config, err error) {
 	return
 }
 
+// tlsALPN01Certificate returns the certificate that responds to the ACME
+// tls-alpn-01 challenge for the name.
+func (s *Server) tlsALPN01Certificate(name string) (*tls.Certificate, error) {
+	name = strings.ToLower(name)
+	c, err := s.CertificateService.ACMEChallenge(name)
+	if err != nil {
+		return nil, fmt.Errorf("get certificate: %s: acme challenge: %s", name, err)
+	}
+	if c.Type != certificate.ChallengeTypeTLSALPN01 {
+		return nil, fmt.Errorf("get certificate: %s: acme challenge type %q", name, c.Type)
+	}
+	cert, err := tlsALPN01ChallengeCertificate(name, c.KeyAuth)
+	if err != nil {
+		return nil, fmt.Errorf("get certificate: %s: acme challenge certificate: %s", name, err)
+	}
+	s.Logger.Debugf("get certificate: %s: acme tls-alpn-01 challenge", name)
+	return &cert, nil
+}
+
+// obtainTLSCertificate obtains a new certificate for the name, or waits
+// for the certificate if it is already being obtained. Alternative RSA
+// certificate is returned to clients that do not support ECDSA.
+func (s *Server) obtainTLSCertificate(clientHello *tls.ClientHelloInfo, name string) (c *tls.Certificate, err error) {
+	obtainCertificate := false
+	// Check if there is not already a request for new certificate active.
+	for i := 0; i < 50; i++ {
+		yes, err := s.CertificateService.IsCertificateBeingObtained(name)
+		if err != nil {
+			return nil, fmt.Errorf("get certificate %s: is certificate being obtained: %s", name, err)
+		}
+		if yes {
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+		obtainCertificate = i == 0
+		break
+	}
+
+	if !obtainCertificate {
+		c, err = s.certificateCache.ClientCertificate(clientHello, name)
+		if err != nil {
+			return nil, fmt.Errorf("get certificate: %s: certificate cache: %s", name, err)
+		}
+		return
+	}
+
+	s.Logger.Debugf("get certificate: %s: obtaining certificate for domain", name)
+	cert, err := s.CertificateService.ObtainCertificate(name)
+	if err != nil {
+		return nil, fmt.Errorf("get certificate %s: obtain certificate: %s", name, err)
+	}
+	c = &tls.Certificate{}
+	*c, err = tls.X509KeyPair([]byte(cert.Cert), []byte(cert.Key))
+	if err != nil {
+		return nil, fmt.Errorf("get certificate: %s: tls X509KeyPair: %s", name, err)
+	}
+	if cert.AltCert != "" && clientHello.SupportsCertificate(c) != nil {
+		*c, err = tls.X509KeyPair([]byte(cert.AltCert), []byte(cert.AltKey))
+		if err != nil {
+			return nil, fmt.Errorf("get certificate: %s: alternative certificate: tls X509KeyPair: %s", name, err)
+		}
+	}
+	// Clean cached empty certificate.
+	s.certificateCache.InvalidateCertificate(name)
+	return
+}
+
+// isWildcardDomainHost returns true if the host is not registered as
+// a domain, but it is covered by an enabled wildcard domain that
+// requires a TLS certificate.
+func (s *Server) isWildcardDomainHost(host string) bool {
+	if !fqdnRegex.MatchString(host) || host == s.Domain || strings.HasSuffix(host, "."+s.Domain) {
+		return false
+	}
+	wildcard := packages.WildcardFQDN(host)
+	if wildcard == "" {
+		return false
+	}
+	if _, err := s.PackagesService.Domain(host); err != packages.ErrDomainNotFound {
+		if err != nil {
+			s.Logger.Errorf("wildcard domain host: domain %s: %s", host, err)
+		}
+		return false
+	}
+	domain, err := s.PackagesService.Domain(wildcard)
+	if err != nil {
+		if err != packages.ErrDomainNotFound {
+			s.Logger.Errorf("wildcard domain host: domain %s: %s", wildcard, err)
+		}
+		return false
+	}
+	return !domain.Disabled && !domain.CertificateIgnore
+}
+
 // Serve starts servers.
 func (s *Server) Serve() error {
 	return s.servers.Serve()
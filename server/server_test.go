@@ -44,7 +44,7 @@ func (o *testServerOptions) set(field string, value interface{}) (isSet bool) {
 		return
 	}
 	v := reflect.ValueOf(value)
-	if f.Kind() != v.Kind() {
+	if !v.Type().AssignableTo(f.Type()) {
 		return
 	}
 	f.Set(v)
@@ -197,10 +197,12 @@ func newTestServer(options map[string]interface{}) (*Server, error) {
 			ForbiddenDomains: []string{
 				"forbidden.com",
 			},
-			APITrustedProxyCIDRs: []string{},
-			APIProxyRealIPHeader: "X-Real-Ip",
-			APIHourlyRateLimit:   0,
-			APIEnabled:           true,
+			APITrustedProxyCIDRs:    []string{},
+			APIProxyRealIPHeader:    "X-Real-Ip",
+			APIHourlyRateLimit:      0,
+			APIHourlyReadRateLimit:  0,
+			APIHourlyWriteRateLimit: 0,
+			APIEnabled:              true,
 
 			Logger:              logger,
 			AccessLogger:        logger,
@@ -238,6 +240,19 @@ func newTestServer(options map[string]interface{}) (*Server, error) {
 		return nil, fmt.Errorf("serve %s", err)
 	}
 
+	// Servers set their addresses asynchronously after listeners are
+	// created, so wait for them to be available to tests.
+	if o.Listen != "" {
+		deadline := time.Now().Add(5 * time.Second)
+		for s.servers.Addr("HTTP") == nil {
+			if time.Now().After(deadline) {
+				s.stopTestServer()
+				return nil, fmt.Errorf("serve: timeout waiting for HTTP server address")
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
 	return s, nil
 }
 
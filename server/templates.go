@@ -29,7 +29,7 @@ var htmlTemplates = map[string][]string{
 	"SettingsEmail":         {"base.html", "app.html", "settings/email.html"},
 	"SettingsNotifications": {"base.html", "app.html", "settings/notifications.html"},
 	"SettingsPassword":      {"base.html", "app.html", "settings/password.html"},
-	"SettingsAPIAccess":     {"base.html", "app.html", "settings/api-access.html"},
+	"SettingsAPIAccess":     {"base.html", "app.html", "api-key.html", "settings/api-access.html"},
 	"SettingsDeleteAccount": {"base.html", "app.html", "settings/delete-account.html"},
 	// Certificates
 	"RegisterACMEUser":        {"base.html", "cover.html", "register-acme-user.html"},
@@ -45,7 +45,11 @@ var htmlTemplates = map[string][]string{
 	"DomainUserGrant":   {"base.html", "app.html", "domain-user-grant.html"},
 	"DomainUserRevoke":  {"base.html", "app.html", "domain-user-revoke.html"},
 	"DomainOwnerChange": {"base.html", "app.html", "domain-owner-change.html"},
+	"DomainTransfer":    {"base.html", "app.html", "domain-transfer.html"},
 	"UserPage":          {"base.html", "app.html", "user-page.html"},
+	// Organizations
+	"OrganizationAdd": {"base.html", "app.html", "organization-add.html"},
+	"Organization":    {"base.html", "app.html", "api-key.html", "organization.html"},
 	// Maintenance
 	"Maintenance": {"base.html", "cover.html", "error.html", "error/maintenance.html"},
 	// HTTP Errors
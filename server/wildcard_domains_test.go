@@ -0,0 +1,174 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gopherpit.com/gopherpit/api"
+	"gopherpit.com/gopherpit/services/key"
+	"gopherpit.com/gopherpit/services/packages"
+	"gopherpit.com/gopherpit/services/user"
+)
+
+func TestWildcardDomains(t *testing.T) {
+	s, err := newTestServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.stopTestServer()
+
+	username := "alice"
+	email := "alice@localhost.loc"
+	u, err := s.UserService.CreateUser(&user.Options{
+		Email:    &email,
+		Username: &username,
+	})
+	if err != nil {
+		t.Fatalf("create user: %s", err)
+	}
+	_, ipV4Net, err := net.ParseCIDR("0.0.0.0/0")
+	if err != nil {
+		t.Fatalf("parse IPv4 net: %s", err)
+	}
+	_, ipV6Net, err := net.ParseCIDR("::/0")
+	if err != nil {
+		t.Fatalf("parse IPv6 net: %s", err)
+	}
+	k, err := s.KeyService.CreateKey(u.ID, &key.Options{
+		AuthorizedNetworks: &[]net.IPNet{
+			*ipV4Net,
+			*ipV6Net,
+		},
+	})
+	if err != nil {
+		t.Fatalf("create key: %s", err)
+	}
+	port := strconv.Itoa(s.servers.Addr("HTTP").Port)
+	c := api.NewClientWithEndpoint("localhost:"+port+"/api/v1", k.Secret)
+
+	t.Run("add domain", func(t *testing.T) {
+		for fqdn, want := range map[string]error{
+			"*.localhost":              api.ErrDomainFQDNInvalid,
+			"*.team.localhost":         api.ErrDomainNotAvailable,
+			"*.forbidden.com":          api.ErrDomainNotAvailable,
+			"*.*.teams.trusted.com":    api.ErrDomainFQDNInvalid,
+			"team.*.trusted.com":       api.ErrDomainFQDNInvalid,
+			"*.teams.trusted.com":      nil,
+			"exact.teams.trusted.com":  nil,
+			"*.unverified.example.com": api.ErrDomainNeedsVerification,
+		} {
+			fqdn := fqdn
+			_, err := c.AddDomain(&api.DomainOptions{FQDN: &fqdn})
+			if err != want {
+				t.Errorf("%s: expected error %v, got %v", fqdn, want, err)
+			}
+		}
+	})
+
+	vcs := api.VCSGit
+	for fqdn, repoRoot := range map[string]string{
+		"*.teams.trusted.com":     "https://github.com/gopherpit/wildcard.git",
+		"exact.teams.trusted.com": "https://github.com/gopherpit/exact.git",
+	} {
+		fqdn, repoRoot := fqdn, repoRoot
+		path := "/lib"
+		if _, err := c.AddPackage(&api.PackageOptions{
+			Domain:   &fqdn,
+			Path:     &path,
+			VCS:      &vcs,
+			RepoRoot: &repoRoot,
+		}); err != nil {
+			t.Fatalf("%s: add package: %s", fqdn, err)
+		}
+	}
+
+	t.Run("resolve package", func(t *testing.T) {
+		for _, tc := range []struct {
+			path         string
+			importPrefix string
+			repoRoot     string
+			err          error
+		}{
+			{
+				path:         "team.teams.trusted.com/lib/sub",
+				importPrefix: "team.teams.trusted.com/lib",
+				repoRoot:     "https://github.com/gopherpit/wildcard.git",
+			},
+			{
+				path:         "exact.teams.trusted.com/lib",
+				importPrefix: "exact.teams.trusted.com/lib",
+				repoRoot:     "https://github.com/gopherpit/exact.git",
+			},
+			{
+				path: "a.team.teams.trusted.com/lib",
+				err:  packages.ErrDomainNotFound,
+			},
+			{
+				path: "teams.trusted.com/lib",
+				err:  packages.ErrDomainNotFound,
+			},
+		} {
+			resolution, err := s.PackagesService.ResolvePackage(tc.path)
+			if err != tc.err {
+				t.Errorf("%s: expected error %v, got %v", tc.path, tc.err, err)
+				continue
+			}
+			if err != nil {
+				continue
+			}
+			if resolution.ImportPrefix != tc.importPrefix {
+				t.Errorf("%s: expected import prefix %q, got %q", tc.path, tc.importPrefix, resolution.ImportPrefix)
+			}
+			if resolution.RepoRoot != tc.repoRoot {
+				t.Errorf("%s: expected repo root %q, got %q", tc.path, tc.repoRoot, resolution.RepoRoot)
+			}
+		}
+	})
+
+	t.Run("go get", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "http://localhost:"+port+"/lib?go-get=1", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = "team.teams.trusted.com"
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "team.teams.trusted.com/lib git https://github.com/gopherpit/wildcard.git"
+		if !strings.Contains(string(body), want) {
+			t.Errorf("expected go-import %q in response %q", want, string(body))
+		}
+	})
+
+	t.Run("wildcard domain host", func(t *testing.T) {
+		for host, want := range map[string]bool{
+			"team.teams.trusted.com":   true,
+			"exact.teams.trusted.com":  false,
+			"a.team.teams.trusted.com": false,
+			"teams.trusted.com":        false,
+			"team.localhost":           false,
+		} {
+			if got := s.isWildcardDomainHost(host); got != want {
+				t.Errorf("%s: expected %v, got %v", host, want, got)
+			}
+		}
+	})
+}
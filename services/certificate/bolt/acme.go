@@ -6,183 +6,449 @@
 package boltCertificate
 
 import (
+	"context"
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
-	"github.com/xenolf/lego/acme"
-	jose "gopkg.in/square/go-jose.v1"
+	"golang.org/x/crypto/acme"
 
+	"gopherpit.com/gopherpit/pkg/acme/dnsprovider"
 	"gopherpit.com/gopherpit/services/certificate"
 )
 
-type acmeUser struct {
-	Email        string                     `json:"email"`
-	Key          *rsa.PrivateKey            `json:"key"`
-	Registration *acme.RegistrationResource `json:"registration"`
-	DirectoryURL string                     `json:"directory-url"`
+var (
+	errACMEHMACKeyEncoding = errors.New("acme: invalid hmac key encoding")
+
+	// acmeUserMu serializes reading and writing of ACME user file.
+	acmeUserMu = &sync.Mutex{}
+
+	// acmeV1DirectoryURLs maps retired ACME v1 directories to their
+	// ACME v2 replacements for migration of existing ACME users.
+	acmeV1DirectoryURLs = map[string]string{
+		"https://acme-v01.api.letsencrypt.org/directory":     "https://acme-v02.api.letsencrypt.org/directory",
+		"https://acme-staging.api.letsencrypt.org/directory": "https://acme-staging-v02.api.letsencrypt.org/directory",
+	}
+)
+
+// acmeTimeout limits the duration of a single ACME operation, including
+// waiting for challenge validation and certificate issuance.
+const acmeTimeout = 10 * time.Minute
+
+// acmeProblemTypePrefix is the namespace for ACME error types.
+const acmeProblemTypePrefix = "urn:ietf:params:acme:error:"
+
+// acmeUserRecordVersion is the version of acmeUserRecord for ACME v2
+// accounts. Records without version are registered with ACME v1.
+const acmeUserRecordVersion = 2
+
+type acmeUserRecord struct {
+	Version              int    `json:"version,omitempty"`
+	ID                   int    `json:"id"`
+	Email                string `json:"email"`
+	PrivateKey           []byte `json:"private-key"`
+	URL                  string `json:"url"`
+	NewAuthzURL          string `json:"new-authz-url,omitempty"`
+	DirectoryURL         string `json:"directory-url"`
+	ExternalAccountKeyID string `json:"external-account-key-id,omitempty"`
 }
 
-func registerACMEUser(directoryURL, email, filename string) (u *acmeUser, err error) {
-	var privateKey *rsa.PrivateKey
-	privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
-	if err != nil {
-		return
+func (u acmeUserRecord) export() *certificate.ACMEUser {
+	return &certificate.ACMEUser{
+		ID:                   u.ID,
+		Email:                u.Email,
+		PrivateKey:           u.PrivateKey,
+		URL:                  u.URL,
+		DirectoryURL:         u.DirectoryURL,
+		ExternalAccountKeyID: u.ExternalAccountKeyID,
 	}
-	u = &acmeUser{
-		Email:        email,
-		Key:          privateKey,
-		DirectoryURL: directoryURL,
+}
+
+// key parses the account private key. ACME v1 records hold PKCS #1
+// encoded RSA keys, while ACME v2 records hold PKCS #8 encoded keys.
+func (u acmeUserRecord) key() (crypto.Signer, error) {
+	if key, err := x509.ParsePKCS8PrivateKey(u.PrivateKey); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+		return nil, fmt.Errorf("unsupported private key type %T", key)
 	}
-	client, err := acme.NewClient(directoryURL, u, acme.RSA2048)
+	return x509.ParsePKCS1PrivateKey(u.PrivateKey)
+}
+
+func (u *acmeUserRecord) setKey(key crypto.Signer) (err error) {
+	u.PrivateKey, err = x509.MarshalPKCS8PrivateKey(key)
+	return
+}
+
+// setAccount sets the account URL and the ID that Let's Encrypt and
+// compatible providers put as the last URL path element.
+func (u *acmeUserRecord) setAccount(url string) {
+	u.URL = url
+	u.ID, _ = strconv.Atoi(path.Base(url))
+}
+
+// save writes the record to a temporary file and renames it, so that the
+// account key is not lost if writing fails.
+func (u acmeUserRecord) save(filename string) (err error) {
+	data, err := json.MarshalIndent(u, "", "    ")
 	if err != nil {
 		return
 	}
-	u.Registration, err = client.Register()
-	if err != nil {
+	tmp := filename + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0600); err != nil {
 		return
 	}
-	if err = client.AgreeToTOS(); err != nil {
-		return
+	return os.Rename(tmp, filename)
+}
+
+func (s Service) acmeUserFilename() string {
+	if s.DB == nil {
+		return ""
 	}
-	var data []byte
-	data, err = json.MarshalIndent(u.export(), "", "    ")
+	return filepath.Join(filepath.Dir(s.DB.Path()), "acme-user.json")
+}
+
+func (s Service) acmeUserRecord() (r *acmeUserRecord, err error) {
+	data, err := ioutil.ReadFile(s.acmeUserFilename())
 	if err != nil {
+		if os.IsNotExist(err) {
+			err = certificate.ErrACMEUserNotFound
+		}
 		return
 	}
-	if err = ioutil.WriteFile(filename, data, 0600); err != nil {
-		return
+	r = &acmeUserRecord{}
+	if err = json.Unmarshal(data, r); err != nil {
+		return nil, fmt.Errorf("acme user: json unmarshal: %s", err)
 	}
 	return
 }
 
-func (u acmeUser) GetEmail() string {
-	return u.Email
-}
-
-func (u acmeUser) GetRegistration() *acme.RegistrationResource {
-	return u.Registration
+func (s Service) newACMEClient(directoryURL string, key crypto.Signer, accountURL string) *acme.Client {
+	return &acme.Client{
+		DirectoryURL: directoryURL,
+		Key:          key,
+		KID:          acme.KeyID(accountURL),
+		UserAgent:    s.UserAgent,
+		HTTPClient:   s.HTTPClient,
+	}
 }
 
-func (u acmeUser) GetPrivateKey() crypto.PrivateKey {
-	return crypto.PrivateKey(u.Key)
+// acmeClient returns ACME client for the saved ACME user, migrating the
+// user to ACME v2 if it was registered with ACME v1. The caller must hold
+// acmeUserMu lock.
+func (s Service) acmeClient(ctx context.Context) (client *acme.Client, r *acmeUserRecord, err error) {
+	r, err = s.acmeUserRecord()
+	if err != nil {
+		return
+	}
+	key, err := r.key()
+	if err != nil {
+		return nil, nil, fmt.Errorf("acme user: private key: %s", err)
+	}
+	if r.Version < acmeUserRecordVersion {
+		if err = s.migrateACMEUser(ctx, r, key); err != nil {
+			return nil, nil, fmt.Errorf("acme user: migrate to acme v2: %s", err)
+		}
+	}
+	client = s.newACMEClient(r.DirectoryURL, key, r.URL)
+	return
 }
 
-func (u acmeUser) export() *acmeUserRecord {
-	r := &acmeUserRecord{
-		Email:        u.Email,
-		DirectoryURL: u.DirectoryURL,
+// migrateACMEUser converts ACME v1 user to ACME v2 account. ACME v2
+// account with the same key is used if it exists, as Let's Encrypt shares
+// accounts between protocol versions, otherwise a new account is
+// registered. The original file is preserved with ".v1" suffix.
+func (s Service) migrateACMEUser(ctx context.Context, r *acmeUserRecord, key crypto.Signer) (err error) {
+	directoryURL := r.DirectoryURL
+	if u, ok := acmeV1DirectoryURLs[directoryURL]; ok {
+		directoryURL = u
 	}
-	if u.Key != nil {
-		r.PrivateKey = x509.MarshalPKCS1PrivateKey(u.Key)
+	client := s.newACMEClient(directoryURL, key, "")
+	account, err := client.GetReg(ctx, "")
+	if err == acme.ErrNoAccount {
+		account, err = client.Register(ctx, &acme.Account{
+			Contact: acmeContact(r.Email),
+		}, acme.AcceptTOS)
 	}
-	if u.Registration != nil {
-		r.URL = u.Registration.URI
-		r.NewAuthzURL = u.Registration.NewAuthzURL
-		r.ID = u.Registration.Body.ID
+	if err != nil {
+		return
 	}
-	return r
-}
 
-type acmeUserRecord struct {
-	ID           int    `json:"id"`
-	Email        string `json:"email"`
-	PrivateKey   []byte `json:"private-key"`
-	URL          string `json:"url"`
-	NewAuthzURL  string `json:"new-authz-url"`
-	DirectoryURL string `json:"directory-url"`
-}
+	filename := s.acmeUserFilename()
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return
+	}
+	if err = ioutil.WriteFile(filename+".v1", data, 0600); err != nil {
+		return
+	}
 
-func (u acmeUserRecord) export() *certificate.ACMEUser {
-	return &certificate.ACMEUser{
-		ID:           u.ID,
-		Email:        u.Email,
-		PrivateKey:   u.PrivateKey,
-		URL:          u.URL,
-		NewAuthzURL:  u.NewAuthzURL,
-		DirectoryURL: u.DirectoryURL,
+	m := *r
+	m.Version = acmeUserRecordVersion
+	m.NewAuthzURL = ""
+	m.DirectoryURL = directoryURL
+	m.setAccount(account.URI)
+	if err = m.setKey(key); err != nil {
+		return
+	}
+	if err = m.save(filename); err != nil {
+		return
 	}
+	s.Logger.Infof("acme user: migrated %s from %s to acme v2 account %s", r.Email, r.DirectoryURL, account.URI)
+	*r = m
+	return
 }
 
-func (s *Service) acmeUserFilename() string {
-	if s.DB == nil {
-		return ""
+func (s Service) registerACMEUser(ctx context.Context, directoryURL, email string, eab *certificate.ExternalAccountBinding) (r *acmeUserRecord, err error) {
+	var binding *acme.ExternalAccountBinding
+	if eab != nil {
+		if eab.KeyID == "" {
+			return nil, certificate.ErrACMEUserEABInvalid
+		}
+		hmacKey, err := decodeHMACKey(eab.HMACKey)
+		if err != nil || len(hmacKey) == 0 {
+			return nil, certificate.ErrACMEUserEABInvalid
+		}
+		binding = &acme.ExternalAccountBinding{
+			KID: eab.KeyID,
+			Key: hmacKey,
+		}
 	}
-	return filepath.Join(filepath.Dir(s.DB.Path()), "acme-user.json")
+	key, err := newACMEUserKey()
+	if err != nil {
+		return
+	}
+	client := s.newACMEClient(directoryURL, key, "")
+	account, err := client.Register(ctx, &acme.Account{
+		Contact:                acmeContact(email),
+		ExternalAccountBinding: binding,
+	}, acme.AcceptTOS)
+	if err != nil {
+		if e, ok := err.(*acme.Error); ok {
+			switch {
+			case e.ProblemType == acmeProblemTypePrefix+"externalAccountRequired":
+				return nil, certificate.ErrACMEUserEABRequired
+			case e.ProblemType == acmeProblemTypePrefix+"unauthorized" && binding != nil:
+				return nil, certificate.ErrACMEUserEABInvalid
+			case e.ProblemType == acmeProblemTypePrefix+"invalidContact":
+				return nil, certificate.ErrACMEUserEmailInvalid
+			}
+		}
+		return
+	}
+	r = &acmeUserRecord{
+		Version:      acmeUserRecordVersion,
+		Email:        email,
+		DirectoryURL: directoryURL,
+	}
+	if binding != nil {
+		r.ExternalAccountKeyID = binding.KID
+	}
+	r.setAccount(account.URI)
+	if err = r.setKey(key); err != nil {
+		return
+	}
+	if err = r.save(s.acmeUserFilename()); err != nil {
+		return
+	}
+	return
 }
 
-func (s *Service) acmeUser() (u *acmeUser, err error) {
-	if s.acmeUserCache != nil {
-		u = s.acmeUserCache
+// rolloverACMEUserKey replaces the account key. The record with the new
+// key is written before the key change request, so that the new key can be
+// recovered from the ".new" file if the key is changed, but the record is
+// not saved.
+func (s Service) rolloverACMEUserKey(ctx context.Context) (r *acmeUserRecord, err error) {
+	client, r, err := s.acmeClient(ctx)
+	if err != nil {
+		return
+	}
+	key, err := newACMEUserKey()
+	if err != nil {
+		return
+	}
+	n := *r
+	if err = n.setKey(key); err != nil {
+		return
 	}
-	defer func() {
-		if err == nil {
-			s.acmeUserCache = u
-		}
-	}()
 	filename := s.acmeUserFilename()
-	_, err = os.Stat(filename)
+	if err = n.save(filename + ".new"); err != nil {
+		return
+	}
+	if err = client.AccountKeyRollover(ctx, key); err != nil {
+		os.Remove(filename + ".new")
+		return
+	}
+	if err = os.Rename(filename+".new", filename); err != nil {
+		return
+	}
+	return &n, nil
+}
+
+// authorize responds to a challenge of an authorization. If dnsProvider is
+// nil, http-01 or tls-alpn-01 challenge response, depending on the
+// configured ChallengeType, is stored to be served by the server,
+// otherwise dns-01 challenge record is created with the dnsProvider.
+func (s Service) authorize(ctx context.Context, client *acme.Client, url string, dnsProvider dnsprovider.Provider) (err error) {
+	authz, err := client.GetAuthorization(ctx, url)
 	if err != nil {
-		if os.IsNotExist(err) {
-			err = certificate.ErrACMEUserNotFound
-			return
-		}
 		return
 	}
-	var data []byte
-	data, err = ioutil.ReadFile(filename)
+	if authz.Status == acme.StatusValid {
+		return
+	}
+	fqdn := authz.Identifier.Value
+	challengeType := certificate.ChallengeTypeHTTP01
+	switch {
+	case dnsProvider != nil:
+		challengeType = certificate.ChallengeTypeDNS01
+	case s.ChallengeType == certificate.ChallengeTypeTLSALPN01:
+		challengeType = certificate.ChallengeTypeTLSALPN01
+	}
+	var challenge *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == challengeType {
+			challenge = c
+			break
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("acme: %s: %s challenge not offered", fqdn, challengeType)
+	}
+	if dnsProvider != nil {
+		return s.authorizeDNS01(ctx, client, url, fqdn, challenge, dnsProvider)
+	}
+	// Key authorization is the same for http-01 and tls-alpn-01
+	// challenges.
+	keyAuth, err := client.HTTP01ChallengeResponse(challenge.Token)
 	if err != nil {
 		return
 	}
-	r := &acmeUserRecord{}
-	if err = json.Unmarshal(data, r); err != nil {
+	var t string
+	if challengeType == certificate.ChallengeTypeTLSALPN01 {
+		t = challengeType
+	}
+	if _, err = s.UpdateACMEChallenge(fqdn, &certificate.ACMEChallengeOptions{
+		Type:    &t,
+		Token:   &challenge.Token,
+		KeyAuth: &keyAuth,
+	}); err != nil {
 		return
 	}
-	var key *rsa.PrivateKey
-	key, err = x509.ParsePKCS1PrivateKey(r.PrivateKey)
+	defer func() {
+		if _, err := s.DeleteACMEChallenge(fqdn); err != nil {
+			s.Logger.Warningf("acme: %s: delete challenge: %s", fqdn, err)
+		}
+	}()
+	if _, err = client.Accept(ctx, challenge); err != nil {
+		return
+	}
+	_, err = client.WaitAuthorization(ctx, url)
+	return
+}
+
+// authorizeDNS01 creates the dns-01 challenge TXT record with the
+// dnsProvider and removes it after the authorization is validated.
+func (s Service) authorizeDNS01(ctx context.Context, client *acme.Client, url, fqdn string, challenge *acme.Challenge, dnsProvider dnsprovider.Provider) (err error) {
+	value, err := client.DNS01ChallengeRecord(challenge.Token)
 	if err != nil {
 		return
 	}
-	u = &acmeUser{
-		Email: r.Email,
-		Key:   key,
-		Registration: &acme.RegistrationResource{
-			Body: acme.Registration{
-				Resource: "reg",
-				ID:       r.ID,
-				Key: jose.JsonWebKey{
-					Key: &key.PublicKey,
-				},
-			},
-			URI:         r.URL,
-			NewAuthzURL: r.NewAuthzURL,
-		},
-		DirectoryURL: r.DirectoryURL,
+	// Wildcard domains are validated with the record of their base
+	// domain.
+	name := "_acme-challenge." + strings.TrimPrefix(fqdn, "*.")
+	if err = dnsProvider.Present(ctx, name, value); err != nil {
+		return fmt.Errorf("acme: %s: dns provider: %s", fqdn, err)
 	}
+	defer func() {
+		if err := dnsProvider.CleanUp(context.Background(), name, value); err != nil {
+			s.Logger.Warningf("acme: %s: dns provider: clean up: %s", fqdn, err)
+		}
+	}()
+	if _, err = client.Accept(ctx, challenge); err != nil {
+		return
+	}
+	_, err = client.WaitAuthorization(ctx, url)
 	return
 }
 
-type challengeProvider struct {
-	s Service
+// decodeHMACKey decodes an external account binding MAC key which is
+// usually provided in base64url encoding without padding.
+func decodeHMACKey(s string) ([]byte, error) {
+	for _, e := range []*base64.Encoding{
+		base64.RawURLEncoding,
+		base64.URLEncoding,
+		base64.StdEncoding,
+		base64.RawStdEncoding,
+	} {
+		if b, err := e.DecodeString(s); err == nil {
+			return b, nil
+		}
+	}
+	return nil, errACMEHMACKeyEncoding
 }
 
-func (p challengeProvider) Present(fqdn, token, keyAuth string) (err error) {
-	_, err = p.s.UpdateACMEChallenge(fqdn, &certificate.ACMEChallengeOptions{
-		Token:   &token,
-		KeyAuth: &keyAuth,
-	})
-	return
+func newACMEUserKey() (crypto.Signer, error) {
+	return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 }
 
-func (p challengeProvider) CleanUp(fqdn, token, keyAuth string) (err error) {
-	_, err = p.s.DeleteACMEChallenge(fqdn)
-	return
+// newCertificateKey generates a new certificate private key of the key
+// type and returns it with its PEM encoding.
+func newCertificateKey(keyType string) (key crypto.Signer, keyPEM string, err error) {
+	var block *pem.Block
+	switch keyType {
+	case certificate.KeyTypeP256, certificate.KeyTypeP384:
+		curve := elliptic.P256()
+		if keyType == certificate.KeyTypeP384 {
+			curve = elliptic.P384()
+		}
+		k, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return nil, "", err
+		}
+		der, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, "", err
+		}
+		key, block = k, &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+	case certificate.KeyTypeRSA2048, certificate.KeyTypeRSA4096:
+		bits := 2048
+		if keyType == certificate.KeyTypeRSA4096 {
+			bits = 4096
+		}
+		k, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return nil, "", err
+		}
+		key, block = k, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}
+	default:
+		return nil, "", certificate.ErrKeyTypeInvalid
+	}
+	return key, string(pem.EncodeToMemory(block)), nil
+}
+
+func acmeContact(email string) []string {
+	if email == "" {
+		return nil
+	}
+	return []string{"mailto:" + email}
 }
 
 func loadPEMCertificates(pemBlock []byte) ([][]byte, error) {
@@ -34,9 +34,19 @@ type certificateRecord struct {
 	ExpirationTime *time.Time `json:"expiration-time,omitempty"`
 	Cert           string     `json:"cert,omitempty"`
 	Key            string     `json:"key,omitempty"`
+	KeyType        string     `json:"key-type,omitempty"`
+	AltCert        string     `json:"alt-cert,omitempty"`
+	AltKey         string     `json:"alt-key,omitempty"`
 	ACMEURL        string     `json:"acme-url,omitempty"`
 	ACMEURLStable  string     `json:"acme-url-stable,omitempty"`
 	ACMEAccount    string     `json:"acme-account,omitempty"`
+
+	RenewalAttemptTime *time.Time `json:"renewal-attempt-time,omitempty"`
+	RenewalError       string     `json:"renewal-error,omitempty"`
+	RenewalFailures    int        `json:"renewal-failures,omitempty"`
+
+	ExpiryNotificationTime *time.Time `json:"expiry-notification-time,omitempty"`
+	NotifiedExpirationTime *time.Time `json:"notified-expiration-time,omitempty"`
 }
 
 func (t certificateRecord) export() *certificate.Certificate {
@@ -45,9 +55,19 @@ func (t certificateRecord) export() *certificate.Certificate {
 		ExpirationTime: t.ExpirationTime,
 		Cert:           t.Cert,
 		Key:            t.Key,
+		KeyType:        t.KeyType,
+		AltCert:        t.AltCert,
+		AltKey:         t.AltKey,
 		ACMEURL:        t.ACMEURL,
 		ACMEURLStable:  t.ACMEURLStable,
 		ACMEAccount:    t.ACMEAccount,
+
+		RenewalAttemptTime: t.RenewalAttemptTime,
+		RenewalError:       t.RenewalError,
+		RenewalFailures:    t.RenewalFailures,
+
+		ExpiryNotificationTime: t.ExpiryNotificationTime,
+		NotifiedExpirationTime: t.NotifiedExpirationTime,
 	}
 }
 
@@ -64,6 +84,15 @@ func (t *certificateRecord) update(o *certificate.Options, expirationTime *time.
 	if o.Key != nil {
 		t.Key = *o.Key
 	}
+	if o.KeyType != nil {
+		t.KeyType = *o.KeyType
+	}
+	if o.AltCert != nil {
+		t.AltCert = *o.AltCert
+	}
+	if o.AltKey != nil {
+		t.AltKey = *o.AltKey
+	}
 	if o.ACMEURL != nil {
 		t.ACMEURL = *o.ACMEURL
 	}
@@ -73,6 +102,12 @@ func (t *certificateRecord) update(o *certificate.Options, expirationTime *time.
 	if o.ACMEURL != nil {
 		t.ACMEAccount = *o.ACMEAccount
 	}
+	if o.ExpiryNotificationTime != nil {
+		t.ExpiryNotificationTime = o.ExpiryNotificationTime
+	}
+	if o.NotifiedExpirationTime != nil {
+		t.NotifiedExpirationTime = o.NotifiedExpirationTime
+	}
 	return nil
 }
 
@@ -97,18 +132,34 @@ func getCertificateRecord(tx *bolt.Tx, fqdn []byte) (t *certificateRecord, err e
 type certificateInfo struct {
 	fqdn           string
 	ExpirationTime *time.Time `json:"expiration-time,omitempty"`
+	KeyType        string     `json:"key-type,omitempty"`
 	ACMEURL        string     `json:"acme-url,omitempty"`
 	ACMEURLStable  string     `json:"acme-url-stable,omitempty"`
 	ACMEAccount    string     `json:"acme-account,omitempty"`
+
+	RenewalAttemptTime *time.Time `json:"renewal-attempt-time,omitempty"`
+	RenewalError       string     `json:"renewal-error,omitempty"`
+	RenewalFailures    int        `json:"renewal-failures,omitempty"`
+
+	ExpiryNotificationTime *time.Time `json:"expiry-notification-time,omitempty"`
+	NotifiedExpirationTime *time.Time `json:"notified-expiration-time,omitempty"`
 }
 
 func (t certificateInfo) export() *certificate.Info {
 	return &certificate.Info{
 		FQDN:           t.fqdn,
 		ExpirationTime: t.ExpirationTime,
+		KeyType:        t.KeyType,
 		ACMEURL:        t.ACMEURL,
 		ACMEURLStable:  t.ACMEURLStable,
 		ACMEAccount:    t.ACMEAccount,
+
+		RenewalAttemptTime: t.RenewalAttemptTime,
+		RenewalError:       t.RenewalError,
+		RenewalFailures:    t.RenewalFailures,
+
+		ExpiryNotificationTime: t.ExpiryNotificationTime,
+		NotifiedExpirationTime: t.NotifiedExpirationTime,
 	}
 }
 
@@ -212,6 +263,7 @@ func (t certificateRecord) delete(tx *bolt.Tx) (err error) {
 }
 
 func getCertificates(tx *bolt.Tx, start []byte, limit int) (page *certificate.CertificatesPage, err error) {
+	page = &certificate.CertificatesPage{}
 	bucket := tx.Bucket(bucketNameCertificates)
 	if bucket == nil {
 		return
@@ -251,6 +303,7 @@ func getCertificates(tx *bolt.Tx, start []byte, limit int) (page *certificate.Ce
 }
 
 func getCertificatesByExpiry(tx *bolt.Tx, since time.Time, start []byte, limit int) (page *certificate.InfosPage, err error) {
+	page = &certificate.InfosPage{}
 	bucket := tx.Bucket(bucketNameIndexCertificateExpirationTimeFQDN)
 	if bucket == nil {
 		return
@@ -21,6 +21,7 @@ var (
 
 type acmeChallengeRecord struct {
 	fqdn    string
+	Type    string `json:"type,omitempty"`
 	Token   string `json:"token,omitempty"`
 	KeyAuth string `json:"key-auth,omitempty"`
 }
@@ -28,12 +29,16 @@ type acmeChallengeRecord struct {
 func (t acmeChallengeRecord) export() *certificate.ACMEChallenge {
 	return &certificate.ACMEChallenge{
 		FQDN:    t.fqdn,
+		Type:    t.Type,
 		Token:   t.Token,
 		KeyAuth: t.KeyAuth,
 	}
 }
 
 func (t *acmeChallengeRecord) update(o *certificate.ACMEChallengeOptions) error {
+	if o.Type != nil {
+		t.Type = *o.Type
+	}
 	if o.Token != nil {
 		t.Token = *o.Token
 	}
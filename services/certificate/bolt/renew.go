@@ -6,18 +6,61 @@
 package boltCertificate
 
 import (
+	"fmt"
 	"net"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/boltdb/bolt"
+
+	"gopherpit.com/gopherpit/services/certificate"
+)
+
+// Default values for renewal queue options.
+var (
+	defaultRenewWorkers    = 2
+	defaultRenewBackoff    = time.Hour
+	defaultRenewMaxBackoff = 24 * time.Hour
 )
 
-// Renew requests a new SSL/TLS certificate before it expires.
-func (s Service) Renew() error {
-	return s.DB.View(func(tx *bolt.Tx) error {
-		s.Logger.Debug("acme certificates renewal: started")
-		defer s.Logger.Debug("acme certificates renewal: ended")
+// renewalQueue holds FQDNs of certificates that are waiting to be
+// renewed, in order in which they are queued. FQDNs in queued are
+// waiting or are being renewed, and the value is true if the renewal is
+// forced, regardless of the expiration time and backoff. There is at
+// most one timer for every FQDN that waits for its renewal backoff to
+// expire.
+type renewalQueue struct {
+	fqdns   []string
+	queued  map[string]bool
+	timers  map[string]*time.Timer
+	workers int
+	mu      sync.Mutex
+}
 
+// renewalQueueMu protects initialization of renewal queues of services.
+var renewalQueueMu = &sync.Mutex{}
+
+// renewalQueue returns the renewal queue of the service, creating it
+// on the first call.
+func (s *Service) renewalQueue() *renewalQueue {
+	renewalQueueMu.Lock()
+	defer renewalQueueMu.Unlock()
+
+	if s.renewal == nil {
+		s.renewal = &renewalQueue{
+			queued: map[string]bool{},
+			timers: map[string]*time.Timer{},
+		}
+	}
+	return s.renewal
+}
+
+// Renew queues SSL/TLS certificates that expire in the renew period
+// for renewal.
+func (s *Service) Renew() error {
+	var fqdns []string
+	if err := s.DB.View(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(bucketNameIndexCertificateExpirationTimeFQDN)
 		if bucket == nil {
 			return nil
@@ -32,40 +75,213 @@ func (s Service) Renew() error {
 			if time.Now().Before(et.Add(-s.RenewPeriod)) {
 				break
 			}
+			fqdns = append(fqdns, string(k[keyTimeLayoutLen:]))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
 
-			go func(fqdn string) {
-				defer s.RecoveryService.Recover()
-
-				addrs, err := net.LookupHost(fqdn)
-				if err != nil {
-					if e, ok := err.(*net.DNSError); ok {
-						if e.Err == "no such host" {
-							return
-						}
-					}
-					s.Logger.Errorf("acme certificates renewal: lookup dns host: %s: %s", fqdn, err)
-					return
-				}
+	s.Logger.Debug("acme certificates renewal: queued certificates: ", len(fqdns))
+	q := s.renewalQueue()
+	for _, fqdn := range fqdns {
+		s.queueRenewal(q, fqdn, false)
+	}
+	return nil
+}
 
-				if len(addrs) == 0 {
-					s.Logger.Errorf("acme certificates renewal: lookup dns host: %s: no addresses returned", fqdn)
-					return
-				}
+// RenewCertificate queues an existing certificate for renewal, regardless
+// of its expiration time and renewal backoff.
+func (s *Service) RenewCertificate(fqdn string) (err error) {
+	if err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		_, err = getCertificateInfo(tx, []byte(fqdn))
+		return
+	}); err != nil {
+		return
+	}
+	s.queueRenewal(s.renewalQueue(), fqdn, true)
+	return nil
+}
 
-				cert, err := s.ObtainCertificate(fqdn)
-				if err != nil {
-					s.Logger.Errorf("acme certificates renewal: certificate renew: %s: %s", fqdn, err)
-					return
-				}
-				s.Logger.Infof("acme certificates renewal: renewed: %s that expires %s", cert.FQDN, cert.ExpirationTime)
-			}(string(k[keyTimeLayoutLen:]))
+// queueRenewal adds the FQDN to the renewal queue if it is not already
+// queued, and starts a new worker if the number of workers is not
+// exceeded. A pending backoff timer for the FQDN is stopped.
+func (s Service) queueRenewal(q *renewalQueue, fqdn string, force bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if t, ok := q.timers[fqdn]; ok {
+		t.Stop()
+		delete(q.timers, fqdn)
+	}
+	if f, ok := q.queued[fqdn]; ok {
+		q.queued[fqdn] = f || force
+		return
+	}
+	q.queued[fqdn] = force
+	q.fqdns = append(q.fqdns, fqdn)
+
+	workers := s.RenewWorkers
+	if workers <= 0 {
+		workers = defaultRenewWorkers
+	}
+	if q.workers < workers {
+		q.workers++
+		go s.renewalWorker(q)
+	}
+}
+
+// renewalWorker renews certificates from the renewal queue until
+// it is empty.
+func (s Service) renewalWorker(q *renewalQueue) {
+	for {
+		q.mu.Lock()
+		if len(q.fqdns) == 0 {
+			q.workers--
+			q.mu.Unlock()
+			return
+		}
+		fqdn := q.fqdns[0]
+		q.fqdns = q.fqdns[1:]
+		force := q.queued[fqdn]
+		q.mu.Unlock()
+
+		next := s.renew(fqdn, force)
+
+		q.mu.Lock()
+		delete(q.queued, fqdn)
+		q.mu.Unlock()
+
+		if next != nil {
+			s.scheduleRenewal(q, fqdn, *next)
+		}
+	}
+}
+
+// renew obtains a new certificate for the FQDN and saves the renewal
+// attempt. It returns the time after which the certificate should be
+// renewed again if it is in the renewal backoff or the renewal failed.
+// The backoff period doubles with every consecutive failure.
+func (s Service) renew(fqdn string, force bool) (next *time.Time) {
+	defer s.RecoveryService.Recover()
+
+	c, err := s.Certificate(fqdn)
+	if err != nil {
+		if err != certificate.ErrCertificateNotFound {
+			s.Logger.Errorf("acme certificates renewal: %s: certificate: %s", fqdn, err)
 		}
 		return nil
+	}
+	if !force {
+		if next := s.nextRenewalTime(c); next != nil && time.Now().Before(*next) {
+			s.Logger.Debug("acme certificates renewal: ", fqdn, ": backoff until ", next)
+			return next
+		}
+	}
+
+	err = s.obtainRenewedCertificate(fqdn)
+	if err := s.saveRenewalAttempt(fqdn, err); err != nil {
+		s.Logger.Errorf("acme certificates renewal: %s: save renewal attempt: %s", fqdn, err)
+	}
+	if err == nil {
+		return nil
+	}
+	s.Logger.Errorf("acme certificates renewal: %s: %s", fqdn, err)
+
+	c, err = s.Certificate(fqdn)
+	if err != nil {
+		s.Logger.Errorf("acme certificates renewal: %s: certificate: %s", fqdn, err)
+		return nil
+	}
+	return s.nextRenewalTime(c)
+}
+
+// scheduleRenewal queues the FQDN for renewal at the provided time,
+// replacing the previously scheduled renewal of the same FQDN. Renewal
+// is not scheduled if the FQDN is already queued.
+func (s Service) scheduleRenewal(q *renewalQueue, fqdn string, t time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.queued[fqdn]; ok {
+		return
+	}
+	if timer, ok := q.timers[fqdn]; ok {
+		timer.Stop()
+	}
+	q.timers[fqdn] = time.AfterFunc(time.Until(t), func() {
+		s.queueRenewal(q, fqdn, false)
 	})
 }
 
+// obtainRenewedCertificate obtains a new certificate if the FQDN still
+// resolves. Wildcard certificates are renewed if their base domain
+// still resolves.
+func (s Service) obtainRenewedCertificate(fqdn string) error {
+	addrs, err := net.LookupHost(strings.TrimPrefix(fqdn, "*."))
+	if err != nil {
+		return fmt.Errorf("lookup dns host: %s", err)
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("lookup dns host: no addresses returned")
+	}
+
+	cert, err := s.ObtainCertificate(fqdn)
+	if err != nil {
+		return fmt.Errorf("certificate renew: %s", err)
+	}
+	s.Logger.Infof("acme certificates renewal: renewed: %s that expires %s", cert.FQDN, cert.ExpirationTime)
+	return nil
+}
+
+// saveRenewalAttempt saves the time and the error of the renewal attempt
+// and counts consecutive failures.
+func (s Service) saveRenewalAttempt(fqdn string, renewalErr error) error {
+	return s.DB.Update(func(tx *bolt.Tx) (err error) {
+		r, err := getCertificateRecord(tx, []byte(fqdn))
+		if err != nil {
+			return
+		}
+		now := time.Now()
+		r.RenewalAttemptTime = &now
+		if renewalErr != nil {
+			r.RenewalError = renewalErr.Error()
+			r.RenewalFailures++
+		} else {
+			r.RenewalError = ""
+			r.RenewalFailures = 0
+		}
+		return r.save(tx)
+	})
+}
+
+// nextRenewalTime returns the time before which the certificate should not
+// be renewed because of previously failed renewals, or nil if the last
+// renewal did not fail.
+func (s Service) nextRenewalTime(c *certificate.Certificate) *time.Time {
+	if c.RenewalFailures == 0 || c.RenewalAttemptTime == nil {
+		return nil
+	}
+	backoff := s.RenewBackoff
+	if backoff <= 0 {
+		backoff = defaultRenewBackoff
+	}
+	maxBackoff := s.RenewMaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRenewMaxBackoff
+	}
+	for i := 1; i < c.RenewalFailures && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	t := c.RenewalAttemptTime.Add(backoff)
+	return &t
+}
+
 // PeriodicRenew requests new SSL/TLS certificates on configured period.
-func (s Service) PeriodicRenew() error {
+func (s *Service) PeriodicRenew() error {
 	s.Logger.Info("acme certificates periodic renewal: initialized")
 	go func() {
 		defer s.RecoveryService.Recover()
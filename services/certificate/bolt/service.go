@@ -6,17 +6,25 @@
 package boltCertificate
 
 import (
+	"bytes"
+	"context"
+	"crypto/rand"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/boltdb/bolt"
-	"github.com/xenolf/lego/acme"
+	"golang.org/x/crypto/acme"
 	"resenje.org/recovery"
 
+	"gopherpit.com/gopherpit/pkg/acme/dnsprovider"
 	"gopherpit.com/gopherpit/services/certificate"
 )
 
@@ -40,19 +48,42 @@ type Service struct {
 
 	// Default ACME directory URL.
 	DefaultACMEDirectoryURL string
+	// UserAgent is sent in HTTP requests to ACME provider.
+	UserAgent string
+	// HTTPClient is used for requests to ACME provider. If nil,
+	// http.DefaultClient is used.
+	HTTPClient *http.Client
+	// DNSProviders are named providers that can be selected in
+	// ACMESettings for responding to dns-01 challenges.
+	DNSProviders map[string]dnsprovider.Provider
+	// KeyType is the default type of certificate private keys, one of
+	// certificate.KeyTypes. Default is certificate.KeyTypeRSA2048.
+	KeyType string
+	// ChallengeType is the type of ACME challenges, "http-01" or
+	// "tls-alpn-01", that are responded for FQDNs without a DNS
+	// provider. Default is "http-01".
+	ChallengeType string
 
 	// RenewPeriod is a duration after issuing a certificate
 	// to try to renew it.
 	RenewPeriod time.Duration
 	// RenewCheckPeriod is a period of renewal process.
 	RenewCheckPeriod time.Duration
+	// RenewWorkers is the maximal number of certificates that are
+	// renewed at the same time. Default is 2.
+	RenewWorkers int
+	// RenewBackoff is a duration to wait before renewing a certificate
+	// again after a failed renewal. It doubles with every consecutive
+	// failure up to RenewMaxBackoff. Defaults are 1 hour and 24 hours.
+	RenewBackoff    time.Duration
+	RenewMaxBackoff time.Duration
 
 	// RecoveryService recovers from panics, and logs and informs about it.
 	RecoveryService recovery.Service
 	// Default logger for this service.
 	Logger Logger
 
-	acmeUserCache *acmeUser
+	renewal *renewalQueue
 }
 
 // NewDB opens a new BoltDB database.
@@ -95,38 +126,150 @@ func (s Service) ObtainCertificate(fqdn string) (c *certificate.Certificate, err
 		mu.Unlock()
 	}()
 
-	var u *acmeUser
-	u, err = s.acmeUser()
-	if err != nil {
+	settings, err := s.ACMESettings(fqdn)
+	switch err {
+	case nil:
+	case certificate.ErrACMESettingsNotFound:
+		settings, err = &certificate.ACMESettings{FQDN: fqdn}, nil
+	default:
 		return
 	}
-	var client *acme.Client
-	client, err = acme.NewClient(u.DirectoryURL, u, acme.RSA2048)
+	dnsProvider, err := s.dnsProvider(settings)
 	if err != nil {
 		return
 	}
-	if err = client.SetChallengeProvider(acme.HTTP01, challengeProvider{s}); err != nil {
+	keyType := s.keyType(settings)
+
+	ctx, cancel := context.WithTimeout(context.Background(), acmeTimeout)
+	defer cancel()
+
+	acmeUserMu.Lock()
+	client, _, err := s.acmeClient(ctx)
+	acmeUserMu.Unlock()
+	if err != nil {
 		return
 	}
-	client.ExcludeChallenges([]acme.Challenge{acme.TLSSNI01, acme.DNS01})
 
-	certResource, failures := client.ObtainCertificate([]string{fqdn}, true, nil, false)
-	if len(failures) > 0 {
-		err = failures[fqdn]
+	primaryKeyType := keyType
+	if keyType == certificate.KeyTypeDual {
+		primaryKeyType = certificate.KeyTypeP256
+	}
+	cert, keyPEM, url, err := s.issueCertificate(ctx, client, fqdn, dnsProvider, primaryKeyType)
+	if err != nil {
 		return
 	}
+	// The alternative certificate is issued with a separate order, as
+	// an order can be finalized only once. Authorizations from the
+	// first order are reused.
+	var altCert, altKeyPEM string
+	if keyType == certificate.KeyTypeDual {
+		altCert, altKeyPEM, _, err = s.issueCertificate(ctx, client, fqdn, dnsProvider, certificate.KeyTypeRSA2048)
+		if err != nil {
+			return
+		}
+	}
 
-	cert := string(certResource.Certificate)
-	key := string(certResource.PrivateKey)
-	return s.UpdateCertificate(certResource.Domain, &certificate.Options{
+	// ACME v2 does not provide stable certificate URLs.
+	stableURL := ""
+	accountURL := string(client.KID)
+	return s.UpdateCertificate(fqdn, &certificate.Options{
 		Cert:          &cert,
-		Key:           &key,
-		ACMEURL:       &certResource.CertURL,
-		ACMEURLStable: &certResource.CertStableURL,
-		ACMEAccount:   &certResource.AccountRef,
+		Key:           &keyPEM,
+		KeyType:       &keyType,
+		AltCert:       &altCert,
+		AltKey:        &altKeyPEM,
+		ACMEURL:       &url,
+		ACMEURLStable: &stableURL,
+		ACMEAccount:   &accountURL,
 	})
 }
 
+// issueCertificate creates an order for the FQDN, responds to its
+// challenges and finalizes it with a new private key of the key type.
+// It returns PEM encoded certificate chain and private key, and the
+// certificate URL.
+func (s Service) issueCertificate(ctx context.Context, client *acme.Client, fqdn string, dnsProvider dnsprovider.Provider, keyType string) (cert, keyPEM, url string, err error) {
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(fqdn))
+	if err != nil {
+		return
+	}
+	// Order URL is provided only in the Location header of the new
+	// order response.
+	orderURL := order.URI
+	for _, u := range order.AuthzURLs {
+		if err = s.authorize(ctx, client, u, dnsProvider); err != nil {
+			return
+		}
+	}
+	order, err = client.WaitOrder(ctx, orderURL)
+	if err != nil {
+		return
+	}
+
+	key, keyPEM, err := newCertificateKey(keyType)
+	if err != nil {
+		return
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: fqdn},
+		DNSNames: []string{fqdn},
+	}, key)
+	if err != nil {
+		return
+	}
+	der, url, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		// Finalize response is not required to have the Location header,
+		// without which the client can not wait for the order that is
+		// still processing. The error is returned only if the order does
+		// not become valid.
+		o, werr := client.WaitOrder(ctx, orderURL)
+		if werr != nil || o.Status != acme.StatusValid {
+			return
+		}
+		url = o.CertURL
+		if der, err = client.FetchCert(ctx, url, true); err != nil {
+			return
+		}
+	}
+	var chain bytes.Buffer
+	for _, b := range der {
+		if err = pem.Encode(&chain, &pem.Block{Type: "CERTIFICATE", Bytes: b}); err != nil {
+			return
+		}
+	}
+	return chain.String(), keyPEM, url, nil
+}
+
+// keyType returns the key type that is selected in ACMESettings, or the
+// default key type of the service.
+func (s Service) keyType(settings *certificate.ACMESettings) string {
+	if settings.KeyType != "" {
+		return settings.KeyType
+	}
+	if s.KeyType != "" {
+		return s.KeyType
+	}
+	return certificate.KeyTypeRSA2048
+}
+
+// dnsProvider returns the DNS provider that is selected in ACMESettings,
+// or nil if http-01 or tls-alpn-01 challenges should be used.
+func (s Service) dnsProvider(settings *certificate.ACMESettings) (p dnsprovider.Provider, err error) {
+	fqdn := settings.FQDN
+	if settings.DNSProvider == "" {
+		if strings.HasPrefix(fqdn, "*.") {
+			err = certificate.ErrDNSProviderRequired
+		}
+		return
+	}
+	p, ok := s.DNSProviders[settings.DNSProvider]
+	if !ok {
+		err = certificate.ErrDNSProviderNotFound
+	}
+	return
+}
+
 // IsCertificateBeingObtained tests if certificate is being obtained currently.
 func (s Service) IsCertificateBeingObtained(fqdn string) (yes bool, err error) {
 	mu.RLock()
@@ -230,28 +373,56 @@ func (s Service) CertificatesInfoByExpiry(since time.Time, start string, limit i
 
 // ACMEUser returns ACME user with ACME authentication details.
 func (s Service) ACMEUser() (u *certificate.ACMEUser, err error) {
-	var au *acmeUser
-	au, err = s.acmeUser()
+	acmeUserMu.Lock()
+	defer acmeUserMu.Unlock()
+
+	r, err := s.acmeUserRecord()
 	if err != nil {
 		return
 	}
-	u = au.export().export()
+	u = r.export()
 	return
 }
 
 // RegisterACMEUser registers and saves ACME user authentication data.
-func (s Service) RegisterACMEUser(directoryURL, email string) (u *certificate.ACMEUser, err error) {
+func (s Service) RegisterACMEUser(directoryURL, email string, eab *certificate.ExternalAccountBinding) (u *certificate.ACMEUser, err error) {
 	if email != "" {
 		if !emailRegex.MatchString(email) {
 			err = certificate.ErrACMEUserEmailInvalid
 			return
 		}
 	}
-	au, err := registerACMEUser(directoryURL, email, s.acmeUserFilename())
+	if directoryURL == "" {
+		directoryURL = s.DefaultACMEDirectoryURL
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), acmeTimeout)
+	defer cancel()
+
+	acmeUserMu.Lock()
+	defer acmeUserMu.Unlock()
+
+	r, err := s.registerACMEUser(ctx, directoryURL, email, eab)
+	if err != nil {
+		return
+	}
+	u = r.export()
+	return
+}
+
+// RolloverACMEUserKey replaces the ACME user account key with a newly
+// generated one.
+func (s Service) RolloverACMEUserKey() (u *certificate.ACMEUser, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), acmeTimeout)
+	defer cancel()
+
+	acmeUserMu.Lock()
+	defer acmeUserMu.Unlock()
+
+	r, err := s.rolloverACMEUserKey(ctx)
 	if err != nil {
 		return
 	}
-	u = au.export().export()
+	u = r.export()
 	return
 }
 
@@ -316,3 +487,78 @@ func (s Service) ACMEChallenges(start string, limit int) (page *certificate.ACME
 	})
 	return
 }
+
+// ACMESettings returns an instance of ACMESettings for a FQDN.
+func (s Service) ACMESettings(fqdn string) (as *certificate.ACMESettings, err error) {
+	var r *acmeSettingsRecord
+	if err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		r, err = getACMESettingsRecord(tx, []byte(fqdn))
+		return
+	}); err != nil {
+		return
+	}
+	as = r.export()
+	return
+}
+
+// UpdateACMESettings alters the fields of existing ACMESettings or
+// creates new ones. DNS provider must be one of configured DNSProviders,
+// and key type one of certificate.KeyTypes.
+func (s Service) UpdateACMESettings(fqdn string, o *certificate.ACMESettingsOptions) (as *certificate.ACMESettings, err error) {
+	if o.DNSProvider != nil && *o.DNSProvider != "" {
+		if _, ok := s.DNSProviders[*o.DNSProvider]; !ok {
+			err = certificate.ErrDNSProviderNotFound
+			return
+		}
+	}
+	if o.KeyType != nil && *o.KeyType != "" && !certificate.IsValidKeyType(*o.KeyType) {
+		err = certificate.ErrKeyTypeInvalid
+		return
+	}
+	r := &acmeSettingsRecord{}
+	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		r, err = getACMESettingsRecord(tx, []byte(fqdn))
+		switch err {
+		case certificate.ErrACMESettingsNotFound:
+			r = &acmeSettingsRecord{
+				fqdn: fqdn,
+			}
+		case nil:
+		default:
+			return
+		}
+		r.update(o)
+		return r.save(tx)
+	}); err != nil {
+		return
+	}
+	as = r.export()
+	return
+}
+
+// DeleteACMESettings deletes existing ACMESettings for a
+// provided FQDN and returns them.
+func (s Service) DeleteACMESettings(fqdn string) (as *certificate.ACMESettings, err error) {
+	r := &acmeSettingsRecord{}
+	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		r, err = getACMESettingsRecord(tx, []byte(fqdn))
+		if err != nil {
+			return
+		}
+		return r.delete(tx)
+	}); err != nil {
+		return
+	}
+	as = r.export()
+	return
+}
+
+// ACMEDNSProviders returns sorted names of configured DNS providers.
+func (s Service) ACMEDNSProviders() (names []string, err error) {
+	names = []string{}
+	for name := range s.DNSProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package boltCertificate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/boltdb/bolt"
+
+	"gopherpit.com/gopherpit/services/certificate"
+)
+
+var (
+	bucketNameACMESettings = []byte("ACMESettings")
+)
+
+type acmeSettingsRecord struct {
+	fqdn        string
+	DNSProvider string `json:"dns-provider,omitempty"`
+	KeyType     string `json:"key-type,omitempty"`
+}
+
+func (t acmeSettingsRecord) export() *certificate.ACMESettings {
+	return &certificate.ACMESettings{
+		FQDN:        t.fqdn,
+		DNSProvider: t.DNSProvider,
+		KeyType:     t.KeyType,
+	}
+}
+
+func (t *acmeSettingsRecord) update(o *certificate.ACMESettingsOptions) error {
+	if o.DNSProvider != nil {
+		t.DNSProvider = *o.DNSProvider
+	}
+	if o.KeyType != nil {
+		t.KeyType = *o.KeyType
+	}
+	return nil
+}
+
+func getACMESettingsRecord(tx *bolt.Tx, fqdn []byte) (t *acmeSettingsRecord, err error) {
+	bucket := tx.Bucket(bucketNameACMESettings)
+	if bucket == nil {
+		err = certificate.ErrACMESettingsNotFound
+		return
+	}
+	data := bucket.Get(fqdn)
+	if data == nil {
+		err = certificate.ErrACMESettingsNotFound
+		return
+	}
+	if err = json.Unmarshal(data, &t); err != nil {
+		return
+	}
+	t.fqdn = string(fqdn)
+	return
+}
+
+func (t *acmeSettingsRecord) save(tx *bolt.Tx) (err error) {
+	t.fqdn = strings.ToLower(strings.TrimSpace(t.fqdn))
+	// Required fields
+	if t.fqdn == "" {
+		return certificate.ErrFQDNMissing
+	}
+
+	// Save the ACME Settings record data
+	value, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("json marshal: %s", err)
+	}
+	bucket, err := tx.CreateBucketIfNotExists(bucketNameACMESettings)
+	if err != nil {
+		return fmt.Errorf("CreateBucketIfNotExists(%s) %s", bucketNameACMESettings, err)
+	}
+	if err := bucket.Put([]byte(t.fqdn), value); err != nil {
+		return fmt.Errorf("bucket(%s).Put(%s) %s", bucketNameACMESettings, t.fqdn, err)
+	}
+
+	return nil
+}
+
+func (t acmeSettingsRecord) delete(tx *bolt.Tx) (err error) {
+	// ACME Settings data
+	bucket, err := tx.CreateBucketIfNotExists(bucketNameACMESettings)
+	if err != nil {
+		return
+	}
+	return bucket.Delete([]byte(t.fqdn))
+}
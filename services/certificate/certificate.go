@@ -46,6 +46,9 @@ type ACMEService interface {
 	// ObtainCertificate requests a new SSL/TLS certificate from
 	// ACME provider and returns an instance of Certificate.
 	ObtainCertificate(fqdn string) (c *Certificate, err error)
+	// RenewCertificate queues an existing certificate for renewal,
+	// regardless of its expiration time and renewal backoff.
+	RenewCertificate(fqdn string) (err error)
 	// IsCertificateBeingObtained tests if certificate is being obtained currently.
 	// It can be used as a locking mechanism.
 	IsCertificateBeingObtained(fqdn string) (yes bool, err error)
@@ -58,6 +61,17 @@ type ACMEService interface {
 	DeleteACMEChallenge(fqdn string) (c *ACMEChallenge, err error)
 	// ACMEChallenges retrieves a paginated list of ACMEChallenge instances.
 	ACMEChallenges(start string, limit int) (page *ACMEChallengesPage, err error)
+	// ACMESettings returns an instance of ACMESettings for a FQDN.
+	ACMESettings(fqdn string) (s *ACMESettings, err error)
+	// UpdateACMESettings alters the fields of existing ACMESettings
+	// or creates new ones.
+	UpdateACMESettings(fqdn string, o *ACMESettingsOptions) (s *ACMESettings, err error)
+	// DeleteACMESettings deletes existing ACMESettings for a
+	// provided FQDN and returns them.
+	DeleteACMESettings(fqdn string) (s *ACMESettings, err error)
+	// ACMEDNSProviders returns names of configured DNS providers
+	// that can be used for dns-01 challenges.
+	ACMEDNSProviders() (names []string, err error)
 }
 
 // ACMEUserService handlers ACME user.
@@ -65,18 +79,75 @@ type ACMEUserService interface {
 	// ACMEUser returns ACME user with ACME authentication details.
 	ACMEUser() (u *ACMEUser, err error)
 	// RegisterACMEUser registers and saves ACME user authentication data.
-	RegisterACMEUser(directoryURL, email string) (u *ACMEUser, err error)
+	// External account binding is required only by some ACME providers
+	// and can be nil.
+	RegisterACMEUser(directoryURL, email string, eab *ExternalAccountBinding) (u *ACMEUser, err error)
+	// RolloverACMEUserKey replaces the ACME user account key with a newly
+	// generated one.
+	RolloverACMEUserKey() (u *ACMEUser, err error)
 }
 
-// Certificate holds data related to SSL/TLS certificate.
+// Types of ACME challenges.
+const (
+	ChallengeTypeHTTP01    = "http-01"
+	ChallengeTypeDNS01     = "dns-01"
+	ChallengeTypeTLSALPN01 = "tls-alpn-01"
+)
+
+// Key types of certificate private keys.
+const (
+	KeyTypeP256    = "p256"
+	KeyTypeP384    = "p384"
+	KeyTypeRSA2048 = "rsa2048"
+	KeyTypeRSA4096 = "rsa4096"
+	// KeyTypeDual is ECDSA P-256 certificate with an alternative
+	// RSA-2048 certificate for clients that do not support ECDSA.
+	KeyTypeDual = "dual"
+)
+
+// KeyTypes is a list of all supported key types.
+var KeyTypes = []string{
+	KeyTypeP256,
+	KeyTypeP384,
+	KeyTypeRSA2048,
+	KeyTypeRSA4096,
+	KeyTypeDual,
+}
+
+// IsValidKeyType returns true if keyType is one of KeyTypes.
+func IsValidKeyType(keyType string) bool {
+	for _, t := range KeyTypes {
+		if t == keyType {
+			return true
+		}
+	}
+	return false
+}
+
+// Certificate holds data related to SSL/TLS certificate. AltCert and
+// AltKey hold the alternative RSA certificate for KeyTypeDual.
+// RenewalAttemptTime and RenewalError describe the last renewal attempt,
+// and RenewalFailures is the number of consecutive failed renewals.
+// ExpiryNotificationTime is the time when the last expiry notification
+// was sent for the certificate that expires at NotifiedExpirationTime.
 type Certificate struct {
 	FQDN           string     `json:"fqdn"`
 	ExpirationTime *time.Time `json:"expiration-time,omitempty"`
 	Cert           string     `json:"cert,omitempty"`
 	Key            string     `json:"key,omitempty"`
+	KeyType        string     `json:"key-type,omitempty"`
+	AltCert        string     `json:"alt-cert,omitempty"`
+	AltKey         string     `json:"alt-key,omitempty"`
 	ACMEURL        string     `json:"acme-url,omitempty"`
 	ACMEURLStable  string     `json:"acme-url-stable,omitempty"`
 	ACMEAccount    string     `json:"acme-account,omitempty"`
+
+	RenewalAttemptTime *time.Time `json:"renewal-attempt-time,omitempty"`
+	RenewalError       string     `json:"renewal-error,omitempty"`
+	RenewalFailures    int        `json:"renewal-failures,omitempty"`
+
+	ExpiryNotificationTime *time.Time `json:"expiry-notification-time,omitempty"`
+	NotifiedExpirationTime *time.Time `json:"notified-expiration-time,omitempty"`
 }
 
 // Certificates is a list of Certificate instances.
@@ -96,19 +167,34 @@ type CertificatesPage struct {
 type Options struct {
 	Cert          *string `json:"cert,omitempty"`
 	Key           *string `json:"key,omitempty"`
+	KeyType       *string `json:"key-type,omitempty"`
+	AltCert       *string `json:"alt-cert,omitempty"`
+	AltKey        *string `json:"alt-key,omitempty"`
 	ACMEURL       *string `json:"acme-url,omitempty"`
 	ACMEURLStable *string `json:"acme-url-stable,omitempty"`
 	ACMEAccount   *string `json:"acme-account,omitempty"`
+
+	ExpiryNotificationTime *time.Time `json:"expiry-notification-time,omitempty"`
+	NotifiedExpirationTime *time.Time `json:"notified-expiration-time,omitempty"`
 }
 
 // Info is a subset of Certificate structure fields to provide
-// information about expiration time and ACME issuer.
+// information about expiration time, ACME issuer, renewal attempts and
+// expiry notifications.
 type Info struct {
 	FQDN           string     `json:"fqdn"`
 	ExpirationTime *time.Time `json:"expiration-time,omitempty"`
+	KeyType        string     `json:"key-type,omitempty"`
 	ACMEURL        string     `json:"acme-url,omitempty"`
 	ACMEURLStable  string     `json:"acme-url-stable,omitempty"`
 	ACMEAccount    string     `json:"acme-account,omitempty"`
+
+	RenewalAttemptTime *time.Time `json:"renewal-attempt-time,omitempty"`
+	RenewalError       string     `json:"renewal-error,omitempty"`
+	RenewalFailures    int        `json:"renewal-failures,omitempty"`
+
+	ExpiryNotificationTime *time.Time `json:"expiry-notification-time,omitempty"`
+	NotifiedExpirationTime *time.Time `json:"notified-expiration-time,omitempty"`
 }
 
 // Infos is a list of Info instances.
@@ -124,18 +210,27 @@ type InfosPage struct {
 
 // ACMEUser is hods data about authentication to ACME provider.
 type ACMEUser struct {
-	ID           int    `json:"id"`
-	Email        string `json:"email"`
-	PrivateKey   []byte `json:"private-key"`
-	URL          string `json:"url"`
-	NewAuthzURL  string `json:"new-authz-url"`
-	DirectoryURL string `json:"directory-url"`
+	ID                   int    `json:"id"`
+	Email                string `json:"email"`
+	PrivateKey           []byte `json:"private-key"`
+	URL                  string `json:"url"`
+	DirectoryURL         string `json:"directory-url"`
+	ExternalAccountKeyID string `json:"external-account-key-id,omitempty"`
+}
+
+// ExternalAccountBinding holds credentials from ACME provider that
+// associate a new ACME account with an existing account at the provider.
+type ExternalAccountBinding struct {
+	KeyID   string `json:"key-id"`
+	HMACKey string `json:"hmac-key"`
 }
 
 // ACMEChallenge provides data about ACME challenge for
-// new certificate issue.
+// new certificate issue. Type is "tls-alpn-01" for challenges that are
+// responded in TLS handshakes, or empty for http-01 challenges.
 type ACMEChallenge struct {
 	FQDN    string `json:"fqdn"`
+	Type    string `json:"type,omitempty"`
 	Token   string `json:"token,omitempty"`
 	KeyAuth string `json:"key-auth,omitempty"`
 }
@@ -155,10 +250,30 @@ type ACMEChallengesPage struct {
 // pointers to set ACME challenge data. If a parameter is nil,
 // the corresponding ACMEChallenge parameter will not be changed.
 type ACMEChallengeOptions struct {
+	Type    *string `json:"type,omitempty"`
 	Token   *string `json:"token,omitempty"`
 	KeyAuth *string `json:"key-auth,omitempty"`
 }
 
+// ACMESettings holds per FQDN parameters for obtaining certificates from
+// ACME provider. If DNSProvider is set, dns-01 challenges are responded
+// with the named DNS provider, instead of http-01 challenges. Certificates
+// for wildcard FQDNs can be obtained only with dns-01 challenges. If
+// KeyType is empty, the default key type of the service is used.
+type ACMESettings struct {
+	FQDN        string `json:"fqdn"`
+	DNSProvider string `json:"dns-provider,omitempty"`
+	KeyType     string `json:"key-type,omitempty"`
+}
+
+// ACMESettingsOptions is a structure with parameters as
+// pointers to set ACME settings. If a parameter is nil,
+// the corresponding ACMESettings parameter will not be changed.
+type ACMESettingsOptions struct {
+	DNSProvider *string `json:"dns-provider,omitempty"`
+	KeyType     *string `json:"key-type,omitempty"`
+}
+
 // Errors that are related to the Certificate Service.
 var (
 	ErrCertificateNotFound   = errors.New("certificate not found")
@@ -168,5 +283,11 @@ var (
 	ErrFQDNExists            = errors.New("fqdn exists")
 	ErrACMEUserNotFound      = errors.New("acme user not found")
 	ErrACMEUserEmailInvalid  = errors.New("acme user email invalid")
+	ErrACMEUserEABRequired   = errors.New("acme user external account binding required")
+	ErrACMEUserEABInvalid    = errors.New("acme user external account binding invalid")
 	ErrACMEChallengeNotFound = errors.New("acme challenge not found")
+	ErrACMESettingsNotFound  = errors.New("acme settings not found")
+	ErrDNSProviderNotFound   = errors.New("dns provider not found")
+	ErrDNSProviderRequired   = errors.New("dns provider required")
+	ErrKeyTypeInvalid        = errors.New("key type invalid")
 )
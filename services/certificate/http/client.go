@@ -64,6 +64,14 @@ func (c Client) ObtainCertificate(fqdn string) (crt *certificate.Certificate, er
 	return
 }
 
+// RenewCertificate queues an existing certificate for renewal by making
+// a HTTP POST request to {Client.Endpoint}/certificates/{fqdn}/renew.
+func (c Client) RenewCertificate(fqdn string) (err error) {
+	err = c.JSON("POST", "/certificates/"+fqdn+"/renew", nil, nil, nil)
+	err = getServiceError(err)
+	return
+}
+
 // IsCertificateBeingObtainedResponse is expected structure of JSON-encoded response
 // body for IsCertificateBeingObtained HTTP request.
 type IsCertificateBeingObtainedResponse struct {
@@ -143,6 +151,7 @@ func (c Client) CertificatesInfoByExpiry(since time.Time, start string, limit in
 // ACMEUser returns ACME user with ACME authentication details by making a HTTP GET
 // request to {Client.Endpoint}/acme/user.
 func (c Client) ACMEUser() (u *certificate.ACMEUser, err error) {
+	u = &certificate.ACMEUser{}
 	err = c.JSON("GET", "/acme/user", nil, nil, u)
 	err = getServiceError(err)
 	return
@@ -151,17 +160,19 @@ func (c Client) ACMEUser() (u *certificate.ACMEUser, err error) {
 // RegisterACMEUserRequest is a structure that is passed as JSON-encoded body
 // to RegisterACMEUser HTTP request.
 type RegisterACMEUserRequest struct {
-	DirectoryURL string `json:"directory-url"`
-	Email        string `json:"email"`
+	DirectoryURL           string                              `json:"directory-url"`
+	Email                  string                              `json:"email"`
+	ExternalAccountBinding *certificate.ExternalAccountBinding `json:"external-account-binding,omitempty"`
 }
 
 // RegisterACMEUser registers and saves ACME user authentication data by making a
 // HTTP POST request to {Client.Endpoint}/acme/user. Post body is a JSON-encoded
 // RegisterACMEUserRequest instance.
-func (c Client) RegisterACMEUser(directoryURL, email string) (u *certificate.ACMEUser, err error) {
+func (c Client) RegisterACMEUser(directoryURL, email string, eab *certificate.ExternalAccountBinding) (u *certificate.ACMEUser, err error) {
 	body, err := json.Marshal(RegisterACMEUserRequest{
-		DirectoryURL: directoryURL,
-		Email:        email,
+		DirectoryURL:           directoryURL,
+		Email:                  email,
+		ExternalAccountBinding: eab,
 	})
 	if err != nil {
 		return
@@ -172,6 +183,16 @@ func (c Client) RegisterACMEUser(directoryURL, email string) (u *certificate.ACM
 	return
 }
 
+// RolloverACMEUserKey replaces the ACME user account key with a newly
+// generated one by making a HTTP POST request to
+// {Client.Endpoint}/acme/user/key-rollover.
+func (c Client) RolloverACMEUserKey() (u *certificate.ACMEUser, err error) {
+	u = &certificate.ACMEUser{}
+	err = c.JSON("POST", "/acme/user/key-rollover", nil, nil, u)
+	err = getServiceError(err)
+	return
+}
+
 // ACMEChallenge returns an instance of ACMEChallenge for a FQDN by making a
 // HTTP GET request to {Client.Endpoint}/acme/challenges/{fqdn}.
 func (c Client) ACMEChallenge(fqdn string) (ac *certificate.ACMEChallenge, err error) {
@@ -219,3 +240,51 @@ func (c Client) ACMEChallenges(start string, limit int) (page *certificate.ACMEC
 	err = getServiceError(err)
 	return
 }
+
+// ACMESettings returns an instance of ACMESettings for a FQDN by making a
+// HTTP GET request to {Client.Endpoint}/acme/settings/{fqdn}.
+func (c Client) ACMESettings(fqdn string) (as *certificate.ACMESettings, err error) {
+	as = &certificate.ACMESettings{}
+	err = c.JSON("GET", "/acme/settings/"+fqdn, nil, nil, as)
+	err = getServiceError(err)
+	return
+}
+
+// UpdateACMESettings alters the fields of existing ACMESettings by making a
+// HTTP POST request to {Client.Endpoint}/acme/settings/{fqdn}. Post body is a JSON-encoded
+// certificate.ACMESettingsOptions instance.
+func (c Client) UpdateACMESettings(fqdn string, o *certificate.ACMESettingsOptions) (as *certificate.ACMESettings, err error) {
+	body, err := json.Marshal(o)
+	if err != nil {
+		return
+	}
+	as = &certificate.ACMESettings{}
+	err = c.JSON("POST", "/acme/settings/"+fqdn, nil, bytes.NewReader(body), as)
+	err = getServiceError(err)
+	return
+}
+
+// DeleteACMESettings deletes existing ACMESettings for a provided FQDN
+// and returns them by making a HTTP DELETE request to {Client.Endpoint}/acme/settings/{fqdn}.
+func (c Client) DeleteACMESettings(fqdn string) (as *certificate.ACMESettings, err error) {
+	as = &certificate.ACMESettings{}
+	err = c.JSON("DELETE", "/acme/settings/"+fqdn, nil, nil, as)
+	err = getServiceError(err)
+	return
+}
+
+// ACMEDNSProvidersResponse is expected structure of JSON-encoded response
+// body for ACMEDNSProviders HTTP request.
+type ACMEDNSProvidersResponse struct {
+	Names []string `json:"names"`
+}
+
+// ACMEDNSProviders returns names of configured DNS providers by making a
+// HTTP GET request to {Client.Endpoint}/acme/dns-providers.
+func (c Client) ACMEDNSProviders() (names []string, err error) {
+	response := &ACMEDNSProvidersResponse{}
+	err = c.JSON("GET", "/acme/dns-providers", nil, nil, response)
+	err = getServiceError(err)
+	names = response.Names
+	return
+}
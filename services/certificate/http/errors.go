@@ -22,7 +22,13 @@ var (
 	ErrFQDNExists            = errorRegistry.MustAddMessageError(1102, "FQDN Exists")
 	ErrACMEUserNotFound      = errorRegistry.MustAddMessageError(1200, "ACME User Not Found")
 	ErrACMEUserEmailInvalid  = errorRegistry.MustAddMessageError(1201, "ACME User Email Invalid")
+	ErrACMEUserEABRequired   = errorRegistry.MustAddMessageError(1202, "ACME User External Account Binding Required")
+	ErrACMEUserEABInvalid    = errorRegistry.MustAddMessageError(1203, "ACME User External Account Binding Invalid")
 	ErrACMEChallengeNotFound = errorRegistry.MustAddMessageError(1300, "ACME Challenge Not Found")
+	ErrACMESettingsNotFound  = errorRegistry.MustAddMessageError(1400, "ACME Settings Not Found")
+	ErrDNSProviderNotFound   = errorRegistry.MustAddMessageError(1500, "DNS Provider Not Found")
+	ErrDNSProviderRequired   = errorRegistry.MustAddMessageError(1501, "DNS Provider Required")
+	ErrKeyTypeInvalid        = errorRegistry.MustAddMessageError(1600, "Key Type Invalid")
 )
 
 var errorMap = map[error]error{
@@ -33,7 +39,13 @@ var errorMap = map[error]error{
 	ErrFQDNExists:            certificate.ErrFQDNExists,
 	ErrACMEUserNotFound:      certificate.ErrACMEUserNotFound,
 	ErrACMEUserEmailInvalid:  certificate.ErrACMEUserEmailInvalid,
+	ErrACMEUserEABRequired:   certificate.ErrACMEUserEABRequired,
+	ErrACMEUserEABInvalid:    certificate.ErrACMEUserEABInvalid,
 	ErrACMEChallengeNotFound: certificate.ErrACMEChallengeNotFound,
+	ErrACMESettingsNotFound:  certificate.ErrACMESettingsNotFound,
+	ErrDNSProviderNotFound:   certificate.ErrDNSProviderNotFound,
+	ErrDNSProviderRequired:   certificate.ErrDNSProviderRequired,
+	ErrKeyTypeInvalid:        certificate.ErrKeyTypeInvalid,
 }
 
 func getServiceError(err error) error {
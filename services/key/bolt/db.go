@@ -23,6 +23,7 @@ import (
 var (
 	bucketNameKeys           = []byte("Keys")
 	bucketNameIndexRefSecret = []byte("Index_Ref_Secret")
+	bucketNameRateLimits     = []byte("RateLimits")
 )
 
 type keyRecord struct {
@@ -230,6 +231,75 @@ func getKeys(tx *bolt.Tx, start []byte, limit int) (page key.KeysPage, err error
 	return
 }
 
+type rateLimitsRecord struct {
+	ref             string
+	HourlyLimit     int `json:"hourly-limit,omitempty"`
+	HourlyReadLimit int `json:"hourly-read-limit,omitempty"`
+}
+
+func (r rateLimitsRecord) export() *key.RateLimits {
+	return &key.RateLimits{
+		HourlyLimit:     r.HourlyLimit,
+		HourlyReadLimit: r.HourlyReadLimit,
+	}
+}
+
+func (r *rateLimitsRecord) update(o *key.RateLimitsOptions) {
+	if o == nil {
+		return
+	}
+	if o.HourlyLimit != nil {
+		r.HourlyLimit = *o.HourlyLimit
+	}
+	if o.HourlyReadLimit != nil {
+		r.HourlyReadLimit = *o.HourlyReadLimit
+	}
+}
+
+// getRateLimitsRecord returns rate limits for the ref, or an empty record
+// if they are not set.
+func getRateLimitsRecord(tx *bolt.Tx, ref []byte) (r *rateLimitsRecord, err error) {
+	r = &rateLimitsRecord{}
+	if bucket := tx.Bucket(bucketNameRateLimits); bucket != nil {
+		if data := bucket.Get(ref); data != nil {
+			if err = json.Unmarshal(data, &r); err != nil {
+				return
+			}
+		}
+	}
+	r.ref = string(ref)
+	return
+}
+
+// save stores rate limits of the ref, or removes them if all limits have
+// default values.
+func (r *rateLimitsRecord) save(tx *bolt.Tx) (err error) {
+	if r.ref == "" {
+		return key.ErrKeyRefRequired
+	}
+
+	bucket, err := tx.CreateBucketIfNotExists(bucketNameRateLimits)
+	if err != nil {
+		return fmt.Errorf("CreateBucketIfNotExists(%s): %s", bucketNameRateLimits, err)
+	}
+
+	if r.HourlyLimit == 0 && r.HourlyReadLimit == 0 {
+		if err := bucket.Delete([]byte(r.ref)); err != nil {
+			return fmt.Errorf("bucket(%s).Delete(%s): %s", bucketNameRateLimits, r.ref, err)
+		}
+		return nil
+	}
+
+	value, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("json marshal: %s", err)
+	}
+	if err := bucket.Put([]byte(r.ref), value); err != nil {
+		return fmt.Errorf("bucket(%s).Put(%s): %s", bucketNameRateLimits, r.ref, err)
+	}
+	return nil
+}
+
 var base32Encoding = base32.NewEncoding("ABCDEFGHJKMNPQRSTVWXYZ0123456789")
 
 func newKeySecret(tx *bolt.Tx) (secret string, err error) {
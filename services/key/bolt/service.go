@@ -133,3 +133,35 @@ func (s Service) Keys(startName string, limit int) (page key.KeysPage, err error
 	})
 	return
 }
+
+// RateLimits returns API rate limit overrides for the ref. Empty
+// RateLimits are returned if overrides are not set.
+func (s Service) RateLimits(ref string) (l *key.RateLimits, err error) {
+	var r *rateLimitsRecord
+	if err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		r, err = getRateLimitsRecord(tx, []byte(ref))
+		return
+	}); err != nil {
+		return
+	}
+	l = r.export()
+	return
+}
+
+// UpdateRateLimits changes API rate limit overrides for the ref. It does
+// not require the key with the ref to exist.
+func (s Service) UpdateRateLimits(ref string, o *key.RateLimitsOptions) (l *key.RateLimits, err error) {
+	var r *rateLimitsRecord
+	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		r, err = getRateLimitsRecord(tx, []byte(ref))
+		if err != nil {
+			return
+		}
+		r.update(o)
+		return r.save(tx)
+	}); err != nil {
+		return
+	}
+	l = r.export()
+	return
+}
@@ -131,3 +131,26 @@ func (c Client) Keys(startID string, limit int) (page key.KeysPage, err error) {
 	err = getServiceError(err)
 	return
 }
+
+// RateLimits retrieves API rate limit overrides by making a HTTP GET
+// request to {Client.Endpoint}/keys/{ref}/rate-limits.
+func (c Client) RateLimits(ref string) (l *key.RateLimits, err error) {
+	l = &key.RateLimits{}
+	err = c.JSON("GET", "/keys/"+ref+"/rate-limits", nil, nil, l)
+	err = getServiceError(err)
+	return
+}
+
+// UpdateRateLimits changes API rate limit overrides by making a HTTP PUT
+// request to {Client.Endpoint}/keys/{ref}/rate-limits. Post body is
+// a JSON-encoded key.RateLimitsOptions instance.
+func (c Client) UpdateRateLimits(ref string, o *key.RateLimitsOptions) (l *key.RateLimits, err error) {
+	body, err := json.Marshal(o)
+	if err != nil {
+		return
+	}
+	l = &key.RateLimits{}
+	err = c.JSON("PUT", "/keys/"+ref+"/rate-limits", nil, bytes.NewReader(body), l)
+	err = getServiceError(err)
+	return
+}
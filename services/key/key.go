@@ -18,6 +18,8 @@ type Service interface {
 	DeleteKey(ref string) error
 	RegenerateSecret(ref string) (string, error)
 	Keys(startID string, limit int) (KeysPage, error)
+	RateLimits(ref string) (*RateLimits, error)
+	UpdateRateLimits(ref string, o *RateLimitsOptions) (*RateLimits, error)
 }
 
 type Key struct {
@@ -30,6 +32,21 @@ type Options struct {
 	AuthorizedNetworks *[]net.IPNet `json:"authorized-networks,omitempty"`
 }
 
+// RateLimits override default API rate limits for a key reference.
+// They are stored independently of keys, so that they are kept when
+// the key is deleted and created again, and they can be set before the
+// key is created. Zero value keeps the default limit and a negative
+// value disables the limit.
+type RateLimits struct {
+	HourlyLimit     int `json:"hourly-limit,omitempty"`
+	HourlyReadLimit int `json:"hourly-read-limit,omitempty"`
+}
+
+type RateLimitsOptions struct {
+	HourlyLimit     *int `json:"hourly-limit,omitempty"`
+	HourlyReadLimit *int `json:"hourly-read-limit,omitempty"`
+}
+
 type Keys []Key
 
 type KeysPage struct {
@@ -183,6 +183,11 @@ type chagelogRecordData struct {
 }
 
 func (s Service) newChangelogRecord(data chagelogRecordData) error {
+	return s.newChangelogRecords([]chagelogRecordData{data})
+}
+
+// newChangelogRecords saves multiple changelog records in a single transaction.
+func (s Service) newChangelogRecords(data []chagelogRecordData) error {
 	now := time.Now().UTC()
 	c, err := s.Changelog.NewConnection(now)
 	if err != nil {
@@ -191,16 +196,21 @@ func (s Service) newChangelogRecord(data chagelogRecordData) error {
 	defer c.Close()
 
 	if err = c.DB.Update(func(tx *bolt.Tx) (err error) {
-		return (&changelogRecord{
-			time:      now,
-			domainID:  data.domainID,
-			FQDN:      data.fqdn,
-			PackageID: data.packageID,
-			Path:      data.path,
-			UserID:    data.userID,
-			Action:    data.action,
-			Changes:   data.changes,
-		}).save(tx)
+		for _, d := range data {
+			if err = (&changelogRecord{
+				time:      now,
+				domainID:  d.domainID,
+				FQDN:      d.fqdn,
+				PackageID: d.packageID,
+				Path:      d.path,
+				UserID:    d.userID,
+				Action:    d.action,
+				Changes:   d.changes,
+			}).save(tx); err != nil {
+				return
+			}
+		}
+		return
 	}); err != nil {
 		return err
 	}
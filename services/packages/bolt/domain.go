@@ -14,6 +14,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 var (
 	bucketNameDomains                      = []byte("Domains")
 	bucketNameIndexFQDNDomainID            = []byte("Index_FQDN_DomainID")
+	bucketNameIndexAliasFQDNDomainID       = []byte("Index_AliasFQDN_DomainID")
 	bucketNameIndexOwnerUserIDFQDNDomainID = []byte("Index_OwnerUserID_FQDN_DomainID")
 	bucketNameIndexDisabledDomainIDs       = []byte("Index_Disabled_DomainID_1")
 
@@ -40,11 +42,19 @@ type domainRecord struct {
 	id                string
 	FQDN              string `json:"fqdn"`
 	OwnerUserID       string `json:"owner-user-id,omitempty"`
+	OrganizationID    string `json:"organization-id,omitempty"`
 	CertificateIgnore bool   `json:"certificate-ignore,omitempty"`
 	Disabled          bool   `json:"disabled,omitempty"`
+	Revision          uint64 `json:"revision,omitempty"`
+
+	PackageDefaults *packages.PackageDefaults `json:"package-defaults,omitempty"`
+
+	Aliases       []string `json:"aliases,omitempty"`
+	AliasRedirect bool     `json:"alias-redirect,omitempty"`
 
 	// Internal functionality fields (changes not logged)
-	CertificateIgnoreMissing bool `json:"certificate-ignore-missing,omitempty"`
+	CertificateIgnoreMissing bool       `json:"certificate-ignore-missing,omitempty"`
+	VerificationFailed       *time.Time `json:"verification-failed,omitempty"`
 }
 
 func (d domainRecord) export() *packages.Domain {
@@ -52,10 +62,18 @@ func (d domainRecord) export() *packages.Domain {
 		ID:                d.id,
 		FQDN:              d.FQDN,
 		OwnerUserID:       d.OwnerUserID,
+		OrganizationID:    d.OrganizationID,
 		CertificateIgnore: d.CertificateIgnore,
 		Disabled:          d.Disabled,
+		Revision:          d.Revision,
+
+		PackageDefaults: d.PackageDefaults,
+
+		Aliases:       d.Aliases,
+		AliasRedirect: d.AliasRedirect,
 
 		CertificateIgnoreMissing: d.CertificateIgnoreMissing,
+		VerificationFailed:       d.VerificationFailed,
 	}
 }
 
@@ -79,6 +97,30 @@ func (d *domainRecord) update(o *packages.DomainOptions) (changes packages.Chang
 			})
 		}
 		d.OwnerUserID = *o.OwnerUserID
+		if d.OwnerUserID != "" && d.OrganizationID != "" {
+			changes = append(changes, packages.Change{
+				Field: "organization-id",
+				From:  stringToStringPtr(d.OrganizationID),
+			})
+			d.OrganizationID = ""
+		}
+	}
+	if o.OrganizationID != nil {
+		if *o.OrganizationID != d.OrganizationID {
+			changes = append(changes, packages.Change{
+				Field: "organization-id",
+				From:  stringToStringPtr(d.OrganizationID),
+				To:    stringToStringPtr(*o.OrganizationID),
+			})
+		}
+		d.OrganizationID = *o.OrganizationID
+		if d.OrganizationID != "" && d.OwnerUserID != "" {
+			changes = append(changes, packages.Change{
+				Field: "owner-user-id",
+				From:  stringToStringPtr(d.OwnerUserID),
+			})
+			d.OwnerUserID = ""
+		}
 	}
 	if o.CertificateIgnore != nil {
 		if *o.CertificateIgnore != d.CertificateIgnore {
@@ -100,11 +142,80 @@ func (d *domainRecord) update(o *packages.DomainOptions) (changes packages.Chang
 		}
 		d.Disabled = *o.Disabled
 	}
+	if o.PackageDefaults != nil {
+		var from packages.PackageDefaults
+		if d.PackageDefaults != nil {
+			from = *d.PackageDefaults
+		}
+		changes = append(changes, packageDefaultsChanges(from, *o.PackageDefaults)...)
+		if o.PackageDefaults.IsZero() {
+			d.PackageDefaults = nil
+		} else {
+			pd := *o.PackageDefaults
+			d.PackageDefaults = &pd
+		}
+	}
+
+	if o.Aliases != nil {
+		from := strings.Join(d.Aliases, ", ")
+		to := strings.Join(*o.Aliases, ", ")
+		if from != to {
+			changes = append(changes, packages.Change{
+				Field: "aliases",
+				From:  stringToStringPtr(from),
+				To:    stringToStringPtr(to),
+			})
+		}
+		d.Aliases = append([]string(nil), *o.Aliases...)
+	}
+	if o.AliasRedirect != nil {
+		if *o.AliasRedirect != d.AliasRedirect {
+			changes = append(changes, packages.Change{
+				Field: "alias-redirect",
+				From:  boolPtrToStringPtr(&d.AliasRedirect),
+				To:    boolPtrToStringPtr(o.AliasRedirect),
+			})
+		}
+		d.AliasRedirect = *o.AliasRedirect
+	}
 
 	// Internal functionality fields (changes not logged)
 	if o.CertificateIgnoreMissing != nil {
 		d.CertificateIgnoreMissing = *o.CertificateIgnoreMissing
 	}
+	if o.VerificationFailed != nil {
+		if o.VerificationFailed.IsZero() {
+			d.VerificationFailed = nil
+		} else {
+			t := *o.VerificationFailed
+			d.VerificationFailed = &t
+		}
+	}
+	return
+}
+
+// packageDefaultsChanges returns changes of every package defaults
+// field, with field names prefixed by "default-".
+func packageDefaultsChanges(from, to packages.PackageDefaults) (changes packages.Changes) {
+	for _, f := range []struct {
+		field    string
+		from, to string
+	}{
+		{"default-vcs", string(from.VCS), string(to.VCS)},
+		{"default-repo-root", from.RepoRoot, to.RepoRoot},
+		{"default-ref-type", string(from.RefType), string(to.RefType)},
+		{"default-ref-name", from.RefName, to.RefName},
+		{"default-go-source", from.GoSource, to.GoSource},
+		{"default-redirect-url", from.RedirectURL, to.RedirectURL},
+	} {
+		if f.from != f.to {
+			changes = append(changes, packages.Change{
+				Field: f.field,
+				From:  stringToStringPtr(f.from),
+				To:    stringToStringPtr(f.to),
+			})
+		}
+	}
 	return
 }
 
@@ -159,7 +270,26 @@ func isDomainDisabled(tx *bolt.Tx, id []byte) (disabled bool, err error) {
 	return
 }
 
+// getDomainIDByFQDN returns the ID of the domain with the provided
+// fqdn or alias or, if there is no such domain, the ID of the wildcard
+// domain that covers it.
 func getDomainIDByFQDN(tx *bolt.Tx, fqdn []byte) (id []byte, err error) {
+	id, err = getDomainIDByExactFQDN(tx, fqdn)
+	if err != packages.ErrDomainNotFound || packages.IsWildcardFQDN(string(fqdn)) {
+		return
+	}
+	id, err = getDomainIDByAliasFQDN(tx, fqdn)
+	if err != packages.ErrDomainNotFound {
+		return
+	}
+	wildcard := packages.WildcardFQDN(string(fqdn))
+	if wildcard == "" {
+		return
+	}
+	return getDomainIDByExactFQDN(tx, []byte(wildcard))
+}
+
+func getDomainIDByExactFQDN(tx *bolt.Tx, fqdn []byte) (id []byte, err error) {
 	bucket := tx.Bucket(bucketNameIndexFQDNDomainID)
 	if bucket == nil {
 		err = packages.ErrDomainNotFound
@@ -173,6 +303,22 @@ func getDomainIDByFQDN(tx *bolt.Tx, fqdn []byte) (id []byte, err error) {
 	return
 }
 
+// getDomainIDByAliasFQDN returns the ID of the domain that has the
+// provided fqdn as an alias.
+func getDomainIDByAliasFQDN(tx *bolt.Tx, fqdn []byte) (id []byte, err error) {
+	bucket := tx.Bucket(bucketNameIndexAliasFQDNDomainID)
+	if bucket == nil {
+		err = packages.ErrDomainNotFound
+		return
+	}
+	id = bucket.Get(fqdn)
+	if id == nil {
+		err = packages.ErrDomainNotFound
+		return
+	}
+	return
+}
+
 func (d *domainRecord) save(tx *bolt.Tx) (err error) {
 	// Fields validation
 	d.FQDN = strings.TrimSpace(strings.ToLower(d.FQDN))
@@ -180,9 +326,30 @@ func (d *domainRecord) save(tx *bolt.Tx) (err error) {
 		return packages.ErrDomainFQDNRequired
 	}
 
-	if d.OwnerUserID == "" {
+	aliases := []string{}
+	for _, a := range d.Aliases {
+		a = strings.TrimSpace(strings.ToLower(a))
+		if a == "" || contains(aliases, a) {
+			continue
+		}
+		if a == d.FQDN || packages.IsWildcardFQDN(a) || packages.IsWildcardFQDN(d.FQDN) {
+			return packages.ErrDomainAliasInvalid
+		}
+		aliases = append(aliases, a)
+	}
+	d.Aliases = nil
+	if len(aliases) > 0 {
+		d.Aliases = aliases
+	}
+
+	if d.OwnerUserID == "" && d.OrganizationID == "" {
 		return packages.ErrDomainOwnerUserIDRequired
 	}
+	if d.OrganizationID != "" {
+		if _, err := getOrganizationRecordByID(tx, []byte(d.OrganizationID)); err != nil {
+			return err
+		}
+	}
 
 	fqdn := []byte(d.FQDN)
 
@@ -210,7 +377,7 @@ func (d *domainRecord) save(tx *bolt.Tx) (err error) {
 
 	// FQDN must be unique
 	if d.FQDN != "" {
-		ci, err := getDomainIDByFQDN(tx, fqdn)
+		ci, err := getDomainIDByExactFQDN(tx, fqdn)
 		switch err {
 		case packages.ErrDomainNotFound:
 		case nil:
@@ -220,6 +387,37 @@ func (d *domainRecord) save(tx *bolt.Tx) (err error) {
 		default:
 			return fmt.Errorf("get domain id by fqdn: %s", err)
 		}
+		ci, err = getDomainIDByAliasFQDN(tx, fqdn)
+		switch err {
+		case packages.ErrDomainNotFound:
+		case nil:
+			if !bytes.Equal(ci, id) {
+				return packages.ErrDomainAlreadyExists
+			}
+		default:
+			return fmt.Errorf("get domain id by alias fqdn: %s", err)
+		}
+	}
+
+	// Aliases must not be used by other domains
+	for _, a := range d.Aliases {
+		ci, err := getDomainIDByExactFQDN(tx, []byte(a))
+		if err == nil {
+			return packages.ErrDomainAliasAlreadyExists
+		}
+		if err != packages.ErrDomainNotFound {
+			return fmt.Errorf("get domain id by fqdn: %s", err)
+		}
+		ci, err = getDomainIDByAliasFQDN(tx, []byte(a))
+		switch err {
+		case packages.ErrDomainNotFound:
+		case nil:
+			if !bytes.Equal(ci, id) {
+				return packages.ErrDomainAliasAlreadyExists
+			}
+		default:
+			return fmt.Errorf("get domain id by alias fqdn: %s", err)
+		}
 	}
 
 	var bucket *bolt.Bucket
@@ -284,6 +482,30 @@ func (d *domainRecord) save(tx *bolt.Tx) (err error) {
 		}
 	}
 
+	// Alias FQDN index
+	for _, a := range ed.Aliases {
+		if contains(d.Aliases, a) {
+			continue
+		}
+		if bucket = tx.Bucket(bucketNameIndexAliasFQDNDomainID); bucket != nil {
+			if err := bucket.Delete([]byte(a)); err != nil {
+				return fmt.Errorf("bucket(%s).Delete(%s): %s", bucketNameIndexAliasFQDNDomainID, a, err)
+			}
+		}
+	}
+	for _, a := range d.Aliases {
+		if contains(ed.Aliases, a) {
+			continue
+		}
+		bucket, err = tx.CreateBucketIfNotExists(bucketNameIndexAliasFQDNDomainID)
+		if err != nil {
+			return
+		}
+		if err := bucket.Put([]byte(a), id); err != nil {
+			return fmt.Errorf("bucket(%s).Put(%s): %s", bucketNameIndexAliasFQDNDomainID, a, err)
+		}
+	}
+
 	if d.OwnerUserID != ed.OwnerUserID || d.FQDN != ed.FQDN {
 		// Combined OwnerUserID FQDN index
 		if ed.FQDN != "" && ed.OwnerUserID != "" {
@@ -332,11 +554,44 @@ func (d *domainRecord) save(tx *bolt.Tx) (err error) {
 		}
 	}
 
-	if ed.OwnerUserID != "" && d.OwnerUserID != ed.OwnerUserID {
-		if err = d.addUser(tx, []byte(ed.OwnerUserID), false); err != nil {
+	if d.OrganizationID != ed.OrganizationID || d.FQDN != ed.FQDN {
+		// Combined OrganizationID FQDN index
+		if ed.FQDN != "" && ed.OrganizationID != "" {
+			if err := boltutils.BoltDeepDelete(
+				tx,
+				bucketNameIndexOrganizationIDFQDNDomainID,
+				[]byte(ed.OrganizationID),
+				[]byte(ed.FQDN),
+			); err != nil {
+				return fmt.Errorf("bolt deep delete: %s", err)
+			}
+		}
+		if d.FQDN != "" && d.OrganizationID != "" {
+			if err := boltutils.BoltDeepPut(
+				tx,
+				bucketNameIndexOrganizationIDFQDNDomainID,
+				[]byte(d.OrganizationID),
+				[]byte(d.FQDN),
+				id,
+			); err != nil {
+				return fmt.Errorf("bolt deep put: %s", err)
+			}
+		}
+	}
+
+	// Previous owner keeps the access only if the domain is given to
+	// another user, as organization members have access to its domains.
+	if ed.OwnerUserID != "" && d.OwnerUserID != "" && d.OwnerUserID != ed.OwnerUserID {
+		if err = d.addUser(tx, []byte(ed.OwnerUserID), packages.DomainRoleMaintainer, false); err != nil {
 			return fmt.Errorf("add previous owner as user: %s", err)
 		}
 	}
+	if (ed.OwnerUserID != "" || ed.OrganizationID != "") && (d.OwnerUserID != ed.OwnerUserID || d.OrganizationID != ed.OrganizationID) {
+		// Pending transfer is not valid after the owner is changed
+		if err = deleteDomainTransferRecord(tx, id); err != nil {
+			return fmt.Errorf("delete domain transfer: %s", err)
+		}
+	}
 
 	// Disabled index
 	if d.Disabled == false && ed.Disabled == true {
@@ -356,6 +611,9 @@ func (d *domainRecord) save(tx *bolt.Tx) (err error) {
 		}
 	}
 
+	// Every saved change results in a new revision
+	d.Revision = ed.Revision + 1
+
 	// Save the domain record data
 	value, err := json.Marshal(d)
 	if err != nil {
@@ -406,6 +664,17 @@ func (d domainRecord) delete(tx *bolt.Tx) (err error) {
 				return fmt.Errorf("bolt deep delete: %s", err)
 			}
 		}
+		// Combined OrganizationID FQDN index
+		if d.OrganizationID != "" {
+			if err := boltutils.BoltDeepDelete(
+				tx,
+				bucketNameIndexOrganizationIDFQDNDomainID,
+				[]byte(d.OrganizationID),
+				fqdn,
+			); err != nil {
+				return fmt.Errorf("bolt deep delete: %s", err)
+			}
+		}
 
 		// Combined UserID FQDN index
 		bucket = tx.Bucket(bucketNameIndexDomainIDUserIDs)
@@ -429,6 +698,15 @@ func (d domainRecord) delete(tx *bolt.Tx) (err error) {
 		}
 	}
 
+	// Alias FQDN index
+	if bucket = tx.Bucket(bucketNameIndexAliasFQDNDomainID); bucket != nil {
+		for _, a := range d.Aliases {
+			if err := bucket.Delete([]byte(a)); err != nil {
+				return fmt.Errorf("bucket(%s).Delete(%s): %s", bucketNameIndexAliasFQDNDomainID, a, err)
+			}
+		}
+	}
+
 	// DomainID UserIDs index
 	bucket = tx.Bucket(bucketNameIndexDomainIDUserIDs)
 	if bucket != nil {
@@ -437,6 +715,11 @@ func (d domainRecord) delete(tx *bolt.Tx) (err error) {
 		}
 	}
 
+	// Domain transfer
+	if err = deleteDomainTransferRecord(tx, id); err != nil {
+		return
+	}
+
 	// Disabled index
 	bucket = tx.Bucket(bucketNameIndexDisabledDomainIDs)
 	if bucket != nil {
@@ -468,7 +751,7 @@ func (d domainRecord) delete(tx *bolt.Tx) (err error) {
 	return bucket.Delete(id)
 }
 
-func (d domainRecord) addUser(tx *bolt.Tx, userID []byte, checkExists bool) (err error) {
+func (d domainRecord) addUser(tx *bolt.Tx, userID []byte, role packages.DomainRole, checkExists bool) (err error) {
 	// DomainID UserIDs index
 	bucket, err := tx.CreateBucketIfNotExists(bucketNameIndexDomainIDUserIDs)
 	if err != nil {
@@ -483,7 +766,7 @@ func (d domainRecord) addUser(tx *bolt.Tx, userID []byte, checkExists bool) (err
 		err = packages.ErrUserExists
 		return
 	}
-	if err = bucket.Put(userID, flagBytes); err != nil {
+	if err = bucket.Put(userID, []byte(role)); err != nil {
 		return
 	}
 
@@ -529,24 +812,189 @@ func (d domainRecord) removeUser(tx *bolt.Tx, userID []byte) (err error) {
 	return nil
 }
 
-func (d domainRecord) isOwner(id string) bool {
-	return d.OwnerUserID == id
+func (d domainRecord) setUserRole(tx *bolt.Tx, userID []byte, role packages.DomainRole) (from packages.DomainRole, err error) {
+	bucket := tx.Bucket(bucketNameIndexDomainIDUserIDs)
+	if bucket != nil {
+		bucket = bucket.Bucket([]byte(d.id))
+	}
+	if bucket == nil {
+		err = packages.ErrUserDoesNotExist
+		return
+	}
+	value := bucket.Get(userID)
+	if value == nil {
+		err = packages.ErrUserDoesNotExist
+		return
+	}
+	from = domainRoleFromIndexValue(value)
+	err = bucket.Put(userID, []byte(role))
+	return
+}
+
+func (d domainRecord) isOwner(tx *bolt.Tx, id string) bool {
+	return d.role(tx, id) == packages.DomainRoleOwner
+}
+
+func (d domainRecord) canEditPackages(tx *bolt.Tx, id string) bool {
+	return d.role(tx, id).CanEditPackages()
 }
 
-func (d domainRecord) isUser(tx *bolt.Tx, id string) bool {
-	if d.isOwner(id) {
-		return true
+// role returns the role of the user on the domain or an empty string
+// if the user does not have access to it. Organization members have the
+// role that corresponds to their organization role and requests made with
+// the organization key, identified by the organization ID, have the
+// maintainer role.
+func (d domainRecord) role(tx *bolt.Tx, id string) packages.DomainRole {
+	if id == "" {
+		return ""
+	}
+	if d.OwnerUserID == id {
+		return packages.DomainRoleOwner
+	}
+	if d.OrganizationID != "" {
+		if d.OrganizationID == id {
+			return packages.DomainRoleMaintainer
+		}
+		if role := organizationMemberRole(tx, []byte(d.OrganizationID), []byte(id)).DomainRole(); role != "" {
+			return role
+		}
 	}
 	bucket := tx.Bucket(bucketNameIndexDomainIDUserIDs)
 	if bucket != nil {
 		bucket = bucket.Bucket([]byte(d.id))
 		if bucket != nil {
-			if bucket.Get([]byte(id)) != nil {
-				return true
+			if value := bucket.Get([]byte(id)); value != nil {
+				return domainRoleFromIndexValue(value)
 			}
 		}
 	}
-	return false
+	return ""
+}
+
+// domainRoleFromIndexValue returns the role stored in the DomainID
+// UserIDs index. Users that were added before roles were introduced
+// have a flag value and they are able to change packages.
+func domainRoleFromIndexValue(value []byte) packages.DomainRole {
+	role := packages.DomainRole(value)
+	switch role {
+	case packages.DomainRoleMaintainer, packages.DomainRoleViewer:
+		return role
+	}
+	return packages.DomainRoleMaintainer
+}
+
+// domainsCursor iterates over FQDN and domain ID pairs ordered by FQDN, in
+// the same way as bolt.Cursor iterates over FQDN DomainID index buckets.
+type domainsCursor interface {
+	First() (fqdn, id []byte)
+	Seek(seek []byte) (fqdn, id []byte)
+	Next() (fqdn, id []byte)
+	Prev() (fqdn, id []byte)
+}
+
+// indexDomainsCursor returns a function that creates a cursor over the
+// FQDN DomainID index bucket of the user or the organization with the id.
+func indexDomainsCursor(index, id []byte) func(tx *bolt.Tx) (domainsCursor, error) {
+	return func(tx *bolt.Tx) (domainsCursor, error) {
+		bucket := tx.Bucket(index)
+		if bucket == nil {
+			return nil, nil
+		}
+		bucket = bucket.Bucket(id)
+		if bucket == nil {
+			return nil, packages.ErrUserDoesNotExist
+		}
+		return bucket.Cursor(), nil
+	}
+}
+
+// userDomainsCursor returns a cursor over domains that the user has access
+// to as a domain user or as a member of organizations that own them. The
+// organization ID is accepted as the user ID for requests made with the
+// organization key, in the same way as in domainRecord.role.
+func userDomainsCursor(tx *bolt.Tx, userID []byte) (domainsCursor, error) {
+	c := &fqdnsCursor{ids: map[string][]byte{}}
+	var found bool
+	add := func(index, id []byte) error {
+		bucket := tx.Bucket(index)
+		if bucket != nil {
+			bucket = bucket.Bucket(id)
+		}
+		if bucket == nil {
+			return nil
+		}
+		found = true
+		return bucket.ForEach(func(fqdn, domainID []byte) error {
+			if _, ok := c.ids[string(fqdn)]; !ok {
+				c.ids[string(fqdn)] = domainID
+				c.fqdns = append(c.fqdns, string(fqdn))
+			}
+			return nil
+		})
+	}
+	if err := add(bucketNameIndexUserIDFQDNDomainID, userID); err != nil {
+		return nil, err
+	}
+	if err := add(bucketNameIndexOrganizationIDFQDNDomainID, userID); err != nil {
+		return nil, err
+	}
+	if bucket := tx.Bucket(bucketNameIndexUserIDOrganizationIDs); bucket != nil {
+		if bucket = bucket.Bucket(userID); bucket != nil {
+			if err := bucket.ForEach(func(organizationID, _ []byte) error {
+				return add(bucketNameIndexOrganizationIDFQDNDomainID, organizationID)
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !found {
+		if tx.Bucket(bucketNameIndexUserIDFQDNDomainID) == nil {
+			return nil, nil
+		}
+		return nil, packages.ErrUserDoesNotExist
+	}
+	sort.Strings(c.fqdns)
+	c.i = -1
+	return c, nil
+}
+
+// fqdnsCursor is a domainsCursor over sorted FQDNs.
+type fqdnsCursor struct {
+	fqdns []string
+	ids   map[string][]byte
+	i     int
+}
+
+func (c *fqdnsCursor) First() (fqdn, id []byte) {
+	c.i = 0
+	return c.current()
+}
+
+func (c *fqdnsCursor) Seek(seek []byte) (fqdn, id []byte) {
+	c.i = sort.SearchStrings(c.fqdns, string(seek))
+	return c.current()
+}
+
+func (c *fqdnsCursor) Next() (fqdn, id []byte) {
+	if c.i < len(c.fqdns) {
+		c.i++
+	}
+	return c.current()
+}
+
+func (c *fqdnsCursor) Prev() (fqdn, id []byte) {
+	if c.i >= 0 {
+		c.i--
+	}
+	return c.current()
+}
+
+func (c *fqdnsCursor) current() (fqdn, id []byte) {
+	if c.i < 0 || c.i >= len(c.fqdns) {
+		return nil, nil
+	}
+	fqdn = []byte(c.fqdns[c.i])
+	return fqdn, c.ids[c.fqdns[c.i]]
 }
 
 func newDomainID(tx *bolt.Tx) (id string, err error) {
@@ -572,3 +1020,12 @@ func newDomainID(tx *bolt.Tx) (id string, err error) {
 	}
 	return "", errors.New("unable to generate unique domain id")
 }
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
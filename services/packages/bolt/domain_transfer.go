@@ -0,0 +1,95 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package boltPackages
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/boltdb/bolt"
+
+	"gopherpit.com/gopherpit/services/packages"
+)
+
+var (
+	bucketNameDomainTransfers = []byte("DomainTransfers")
+)
+
+type domainTransferRecord struct {
+	domainID       string
+	FQDN           string    `json:"fqdn"`
+	FromUserID     string    `json:"from-user-id"`
+	ToUserID       string    `json:"to-user-id"`
+	Time           time.Time `json:"time"`
+	ExpirationTime time.Time `json:"expiration-time"`
+}
+
+func (t domainTransferRecord) export() *packages.DomainTransfer {
+	return &packages.DomainTransfer{
+		DomainID:       t.domainID,
+		FQDN:           t.FQDN,
+		FromUserID:     t.FromUserID,
+		ToUserID:       t.ToUserID,
+		Time:           t.Time,
+		ExpirationTime: t.ExpirationTime,
+	}
+}
+
+// changes returns the owner change that the transfer is related to.
+func (t domainTransferRecord) changes() packages.Changes {
+	return packages.Changes{
+		packages.Change{
+			Field: "owner-user-id",
+			From:  stringToStringPtr(t.FromUserID),
+			To:    stringToStringPtr(t.ToUserID),
+		},
+	}
+}
+
+func getDomainTransferRecord(tx *bolt.Tx, domainID []byte) (t *domainTransferRecord, err error) {
+	bucket := tx.Bucket(bucketNameDomainTransfers)
+	if bucket == nil {
+		err = packages.ErrDomainTransferNotFound
+		return
+	}
+	data := bucket.Get(domainID)
+	if data == nil {
+		err = packages.ErrDomainTransferNotFound
+		return
+	}
+	if err = json.Unmarshal(data, &t); err != nil {
+		return
+	}
+	t.domainID = string(domainID)
+	return
+}
+
+func (t *domainTransferRecord) save(tx *bolt.Tx) (err error) {
+	bucket, err := tx.CreateBucketIfNotExists(bucketNameDomainTransfers)
+	if err != nil {
+		return
+	}
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("json marshal domain transfer %s: %s", t.domainID, err)
+	}
+	if err := bucket.Put([]byte(t.domainID), data); err != nil {
+		return fmt.Errorf("bucket(%s).Put(%s): %s", bucketNameDomainTransfers, t.domainID, err)
+	}
+	return nil
+}
+
+func deleteDomainTransferRecord(tx *bolt.Tx, domainID []byte) error {
+	bucket := tx.Bucket(bucketNameDomainTransfers)
+	if bucket == nil {
+		return nil
+	}
+	if err := bucket.Delete(domainID); err != nil {
+		return fmt.Errorf("bucket(%s).Delete(%s): %s", bucketNameDomainTransfers, domainID, err)
+	}
+	return nil
+}
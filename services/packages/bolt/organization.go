@@ -0,0 +1,331 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package boltPackages
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"resenje.org/boltutils"
+
+	"gopherpit.com/gopherpit/services/packages"
+)
+
+var (
+	bucketNameOrganizations                   = []byte("Organizations")
+	bucketNameIndexNameOrganizationID         = []byte("Index_Name_OrganizationID")
+	bucketNameIndexOrganizationIDUserIDs      = []byte("Index_OrganizationID_UserID_Role")
+	bucketNameIndexUserIDOrganizationIDs      = []byte("Index_UserID_OrganizationID_Role")
+	bucketNameIndexOrganizationIDFQDNDomainID = []byte("Index_OrganizationID_FQDN_DomainID")
+	organizationNameRegex                     = regexp.MustCompile(`^[a-z0-9]([a-z0-9\-]{0,61}[a-z0-9])?$`)
+)
+
+type organizationRecord struct {
+	id   string
+	Name string `json:"name"`
+}
+
+func (o organizationRecord) export() *packages.Organization {
+	return &packages.Organization{
+		ID:   o.id,
+		Name: o.Name,
+	}
+}
+
+func (o *organizationRecord) update(opts *packages.OrganizationOptions) {
+	if opts.Name != nil {
+		o.Name = *opts.Name
+	}
+}
+
+func getOrganizationRecord(tx *bolt.Tx, ref []byte) (o *organizationRecord, err error) {
+	o, err = getOrganizationRecordByID(tx, ref)
+	if err == packages.ErrOrganizationNotFound {
+		bucket := tx.Bucket(bucketNameIndexNameOrganizationID)
+		if bucket == nil {
+			return
+		}
+		id := bucket.Get(ref)
+		if id == nil {
+			return
+		}
+		o, err = getOrganizationRecordByID(tx, id)
+	}
+	return
+}
+
+func getOrganizationRecordByID(tx *bolt.Tx, id []byte) (o *organizationRecord, err error) {
+	bucket := tx.Bucket(bucketNameOrganizations)
+	if bucket == nil {
+		err = packages.ErrOrganizationNotFound
+		return
+	}
+	data := bucket.Get(id)
+	if data == nil {
+		err = packages.ErrOrganizationNotFound
+		return
+	}
+	if err = json.Unmarshal(data, &o); err != nil {
+		return
+	}
+	o.id = string(id)
+	return
+}
+
+func (o *organizationRecord) save(tx *bolt.Tx) (err error) {
+	// Fields validation
+	o.Name = strings.TrimSpace(strings.ToLower(o.Name))
+	if o.Name == "" {
+		return packages.ErrOrganizationNameRequired
+	}
+	if !organizationNameRegex.MatchString(o.Name) {
+		return packages.ErrOrganizationNameInvalid
+	}
+
+	// Existing Organization record
+	eo := &organizationRecord{}
+	if o.id == "" {
+		id, err := newOrganizationID(tx)
+		if err != nil {
+			return fmt.Errorf("generate unique ID: %s", err)
+		}
+		o.id = id
+	} else {
+		co, err := getOrganizationRecordByID(tx, []byte(o.id))
+		if err != nil {
+			return fmt.Errorf("get organization record %s: %s", o.id, err)
+		}
+		eo = co
+	}
+
+	id := []byte(o.id)
+
+	// Name index
+	if o.Name != eo.Name {
+		bucket, err := tx.CreateBucketIfNotExists(bucketNameIndexNameOrganizationID)
+		if err != nil {
+			return err
+		}
+		if bucket.Get([]byte(o.Name)) != nil {
+			return packages.ErrOrganizationAlreadyExists
+		}
+		if eo.Name != "" {
+			if err := bucket.Delete([]byte(eo.Name)); err != nil {
+				return fmt.Errorf("bucket(%s).Delete(%s): %s", bucketNameIndexNameOrganizationID, eo.Name, err)
+			}
+		}
+		if err := bucket.Put([]byte(o.Name), id); err != nil {
+			return fmt.Errorf("bucket(%s).Put(%s): %s", bucketNameIndexNameOrganizationID, o.Name, err)
+		}
+	}
+
+	value, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("json marshal: %s", err)
+	}
+	bucket, err := tx.CreateBucketIfNotExists(bucketNameOrganizations)
+	if err != nil {
+		return fmt.Errorf("CreateBucketIfNotExists(%s) %s", bucketNameOrganizations, err)
+	}
+	if err := bucket.Put(id, value); err != nil {
+		return fmt.Errorf("bucket(%s).Put(%s) %s", bucketNameOrganizations, o.id, err)
+	}
+	return
+}
+
+// delete removes the organization and its members. Organizations that
+// own domains can not be deleted.
+func (o organizationRecord) delete(tx *bolt.Tx) (err error) {
+	id := []byte(o.id)
+
+	if bucket := tx.Bucket(bucketNameIndexOrganizationIDFQDNDomainID); bucket != nil {
+		if b := bucket.Bucket(id); b != nil {
+			if k, _ := b.Cursor().First(); k != nil {
+				return packages.ErrOrganizationHasDomains
+			}
+		}
+	}
+
+	members, err := o.members(tx)
+	if err != nil {
+		return
+	}
+	for _, userID := range members.UserIDs {
+		if err := boltutils.BoltDeepDelete(
+			tx,
+			bucketNameIndexUserIDOrganizationIDs,
+			[]byte(userID),
+			id,
+		); err != nil {
+			return fmt.Errorf("bolt deep delete: %s", err)
+		}
+	}
+	if bucket := tx.Bucket(bucketNameIndexOrganizationIDUserIDs); bucket != nil {
+		if err := bucket.DeleteBucket(id); err != nil && err != bolt.ErrBucketNotFound {
+			return fmt.Errorf("bucket(%s).DeleteBucket(%s): %s", bucketNameIndexOrganizationIDUserIDs, o.id, err)
+		}
+	}
+	if bucket := tx.Bucket(bucketNameIndexOrganizationIDFQDNDomainID); bucket != nil {
+		if err := bucket.DeleteBucket(id); err != nil && err != bolt.ErrBucketNotFound {
+			return fmt.Errorf("bucket(%s).DeleteBucket(%s): %s", bucketNameIndexOrganizationIDFQDNDomainID, o.id, err)
+		}
+	}
+	if bucket := tx.Bucket(bucketNameIndexNameOrganizationID); bucket != nil {
+		if err := bucket.Delete([]byte(o.Name)); err != nil {
+			return fmt.Errorf("bucket(%s).Delete(%s): %s", bucketNameIndexNameOrganizationID, o.Name, err)
+		}
+	}
+	bucket, err := tx.CreateBucketIfNotExists(bucketNameOrganizations)
+	if err != nil {
+		return
+	}
+	return bucket.Delete(id)
+}
+
+func (o organizationRecord) members(tx *bolt.Tx) (members packages.OrganizationMembers, err error) {
+	members = packages.OrganizationMembers{
+		UserIDs: []string{},
+		Roles:   map[string]packages.OrganizationRole{},
+	}
+	bucket := tx.Bucket(bucketNameIndexOrganizationIDUserIDs)
+	if bucket != nil {
+		bucket = bucket.Bucket([]byte(o.id))
+	}
+	if bucket == nil {
+		return
+	}
+	err = bucket.ForEach(func(userID, role []byte) error {
+		members.UserIDs = append(members.UserIDs, string(userID))
+		members.Roles[string(userID)] = packages.OrganizationRole(role)
+		return nil
+	})
+	return
+}
+
+// memberRole returns the role of the user in the organization or an empty
+// string if the user is not a member.
+func (o organizationRecord) memberRole(tx *bolt.Tx, userID string) packages.OrganizationRole {
+	return organizationMemberRole(tx, []byte(o.id), []byte(userID))
+}
+
+func organizationMemberRole(tx *bolt.Tx, id, userID []byte) packages.OrganizationRole {
+	bucket := tx.Bucket(bucketNameIndexOrganizationIDUserIDs)
+	if bucket != nil {
+		bucket = bucket.Bucket(id)
+		if bucket != nil {
+			return packages.OrganizationRole(bucket.Get(userID))
+		}
+	}
+	return ""
+}
+
+// setMember adds the user to the organization or changes the role of
+// the existing member. The last owner can not become a member.
+func (o organizationRecord) setMember(tx *bolt.Tx, userID string, role packages.OrganizationRole) (err error) {
+	if o.memberRole(tx, userID) == packages.OrganizationRoleOwner && role != packages.OrganizationRoleOwner {
+		if err = o.checkNotLastOwner(tx); err != nil {
+			return
+		}
+	}
+	if err = boltutils.BoltDeepPut(
+		tx,
+		bucketNameIndexOrganizationIDUserIDs,
+		[]byte(o.id),
+		[]byte(userID),
+		[]byte(role),
+	); err != nil {
+		return fmt.Errorf("bolt deep put: %s", err)
+	}
+	if err = boltutils.BoltDeepPut(
+		tx,
+		bucketNameIndexUserIDOrganizationIDs,
+		[]byte(userID),
+		[]byte(o.id),
+		[]byte(role),
+	); err != nil {
+		return fmt.Errorf("bolt deep put: %s", err)
+	}
+	return
+}
+
+// removeMember removes the user from the organization. The last owner
+// can not be removed.
+func (o organizationRecord) removeMember(tx *bolt.Tx, userID string) (err error) {
+	switch o.memberRole(tx, userID) {
+	case "":
+		return packages.ErrUserDoesNotExist
+	case packages.OrganizationRoleOwner:
+		if err = o.checkNotLastOwner(tx); err != nil {
+			return
+		}
+	}
+	if err = boltutils.BoltDeepDelete(
+		tx,
+		bucketNameIndexOrganizationIDUserIDs,
+		[]byte(o.id),
+		[]byte(userID),
+	); err != nil {
+		return fmt.Errorf("bolt deep delete: %s", err)
+	}
+	if err = boltutils.BoltDeepDelete(
+		tx,
+		bucketNameIndexUserIDOrganizationIDs,
+		[]byte(userID),
+		[]byte(o.id),
+	); err != nil {
+		return fmt.Errorf("bolt deep delete: %s", err)
+	}
+	return
+}
+
+func (o organizationRecord) checkNotLastOwner(tx *bolt.Tx) error {
+	members, err := o.members(tx)
+	if err != nil {
+		return err
+	}
+	owners := 0
+	for _, role := range members.Roles {
+		if role == packages.OrganizationRoleOwner {
+			owners++
+		}
+	}
+	if owners <= 1 {
+		return packages.ErrOrganizationLastOwner
+	}
+	return nil
+}
+
+func newOrganizationID(tx *bolt.Tx) (id string, err error) {
+	bp := make([]byte, 2)
+	binary.LittleEndian.PutUint16(bp, uint16(os.Getpid()))
+	br := make([]byte, 19)
+	bt := make([]byte, 4)
+	binary.LittleEndian.PutUint32(bt, uint32(time.Now().UTC().Unix()))
+	bucket, err := tx.CreateBucketIfNotExists(bucketNameOrganizations)
+	if err != nil {
+		return
+	}
+	for i := 0; i < 100; i++ {
+		_, err = rand.Read(br)
+		if err != nil {
+			return
+		}
+		b := append(bt, append(bp, br...)...)
+		id = strings.TrimRight(base32Encoding.EncodeToString(b), "=")
+		if v := bucket.Get([]byte(id)); v == nil {
+			return
+		}
+	}
+	return "", errors.New("unable to generate unique organization id")
+}
@@ -29,9 +29,151 @@ var (
 	bucketNameIndexDomainIDPathPackageID = []byte("Index_DomainID_Path_PackageID")
 	bucketNameIndexDisabledPackageIDs    = []byte("Index_Disabled_PackageID_1")
 
+	bucketNameIndexDomainIDVCSPathPackageID          = []byte("Index_DomainID_VCS_Path_PackageID")
+	bucketNameIndexDomainIDRefTypePathPackageID      = []byte("Index_DomainID_RefType_Path_PackageID")
+	bucketNameIndexDomainIDRepoRootHostPathPackageID = []byte("Index_DomainID_RepoRootHost_Path_PackageID")
+	bucketNameIndexDomainIDDisabledPathPackageID     = []byte("Index_DomainID_Disabled_Path_PackageID")
+
 	hostAndPortRegex = regexp.MustCompile(`^([a-z0-9]+[\-a-z0-9\.]*)(?:\:\d+)?$`)
 )
 
+// packageFilterIndex defines an index of packages under a domain by
+// a value of a single package field. Index buckets are nested by domain ID
+// and field value, and hold package paths as keys and IDs as values,
+// so that filtered packages can be listed ordered by path.
+// Packages with empty field value are not indexed.
+type packageFilterIndex struct {
+	bucket []byte
+	value  func(p *packageRecord) string
+}
+
+var (
+	packageFilterIndexVCS = packageFilterIndex{
+		bucket: bucketNameIndexDomainIDVCSPathPackageID,
+		value:  func(p *packageRecord) string { return string(p.VCS) },
+	}
+	packageFilterIndexRefType = packageFilterIndex{
+		bucket: bucketNameIndexDomainIDRefTypePathPackageID,
+		value:  func(p *packageRecord) string { return string(p.RefType) },
+	}
+	packageFilterIndexRepoRootHost = packageFilterIndex{
+		bucket: bucketNameIndexDomainIDRepoRootHostPathPackageID,
+		value:  func(p *packageRecord) string { return repoRootHost(p.RepoRoot) },
+	}
+	packageFilterIndexDisabled = packageFilterIndex{
+		bucket: bucketNameIndexDomainIDDisabledPathPackageID,
+		value: func(p *packageRecord) string {
+			if p.Disabled {
+				return string(flagBytes)
+			}
+			return ""
+		},
+	}
+
+	packageFilterIndexes = []packageFilterIndex{
+		packageFilterIndexVCS,
+		packageFilterIndexRefType,
+		packageFilterIndexRepoRootHost,
+		packageFilterIndexDisabled,
+	}
+)
+
+// save updates index entries of a package if its domain, path or indexed
+// value is changed. Previous package record ep is empty for new packages.
+func (i packageFilterIndex) save(tx *bolt.Tx, p, ep *packageRecord) error {
+	value, prevValue := i.value(p), i.value(ep)
+	if p.DomainID == ep.DomainID && p.Path == ep.Path && value == prevValue {
+		return nil
+	}
+	if err := i.delete(tx, ep); err != nil {
+		return err
+	}
+	if p.DomainID == "" || p.Path == "" || value == "" {
+		return nil
+	}
+	if err := boltutils.BoltDeepPut(
+		tx,
+		i.bucket,
+		[]byte(p.DomainID),
+		[]byte(value),
+		[]byte(p.Path),
+		[]byte(p.id),
+	); err != nil {
+		return fmt.Errorf("bolt deep put: %s", err)
+	}
+	return nil
+}
+
+func (i packageFilterIndex) delete(tx *bolt.Tx, p *packageRecord) error {
+	value := i.value(p)
+	if p.DomainID == "" || p.Path == "" || value == "" {
+		return nil
+	}
+	if err := boltutils.BoltDeepDelete(
+		tx,
+		i.bucket,
+		[]byte(p.DomainID),
+		[]byte(value),
+		[]byte(p.Path),
+	); err != nil {
+		return fmt.Errorf("bolt deep delete: %s", err)
+	}
+	return nil
+}
+
+// packages returns a bucket with paths and IDs of packages under a domain
+// that have the provided field value, or nil if there are no such packages.
+func (i packageFilterIndex) packages(tx *bolt.Tx, domainID []byte, value string) *bolt.Bucket {
+	bucket := tx.Bucket(i.bucket)
+	if bucket == nil {
+		return nil
+	}
+	bucket = bucket.Bucket(domainID)
+	if bucket == nil {
+		return nil
+	}
+	return bucket.Bucket([]byte(value))
+}
+
+// indexPackageFilters creates package filter indexes for packages
+// that are saved before the indexes were introduced.
+func indexPackageFilters(tx *bolt.Tx) error {
+	if tx.Bucket(bucketNameIndexDomainIDVCSPathPackageID) != nil {
+		return nil
+	}
+	bucket := tx.Bucket(bucketNamePackages)
+	if bucket == nil {
+		return nil
+	}
+	return bucket.ForEach(func(k, v []byte) error {
+		p := &packageRecord{}
+		if err := json.Unmarshal(v, p); err != nil {
+			return fmt.Errorf("package %s: %s", k, err)
+		}
+		p.id = string(k)
+		for _, i := range packageFilterIndexes {
+			if err := i.save(tx, p, &packageRecord{}); err != nil {
+				return fmt.Errorf("package %s: %s", k, err)
+			}
+		}
+		return nil
+	})
+}
+
+// repoRootHost returns a lowercase host name of the repository root URL
+// without the port number.
+func repoRootHost(repoRoot string) string {
+	u, err := url.Parse(repoRoot)
+	if err != nil {
+		return ""
+	}
+	host := strings.ToLower(u.Host)
+	if m := hostAndPortRegex.FindStringSubmatch(host); m != nil {
+		return m[1]
+	}
+	return host
+}
+
 type packageRecord struct {
 	id          string
 	DomainID    string           `json:"domain-id,omitempty"`
@@ -43,6 +185,7 @@ type packageRecord struct {
 	GoSource    string           `json:"go-source,omitempty"`
 	RedirectURL string           `json:"redirect-url,omitempty"`
 	Disabled    bool             `json:"disabled,omitempty"`
+	Revision    uint64           `json:"revision,omitempty"`
 }
 
 func (p packageRecord) export(tx *bolt.Tx) (pkg *packages.Package, err error) {
@@ -56,6 +199,7 @@ func (p packageRecord) export(tx *bolt.Tx) (pkg *packages.Package, err error) {
 		GoSource:    p.GoSource,
 		RedirectURL: p.RedirectURL,
 		Disabled:    p.Disabled,
+		Revision:    p.Revision,
 	}
 	if tx != nil && p.DomainID != "" {
 		d, err := getDomainRecordByID(tx, []byte(p.DomainID))
@@ -363,6 +507,16 @@ func (p *packageRecord) save(tx *bolt.Tx) (err error) {
 		}
 	}
 
+	// Filter indexes
+	for _, i := range packageFilterIndexes {
+		if err := i.save(tx, p, ep); err != nil {
+			return err
+		}
+	}
+
+	// Every saved change results in a new revision
+	p.Revision = ep.Revision + 1
+
 	// Save the package record data
 	value, err := json.Marshal(p)
 	if err != nil {
@@ -410,6 +564,13 @@ func (p packageRecord) delete(tx *bolt.Tx) (err error) {
 		}
 	}
 
+	// Filter indexes
+	for _, i := range packageFilterIndexes {
+		if err := i.delete(tx, &p); err != nil {
+			return err
+		}
+	}
+
 	// Package data
 	bucket, err = tx.CreateBucketIfNotExists(bucketNamePackages)
 	if err != nil {
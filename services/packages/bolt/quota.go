@@ -0,0 +1,203 @@
+// Copyright (c) 2017, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package boltPackages
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+
+	"gopherpit.com/gopherpit/services/packages"
+)
+
+var (
+	bucketNameQuotas = []byte("Quotas")
+)
+
+// quotaRecord holds overrides of default quotas for a user or an
+// organization.
+type quotaRecord struct {
+	Domains  int `json:"domains,omitempty"`
+	Packages int `json:"packages,omitempty"`
+}
+
+func getQuotaRecord(tx *bolt.Tx, ownerID []byte) (q *quotaRecord, err error) {
+	q = &quotaRecord{}
+	bucket := tx.Bucket(bucketNameQuotas)
+	if bucket == nil {
+		return
+	}
+	data := bucket.Get(ownerID)
+	if data == nil {
+		return
+	}
+	if err = json.Unmarshal(data, q); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %s", err)
+	}
+	return
+}
+
+// save stores quota overrides, removing the record if there are no
+// overrides.
+func (q quotaRecord) save(tx *bolt.Tx, ownerID []byte) (err error) {
+	if q.Domains < -1 || q.Packages < -1 {
+		return packages.ErrQuotaInvalid
+	}
+	bucket, err := tx.CreateBucketIfNotExists(bucketNameQuotas)
+	if err != nil {
+		return fmt.Errorf("CreateBucketIfNotExists(%s) %s", bucketNameQuotas, err)
+	}
+	if q.Domains == 0 && q.Packages == 0 {
+		if err := bucket.Delete(ownerID); err != nil {
+			return fmt.Errorf("bucket(%s).Delete(%s) %s", bucketNameQuotas, ownerID, err)
+		}
+		return
+	}
+	value, err := json.Marshal(q)
+	if err != nil {
+		return fmt.Errorf("json marshal: %s", err)
+	}
+	if err := bucket.Put(ownerID, value); err != nil {
+		return fmt.Errorf("bucket(%s).Put(%s) %s", bucketNameQuotas, ownerID, err)
+	}
+	return
+}
+
+// ownerDomainIDs returns IDs of all domains that are owned by a user or
+// an organization.
+func ownerDomainIDs(tx *bolt.Tx, ownerID []byte) (ids [][]byte, err error) {
+	for _, name := range [][]byte{
+		bucketNameIndexOwnerUserIDFQDNDomainID,
+		bucketNameIndexOrganizationIDFQDNDomainID,
+	} {
+		bucket := tx.Bucket(name)
+		if bucket == nil {
+			continue
+		}
+		bucket = bucket.Bucket(ownerID)
+		if bucket == nil {
+			continue
+		}
+		if err = bucket.ForEach(func(_, id []byte) error {
+			ids = append(ids, append([]byte{}, id...))
+			return nil
+		}); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// quota returns effective quotas and the usage of a user or an
+// organization.
+func (s Service) quota(tx *bolt.Tx, ownerID string) (q *packages.Quota, err error) {
+	r, err := getQuotaRecord(tx, []byte(ownerID))
+	if err != nil {
+		return
+	}
+	q = &packages.Quota{
+		Domains:          s.DomainQuota,
+		Packages:         s.PackageQuota,
+		DomainsOverride:  r.Domains,
+		PackagesOverride: r.Packages,
+	}
+	if r.Domains != 0 {
+		q.Domains = r.Domains
+	}
+	if r.Packages != 0 {
+		q.Packages = r.Packages
+	}
+	if q.Domains < 0 {
+		q.Domains = 0
+	}
+	if q.Packages < 0 {
+		q.Packages = 0
+	}
+	ids, err := ownerDomainIDs(tx, []byte(ownerID))
+	if err != nil {
+		return nil, fmt.Errorf("owner domains: %s", err)
+	}
+	q.DomainsUsed = len(ids)
+	bucket := tx.Bucket(bucketNameIndexDomainIDPathPackageID)
+	if bucket == nil {
+		return
+	}
+	for _, id := range ids {
+		// Bucket statistics do not include changes that are not yet
+		// committed in the current transaction, so keys are counted.
+		b := bucket.Bucket(id)
+		if b == nil {
+			continue
+		}
+		c := b.Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			q.PackagesUsed++
+		}
+	}
+	return
+}
+
+// checkQuota returns ErrDomainQuotaExceeded or ErrPackageQuotaExceeded if
+// the change described by the changelog record data added a domain or a
+// package over the quota of the domain owner. Changes that do not add
+// domains or packages to an owner are not checked, so that owners over
+// the quota can still manage what they have.
+func (s Service) checkQuota(tx *bolt.Tx, crd *chagelogRecordData) error {
+	if crd == nil {
+		return nil
+	}
+	var checkDomains, checkPackages bool
+	switch crd.action {
+	case packages.ActionAddDomain:
+		checkDomains = true
+	case packages.ActionUpdateDomain, packages.ActionAcceptDomainTransfer:
+		for _, c := range crd.changes {
+			if (c.Field == "owner-user-id" || c.Field == "organization-id") && c.To != nil && *c.To != "" {
+				checkDomains = true
+				checkPackages = true
+			}
+		}
+	case packages.ActionAddPackage:
+		checkPackages = true
+	case packages.ActionUpdatePackage:
+		for _, c := range crd.changes {
+			if c.Field == "domain-id" {
+				checkPackages = true
+			}
+		}
+	}
+	if !checkDomains && !checkPackages {
+		return nil
+	}
+	return s.checkDomainQuota(tx, []byte(crd.domainID), checkDomains, checkPackages)
+}
+
+// checkDomainQuota checks domain and package quotas of the domain owner.
+func (s Service) checkDomainQuota(tx *bolt.Tx, domainID []byte, checkDomains, checkPackages bool) error {
+	d, err := getDomainRecordByID(tx, domainID)
+	if err != nil {
+		return err
+	}
+	ownerID := d.OwnerUserID
+	if d.OrganizationID != "" {
+		ownerID = d.OrganizationID
+	}
+	if ownerID == "" {
+		return nil
+	}
+	q, err := s.quota(tx, ownerID)
+	if err != nil {
+		return err
+	}
+	if checkDomains && q.Domains > 0 && q.DomainsUsed > q.Domains {
+		return packages.ErrDomainQuotaExceeded
+	}
+	if checkPackages && q.Packages > 0 && q.PackagesUsed > q.Packages {
+		return packages.ErrPackageQuotaExceeded
+	}
+	return nil
+}
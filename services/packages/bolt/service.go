@@ -8,8 +8,10 @@ package boltPackages
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -34,6 +36,12 @@ type Service struct {
 	DB        *bolt.DB
 	Changelog *timed.Pool
 	Logger    Logger
+
+	// DomainQuota and PackageQuota are default limits of domains and
+	// packages that a user or an organization can own. Zero value means
+	// no limit.
+	DomainQuota  int
+	PackageQuota int
 }
 
 // NewDB opens a new BoltDB database.
@@ -48,6 +56,16 @@ func NewDB(filename string, fileMode os.FileMode, boltOptions *bolt.Options) (db
 		fileMode = 0640
 	}
 	db, err = bolt.Open(filename, fileMode, boltOptions)
+	if err != nil {
+		return
+	}
+	if boltOptions.ReadOnly {
+		return
+	}
+	if err = db.Update(indexPackageFilters); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("index package filters: %s", err)
+	}
 	return
 }
 
@@ -77,17 +95,51 @@ func (s Service) Domain(ref string) (d *packages.Domain, err error) {
 	return
 }
 
+// DomainByHost returns the domain that serves the host, by its FQDN,
+// one of its aliases or as a wildcard domain that covers the host.
+func (s Service) DomainByHost(host string) (d *packages.Domain, err error) {
+	var r *domainRecord
+	if err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		id, err := getDomainIDByFQDN(tx, []byte(host))
+		if err != nil {
+			return
+		}
+		r, err = getDomainRecordByID(tx, id)
+		return
+	}); err != nil {
+		return
+	}
+	d = r.export()
+	return
+}
+
 func (s Service) AddDomain(o *packages.DomainOptions, byUserID string) (d *packages.Domain, err error) {
+	var crd *chagelogRecordData
+	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		d, crd, err = addDomain(tx, o, byUserID)
+		if err != nil {
+			return
+		}
+		return s.checkQuota(tx, crd)
+	}); err != nil {
+		return
+	}
+	err = s.newChangelogRecord(*crd)
+	return
+}
+
+func addDomain(tx *bolt.Tx, o *packages.DomainOptions, byUserID string) (d *packages.Domain, crd *chagelogRecordData, err error) {
+	if err = checkDomainOrganization(tx, o, "", byUserID); err != nil {
+		return
+	}
 	r := domainRecord{}
 	r.update(o)
-	if err = s.DB.Update(func(tx *bolt.Tx) error {
-		return r.save(tx)
-	}); err != nil {
+	if err = r.save(tx); err != nil {
 		return
 	}
 	d = r.export()
 
-	err = s.newChangelogRecord(chagelogRecordData{
+	crd = &chagelogRecordData{
 		domainID: d.ID,
 		fqdn:     d.FQDN,
 		userID:   byUserID,
@@ -101,6 +153,10 @@ func (s Service) AddDomain(o *packages.DomainOptions, byUserID string) (d *packa
 				Field: "owner-user-id",
 				To:    o.OwnerUserID,
 			},
+			packages.Change{
+				Field: "organization-id",
+				To:    o.OrganizationID,
+			},
 			packages.Change{
 				Field: "certificate-ignore",
 				To:    boolPtrToStringPtr(o.CertificateIgnore),
@@ -110,233 +166,707 @@ func (s Service) AddDomain(o *packages.DomainOptions, byUserID string) (d *packa
 				To:    boolPtrToStringPtr(o.Disabled),
 			},
 		},
-	})
-
+	}
 	return
 }
 
 func (s Service) UpdateDomain(ref string, o *packages.DomainOptions, byUserID string) (d *packages.Domain, err error) {
-	r := &domainRecord{}
-	var crd chagelogRecordData
+	var crd *chagelogRecordData
 	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
-		r, err = getDomainRecord(tx, []byte(ref))
+		d, crd, err = updateDomain(tx, ref, o, byUserID)
 		if err != nil {
 			return
 		}
-		if !r.isOwner(byUserID) {
-			err = packages.ErrForbidden
-			return
-		}
-		crd = chagelogRecordData{
-			domainID: r.id,
-			fqdn:     r.FQDN,
-			userID:   byUserID,
-			action:   packages.ActionUpdateDomain,
-			changes:  r.update(o),
-		}
-		return r.save(tx)
+		return s.checkQuota(tx, crd)
 	}); err != nil {
 		return
 	}
-	d = r.export()
-
-	if len(crd.changes) > 0 {
-		err = s.newChangelogRecord(crd)
+	if crd != nil {
+		err = s.newChangelogRecord(*crd)
 	}
+	return
+}
 
+// updateDomain returns nil changelog record data if there are no changes.
+func updateDomain(tx *bolt.Tx, ref string, o *packages.DomainOptions, byUserID string) (d *packages.Domain, crd *chagelogRecordData, err error) {
+	r, err := getDomainRecord(tx, []byte(ref))
+	if err != nil {
+		return
+	}
+	if !r.isOwner(tx, byUserID) {
+		err = packages.ErrForbidden
+		return
+	}
+	if o.IfRevision != nil && *o.IfRevision != r.Revision {
+		err = packages.ErrDomainRevisionMismatch
+		return
+	}
+	if err = checkDomainOrganization(tx, o, r.OrganizationID, byUserID); err != nil {
+		return
+	}
+	data := chagelogRecordData{
+		domainID: r.id,
+		fqdn:     r.FQDN,
+		userID:   byUserID,
+		action:   packages.ActionUpdateDomain,
+		changes:  r.update(o),
+	}
+	if err = r.save(tx); err != nil {
+		return
+	}
+	d = r.export()
+	if len(data.changes) > 0 {
+		crd = &data
+	}
 	return
 }
 
-func (s Service) DeleteDomain(ref, byUserID string) (d *packages.Domain, err error) {
-	r := &domainRecord{}
+// checkDomainOrganization returns ErrForbidden if the domain is given to
+// an organization in which the user is not an owner.
+func checkDomainOrganization(tx *bolt.Tx, o *packages.DomainOptions, currentOrganizationID, byUserID string) error {
+	if o.OrganizationID == nil || *o.OrganizationID == "" || *o.OrganizationID == currentOrganizationID {
+		return nil
+	}
+	org, err := getOrganizationRecordByID(tx, []byte(*o.OrganizationID))
+	if err != nil {
+		return err
+	}
+	if org.memberRole(tx, byUserID) != packages.OrganizationRoleOwner {
+		return packages.ErrForbidden
+	}
+	return nil
+}
+
+// DisableUnverifiedDomain disables the domain which ownership could not
+// be verified. Changelog record is saved without the user ID, as the
+// change is not made by any user.
+func (s Service) DisableUnverifiedDomain(ref string) (d *packages.Domain, err error) {
+	var crd *chagelogRecordData
 	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
-		r, err = getDomainRecord(tx, []byte(ref))
+		r, err := getDomainRecord(tx, []byte(ref))
 		if err != nil {
 			return
 		}
-		if !r.isOwner(byUserID) {
-			err = packages.ErrForbidden
+		t := true
+		data := chagelogRecordData{
+			domainID: r.id,
+			fqdn:     r.FQDN,
+			action:   packages.ActionDisableUnverifiedDomain,
+			changes: r.update(&packages.DomainOptions{
+				Disabled: &t,
+			}),
+		}
+		if err = r.save(tx); err != nil {
 			return
 		}
-		return r.delete(tx)
+		d = r.export()
+		if len(data.changes) > 0 {
+			crd = &data
+		}
+		return
 	}); err != nil {
 		return
 	}
-	d = r.export()
-
-	err = s.newChangelogRecord(chagelogRecordData{
-		domainID: d.ID,
-		fqdn:     d.FQDN,
-		userID:   byUserID,
-		action:   packages.ActionDeleteDomain,
-	})
-
+	if crd != nil {
+		err = s.newChangelogRecord(*crd)
+	}
 	return
 }
 
-func (s Service) DomainUsers(ref string) (users packages.DomainUsers, err error) {
+func (s Service) DomainTransfer(ref string) (t *packages.DomainTransfer, err error) {
 	err = s.DB.View(func(tx *bolt.Tx) (err error) {
-		d, err := getDomainRecord(tx, []byte(ref))
+		r, err := getDomainRecord(tx, []byte(ref))
 		if err != nil {
 			return
 		}
-		users.OwnerUserID = d.OwnerUserID
-		bucket := tx.Bucket(bucketNameIndexDomainIDUserIDs)
-		if bucket != nil {
-			bucket = bucket.Bucket([]byte(d.id))
-			if bucket != nil {
-				users.UserIDs = []string{}
-				if err = bucket.ForEach(func(userID, _ []byte) error {
-					id := string(userID)
-					if id != d.OwnerUserID {
-						users.UserIDs = append(users.UserIDs, id)
-					}
-					return nil
-				}); err != nil {
-					return
-				}
-			}
+		tr, err := getDomainTransferRecord(tx, []byte(r.id))
+		if err != nil {
+			return
 		}
+		t = tr.export()
 		return
 	})
 	return
 }
 
-func (s Service) AddUserToDomain(ref, userID, byUserID string) (err error) {
-	d := &domainRecord{}
+// DomainTransfersByUser returns all transfers that the user requested
+// or should accept.
+func (s Service) DomainTransfersByUser(userID string) (transfers packages.DomainTransfers, err error) {
+	transfers = packages.DomainTransfers{}
+	err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		bucket := tx.Bucket(bucketNameDomainTransfers)
+		if bucket == nil {
+			return
+		}
+		return bucket.ForEach(func(k, _ []byte) error {
+			t, err := getDomainTransferRecord(tx, k)
+			if err != nil {
+				return err
+			}
+			if t.FromUserID == userID || t.ToUserID == userID {
+				transfers = append(transfers, *t.export())
+			}
+			return nil
+		})
+	})
+	return
+}
 
+// RequestDomainTransfer saves a pending transfer of the domain to the
+// user with toUserID. Only the domain owner can request the transfer.
+// An expired transfer is replaced by the new one.
+func (s Service) RequestDomainTransfer(ref, toUserID string, expirationTime time.Time, byUserID string) (t *packages.DomainTransfer, err error) {
+	var crd *chagelogRecordData
 	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
-		d, err = getDomainRecord(tx, []byte(ref))
+		r, err := getDomainRecord(tx, []byte(ref))
 		if err != nil {
 			return
 		}
-		if !d.isOwner(byUserID) {
-			err = packages.ErrForbidden
+		if !r.isOwner(tx, byUserID) {
+			return packages.ErrForbidden
+		}
+		if r.isOwner(tx, toUserID) {
+			return packages.ErrDomainTransferToOwner
+		}
+		et, err := getDomainTransferRecord(tx, []byte(r.id))
+		switch err {
+		case packages.ErrDomainTransferNotFound:
+		case nil:
+			if !et.export().IsExpired() {
+				return packages.ErrDomainTransferAlreadyExists
+			}
+		default:
 			return
-		} else if byUserID == userID {
-			err = packages.ErrUserExists
+		}
+		tr := &domainTransferRecord{
+			domainID:       r.id,
+			FQDN:           r.FQDN,
+			FromUserID:     byUserID,
+			ToUserID:       toUserID,
+			Time:           time.Now().UTC(),
+			ExpirationTime: expirationTime.UTC(),
+		}
+		if err = tr.save(tx); err != nil {
 			return
 		}
-		err = d.addUser(tx, []byte(userID), true)
+		t = tr.export()
+		crd = &chagelogRecordData{
+			domainID: r.id,
+			fqdn:     r.FQDN,
+			userID:   byUserID,
+			action:   packages.ActionRequestDomainTransfer,
+			changes:  tr.changes(),
+		}
 		return
 	}); err != nil {
 		return
 	}
-
-	err = s.newChangelogRecord(chagelogRecordData{
-		domainID: d.id,
-		fqdn:     d.FQDN,
-		userID:   byUserID,
-		action:   packages.ActionDomainAddUser,
-		changes: packages.Changes{
-			packages.Change{
-				Field: "id",
-				To:    &userID,
-			},
-		},
-	})
-
+	err = s.newChangelogRecord(*crd)
 	return
 }
 
-func (s Service) RemoveUserFromDomain(ref, userID, byUserID string) (err error) {
-	d := &domainRecord{}
+// AcceptDomainTransfer changes the domain owner to the user that the
+// transfer is requested for. Only that user can accept the transfer.
+func (s Service) AcceptDomainTransfer(ref, byUserID string) (d *packages.Domain, err error) {
+	var crd *chagelogRecordData
+	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		r, err := getDomainRecord(tx, []byte(ref))
+		if err != nil {
+			return
+		}
+		tr, err := getDomainTransferRecord(tx, []byte(r.id))
+		if err != nil {
+			return
+		}
+		if tr.ToUserID != byUserID {
+			return packages.ErrForbidden
+		}
+		if tr.export().IsExpired() {
+			return packages.ErrDomainTransferExpired
+		}
+		data := chagelogRecordData{
+			domainID: r.id,
+			fqdn:     r.FQDN,
+			userID:   byUserID,
+			action:   packages.ActionAcceptDomainTransfer,
+			changes: r.update(&packages.DomainOptions{
+				OwnerUserID: &tr.ToUserID,
+			}),
+		}
+		// Saving the domain with the new owner removes the transfer.
+		if err = r.save(tx); err != nil {
+			return
+		}
+		d = r.export()
+		crd = &data
+		return s.checkQuota(tx, crd)
+	}); err != nil {
+		return
+	}
+	err = s.newChangelogRecord(*crd)
+	return
+}
 
+// CancelDomainTransfer removes the pending transfer. It can be canceled
+// by the domain owner or declined by the user that it is requested for.
+func (s Service) CancelDomainTransfer(ref, byUserID string) (t *packages.DomainTransfer, err error) {
+	var crd *chagelogRecordData
 	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
-		d, err = getDomainRecord(tx, []byte(ref))
+		r, err := getDomainRecord(tx, []byte(ref))
+		if err != nil {
+			return
+		}
+		tr, err := getDomainTransferRecord(tx, []byte(r.id))
 		if err != nil {
 			return
 		}
-		if !d.isOwner(byUserID) {
-			err = packages.ErrForbidden
+		if !r.isOwner(tx, byUserID) && tr.ToUserID != byUserID {
+			return packages.ErrForbidden
+		}
+		if err = deleteDomainTransferRecord(tx, []byte(r.id)); err != nil {
 			return
 		}
-		err = d.removeUser(tx, []byte(userID))
+		t = tr.export()
+		crd = &chagelogRecordData{
+			domainID: r.id,
+			fqdn:     r.FQDN,
+			userID:   byUserID,
+			action:   packages.ActionCancelDomainTransfer,
+			changes:  tr.changes(),
+		}
 		return
 	}); err != nil {
 		return
 	}
+	err = s.newChangelogRecord(*crd)
+	return
+}
 
-	err = s.newChangelogRecord(chagelogRecordData{
-		domainID: d.id,
-		fqdn:     d.FQDN,
-		userID:   byUserID,
-		action:   packages.ActionDomainRemoveUser,
-		changes: packages.Changes{
-			packages.Change{
-				Field: "id",
-				To:    &userID,
-			},
-		},
+func (s Service) Organization(ref string) (o *packages.Organization, err error) {
+	err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		r, err := getOrganizationRecord(tx, []byte(ref))
+		if err != nil {
+			return
+		}
+		o = r.export()
+		return
 	})
-
 	return
 }
 
-func (s Service) Domains(startRef string, limit int) (page packages.DomainsPage, err error) {
-	switch {
-	case limit == 0:
-		limit = 20
-	case limit > 100:
-		limit = 100
-	}
-	start := []byte(startRef)
+// AddOrganization creates a new organization with the user as its owner.
+func (s Service) AddOrganization(o *packages.OrganizationOptions, byUserID string) (org *packages.Organization, err error) {
+	err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		r := &organizationRecord{}
+		r.update(o)
+		if err = r.save(tx); err != nil {
+			return
+		}
+		if err = r.setMember(tx, byUserID, packages.OrganizationRoleOwner); err != nil {
+			return
+		}
+		org = r.export()
+		return
+	})
+	return
+}
 
-	page = packages.DomainsPage{
-		Domains: packages.Domains{},
-	}
-	err = s.DB.View(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(bucketNameIndexFQDNDomainID)
-		if bucket == nil {
-			return nil
+func (s Service) UpdateOrganization(ref string, o *packages.OrganizationOptions, byUserID string) (org *packages.Organization, err error) {
+	err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		r, err := getOrganizationRecord(tx, []byte(ref))
+		if err != nil {
+			return
 		}
-		c := bucket.Cursor()
-		var k, v []byte
-		if len(start) == 0 {
-			k, v = c.First()
-		} else {
-			k, v = c.Seek(start)
-			if !bytes.Equal(start, k) {
-				return packages.ErrDomainNotFound
-			}
-			var prev, p []byte
-			for i := 0; i < limit; i++ {
-				p, _ = c.Prev()
-				if p == nil {
-					break
-				}
-				prev = p
-			}
-			page.Previous = string(prev)
-			k, v = c.Seek(start)
+		if r.memberRole(tx, byUserID) != packages.OrganizationRoleOwner {
+			return packages.ErrForbidden
 		}
-		var i int
-		for i = 0; k != nil && i < limit; i++ {
-			r, err := getDomainRecordByID(tx, v)
-			if err != nil {
-				return err
-			}
-			d := r.export()
-			page.Domains = append(page.Domains, *d)
-			k, v = c.Next()
+		r.update(o)
+		if err = r.save(tx); err != nil {
+			return
 		}
-		page.Next = string(k)
-		page.Count = i
-		return nil
+		org = r.export()
+		return
 	})
-
 	return
 }
 
-func (s Service) DomainsByUser(userID, startRef string, limit int) (p packages.DomainsPage, err error) {
-	return s.domainsByUser(userID, startRef, limit, bucketNameIndexUserIDFQDNDomainID)
+// DeleteOrganization removes the organization that does not own any
+// domains.
+func (s Service) DeleteOrganization(ref, byUserID string) (org *packages.Organization, err error) {
+	err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		r, err := getOrganizationRecord(tx, []byte(ref))
+		if err != nil {
+			return
+		}
+		if r.memberRole(tx, byUserID) != packages.OrganizationRoleOwner {
+			return packages.ErrForbidden
+		}
+		if err = r.delete(tx); err != nil {
+			return
+		}
+		org = r.export()
+		return
+	})
+	return
 }
 
-func (s Service) DomainsByOwner(userID, startRef string, limit int) (p packages.DomainsPage, err error) {
-	return s.domainsByUser(userID, startRef, limit, bucketNameIndexOwnerUserIDFQDNDomainID)
+// OrganizationsByUser returns organizations that the user is a member of,
+// sorted by their names.
+func (s Service) OrganizationsByUser(userID string) (orgs packages.Organizations, err error) {
+	orgs = packages.Organizations{}
+	err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		bucket := tx.Bucket(bucketNameIndexUserIDOrganizationIDs)
+		if bucket != nil {
+			bucket = bucket.Bucket([]byte(userID))
+		}
+		if bucket == nil {
+			return
+		}
+		return bucket.ForEach(func(id, _ []byte) error {
+			r, err := getOrganizationRecordByID(tx, id)
+			if err != nil {
+				return err
+			}
+			orgs = append(orgs, *r.export())
+			return nil
+		})
+	})
+	sort.Slice(orgs, func(i, j int) bool {
+		return orgs[i].Name < orgs[j].Name
+	})
+	return
+}
+
+func (s Service) OrganizationMembers(ref string) (members packages.OrganizationMembers, err error) {
+	err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		r, err := getOrganizationRecord(tx, []byte(ref))
+		if err != nil {
+			return
+		}
+		members, err = r.members(tx)
+		return
+	})
+	return
+}
+
+// SetOrganizationMember adds the user to the organization or changes
+// the role of the existing member. Only organization owners can manage
+// members.
+func (s Service) SetOrganizationMember(ref, userID string, role packages.OrganizationRole, byUserID string) (err error) {
+	switch role {
+	case packages.OrganizationRoleOwner, packages.OrganizationRoleMember:
+	default:
+		return packages.ErrOrganizationRoleInvalid
+	}
+	return s.DB.Update(func(tx *bolt.Tx) (err error) {
+		r, err := getOrganizationRecord(tx, []byte(ref))
+		if err != nil {
+			return
+		}
+		if r.memberRole(tx, byUserID) != packages.OrganizationRoleOwner {
+			return packages.ErrForbidden
+		}
+		return r.setMember(tx, userID, role)
+	})
+}
+
+// RemoveOrganizationMember removes the user from the organization. Owners
+// can remove any member and members can remove themselves.
+func (s Service) RemoveOrganizationMember(ref, userID, byUserID string) (err error) {
+	return s.DB.Update(func(tx *bolt.Tx) (err error) {
+		r, err := getOrganizationRecord(tx, []byte(ref))
+		if err != nil {
+			return
+		}
+		if userID != byUserID && r.memberRole(tx, byUserID) != packages.OrganizationRoleOwner {
+			return packages.ErrForbidden
+		}
+		return r.removeMember(tx, userID)
+	})
+}
+
+// Quota returns effective quotas and the usage of a user or an
+// organization.
+func (s Service) Quota(ownerID string) (q *packages.Quota, err error) {
+	err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		q, err = s.quota(tx, ownerID)
+		return
+	})
+	return
+}
+
+// SetQuota overrides default quotas for a user or an organization.
+func (s Service) SetQuota(ownerID string, o *packages.QuotaOptions) (q *packages.Quota, err error) {
+	err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		r, err := getQuotaRecord(tx, []byte(ownerID))
+		if err != nil {
+			return
+		}
+		if o.Domains != nil {
+			r.Domains = *o.Domains
+		}
+		if o.Packages != nil {
+			r.Packages = *o.Packages
+		}
+		if err = r.save(tx, []byte(ownerID)); err != nil {
+			return
+		}
+		q, err = s.quota(tx, ownerID)
+		return
+	})
+	return
+}
+
+func (s Service) DeleteDomain(ref string, ifRevision *uint64, byUserID string) (d *packages.Domain, err error) {
+	var crd *chagelogRecordData
+	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		d, crd, err = deleteDomain(tx, ref, ifRevision, byUserID)
+		return
+	}); err != nil {
+		return
+	}
+	err = s.newChangelogRecord(*crd)
+	return
+}
+
+func deleteDomain(tx *bolt.Tx, ref string, ifRevision *uint64, byUserID string) (d *packages.Domain, crd *chagelogRecordData, err error) {
+	r, err := getDomainRecord(tx, []byte(ref))
+	if err != nil {
+		return
+	}
+	if !r.isOwner(tx, byUserID) {
+		err = packages.ErrForbidden
+		return
+	}
+	if ifRevision != nil && *ifRevision != r.Revision {
+		err = packages.ErrDomainRevisionMismatch
+		return
+	}
+	if err = r.delete(tx); err != nil {
+		return
+	}
+	d = r.export()
+
+	crd = &chagelogRecordData{
+		domainID: d.ID,
+		fqdn:     d.FQDN,
+		userID:   byUserID,
+		action:   packages.ActionDeleteDomain,
+	}
+	return
+}
+
+func (s Service) DomainUsers(ref string) (users packages.DomainUsers, err error) {
+	err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		d, err := getDomainRecord(tx, []byte(ref))
+		if err != nil {
+			return
+		}
+		users.OwnerUserID = d.OwnerUserID
+		users.OrganizationID = d.OrganizationID
+		bucket := tx.Bucket(bucketNameIndexDomainIDUserIDs)
+		if bucket != nil {
+			bucket = bucket.Bucket([]byte(d.id))
+			if bucket != nil {
+				users.UserIDs = []string{}
+				users.Roles = map[string]packages.DomainRole{}
+				if err = bucket.ForEach(func(userID, value []byte) error {
+					id := string(userID)
+					if id != d.OwnerUserID {
+						users.UserIDs = append(users.UserIDs, id)
+						users.Roles[id] = domainRoleFromIndexValue(value)
+					}
+					return nil
+				}); err != nil {
+					return
+				}
+			}
+		}
+		return
+	})
+	return
+}
+
+// DomainUserRole returns the role of the user on the domain, including
+// the role that the user has as a member of the organization that owns
+// the domain.
+func (s Service) DomainUserRole(ref, userID string) (role packages.DomainRole, err error) {
+	err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		r, err := getDomainRecord(tx, []byte(ref))
+		if err != nil {
+			return
+		}
+		role = r.role(tx, userID)
+		return
+	})
+	return
+}
+
+func (s Service) AddUserToDomain(ref, userID string, role packages.DomainRole, byUserID string) (err error) {
+	var crd *chagelogRecordData
+	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		_, crd, err = addUserToDomain(tx, ref, userID, role, byUserID)
+		return
+	}); err != nil {
+		return
+	}
+	err = s.newChangelogRecord(*crd)
+	return
+}
+
+// addUserToDomain adds the user with the maintainer role if the role
+// is not specified.
+func addUserToDomain(tx *bolt.Tx, ref, userID string, role packages.DomainRole, byUserID string) (d *packages.Domain, crd *chagelogRecordData, err error) {
+	if role == "" {
+		role = packages.DomainRoleMaintainer
+	}
+	if err = validateDomainUserRole(role); err != nil {
+		return
+	}
+	r, err := getDomainRecord(tx, []byte(ref))
+	if err != nil {
+		return
+	}
+	if !r.isOwner(tx, byUserID) {
+		err = packages.ErrForbidden
+		return
+	} else if byUserID == userID {
+		err = packages.ErrUserExists
+		return
+	}
+	if err = r.addUser(tx, []byte(userID), role, true); err != nil {
+		return
+	}
+	d = r.export()
+
+	crd = &chagelogRecordData{
+		domainID: r.id,
+		fqdn:     r.FQDN,
+		userID:   byUserID,
+		action:   packages.ActionDomainAddUser,
+		changes: packages.Changes{
+			packages.Change{
+				Field: "id",
+				To:    &userID,
+			},
+			packages.Change{
+				Field: "role",
+				To:    stringToStringPtr(string(role)),
+			},
+		},
+	}
+	return
+}
+
+func (s Service) SetDomainUserRole(ref, userID string, role packages.DomainRole, byUserID string) (err error) {
+	var crd *chagelogRecordData
+	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		_, crd, err = setDomainUserRole(tx, ref, userID, role, byUserID)
+		return
+	}); err != nil {
+		return
+	}
+	if crd != nil {
+		err = s.newChangelogRecord(*crd)
+	}
+	return
+}
+
+// setDomainUserRole returns nil changelog record data if the role is
+// not changed.
+func setDomainUserRole(tx *bolt.Tx, ref, userID string, role packages.DomainRole, byUserID string) (d *packages.Domain, crd *chagelogRecordData, err error) {
+	if err = validateDomainUserRole(role); err != nil {
+		return
+	}
+	r, err := getDomainRecord(tx, []byte(ref))
+	if err != nil {
+		return
+	}
+	if !r.isOwner(tx, byUserID) {
+		err = packages.ErrForbidden
+		return
+	}
+	from, err := r.setUserRole(tx, []byte(userID), role)
+	if err != nil {
+		return
+	}
+	d = r.export()
+
+	if from == role {
+		return
+	}
+	crd = &chagelogRecordData{
+		domainID: r.id,
+		fqdn:     r.FQDN,
+		userID:   byUserID,
+		action:   packages.ActionDomainUpdateUser,
+		changes: packages.Changes{
+			packages.Change{
+				Field: "id",
+				To:    &userID,
+			},
+			packages.Change{
+				Field: "role",
+				From:  stringToStringPtr(string(from)),
+				To:    stringToStringPtr(string(role)),
+			},
+		},
+	}
+	return
+}
+
+// validateDomainUserRole returns ErrDomainRoleInvalid for roles that can not
+// be given to domain users. Domain owner is changed only by a transfer.
+func validateDomainUserRole(role packages.DomainRole) error {
+	switch role {
+	case packages.DomainRoleMaintainer, packages.DomainRoleViewer:
+		return nil
+	}
+	return packages.ErrDomainRoleInvalid
+}
+
+func (s Service) RemoveUserFromDomain(ref, userID, byUserID string) (err error) {
+	var crd *chagelogRecordData
+	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		_, crd, err = removeUserFromDomain(tx, ref, userID, byUserID)
+		return
+	}); err != nil {
+		return
+	}
+	err = s.newChangelogRecord(*crd)
+	return
+}
+
+func removeUserFromDomain(tx *bolt.Tx, ref, userID, byUserID string) (d *packages.Domain, crd *chagelogRecordData, err error) {
+	r, err := getDomainRecord(tx, []byte(ref))
+	if err != nil {
+		return
+	}
+	if !r.isOwner(tx, byUserID) {
+		err = packages.ErrForbidden
+		return
+	}
+	if err = r.removeUser(tx, []byte(userID)); err != nil {
+		return
+	}
+	d = r.export()
+
+	crd = &chagelogRecordData{
+		domainID: r.id,
+		fqdn:     r.FQDN,
+		userID:   byUserID,
+		action:   packages.ActionDomainRemoveUser,
+		changes: packages.Changes{
+			packages.Change{
+				Field: "id",
+				To:    &userID,
+			},
+		},
+	}
+	return
 }
 
-func (s Service) domainsByUser(userID, startRef string, limit int, bucket []byte) (page packages.DomainsPage, err error) {
+func (s Service) Domains(startRef string, limit int) (page packages.DomainsPage, err error) {
 	switch {
 	case limit == 0:
 		limit = 20
@@ -347,17 +877,12 @@ func (s Service) domainsByUser(userID, startRef string, limit int, bucket []byte
 
 	page = packages.DomainsPage{
 		Domains: packages.Domains{},
-		UserID:  userID,
 	}
 	err = s.DB.View(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(bucket)
+		bucket := tx.Bucket(bucketNameIndexFQDNDomainID)
 		if bucket == nil {
 			return nil
 		}
-		bucket = bucket.Bucket([]byte(userID))
-		if bucket == nil {
-			return packages.ErrUserDoesNotExist
-		}
 		c := bucket.Cursor()
 		var k, v []byte
 		if len(start) == 0 {
@@ -396,224 +921,965 @@ func (s Service) domainsByUser(userID, startRef string, limit int, bucket []byte
 	return
 }
 
-func (s Service) Package(id string) (p *packages.Package, err error) {
-	var r *packageRecord
-	if err = s.DB.View(func(tx *bolt.Tx) (err error) {
-		r, err = getPackageRecord(tx, []byte(id))
-		if err != nil {
-			return
-		}
-		p, err = r.export(tx)
-		return
-	}); err != nil {
-		return
+// DomainsByUser returns domains that the user has access to, including
+// domains owned by organizations that the user is a member of.
+func (s Service) DomainsByUser(userID, startRef string, limit int) (p packages.DomainsPage, err error) {
+	return s.domainsByUser(userID, startRef, limit, func(tx *bolt.Tx) (domainsCursor, error) {
+		return userDomainsCursor(tx, []byte(userID))
+	})
+}
+
+func (s Service) DomainsByOwner(userID, startRef string, limit int) (p packages.DomainsPage, err error) {
+	return s.domainsByUser(userID, startRef, limit, indexDomainsCursor(bucketNameIndexOwnerUserIDFQDNDomainID, []byte(userID)))
+}
+
+// DomainsByOrganization returns domains that are owned by the organization.
+func (s Service) DomainsByOrganization(organizationID, startRef string, limit int) (page packages.DomainsPage, err error) {
+	if err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		_, err = getOrganizationRecordByID(tx, []byte(organizationID))
+		return
+	}); err != nil {
+		return
+	}
+	page, err = s.domainsByUser(organizationID, startRef, limit, indexDomainsCursor(bucketNameIndexOrganizationIDFQDNDomainID, []byte(organizationID)))
+	if err == packages.ErrUserDoesNotExist {
+		// Organization without domains.
+		page, err = packages.DomainsPage{Domains: packages.Domains{}}, nil
+	}
+	page.UserID = ""
+	page.OrganizationID = organizationID
+	return
+}
+
+func (s Service) domainsByUser(userID, startRef string, limit int, cursor func(tx *bolt.Tx) (domainsCursor, error)) (page packages.DomainsPage, err error) {
+	switch {
+	case limit == 0:
+		limit = 20
+	case limit > 100:
+		limit = 100
+	}
+	start := []byte(startRef)
+
+	page = packages.DomainsPage{
+		Domains: packages.Domains{},
+		UserID:  userID,
+	}
+	err = s.DB.View(func(tx *bolt.Tx) error {
+		c, err := cursor(tx)
+		if err != nil || c == nil {
+			return err
+		}
+		var k, v []byte
+		if len(start) == 0 {
+			k, v = c.First()
+		} else {
+			k, v = c.Seek(start)
+			if !bytes.Equal(start, k) {
+				return packages.ErrDomainNotFound
+			}
+			var prev, p []byte
+			for i := 0; i < limit; i++ {
+				p, _ = c.Prev()
+				if p == nil {
+					break
+				}
+				prev = p
+			}
+			page.Previous = string(prev)
+			k, v = c.Seek(start)
+		}
+		var i int
+		for i = 0; k != nil && i < limit; i++ {
+			r, err := getDomainRecordByID(tx, v)
+			if err != nil {
+				return err
+			}
+			d := r.export()
+			page.Domains = append(page.Domains, *d)
+			k, v = c.Next()
+		}
+		page.Next = string(k)
+		page.Count = i
+		return nil
+	})
+
+	return
+}
+
+func (s Service) Package(id string) (p *packages.Package, err error) {
+	var r *packageRecord
+	if err = s.DB.View(func(tx *bolt.Tx) (err error) {
+		r, err = getPackageRecord(tx, []byte(id))
+		if err != nil {
+			return
+		}
+		p, err = r.export(tx)
+		return
+	}); err != nil {
+		return
+	}
+	return
+}
+
+func (s Service) AddPackage(o *packages.PackageOptions, byUserID string) (p *packages.Package, err error) {
+	var crd *chagelogRecordData
+	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		p, crd, err = addPackage(tx, o, byUserID)
+		if err != nil {
+			return
+		}
+		return s.checkQuota(tx, crd)
+	}); err != nil {
+		return
+	}
+	if crd != nil {
+		err = s.newChangelogRecord(*crd)
+	}
+	return
+}
+
+// addPackage returns nil changelog record data if there are no changes.
+func addPackage(tx *bolt.Tx, o *packages.PackageOptions, byUserID string) (p *packages.Package, crd *chagelogRecordData, err error) {
+	if o.Domain == nil {
+		err = packages.ErrPackageDomainRequired
+		return
+	}
+	r := &packageRecord{}
+	d, err := getDomainRecord(tx, []byte(*o.Domain))
+	if err != nil {
+		return
+	}
+	if !d.canEditPackages(tx, byUserID) {
+		err = packages.ErrForbidden
+		return
+	}
+	data := chagelogRecordData{
+		userID: byUserID,
+		action: packages.ActionAddPackage,
+	}
+	data.changes, err = r.update(tx, o)
+	if err != nil {
+		return
+	}
+	if err = r.save(tx); err != nil {
+		return
+	}
+	p, err = r.export(tx)
+	if err != nil {
+		return
+	}
+	data.domainID = p.Domain.ID
+	data.fqdn = p.Domain.FQDN
+	data.packageID = p.ID
+	data.path = p.Path
+	if len(data.changes) > 0 {
+		crd = &data
+	}
+	return
+}
+
+func (s Service) UpdatePackage(id string, o *packages.PackageOptions, byUserID string) (p *packages.Package, err error) {
+	var crd *chagelogRecordData
+	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		p, crd, err = updatePackage(tx, id, o, byUserID)
+		if err != nil {
+			return
+		}
+		return s.checkQuota(tx, crd)
+	}); err != nil {
+		return
+	}
+	if crd != nil {
+		err = s.newChangelogRecord(*crd)
+	}
+	return
+}
+
+// updatePackage returns nil changelog record data if there are no changes.
+func updatePackage(tx *bolt.Tx, id string, o *packages.PackageOptions, byUserID string) (p *packages.Package, crd *chagelogRecordData, err error) {
+	r, err := getPackageRecord(tx, []byte(id))
+	if err != nil {
+		return
+	}
+	d, err := getDomainRecordByID(tx, []byte(r.DomainID))
+	if err != nil {
+		return
+	}
+	if !d.canEditPackages(tx, byUserID) {
+		err = packages.ErrForbidden
+		return
+	}
+	if o.IfRevision != nil && *o.IfRevision != r.Revision {
+		err = packages.ErrPackageRevisionMismatch
+		return
+	}
+	data := chagelogRecordData{
+		userID: byUserID,
+		action: packages.ActionUpdatePackage,
+	}
+	data.changes, err = r.update(tx, o)
+	if err != nil {
+		return
+	}
+	// validate domain access after package update
+	if r.DomainID == "" {
+		err = packages.ErrPackageDomainRequired
+		return
+	}
+	d, err = getDomainRecordByID(tx, []byte(r.DomainID))
+	if err != nil {
+		return
+	}
+	if !d.canEditPackages(tx, byUserID) {
+		err = packages.ErrForbidden
+		return
+	}
+	if err = r.save(tx); err != nil {
+		return
+	}
+	p, err = r.export(tx)
+	if err != nil {
+		return
+	}
+	data.domainID = p.Domain.ID
+	data.fqdn = p.Domain.FQDN
+	data.packageID = p.ID
+	data.path = p.Path
+	if len(data.changes) > 0 {
+		crd = &data
+	}
+	return
+}
+
+func (s Service) DeletePackage(id string, ifRevision *uint64, byUserID string) (p *packages.Package, err error) {
+	var crd *chagelogRecordData
+	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
+		p, crd, err = deletePackage(tx, id, ifRevision, byUserID)
+		return
+	}); err != nil {
+		return
+	}
+	err = s.newChangelogRecord(*crd)
+	return
+}
+
+func deletePackage(tx *bolt.Tx, id string, ifRevision *uint64, byUserID string) (p *packages.Package, crd *chagelogRecordData, err error) {
+	r, err := getPackageRecord(tx, []byte(id))
+	if err != nil {
+		return
+	}
+	d, err := getDomainRecordByID(tx, []byte(r.DomainID))
+	if err != nil {
+		return
+	}
+	if !d.canEditPackages(tx, byUserID) {
+		err = packages.ErrForbidden
+		return
+	}
+	if ifRevision != nil && *ifRevision != r.Revision {
+		err = packages.ErrPackageRevisionMismatch
+		return
+	}
+	if err = r.delete(tx); err != nil {
+		return
+	}
+	p, err = r.export(tx)
+	if err != nil {
+		return
+	}
+
+	crd = &chagelogRecordData{
+		domainID:  p.Domain.ID,
+		fqdn:      p.Domain.FQDN,
+		packageID: p.ID,
+		path:      p.Path,
+		userID:    byUserID,
+		action:    packages.ActionDeletePackage,
+	}
+	return
+}
+
+// Batch applies all operations in a single transaction. If any of the
+// operations fails, none of them are applied and BatchError is returned
+// with the index of the failed operation.
+func (s Service) Batch(operations []packages.BatchOperation, byUserID string) (results []packages.BatchResult, err error) {
+	var records []chagelogRecordData
+	if err = s.DB.Update(func(tx *bolt.Tx) error {
+		results = make([]packages.BatchResult, 0, len(operations))
+		records = nil
+		for i, o := range operations {
+			result := packages.BatchResult{
+				Action: o.Action,
+			}
+			var crd *chagelogRecordData
+			var err error
+			switch o.Action {
+			case packages.ActionAddDomain:
+				if o.DomainOptions == nil {
+					err = packages.ErrDomainFQDNRequired
+					break
+				}
+				result.Domain, crd, err = addDomain(tx, o.DomainOptions, byUserID)
+			case packages.ActionUpdateDomain:
+				if o.DomainOptions == nil {
+					o.DomainOptions = &packages.DomainOptions{}
+				}
+				result.Domain, crd, err = updateDomain(tx, o.Ref, o.DomainOptions, byUserID)
+			case packages.ActionDeleteDomain:
+				result.Domain, crd, err = deleteDomain(tx, o.Ref, o.IfRevision, byUserID)
+			case packages.ActionDomainAddUser:
+				result.Domain, crd, err = addUserToDomain(tx, o.Ref, o.UserID, o.Role, byUserID)
+			case packages.ActionDomainUpdateUser:
+				result.Domain, crd, err = setDomainUserRole(tx, o.Ref, o.UserID, o.Role, byUserID)
+			case packages.ActionDomainRemoveUser:
+				result.Domain, crd, err = removeUserFromDomain(tx, o.Ref, o.UserID, byUserID)
+			case packages.ActionAddPackage:
+				if o.PackageOptions == nil {
+					err = packages.ErrPackageDomainRequired
+					break
+				}
+				result.Package, crd, err = addPackage(tx, o.PackageOptions, byUserID)
+			case packages.ActionUpdatePackage:
+				if o.PackageOptions == nil {
+					o.PackageOptions = &packages.PackageOptions{}
+				}
+				result.Package, crd, err = updatePackage(tx, o.Ref, o.PackageOptions, byUserID)
+			case packages.ActionDeletePackage:
+				result.Package, crd, err = deletePackage(tx, o.Ref, o.IfRevision, byUserID)
+			default:
+				err = packages.ErrBatchActionInvalid
+			}
+			if err == nil {
+				err = s.checkQuota(tx, crd)
+			}
+			if err != nil {
+				return &packages.BatchError{
+					Operation: i,
+					Err:       err,
+				}
+			}
+			results = append(results, result)
+			if crd != nil {
+				records = append(records, *crd)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(records) > 0 {
+		err = s.newChangelogRecords(records)
+	}
+	return
+}
+
+// PackagesByDomain returns a page of packages under a domain ordered by path.
+// If filter is not nil, only packages that match it are returned. Packages
+// are iterated over the most selective index for the filter, and all other
+// criteria are checked against indexes, without reading package records.
+func (s Service) PackagesByDomain(domainRef, startName string, limit int, filter *packages.PackagesFilter) (page packages.PackagesPage, err error) {
+	switch {
+	case limit == 0:
+		limit = 20
+	case limit > 100:
+		limit = 100
+	}
+	start := []byte(startName)
+	if filter == nil {
+		filter = &packages.PackagesFilter{}
+	}
+	query := strings.ToLower(filter.Query)
+
+	page = packages.PackagesPage{
+		Packages: packages.Packages{},
+	}
+
+	err = s.DB.View(func(tx *bolt.Tx) error {
+		r, err := getDomainRecord(tx, []byte(domainRef))
+		if err != nil {
+			return err
+		}
+		page.Domain = r.export()
+		domainID := []byte(r.id)
+
+		// Indexes that package path must be found in with the value from
+		// filter, ordered by expected selectivity, as packages are iterated
+		// over the first one.
+		type indexValue struct {
+			index packageFilterIndex
+			value string
+		}
+		indexes := []indexValue{}
+		if filter.Disabled != nil && *filter.Disabled {
+			indexes = append(indexes, indexValue{packageFilterIndexDisabled, string(flagBytes)})
+		}
+		if filter.RepoRootHost != "" {
+			indexes = append(indexes, indexValue{packageFilterIndexRepoRootHost, strings.ToLower(filter.RepoRootHost)})
+		}
+		if filter.RefType != "" {
+			indexes = append(indexes, indexValue{packageFilterIndexRefType, string(filter.RefType)})
+		}
+		if filter.VCS != "" {
+			indexes = append(indexes, indexValue{packageFilterIndexVCS, string(filter.VCS)})
+		}
+		buckets := []*bolt.Bucket{}
+		for _, i := range indexes {
+			bucket := i.index.packages(tx, domainID, i.value)
+			if bucket == nil {
+				// No packages with this value.
+				return nil
+			}
+			buckets = append(buckets, bucket)
+		}
+		var disabledBucket *bolt.Bucket
+		if filter.Disabled != nil && !*filter.Disabled {
+			disabledBucket = packageFilterIndexDisabled.packages(tx, domainID, string(flagBytes))
+		}
+
+		var bucket *bolt.Bucket
+		if len(buckets) == 0 {
+			bucket = tx.Bucket(bucketNameIndexDomainIDPathPackageID)
+			if bucket == nil {
+				return nil
+			}
+			bucket = bucket.Bucket(domainID)
+			if bucket == nil {
+				return nil
+			}
+		} else {
+			bucket, buckets = buckets[0], buckets[1:]
+		}
+
+		match := func(k []byte) bool {
+			if k == nil {
+				// End of iteration.
+				return true
+			}
+			if query != "" && !strings.Contains(strings.ToLower(string(k)), query) {
+				return false
+			}
+			for _, b := range buckets {
+				if b.Get(k) == nil {
+					return false
+				}
+			}
+			if disabledBucket != nil && disabledBucket.Get(k) != nil {
+				return false
+			}
+			return true
+		}
+
+		c := bucket.Cursor()
+		var k, v []byte
+		if len(start) == 0 {
+			k, v = c.First()
+		} else {
+			k, v = c.Seek(start)
+			if !bytes.Equal(start, k) || !match(k) {
+				return packages.ErrPackageNotFound
+			}
+			var prev, p []byte
+			for i := 0; i < limit; {
+				p, _ = c.Prev()
+				if p == nil {
+					break
+				}
+				if match(p) {
+					prev = p
+					i++
+				}
+			}
+			page.Previous = string(prev)
+			k, v = c.Seek(start)
+		}
+		var i int
+		for i = 0; i < limit; k, v = c.Next() {
+			if !match(k) {
+				continue
+			}
+			if k == nil {
+				break
+			}
+			d, err := getPackageRecord(tx, v)
+			if err != nil {
+				return err
+			}
+			p, err := d.export(nil)
+			if err != nil {
+				return err
+			}
+			page.Packages = append(page.Packages, *p)
+			i++
+		}
+		for !match(k) {
+			k, _ = c.Next()
+		}
+		page.Next = string(k)
+		page.Count = i
+		return nil
+	})
+
+	return
+}
+
+// SearchPackages returns a page of packages with import prefix, repository
+// root or reference name that contain the query, case insensitive. Only
+// packages under domains that the user with userID has access to,
+// including domains of organizations that the user is a member of, are
+// searched, or under all domains if userID is blank. Organization ID is
+// accepted as userID to search domains that the organization owns. Packages are ordered
+// by domain FQDN and path, and startID is the ID of the first package on
+// the page, as returned in the Next field of the previous page.
+func (s Service) SearchPackages(userID, query, startID string, limit int) (page packages.PackagesPage, err error) {
+	switch {
+	case limit == 0:
+		limit = 20
+	case limit > 100:
+		limit = 100
 	}
-	return
-}
+	query = strings.ToLower(query)
 
-func (s Service) AddPackage(o *packages.PackageOptions, byUserID string) (p *packages.Package, err error) {
-	if o.Domain == nil {
-		err = packages.ErrPackageDomainRequired
-		return
+	page = packages.PackagesPage{
+		Packages: packages.Packages{},
 	}
 
-	var crd chagelogRecordData
-	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
-		r := &packageRecord{}
-		d, err := getDomainRecord(tx, []byte(*o.Domain))
-		if err != nil {
-			return err
+	err = s.DB.View(func(tx *bolt.Tx) error {
+		var dc domainsCursor
+		if userID == "" {
+			if b := tx.Bucket(bucketNameIndexFQDNDomainID); b != nil {
+				dc = b.Cursor()
+			}
+		} else {
+			c, err := userDomainsCursor(tx, []byte(userID))
+			if err != nil && err != packages.ErrUserDoesNotExist {
+				return err
+			}
+			dc = c
 		}
-		if !d.isUser(tx, byUserID) {
-			return packages.ErrForbidden
+		pathsBucket := tx.Bucket(bucketNameIndexDomainIDPathPackageID)
+		if dc == nil || pathsBucket == nil {
+			return nil
 		}
-		crd = chagelogRecordData{
-			userID: byUserID,
-			action: packages.ActionAddPackage,
+
+		var startFQDN, startPath []byte
+		if startID != "" {
+			p, err := getPackageRecord(tx, []byte(startID))
+			if err != nil {
+				return err
+			}
+			d, err := getDomainRecordByID(tx, []byte(p.DomainID))
+			if err != nil {
+				return err
+			}
+			startFQDN, startPath = []byte(d.FQDN), []byte(p.Path)
 		}
-		crd.changes, err = r.update(tx, o)
-		if err != nil {
-			return err
+
+		var fqdn, domainID []byte
+		if startFQDN == nil {
+			fqdn, domainID = dc.First()
+		} else {
+			fqdn, domainID = dc.Seek(startFQDN)
+			if !bytes.Equal(fqdn, startFQDN) {
+				// Package is not under domains that the user has access to.
+				return packages.ErrPackageNotFound
+			}
 		}
-		if err = r.save(tx); err != nil {
-			return
+		for ; fqdn != nil; fqdn, domainID = dc.Next() {
+			bucket := pathsBucket.Bucket(domainID)
+			if bucket == nil {
+				continue
+			}
+			var domain *packages.Domain
+			pc := bucket.Cursor()
+			var path, id []byte
+			if startPath != nil && bytes.Equal(fqdn, startFQDN) {
+				path, id = pc.Seek(startPath)
+			} else {
+				path, id = pc.First()
+			}
+			for ; path != nil; path, id = pc.Next() {
+				r, err := getPackageRecord(tx, id)
+				if err != nil {
+					return err
+				}
+				if query != "" &&
+					!strings.Contains(strings.ToLower(string(fqdn)+r.Path), query) &&
+					!strings.Contains(strings.ToLower(r.RepoRoot), query) &&
+					!strings.Contains(strings.ToLower(r.RefName), query) {
+					continue
+				}
+				if page.Count == limit {
+					page.Next = r.id
+					return nil
+				}
+				if domain == nil {
+					d, err := getDomainRecordByID(tx, domainID)
+					if err != nil {
+						return err
+					}
+					domain = d.export()
+				}
+				p, err := r.export(nil)
+				if err != nil {
+					return err
+				}
+				p.Domain = domain
+				page.Packages = append(page.Packages, *p)
+				page.Count++
+			}
 		}
-		p, err = r.export(tx)
-		crd.domainID = p.Domain.ID
-		crd.fqdn = p.Domain.FQDN
-		crd.packageID = p.ID
-		crd.path = p.Path
-		return
-	}); err != nil {
-		return
-	}
-
-	if len(crd.changes) > 0 {
-		err = s.newChangelogRecord(crd)
-	}
+		return nil
+	})
 
 	return
 }
 
-func (s Service) UpdatePackage(id string, o *packages.PackageOptions, byUserID string) (p *packages.Package, err error) {
-	var crd chagelogRecordData
+// errDryRun is returned from SyncPackages transaction function
+// to discard all changes when only a plan is requested.
+var errDryRun = errors.New("dry run")
+
+// SyncPackages makes packages under a domain match the provided list of
+// package options in a single transaction. Packages are matched by path,
+// and the ones that are not in the list are deleted. If dryRun is true,
+// all changes are discarded and only the plan is returned.
+func (s Service) SyncPackages(domainRef string, o []packages.PackageOptions, dryRun bool, byUserID string) (plan *packages.PackagesPlan, err error) {
+	var crds []chagelogRecordData
 
 	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
-		var r *packageRecord
-		r, err = getPackageRecord(tx, []byte(id))
+		d, err := getDomainRecord(tx, []byte(domainRef))
 		if err != nil {
 			return
 		}
-
-		d, err := getDomainRecordByID(tx, []byte(r.DomainID))
-		if err != nil {
-			return err
-		}
-		if !d.isUser(tx, byUserID) {
+		if !d.canEditPackages(tx, byUserID) {
 			return packages.ErrForbidden
 		}
-		crd = chagelogRecordData{
-			userID: byUserID,
-			action: packages.ActionUpdatePackage,
-		}
-		crd.changes, err = r.update(tx, o)
-		if err != nil {
-			return err
-		}
-		// validate domain access after package update
-		if r.DomainID == "" {
-			return packages.ErrPackageDomainRequired
+		plan = &packages.PackagesPlan{
+			Domain:  d.export(),
+			Changes: []packages.PackageChange{},
 		}
-		d, err = getDomainRecordByID(tx, []byte(r.DomainID))
-		if err != nil {
-			return err
+
+		// Package IDs of all existing packages under the domain by path
+		existing := map[string][]byte{}
+		bucket := tx.Bucket(bucketNameIndexDomainIDPathPackageID)
+		if bucket != nil {
+			bucket = bucket.Bucket([]byte(d.id))
+			if bucket != nil {
+				if err = bucket.ForEach(func(path, id []byte) error {
+					existing[string(path)] = append([]byte{}, id...)
+					return nil
+				}); err != nil {
+					return
+				}
+			}
 		}
-		if !d.isUser(tx, byUserID) {
-			return packages.ErrForbidden
+
+		paths := map[string]struct{}{}
+		for _, po := range o {
+			po := syncPackageOptions(po)
+			po.Domain = &d.id
+			*po.Path = strings.TrimRight(strings.TrimSpace(*po.Path), "/")
+			if _, ok := paths[*po.Path]; ok {
+				return packages.ErrPackageAlreadyExists
+			}
+			paths[*po.Path] = struct{}{}
+
+			r := &packageRecord{}
+			action := packages.ActionAddPackage
+			if id, ok := existing[*po.Path]; ok {
+				r, err = getPackageRecord(tx, id)
+				if err != nil {
+					return
+				}
+				action = packages.ActionUpdatePackage
+			}
+			changes, err := r.update(tx, po)
+			if err != nil {
+				return err
+			}
+			if action == packages.ActionUpdatePackage && len(changes) == 0 {
+				continue
+			}
+			if err = r.save(tx); err != nil {
+				return err
+			}
+			p, err := r.export(tx)
+			if err != nil {
+				return err
+			}
+			if dryRun && action == packages.ActionAddPackage {
+				// ID of the package that is not saved is meaningless
+				p.ID = ""
+			}
+			plan.Changes = append(plan.Changes, packages.PackageChange{
+				Action:  action,
+				Package: *p,
+				Changes: changes,
+			})
+			crds = append(crds, chagelogRecordData{
+				domainID:  d.id,
+				fqdn:      d.FQDN,
+				packageID: p.ID,
+				path:      p.Path,
+				userID:    byUserID,
+				action:    action,
+				changes:   changes,
+			})
+		}
+
+		for path, id := range existing {
+			if _, ok := paths[path]; ok {
+				continue
+			}
+			r, err := getPackageRecord(tx, id)
+			if err != nil {
+				return err
+			}
+			if err = r.delete(tx); err != nil {
+				return err
+			}
+			p, err := r.export(tx)
+			if err != nil {
+				return err
+			}
+			plan.Changes = append(plan.Changes, packages.PackageChange{
+				Action:  packages.ActionDeletePackage,
+				Package: *p,
+			})
+			crds = append(crds, chagelogRecordData{
+				domainID:  d.id,
+				fqdn:      d.FQDN,
+				packageID: p.ID,
+				path:      p.Path,
+				userID:    byUserID,
+				action:    packages.ActionDeletePackage,
+			})
+		}
+
+		sort.Slice(plan.Changes, func(i, j int) bool {
+			return plan.Changes[i].Package.Path < plan.Changes[j].Package.Path
+		})
+
+		// Quota is checked after deletions, as synchronization may
+		// replace packages without adding to their number.
+		for _, c := range plan.Changes {
+			if c.Action == packages.ActionAddPackage {
+				if err = s.checkDomainQuota(tx, []byte(d.id), false, true); err != nil {
+					return
+				}
+				break
+			}
 		}
-		if err = r.save(tx); err != nil {
-			return
+
+		if dryRun {
+			return errDryRun
 		}
-		p, err = r.export(tx)
-		crd.domainID = p.Domain.ID
-		crd.fqdn = p.Domain.FQDN
-		crd.packageID = p.ID
-		crd.path = p.Path
 		return
 	}); err != nil {
-		return
+		if err != errDryRun {
+			plan = nil
+			return
+		}
+		return plan, nil
 	}
+	plan.Applied = true
 
-	if len(crd.changes) > 0 {
-		err = s.newChangelogRecord(crd)
+	if len(crds) > 0 {
+		err = s.newChangelogRecords(crds)
 	}
 
 	return
 }
 
-func (s Service) DeletePackage(id string, byUserID string) (p *packages.Package, err error) {
+// syncPackageOptions returns a copy of package options with all fields set,
+// as the package is fully described by the options in synchronization and
+// fields that are not provided are reset to their zero values.
+func syncPackageOptions(o packages.PackageOptions) *packages.PackageOptions {
+	var (
+		path, repoRoot, refName, goSource, redirectURL string
+		vcs                                            packages.VCS
+		refType                                        packages.RefType
+		disabled                                       bool
+	)
+	if o.Path != nil {
+		path = *o.Path
+	}
+	if o.VCS != nil {
+		vcs = *o.VCS
+	}
+	if o.RepoRoot != nil {
+		repoRoot = *o.RepoRoot
+	}
+	if o.RefType != nil {
+		refType = *o.RefType
+	}
+	if o.RefName != nil {
+		refName = *o.RefName
+	}
+	if o.GoSource != nil {
+		goSource = *o.GoSource
+	}
+	if o.RedirectURL != nil {
+		redirectURL = *o.RedirectURL
+	}
+	if o.Disabled != nil {
+		disabled = *o.Disabled
+	}
+	return &packages.PackageOptions{
+		Domain:      o.Domain,
+		Path:        &path,
+		VCS:         &vcs,
+		RepoRoot:    &repoRoot,
+		RefType:     &refType,
+		RefName:     &refName,
+		GoSource:    &goSource,
+		RedirectURL: &redirectURL,
+		Disabled:    &disabled,
+	}
+}
+
+// ApplyPackageDefaults sets fields of all packages under a domain to the
+// domain package defaults in a single transaction. If fields are not
+// provided, all fields that have default values are applied. If dryRun
+// is true, all changes are discarded and only the plan is returned.
+func (s Service) ApplyPackageDefaults(domainRef string, fields []packages.PackageDefaultsField, dryRun bool, byUserID string) (plan *packages.PackagesPlan, err error) {
+	for _, f := range fields {
+		ok := false
+		for _, pf := range packages.PackageDefaultsFields {
+			if f == pf {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return nil, packages.ErrPackageDefaultsFieldInvalid
+		}
+	}
+
+	var crds []chagelogRecordData
+
 	if err = s.DB.Update(func(tx *bolt.Tx) (err error) {
-		r, err := getPackageRecord(tx, []byte(id))
+		d, err := getDomainRecord(tx, []byte(domainRef))
 		if err != nil {
 			return
 		}
-		d, err := getDomainRecordByID(tx, []byte(r.DomainID))
-		if err != nil {
-			return err
+		if !d.canEditPackages(tx, byUserID) {
+			return packages.ErrForbidden
 		}
-		if !d.isUser(tx, byUserID) {
-			err = packages.ErrForbidden
-			return
+		plan = &packages.PackagesPlan{
+			Domain:  d.export(),
+			Changes: []packages.PackageChange{},
 		}
-		if err = r.delete(tx); err != nil {
+		if d.PackageDefaults == nil {
 			return
 		}
-		p, err = r.export(tx)
-		return
-	}); err != nil {
-		return
-	}
-
-	err = s.newChangelogRecord(chagelogRecordData{
-		domainID:  p.Domain.ID,
-		fqdn:      p.Domain.FQDN,
-		packageID: p.ID,
-		path:      p.Path,
-		userID:    byUserID,
-		action:    packages.ActionDeletePackage,
-	})
-
-	return
-}
 
-func (s Service) PackagesByDomain(domainRef, startName string, limit int) (page packages.PackagesPage, err error) {
-	switch {
-	case limit == 0:
-		limit = 20
-	case limit > 100:
-		limit = 100
-	}
-	start := []byte(startName)
-
-	page = packages.PackagesPage{
-		Packages: packages.Packages{},
-	}
-
-	err = s.DB.View(func(tx *bolt.Tx) error {
-		r, err := getDomainRecord(tx, []byte(domainRef))
-		if err != nil {
-			return err
-		}
-		page.Domain = r.export()
 		bucket := tx.Bucket(bucketNameIndexDomainIDPathPackageID)
 		if bucket == nil {
-			return nil
+			return
 		}
-		bucket = bucket.Bucket([]byte(r.id))
+		bucket = bucket.Bucket([]byte(d.id))
 		if bucket == nil {
+			return
+		}
+		ids := [][]byte{}
+		if err = bucket.ForEach(func(_, id []byte) error {
+			ids = append(ids, append([]byte{}, id...))
 			return nil
+		}); err != nil {
+			return
 		}
-		c := bucket.Cursor()
-		var k, v []byte
-		if len(start) == 0 {
-			k, v = c.First()
-		} else {
-			k, v = c.Seek(start)
-			if !bytes.Equal(start, k) {
-				return packages.ErrPackageNotFound
-			}
-			var prev, p []byte
-			for i := 0; i < limit; i++ {
-				p, _ = c.Prev()
-				if p == nil {
-					break
-				}
-				prev = p
+
+		for _, id := range ids {
+			r, err := getPackageRecord(tx, id)
+			if err != nil {
+				return err
 			}
-			page.Previous = string(prev)
-			k, v = c.Seek(start)
-		}
-		var i int
-		for i = 0; k != nil && i < limit; i++ {
-			d, err := getPackageRecord(tx, v)
+			o := packageDefaultsOptions(d.PackageDefaults.ForPackage(d.FQDN, r.Path), fields)
+			changes, err := r.update(tx, o)
 			if err != nil {
 				return err
 			}
-			p, err := d.export(nil)
+			if len(changes) == 0 {
+				continue
+			}
+			if err = r.save(tx); err != nil {
+				return err
+			}
+			p, err := r.export(tx)
 			if err != nil {
 				return err
 			}
-			page.Packages = append(page.Packages, *p)
-			k, v = c.Next()
+			plan.Changes = append(plan.Changes, packages.PackageChange{
+				Action:  packages.ActionUpdatePackage,
+				Package: *p,
+				Changes: changes,
+			})
+			crds = append(crds, chagelogRecordData{
+				domainID:  d.id,
+				fqdn:      d.FQDN,
+				packageID: p.ID,
+				path:      p.Path,
+				userID:    byUserID,
+				action:    packages.ActionUpdatePackage,
+				changes:   changes,
+			})
+		}
+
+		sort.Slice(plan.Changes, func(i, j int) bool {
+			return plan.Changes[i].Package.Path < plan.Changes[j].Package.Path
+		})
+
+		if dryRun {
+			return errDryRun
 		}
-		page.Next = string(k)
-		page.Count = i
-		return nil
-	})
+		return
+	}); err != nil {
+		if err != errDryRun {
+			plan = nil
+			return
+		}
+		return plan, nil
+	}
+	plan.Applied = true
+
+	if len(crds) > 0 {
+		err = s.newChangelogRecords(crds)
+	}
 
 	return
 }
 
+// packageDefaultsOptions returns package options with the provided
+// package defaults fields set. If there are no fields, all fields
+// with default values are set. Empty VCS and repository root are
+// never set as they are required.
+func packageDefaultsOptions(d packages.PackageDefaults, fields []packages.PackageDefaultsField) *packages.PackageOptions {
+	all := len(fields) == 0
+	set := map[packages.PackageDefaultsField]bool{}
+	for _, f := range fields {
+		set[f] = true
+	}
+	o := &packages.PackageOptions{}
+	if d.VCS != "" && (all || set[packages.PackageDefaultsFieldVCS]) {
+		o.VCS = &d.VCS
+	}
+	if d.RepoRoot != "" && (all || set[packages.PackageDefaultsFieldRepoRoot]) {
+		o.RepoRoot = &d.RepoRoot
+	}
+	if (all && d.RefType != "") || set[packages.PackageDefaultsFieldRef] {
+		o.RefType = &d.RefType
+		o.RefName = &d.RefName
+	}
+	if (all && d.GoSource != "") || set[packages.PackageDefaultsFieldGoSource] {
+		o.GoSource = &d.GoSource
+	}
+	if (all && d.RedirectURL != "") || set[packages.PackageDefaultsFieldRedirectURL] {
+		o.RedirectURL = &d.RedirectURL
+	}
+	return o
+}
+
 func (s Service) ResolvePackage(path string) (resolution *packages.PackageResolution, err error) {
 	var fqdn string
 	i := strings.IndexRune(path, '/')
@@ -645,6 +1911,16 @@ func (s Service) ResolvePackage(path string) (resolution *packages.PackageResolu
 		}
 		resolution = &packages.PackageResolution{}
 		resolution.ImportPrefix = fqdn + path
+		if _, err := getDomainIDByExactFQDN(tx, []byte(fqdn)); err == packages.ErrDomainNotFound {
+			d, err := getDomainRecordByID(tx, domainID)
+			if err != nil {
+				return err
+			}
+			if contains(d.Aliases, fqdn) {
+				resolution.CanonicalImportPrefix = d.FQDN + path
+				resolution.AliasRedirect = d.AliasRedirect
+			}
+		}
 		resolution.VCS = p.VCS
 		resolution.RepoRoot = p.RepoRoot
 		resolution.RefType = p.RefType
@@ -690,7 +1966,7 @@ func (s Service) ChangelogRecord(domainRef, id string) (record *packages.Changel
 		d := []byte(domainRef)
 		r, err = getChangelogRecord(tx, d, []byte(id))
 		if err == packages.ErrDomainNotFound {
-			d, err = getDomainIDByFQDN(tx, d)
+			d, err = getDomainIDByExactFQDN(tx, d)
 			if err != nil {
 				return
 			}
@@ -731,7 +2007,7 @@ func (s Service) DeleteChangelogRecord(domainRef, id string) (record *packages.C
 		d := []byte(domainRef)
 		r, err = getChangelogRecord(tx, d, []byte(id))
 		if err == packages.ErrDomainNotFound {
-			d, err = getDomainIDByFQDN(tx, d)
+			d, err = getDomainIDByExactFQDN(tx, d)
 			if err != nil {
 				return
 			}
@@ -815,7 +2091,7 @@ func (s Service) ChangelogForDomain(domainRef, start string, limit int) (page pa
 	domainID := []byte(domainRef)
 
 	if err = s.DB.View(func(tx *bolt.Tx) (err error) {
-		id, _ := getDomainIDByFQDN(tx, domainID)
+		id, _ := getDomainIDByExactFQDN(tx, domainID)
 		if id != nil {
 			domainID = id
 		}
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"net/url"
 	"strconv"
+	"time"
 
 	"resenje.org/web/client/api"
 
@@ -36,6 +37,13 @@ func (c Client) Domain(ref string) (d *packages.Domain, err error) {
 	return
 }
 
+func (c Client) DomainByHost(host string) (d *packages.Domain, err error) {
+	d = &packages.Domain{}
+	err = c.JSON("GET", "/hosts/"+host+"/domain", nil, nil, d)
+	err = getServiceError(err)
+	return
+}
+
 type AddDomainRequest struct {
 	Options  *packages.DomainOptions `json:"options"`
 	ByUserID string                  `json:"by-user-id"`
@@ -75,12 +83,14 @@ func (c Client) UpdateDomain(ref string, o *packages.DomainOptions, byUserID str
 }
 
 type DeleteDomainRequest struct {
-	ByUserID string `json:"by-user-id"`
+	IfRevision *uint64 `json:"if-revision,omitempty"`
+	ByUserID   string  `json:"by-user-id"`
 }
 
-func (c Client) DeleteDomain(ref, byUserID string) (d *packages.Domain, err error) {
+func (c Client) DeleteDomain(ref string, ifRevision *uint64, byUserID string) (d *packages.Domain, err error) {
 	body, err := json.Marshal(DeleteDomainRequest{
-		ByUserID: byUserID,
+		IfRevision: ifRevision,
+		ByUserID:   byUserID,
 	})
 	if err != nil {
 		return
@@ -97,12 +107,20 @@ func (c Client) DomainUsers(ref string) (users packages.DomainUsers, err error)
 	return
 }
 
+func (c Client) DomainUserRole(ref, userID string) (role packages.DomainRole, err error) {
+	err = c.JSON("GET", "/domains/"+ref+"/users/"+userID+"/role", nil, nil, &role)
+	err = getServiceError(err)
+	return
+}
+
 type AddUserToDomainRequest struct {
-	ByUserID string `json:"by-user-id"`
+	Role     packages.DomainRole `json:"role,omitempty"`
+	ByUserID string              `json:"by-user-id"`
 }
 
-func (c Client) AddUserToDomain(ref, userID, byUserID string) (err error) {
+func (c Client) AddUserToDomain(ref, userID string, role packages.DomainRole, byUserID string) (err error) {
 	body, err := json.Marshal(AddUserToDomainRequest{
+		Role:     role,
 		ByUserID: byUserID,
 	})
 	if err != nil {
@@ -113,6 +131,24 @@ func (c Client) AddUserToDomain(ref, userID, byUserID string) (err error) {
 	return
 }
 
+type SetDomainUserRoleRequest struct {
+	Role     packages.DomainRole `json:"role"`
+	ByUserID string              `json:"by-user-id"`
+}
+
+func (c Client) SetDomainUserRole(ref, userID string, role packages.DomainRole, byUserID string) (err error) {
+	body, err := json.Marshal(SetDomainUserRoleRequest{
+		Role:     role,
+		ByUserID: byUserID,
+	})
+	if err != nil {
+		return
+	}
+	err = c.JSON("POST", "/domains/"+ref+"/users/"+userID+"/role", nil, bytes.NewReader(body), nil)
+	err = getServiceError(err)
+	return
+}
+
 type RemoveUserToDomainRequest struct {
 	ByUserID string `json:"by-user-id"`
 }
@@ -168,6 +204,220 @@ func (c Client) DomainsByOwner(userID, startRef string, limit int) (p packages.D
 	return
 }
 
+func (c Client) DomainsByOrganization(organizationID, startRef string, limit int) (p packages.DomainsPage, err error) {
+	query := url.Values{}
+	if startRef != "" {
+		query.Set("start", startRef)
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	err = c.JSON("GET", "/organizations/"+organizationID+"/domains", query, nil, &p)
+	err = getServiceError(err)
+	return
+}
+
+func (c Client) DisableUnverifiedDomain(ref string) (d *packages.Domain, err error) {
+	d = &packages.Domain{}
+	err = c.JSON("POST", "/domains/"+ref+"/disable-unverified", nil, nil, d)
+	err = getServiceError(err)
+	return
+}
+
+func (c Client) DomainTransfer(ref string) (t *packages.DomainTransfer, err error) {
+	t = &packages.DomainTransfer{}
+	err = c.JSON("GET", "/domains/"+ref+"/transfer", nil, nil, t)
+	err = getServiceError(err)
+	return
+}
+
+func (c Client) DomainTransfersByUser(userID string) (transfers packages.DomainTransfers, err error) {
+	err = c.JSON("GET", "/users/"+userID+"/domain-transfers", nil, nil, &transfers)
+	err = getServiceError(err)
+	return
+}
+
+type RequestDomainTransferRequest struct {
+	ToUserID       string    `json:"to-user-id"`
+	ExpirationTime time.Time `json:"expiration-time"`
+	ByUserID       string    `json:"by-user-id"`
+}
+
+func (c Client) RequestDomainTransfer(ref, toUserID string, expirationTime time.Time, byUserID string) (t *packages.DomainTransfer, err error) {
+	body, err := json.Marshal(RequestDomainTransferRequest{
+		ToUserID:       toUserID,
+		ExpirationTime: expirationTime,
+		ByUserID:       byUserID,
+	})
+	if err != nil {
+		return
+	}
+	t = &packages.DomainTransfer{}
+	err = c.JSON("POST", "/domains/"+ref+"/transfer", nil, bytes.NewReader(body), t)
+	err = getServiceError(err)
+	return
+}
+
+type AcceptDomainTransferRequest struct {
+	ByUserID string `json:"by-user-id"`
+}
+
+func (c Client) AcceptDomainTransfer(ref, byUserID string) (d *packages.Domain, err error) {
+	body, err := json.Marshal(AcceptDomainTransferRequest{
+		ByUserID: byUserID,
+	})
+	if err != nil {
+		return
+	}
+	d = &packages.Domain{}
+	err = c.JSON("POST", "/domains/"+ref+"/transfer/accept", nil, bytes.NewReader(body), d)
+	err = getServiceError(err)
+	return
+}
+
+type CancelDomainTransferRequest struct {
+	ByUserID string `json:"by-user-id"`
+}
+
+func (c Client) CancelDomainTransfer(ref, byUserID string) (t *packages.DomainTransfer, err error) {
+	body, err := json.Marshal(CancelDomainTransferRequest{
+		ByUserID: byUserID,
+	})
+	if err != nil {
+		return
+	}
+	t = &packages.DomainTransfer{}
+	err = c.JSON("DELETE", "/domains/"+ref+"/transfer", nil, bytes.NewReader(body), t)
+	err = getServiceError(err)
+	return
+}
+
+func (c Client) Organization(ref string) (o *packages.Organization, err error) {
+	o = &packages.Organization{}
+	err = c.JSON("GET", "/organizations/"+ref, nil, nil, o)
+	err = getServiceError(err)
+	return
+}
+
+type AddOrganizationRequest struct {
+	Options  *packages.OrganizationOptions `json:"options"`
+	ByUserID string                        `json:"by-user-id"`
+}
+
+func (c Client) AddOrganization(o *packages.OrganizationOptions, byUserID string) (org *packages.Organization, err error) {
+	body, err := json.Marshal(AddOrganizationRequest{
+		Options:  o,
+		ByUserID: byUserID,
+	})
+	if err != nil {
+		return
+	}
+	org = &packages.Organization{}
+	err = c.JSON("POST", "/organizations", nil, bytes.NewReader(body), org)
+	err = getServiceError(err)
+	return
+}
+
+type UpdateOrganizationRequest struct {
+	Options  *packages.OrganizationOptions `json:"options"`
+	ByUserID string                        `json:"by-user-id"`
+}
+
+func (c Client) UpdateOrganization(ref string, o *packages.OrganizationOptions, byUserID string) (org *packages.Organization, err error) {
+	body, err := json.Marshal(UpdateOrganizationRequest{
+		Options:  o,
+		ByUserID: byUserID,
+	})
+	if err != nil {
+		return
+	}
+	org = &packages.Organization{}
+	err = c.JSON("POST", "/organizations/"+ref, nil, bytes.NewReader(body), org)
+	err = getServiceError(err)
+	return
+}
+
+type DeleteOrganizationRequest struct {
+	ByUserID string `json:"by-user-id"`
+}
+
+func (c Client) DeleteOrganization(ref, byUserID string) (org *packages.Organization, err error) {
+	body, err := json.Marshal(DeleteOrganizationRequest{
+		ByUserID: byUserID,
+	})
+	if err != nil {
+		return
+	}
+	org = &packages.Organization{}
+	err = c.JSON("DELETE", "/organizations/"+ref, nil, bytes.NewReader(body), org)
+	err = getServiceError(err)
+	return
+}
+
+func (c Client) OrganizationsByUser(userID string) (orgs packages.Organizations, err error) {
+	err = c.JSON("GET", "/users/"+userID+"/organizations", nil, nil, &orgs)
+	err = getServiceError(err)
+	return
+}
+
+func (c Client) OrganizationMembers(ref string) (members packages.OrganizationMembers, err error) {
+	err = c.JSON("GET", "/organizations/"+ref+"/members", nil, nil, &members)
+	err = getServiceError(err)
+	return
+}
+
+type SetOrganizationMemberRequest struct {
+	Role     packages.OrganizationRole `json:"role"`
+	ByUserID string                    `json:"by-user-id"`
+}
+
+func (c Client) SetOrganizationMember(ref, userID string, role packages.OrganizationRole, byUserID string) (err error) {
+	body, err := json.Marshal(SetOrganizationMemberRequest{
+		Role:     role,
+		ByUserID: byUserID,
+	})
+	if err != nil {
+		return
+	}
+	err = c.JSON("POST", "/organizations/"+ref+"/members/"+userID, nil, bytes.NewReader(body), nil)
+	err = getServiceError(err)
+	return
+}
+
+type RemoveOrganizationMemberRequest struct {
+	ByUserID string `json:"by-user-id"`
+}
+
+func (c Client) RemoveOrganizationMember(ref, userID, byUserID string) (err error) {
+	body, err := json.Marshal(RemoveOrganizationMemberRequest{
+		ByUserID: byUserID,
+	})
+	if err != nil {
+		return
+	}
+	err = c.JSON("DELETE", "/organizations/"+ref+"/members/"+userID, nil, bytes.NewReader(body), nil)
+	err = getServiceError(err)
+	return
+}
+
+func (c Client) Quota(ownerID string) (q *packages.Quota, err error) {
+	q = &packages.Quota{}
+	err = c.JSON("GET", "/quotas/"+ownerID, nil, nil, q)
+	err = getServiceError(err)
+	return
+}
+
+func (c Client) SetQuota(ownerID string, o *packages.QuotaOptions) (q *packages.Quota, err error) {
+	body, err := json.Marshal(o)
+	if err != nil {
+		return
+	}
+	q = &packages.Quota{}
+	err = c.JSON("POST", "/quotas/"+ownerID, nil, bytes.NewReader(body), q)
+	err = getServiceError(err)
+	return
+}
+
 func (c Client) Package(id string) (p *packages.Package, err error) {
 	err = c.JSON("GET", "/packages/"+id, nil, nil, p)
 	err = getServiceError(err)
@@ -213,12 +463,14 @@ func (c Client) UpdatePackage(id string, o *packages.PackageOptions, byUserID st
 }
 
 type DeletePackageRequest struct {
-	ByUserID string `json:"by-user-id"`
+	IfRevision *uint64 `json:"if-revision,omitempty"`
+	ByUserID   string  `json:"by-user-id"`
 }
 
-func (c Client) DeletePackage(id string, byUserID string) (p *packages.Package, err error) {
+func (c Client) DeletePackage(id string, ifRevision *uint64, byUserID string) (p *packages.Package, err error) {
 	body, err := json.Marshal(DeletePackageRequest{
-		ByUserID: byUserID,
+		IfRevision: ifRevision,
+		ByUserID:   byUserID,
 	})
 	if err != nil {
 		return
@@ -229,7 +481,7 @@ func (c Client) DeletePackage(id string, byUserID string) (p *packages.Package,
 	return
 }
 
-func (c Client) PackagesByDomain(domainRef, startName string, limit int) (page packages.PackagesPage, err error) {
+func (c Client) PackagesByDomain(domainRef, startName string, limit int, filter *packages.PackagesFilter) (page packages.PackagesPage, err error) {
 	query := url.Values{}
 	if startName != "" {
 		query.Set("start", startName)
@@ -237,7 +489,104 @@ func (c Client) PackagesByDomain(domainRef, startName string, limit int) (page p
 	if limit > 0 {
 		query.Set("limit", strconv.Itoa(limit))
 	}
-	err = c.JSON("GET", "/packages-by-domain/"+domainRef, query, nil, page)
+	if filter != nil {
+		if filter.VCS != "" {
+			query.Set("vcs", string(filter.VCS))
+		}
+		if filter.RefType != "" {
+			query.Set("ref-type", string(filter.RefType))
+		}
+		if filter.RepoRootHost != "" {
+			query.Set("repo-root-host", filter.RepoRootHost)
+		}
+		if filter.Disabled != nil {
+			query.Set("disabled", strconv.FormatBool(*filter.Disabled))
+		}
+		if filter.Query != "" {
+			query.Set("query", filter.Query)
+		}
+	}
+	err = c.JSON("GET", "/packages-by-domain/"+domainRef, query, nil, &page)
+	err = getServiceError(err)
+	return
+}
+
+func (c Client) SearchPackages(userID, query, startID string, limit int) (page packages.PackagesPage, err error) {
+	q := url.Values{}
+	if userID != "" {
+		q.Set("user-id", userID)
+	}
+	if query != "" {
+		q.Set("query", query)
+	}
+	if startID != "" {
+		q.Set("start", startID)
+	}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+	err = c.JSON("GET", "/packages-search", q, nil, &page)
+	err = getServiceError(err)
+	return
+}
+
+type BatchRequest struct {
+	Operations []packages.BatchOperation `json:"operations"`
+	ByUserID   string                    `json:"by-user-id"`
+}
+
+func (c Client) Batch(operations []packages.BatchOperation, byUserID string) (results []packages.BatchResult, err error) {
+	body, err := json.Marshal(BatchRequest{
+		Operations: operations,
+		ByUserID:   byUserID,
+	})
+	if err != nil {
+		return
+	}
+	bc := *c.Client
+	bc.ErrorRegistry = batchErrorRegistry{ErrorRegistry: errorRegistry}
+	err = bc.JSON("POST", "/batch", nil, bytes.NewReader(body), &results)
+	return
+}
+
+type SyncPackagesRequest struct {
+	Options  []packages.PackageOptions `json:"options"`
+	DryRun   bool                      `json:"dry-run,omitempty"`
+	ByUserID string                    `json:"by-user-id"`
+}
+
+func (c Client) SyncPackages(domainRef string, o []packages.PackageOptions, dryRun bool, byUserID string) (plan *packages.PackagesPlan, err error) {
+	body, err := json.Marshal(SyncPackagesRequest{
+		Options:  o,
+		DryRun:   dryRun,
+		ByUserID: byUserID,
+	})
+	if err != nil {
+		return
+	}
+	plan = &packages.PackagesPlan{}
+	err = c.JSON("POST", "/packages-by-domain/"+domainRef+"/sync", nil, bytes.NewReader(body), plan)
+	err = getServiceError(err)
+	return
+}
+
+type ApplyPackageDefaultsRequest struct {
+	Fields   []packages.PackageDefaultsField `json:"fields,omitempty"`
+	DryRun   bool                            `json:"dry-run,omitempty"`
+	ByUserID string                          `json:"by-user-id"`
+}
+
+func (c Client) ApplyPackageDefaults(domainRef string, fields []packages.PackageDefaultsField, dryRun bool, byUserID string) (plan *packages.PackagesPlan, err error) {
+	body, err := json.Marshal(ApplyPackageDefaultsRequest{
+		Fields:   fields,
+		DryRun:   dryRun,
+		ByUserID: byUserID,
+	})
+	if err != nil {
+		return
+	}
+	plan = &packages.PackagesPlan{}
+	err = c.JSON("POST", "/packages-by-domain/"+domainRef+"/defaults", nil, bytes.NewReader(body), plan)
 	err = getServiceError(err)
 	return
 }
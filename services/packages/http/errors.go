@@ -6,6 +6,8 @@
 package httpPackages
 
 import (
+	"encoding/json"
+
 	"gopherpit.com/gopherpit/services/packages"
 	"resenje.org/web/client/api"
 )
@@ -19,8 +21,26 @@ var (
 	ErrDomainAlreadyExists           = errorRegistry.MustAddMessageError(1001, "Domain Already Exists")
 	ErrDomainFQDNRequired            = errorRegistry.MustAddMessageError(1010, "Domain FQDN Required")
 	ErrDomainOwnerUserIDRequired     = errorRegistry.MustAddMessageError(1020, "Domain Owner User ID Required")
+	ErrDomainRevisionMismatch        = errorRegistry.MustAddMessageError(1030, "Domain Revision Mismatch")
+	ErrDomainTransferNotFound        = errorRegistry.MustAddMessageError(1040, "Domain Transfer Not Found")
+	ErrDomainTransferAlreadyExists   = errorRegistry.MustAddMessageError(1041, "Domain Transfer Already Exists")
+	ErrDomainTransferToOwner         = errorRegistry.MustAddMessageError(1042, "Domain Transfer To Owner")
+	ErrDomainTransferExpired         = errorRegistry.MustAddMessageError(1043, "Domain Transfer Expired")
+	ErrDomainAliasInvalid            = errorRegistry.MustAddMessageError(1050, "Domain Alias Invalid")
+	ErrDomainAliasAlreadyExists      = errorRegistry.MustAddMessageError(1051, "Domain Alias Already Exists")
+	ErrDomainQuotaExceeded           = errorRegistry.MustAddMessageError(1060, "Domain Quota Exceeded")
 	ErrUserDoesNotExist              = errorRegistry.MustAddMessageError(1100, "User Does Not Exist")
 	ErrUserExists                    = errorRegistry.MustAddMessageError(1101, "User Exists")
+	ErrDomainRoleInvalid             = errorRegistry.MustAddMessageError(1110, "Domain Role Invalid")
+	ErrOrganizationNotFound          = errorRegistry.MustAddMessageError(1200, "Organization Not Found")
+	ErrOrganizationAlreadyExists     = errorRegistry.MustAddMessageError(1201, "Organization Already Exists")
+	ErrOrganizationNameRequired      = errorRegistry.MustAddMessageError(1210, "Organization Name Required")
+	ErrOrganizationNameInvalid       = errorRegistry.MustAddMessageError(1211, "Organization Name Invalid")
+	ErrOrganizationRoleInvalid       = errorRegistry.MustAddMessageError(1220, "Organization Role Invalid")
+	ErrOrganizationLastOwner         = errorRegistry.MustAddMessageError(1221, "Organization Last Owner")
+	ErrOrganizationHasDomains        = errorRegistry.MustAddMessageError(1230, "Organization Has Domains")
+	ErrQuotaInvalid                  = errorRegistry.MustAddMessageError(1300, "Quota Invalid")
+	ErrPackageDefaultsFieldInvalid   = errorRegistry.MustAddMessageError(2100, "Package Defaults Field Invalid")
 	ErrPackageNotFound               = errorRegistry.MustAddMessageError(2000, "Package Not Found")
 	ErrPackageAlreadyExists          = errorRegistry.MustAddMessageError(2001, "Package Already Exists")
 	ErrPackageDomainRequired         = errorRegistry.MustAddMessageError(2010, "Package Domain Required")
@@ -32,7 +52,10 @@ var (
 	ErrPackageRepoRootSchemeInvalid  = errorRegistry.MustAddMessageError(2043, "Package Repository Root Scheme Invalid")
 	ErrPackageRepoRootHostInvalid    = errorRegistry.MustAddMessageError(2044, "Package Repository Root Host Invalid")
 	ErrPackageRefChangeRejected      = errorRegistry.MustAddMessageError(2070, "Package Reference Change Rejected")
+	ErrPackageRevisionMismatch       = errorRegistry.MustAddMessageError(2090, "Package Revision Mismatch")
+	ErrPackageQuotaExceeded          = errorRegistry.MustAddMessageError(2110, "Package Quota Exceeded")
 	ErrChangelogRecordNotFound       = errorRegistry.MustAddMessageError(3000, "Changelog Record Not Found")
+	ErrBatchActionInvalid            = errorRegistry.MustAddMessageError(4000, "Batch Action Invalid")
 )
 
 var errorMap = map[error]error{
@@ -41,8 +64,26 @@ var errorMap = map[error]error{
 	ErrDomainAlreadyExists:           packages.ErrDomainAlreadyExists,
 	ErrDomainFQDNRequired:            packages.ErrDomainFQDNRequired,
 	ErrDomainOwnerUserIDRequired:     packages.ErrDomainOwnerUserIDRequired,
+	ErrDomainRevisionMismatch:        packages.ErrDomainRevisionMismatch,
+	ErrDomainTransferNotFound:        packages.ErrDomainTransferNotFound,
+	ErrDomainTransferAlreadyExists:   packages.ErrDomainTransferAlreadyExists,
+	ErrDomainTransferToOwner:         packages.ErrDomainTransferToOwner,
+	ErrDomainTransferExpired:         packages.ErrDomainTransferExpired,
+	ErrDomainAliasInvalid:            packages.ErrDomainAliasInvalid,
+	ErrDomainAliasAlreadyExists:      packages.ErrDomainAliasAlreadyExists,
+	ErrDomainQuotaExceeded:           packages.ErrDomainQuotaExceeded,
 	ErrUserDoesNotExist:              packages.ErrUserDoesNotExist,
 	ErrUserExists:                    packages.ErrUserExists,
+	ErrDomainRoleInvalid:             packages.ErrDomainRoleInvalid,
+	ErrOrganizationNotFound:          packages.ErrOrganizationNotFound,
+	ErrOrganizationAlreadyExists:     packages.ErrOrganizationAlreadyExists,
+	ErrOrganizationNameRequired:      packages.ErrOrganizationNameRequired,
+	ErrOrganizationNameInvalid:       packages.ErrOrganizationNameInvalid,
+	ErrOrganizationRoleInvalid:       packages.ErrOrganizationRoleInvalid,
+	ErrOrganizationLastOwner:         packages.ErrOrganizationLastOwner,
+	ErrOrganizationHasDomains:        packages.ErrOrganizationHasDomains,
+	ErrQuotaInvalid:                  packages.ErrQuotaInvalid,
+	ErrPackageDefaultsFieldInvalid:   packages.ErrPackageDefaultsFieldInvalid,
 	ErrPackageNotFound:               packages.ErrPackageNotFound,
 	ErrPackageAlreadyExists:          packages.ErrPackageAlreadyExists,
 	ErrPackageDomainRequired:         packages.ErrPackageDomainRequired,
@@ -54,7 +95,10 @@ var errorMap = map[error]error{
 	ErrPackageRepoRootSchemeInvalid:  packages.ErrPackageRepoRootSchemeInvalid,
 	ErrPackageRepoRootHostInvalid:    packages.ErrPackageRepoRootHostInvalid,
 	ErrPackageRefChangeRejected:      packages.ErrPackageRefChangeRejected,
+	ErrPackageRevisionMismatch:       packages.ErrPackageRevisionMismatch,
+	ErrPackageQuotaExceeded:          packages.ErrPackageQuotaExceeded,
 	ErrChangelogRecordNotFound:       packages.ErrChangelogRecordNotFound,
+	ErrBatchActionInvalid:            packages.ErrBatchActionInvalid,
 }
 
 func getServiceError(err error) error {
@@ -64,3 +108,38 @@ func getServiceError(err error) error {
 	}
 	return err
 }
+
+// batchErrorRegistry returns packages.BatchError with the index of the
+// failed operation from the error response of the batch endpoint.
+type batchErrorRegistry struct {
+	apiClient.ErrorRegistry
+}
+
+func (r batchErrorRegistry) Error(code int) error {
+	return nil
+}
+
+func (r batchErrorRegistry) Handler(code int) func(body []byte) error {
+	return func(body []byte) error {
+		message := struct {
+			Message   string `json:"message"`
+			Operation *int   `json:"operation"`
+		}{}
+		json.Unmarshal(body, &message)
+		err := r.ErrorRegistry.Error(code)
+		if err == nil {
+			err = &apiClient.Error{
+				Message: message.Message,
+				Code:    code,
+			}
+		}
+		err = getServiceError(err)
+		if message.Operation == nil {
+			return err
+		}
+		return &packages.BatchError{
+			Operation: *message.Operation,
+			Err:       err,
+		}
+	}
+}
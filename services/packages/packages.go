@@ -7,37 +7,88 @@ package packages
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 )
 
 type Service interface {
 	DomainService
+	DomainTransferService
+	OrganizationService
+	QuotaService
 	PackageService
 	ChangelogService
+	BatchService
 }
 
 type DomainService interface {
 	Domain(ref string) (*Domain, error)
+	DomainByHost(host string) (*Domain, error)
 	AddDomain(o *DomainOptions, byUserID string) (*Domain, error)
 	UpdateDomain(ref string, o *DomainOptions, byUserID string) (*Domain, error)
-	DeleteDomain(ref, byUserID string) (*Domain, error)
+	DeleteDomain(ref string, ifRevision *uint64, byUserID string) (*Domain, error)
 	DomainUsers(ref string) (DomainUsers, error)
-	AddUserToDomain(ref, userID, byUserID string) error
+	DomainUserRole(ref, userID string) (DomainRole, error)
+	AddUserToDomain(ref, userID string, role DomainRole, byUserID string) error
+	SetDomainUserRole(ref, userID string, role DomainRole, byUserID string) error
 	RemoveUserFromDomain(ref, userID, byUserID string) error
 	Domains(startFQDN string, limit int) (DomainsPage, error)
 	DomainsByUser(userID, startRef string, limit int) (DomainsPage, error)
 	DomainsByOwner(userID, startRef string, limit int) (DomainsPage, error)
+	DomainsByOrganization(organizationID, startRef string, limit int) (DomainsPage, error)
+	DisableUnverifiedDomain(ref string) (*Domain, error)
+}
+
+// DomainTransferService changes domain owners in two steps. The owner
+// requests the transfer that the new owner must accept before it expires.
+type DomainTransferService interface {
+	DomainTransfer(ref string) (*DomainTransfer, error)
+	DomainTransfersByUser(userID string) (DomainTransfers, error)
+	RequestDomainTransfer(ref, toUserID string, expirationTime time.Time, byUserID string) (*DomainTransfer, error)
+	AcceptDomainTransfer(ref, byUserID string) (*Domain, error)
+	CancelDomainTransfer(ref, byUserID string) (*DomainTransfer, error)
+}
+
+// OrganizationService manages organizations that can own domains instead
+// of a single user. Organization owners have the owner role and members
+// the maintainer role on all domains of the organization.
+type OrganizationService interface {
+	Organization(ref string) (*Organization, error)
+	AddOrganization(o *OrganizationOptions, byUserID string) (*Organization, error)
+	UpdateOrganization(ref string, o *OrganizationOptions, byUserID string) (*Organization, error)
+	DeleteOrganization(ref, byUserID string) (*Organization, error)
+	OrganizationsByUser(userID string) (Organizations, error)
+	OrganizationMembers(ref string) (OrganizationMembers, error)
+	SetOrganizationMember(ref, userID string, role OrganizationRole, byUserID string) error
+	RemoveOrganizationMember(ref, userID, byUserID string) error
+}
+
+// QuotaService limits the number of domains and packages that a user or
+// an organization can own. Quotas are checked when a domain or a package
+// is added or given to another owner.
+type QuotaService interface {
+	Quota(ownerID string) (*Quota, error)
+	SetQuota(ownerID string, o *QuotaOptions) (*Quota, error)
 }
 
 type PackageService interface {
 	Package(id string) (*Package, error)
 	AddPackage(o *PackageOptions, byUserID string) (*Package, error)
 	UpdatePackage(id string, o *PackageOptions, byUserID string) (*Package, error)
-	DeletePackage(id string, byUserID string) (*Package, error)
-	PackagesByDomain(domainRef, startName string, limit int) (PackagesPage, error)
+	DeletePackage(id string, ifRevision *uint64, byUserID string) (*Package, error)
+	PackagesByDomain(domainRef, startName string, limit int, filter *PackagesFilter) (PackagesPage, error)
+	SyncPackages(domainRef string, o []PackageOptions, dryRun bool, byUserID string) (*PackagesPlan, error)
+	ApplyPackageDefaults(domainRef string, fields []PackageDefaultsField, dryRun bool, byUserID string) (*PackagesPlan, error)
+	SearchPackages(userID, query, startID string, limit int) (PackagesPage, error)
 	ResolvePackage(path string) (*PackageResolution, error)
 }
 
+// BatchService applies multiple domain and package changes atomically.
+type BatchService interface {
+	Batch(operations []BatchOperation, byUserID string) ([]BatchResult, error)
+}
+
 type ChangelogService interface {
 	ChangelogRecord(domainRef, id string) (*ChangelogRecord, error)
 	DeleteChangelogRecord(domainRef, id string) (*ChangelogRecord, error)
@@ -48,35 +99,213 @@ type Domain struct {
 	ID                string `json:"id"`
 	FQDN              string `json:"fqdn"`
 	OwnerUserID       string `json:"owner-user-id,omitempty"`
+	OrganizationID    string `json:"organization-id,omitempty"`
 	CertificateIgnore bool   `json:"certificate-ignore,omitempty"`
 	Disabled          bool   `json:"disabled,omitempty"`
+	Revision          uint64 `json:"revision,omitempty"`
+
+	PackageDefaults *PackageDefaults `json:"package-defaults,omitempty"`
+
+	// Aliases are additional fully qualified domain names that resolve
+	// the same packages. If AliasRedirect is true, requests to aliases
+	// are redirected to the domain FQDN.
+	Aliases       []string `json:"aliases,omitempty"`
+	AliasRedirect bool     `json:"alias-redirect,omitempty"`
 
 	// Internal functionality fields (changes not logged)
-	CertificateIgnoreMissing bool `json:"certificate-ignore-missing,omitempty"`
+	CertificateIgnoreMissing bool       `json:"certificate-ignore-missing,omitempty"`
+	VerificationFailed       *time.Time `json:"verification-failed,omitempty"`
+}
+
+// IsWildcard returns true if the domain covers all subdomains
+// of its parent domain that are not registered as domains.
+func (d Domain) IsWildcard() bool {
+	return IsWildcardFQDN(d.FQDN)
 }
 
+// HasAlias returns true if fqdn is one of the domain aliases.
+func (d Domain) HasAlias(fqdn string) bool {
+	for _, a := range d.Aliases {
+		if a == fqdn {
+			return true
+		}
+	}
+	return false
+}
+
+// IsWildcardFQDN returns true if fqdn is a wildcard domain name
+// in form *.example.com.
+func IsWildcardFQDN(fqdn string) bool {
+	return strings.HasPrefix(fqdn, "*.")
+}
+
+// WildcardFQDN returns the name of the wildcard domain that covers
+// fqdn, or an empty string if there is no parent domain.
+// For team.example.com it returns *.example.com.
+func WildcardFQDN(fqdn string) string {
+	i := strings.IndexRune(fqdn, '.')
+	if i <= 0 || i == len(fqdn)-1 {
+		return ""
+	}
+	return "*" + fqdn[i:]
+}
+
+// DomainOptions defines Domain fields that can be changed. Domain is owned
+// by a user or by an organization, so setting OrganizationID removes the
+// owner user and setting OwnerUserID removes the organization.
 type DomainOptions struct {
 	FQDN              *string `json:"fqdn,omitempty"`
 	OwnerUserID       *string `json:"owner-user-id,omitempty"`
+	OrganizationID    *string `json:"organization-id,omitempty"`
 	CertificateIgnore *bool   `json:"certificate-ignore,omitempty"`
 	Disabled          *bool   `json:"disabled,omitempty"`
+	// PackageDefaults replaces domain package defaults.
+	// Empty PackageDefaults removes them.
+	PackageDefaults *PackageDefaults `json:"package-defaults,omitempty"`
+	// Aliases replaces all domain aliases.
+	Aliases       *[]string `json:"aliases,omitempty"`
+	AliasRedirect *bool     `json:"alias-redirect,omitempty"`
+
+	// If IfRevision is not nil, domain is updated only if
+	// its current revision is equal to the provided value.
+	IfRevision *uint64 `json:"if-revision,omitempty"`
 
 	CertificateIgnoreMissing *bool `json:"certificate-ignore-missing,omitempty"`
+	// VerificationFailed is the time of the first failed periodic
+	// ownership verification. Zero time clears it.
+	VerificationFailed *time.Time `json:"verification-failed,omitempty"`
 }
 
 type Domains []Domain
 
 type DomainsPage struct {
-	Domains  Domains `json:"domains"`
-	UserID   string  `json:"user-id,omitempty"`
-	Previous string  `json:"previous,omitempty"`
-	Next     string  `json:"next,omitempty"`
-	Count    int     `json:"count,omitempty"`
+	Domains        Domains `json:"domains"`
+	UserID         string  `json:"user-id,omitempty"`
+	OrganizationID string  `json:"organization-id,omitempty"`
+	Previous       string  `json:"previous,omitempty"`
+	Next           string  `json:"next,omitempty"`
+	Count          int     `json:"count,omitempty"`
 }
 
+// DomainUsers holds users that have access to the domain. Members of the
+// organization that owns the domain are not listed. Use DomainUserRole
+// to get the role of any user, including organization members.
 type DomainUsers struct {
-	OwnerUserID string   `json:"owner-user-id"`
-	UserIDs     []string `json:"user-ids,omitempty"`
+	OwnerUserID    string                `json:"owner-user-id"`
+	OrganizationID string                `json:"organization-id,omitempty"`
+	UserIDs        []string              `json:"user-ids,omitempty"`
+	Roles          map[string]DomainRole `json:"roles,omitempty"`
+}
+
+// Role returns the role of the user on the domain or an empty string
+// if the user does not have access to the domain.
+func (u DomainUsers) Role(userID string) DomainRole {
+	if userID != "" && userID == u.OwnerUserID {
+		return DomainRoleOwner
+	}
+	return u.Roles[userID]
+}
+
+// DomainRole defines what a user is allowed to do with a domain.
+type DomainRole string
+
+// Possible DomainRole values. Owners can manage everything, including
+// domain settings and the team, maintainers can add, change and remove
+// packages, and viewers have read only access.
+var (
+	DomainRoleOwner      DomainRole = "owner"
+	DomainRoleMaintainer DomainRole = "maintainer"
+	DomainRoleViewer     DomainRole = "viewer"
+)
+
+// CanEditPackages returns true if the role allows package changes.
+func (r DomainRole) CanEditPackages() bool {
+	return r == DomainRoleOwner || r == DomainRoleMaintainer
+}
+
+// DomainTransfer is a pending change of the domain owner.
+type DomainTransfer struct {
+	DomainID       string    `json:"domain-id"`
+	FQDN           string    `json:"fqdn"`
+	FromUserID     string    `json:"from-user-id"`
+	ToUserID       string    `json:"to-user-id"`
+	Time           time.Time `json:"time"`
+	ExpirationTime time.Time `json:"expiration-time"`
+}
+
+// IsExpired returns true if the transfer can not be accepted anymore.
+func (t DomainTransfer) IsExpired() bool {
+	return !time.Now().Before(t.ExpirationTime)
+}
+
+type DomainTransfers []DomainTransfer
+
+// Organization is a group of users that owns domains.
+type Organization struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// OrganizationOptions defines Organization fields that can be changed.
+type OrganizationOptions struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type Organizations []Organization
+
+// OrganizationMembers holds roles of all organization members.
+type OrganizationMembers struct {
+	UserIDs []string                    `json:"user-ids"`
+	Roles   map[string]OrganizationRole `json:"roles"`
+}
+
+// Role returns the role of the user in the organization or an empty
+// string if the user is not a member.
+func (m OrganizationMembers) Role(userID string) OrganizationRole {
+	return m.Roles[userID]
+}
+
+// OrganizationRole defines what a member is allowed to do with an
+// organization and its domains.
+type OrganizationRole string
+
+// Possible OrganizationRole values. Owners manage the organization, its
+// members and domains, and members can change packages on all domains
+// of the organization.
+var (
+	OrganizationRoleOwner  OrganizationRole = "owner"
+	OrganizationRoleMember OrganizationRole = "member"
+)
+
+// DomainRole returns the role that the organization member has on domains
+// that the organization owns.
+func (r OrganizationRole) DomainRole() DomainRole {
+	switch r {
+	case OrganizationRoleOwner:
+		return DomainRoleOwner
+	case OrganizationRoleMember:
+		return DomainRoleMaintainer
+	}
+	return ""
+}
+
+// Quota holds effective domain and package limits of a user or an
+// organization, where zero means unlimited, together with overrides of
+// default limits and the current usage.
+type Quota struct {
+	Domains          int `json:"domains"`
+	Packages         int `json:"packages"`
+	DomainsOverride  int `json:"domains-override,omitempty"`
+	PackagesOverride int `json:"packages-override,omitempty"`
+	DomainsUsed      int `json:"domains-used"`
+	PackagesUsed     int `json:"packages-used"`
+}
+
+// QuotaOptions defines overrides of default quotas. Zero value resets the
+// override to the default quota and -1 removes the limit.
+type QuotaOptions struct {
+	Domains  *int `json:"domains,omitempty"`
+	Packages *int `json:"packages,omitempty"`
 }
 
 type VCS string
@@ -116,6 +345,7 @@ type Package struct {
 	GoSource    string  `json:"go-source,omitempty"`
 	RedirectURL string  `json:"redirect-url,omitempty"`
 	Disabled    bool    `json:"disabled,omitempty"`
+	Revision    uint64  `json:"revision,omitempty"`
 }
 
 func (p Package) ImportPrefix() string {
@@ -132,6 +362,64 @@ type PackageOptions struct {
 	GoSource    *string  `json:"go-source,omitempty"`
 	RedirectURL *string  `json:"redirect-url,omitempty"`
 	Disabled    *bool    `json:"disabled,omitempty"`
+
+	// If IfRevision is not nil, package is updated only if
+	// its current revision is equal to the provided value.
+	IfRevision *uint64 `json:"if-revision,omitempty"`
+}
+
+// PackageDefaults holds values that new packages under a domain
+// inherit when they are not provided. RepoRoot, GoSource and
+// RedirectURL are templates where {domain} is replaced with the domain
+// FQDN and {path} with the package path without the leading slash.
+type PackageDefaults struct {
+	VCS         VCS     `json:"vcs,omitempty"`
+	RepoRoot    string  `json:"repo-root,omitempty"`
+	RefType     RefType `json:"ref-type,omitempty"`
+	RefName     string  `json:"ref-name,omitempty"`
+	GoSource    string  `json:"go-source,omitempty"`
+	RedirectURL string  `json:"redirect-url,omitempty"`
+}
+
+// IsZero returns true if no default value is set.
+func (d PackageDefaults) IsZero() bool {
+	return d == PackageDefaults{}
+}
+
+// ForPackage returns package defaults with expanded templates for
+// the package with the provided path under the domain with fqdn.
+func (d PackageDefaults) ForPackage(fqdn, path string) PackageDefaults {
+	r := strings.NewReplacer(
+		"{domain}", fqdn,
+		"{path}", strings.Trim(path, "/"),
+	)
+	d.RepoRoot = r.Replace(d.RepoRoot)
+	d.GoSource = r.Replace(d.GoSource)
+	d.RedirectURL = r.Replace(d.RedirectURL)
+	return d
+}
+
+// PackageDefaultsField is a name of a PackageDefaults field that can
+// be applied to existing packages.
+type PackageDefaultsField string
+
+// Package defaults fields. PackageDefaultsFieldRef applies both
+// reference type and reference name.
+var (
+	PackageDefaultsFieldVCS         PackageDefaultsField = "vcs"
+	PackageDefaultsFieldRepoRoot    PackageDefaultsField = "repo-root"
+	PackageDefaultsFieldRef         PackageDefaultsField = "ref"
+	PackageDefaultsFieldGoSource    PackageDefaultsField = "go-source"
+	PackageDefaultsFieldRedirectURL PackageDefaultsField = "redirect-url"
+)
+
+// PackageDefaultsFields are all fields that can be applied.
+var PackageDefaultsFields = []PackageDefaultsField{
+	PackageDefaultsFieldVCS,
+	PackageDefaultsFieldRepoRoot,
+	PackageDefaultsFieldRef,
+	PackageDefaultsFieldGoSource,
+	PackageDefaultsFieldRedirectURL,
 }
 
 type Packages []Package
@@ -144,15 +432,90 @@ type PackagesPage struct {
 	Count    int      `json:"count,omitempty"`
 }
 
+// PackagesFilter holds criteria for listing packages under a domain.
+// Only packages that match all non-zero fields are listed.
+type PackagesFilter struct {
+	VCS          VCS     `json:"vcs,omitempty"`
+	RefType      RefType `json:"ref-type,omitempty"`
+	RepoRootHost string  `json:"repo-root-host,omitempty"`
+	Disabled     *bool   `json:"disabled,omitempty"`
+	// Query is a case insensitive substring of the package path.
+	Query string `json:"query,omitempty"`
+}
+
+// PackageChange describes a single add, update or delete action
+// on a package that is a part of the PackagesPlan.
+type PackageChange struct {
+	Action  Action  `json:"action"`
+	Package Package `json:"package"`
+	Changes Changes `json:"changes,omitempty"`
+}
+
+// PackagesPlan holds all changes that are required for packages
+// under a domain to match the provided list of package options.
+type PackagesPlan struct {
+	Domain  *Domain         `json:"domain,omitempty"`
+	Changes []PackageChange `json:"changes"`
+	Applied bool            `json:"applied,omitempty"`
+}
+
+// BatchOperation defines a single domain or package change in a batch.
+// Action can be one of: ActionAddDomain, ActionUpdateDomain,
+// ActionDeleteDomain, ActionDomainAddUser, ActionDomainUpdateUser,
+// ActionDomainRemoveUser, ActionAddPackage, ActionUpdatePackage and
+// ActionDeletePackage.
+type BatchOperation struct {
+	Action Action `json:"action"`
+	// Ref is a domain ID or FQDN for domain actions, or a package ID
+	// for update and delete package actions.
+	Ref string `json:"ref,omitempty"`
+	// UserID is the ID of the user that is added to or removed from
+	// the domain, and Role is the role of the added or updated user.
+	UserID         string          `json:"user-id,omitempty"`
+	Role           DomainRole      `json:"role,omitempty"`
+	DomainOptions  *DomainOptions  `json:"domain-options,omitempty"`
+	PackageOptions *PackageOptions `json:"package-options,omitempty"`
+	// IfRevision conditions delete actions in the same way as
+	// the ifRevision argument of DeleteDomain and DeletePackage.
+	IfRevision *uint64 `json:"if-revision,omitempty"`
+}
+
+// BatchResult holds the domain or package that is changed
+// by the BatchOperation with the same index in the batch.
+type BatchResult struct {
+	Action  Action   `json:"action"`
+	Domain  *Domain  `json:"domain,omitempty"`
+	Package *Package `json:"package,omitempty"`
+}
+
+// BatchError is returned by Batch when one of the operations fails.
+// None of the operations in the batch are applied in that case.
+type BatchError struct {
+	// Operation is the index of the failed operation in the batch.
+	Operation int   `json:"operation"`
+	Err       error `json:"-"`
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("batch operation %d: %s", e.Operation, e.Err)
+}
+
+// PackageResolution holds information needed to serve a package. If
+// the package is resolved by a domain alias, CanonicalImportPrefix is
+// the import prefix under the domain FQDN, and AliasRedirect is true
+// if requests should be redirected to it.
 type PackageResolution struct {
-	ImportPrefix string  `json:"import-prefix"`
-	VCS          VCS     `json:"vcs"`
-	RepoRoot     string  `json:"repo-root"`
-	RefType      RefType `json:"ref-type"`
-	RefName      string  `json:"ref-name"`
-	GoSource     string  `json:"go-source,omitempty"`
-	RedirectURL  string  `json:"redirect-url,omitempty"`
-	Disabled     bool    `json:"disabled,omitempty"`
+	ImportPrefix          string `json:"import-prefix"`
+	CanonicalImportPrefix string `json:"canonical-import-prefix,omitempty"`
+	AliasRedirect         bool   `json:"alias-redirect,omitempty"`
+
+	VCS         VCS     `json:"vcs"`
+	RepoRoot    string  `json:"repo-root"`
+	RefType     RefType `json:"ref-type"`
+	RefName     string  `json:"ref-name"`
+	GoSource    string  `json:"go-source,omitempty"`
+	RedirectURL string  `json:"redirect-url,omitempty"`
+	Disabled    bool    `json:"disabled,omitempty"`
 }
 
 type Action string
@@ -163,9 +526,16 @@ var (
 	ActionDeleteDomain     Action = "delete-domain"
 	ActionDomainAddUser    Action = "domain-add-user"
 	ActionDomainRemoveUser Action = "domain-remove-user"
+	ActionDomainUpdateUser Action = "domain-update-user"
 	ActionAddPackage       Action = "add-package"
 	ActionUpdatePackage    Action = "update-package"
 	ActionDeletePackage    Action = "delete-package"
+
+	ActionDisableUnverifiedDomain Action = "disable-unverified-domain"
+
+	ActionRequestDomainTransfer Action = "request-domain-transfer"
+	ActionAcceptDomainTransfer  Action = "accept-domain-transfer"
+	ActionCancelDomainTransfer  Action = "cancel-domain-transfer"
 )
 
 type Change struct {
@@ -224,9 +594,26 @@ var (
 	ErrDomainNotFound                = errors.New("domain not found")
 	ErrDomainAlreadyExists           = errors.New("domain already exists")
 	ErrDomainFQDNRequired            = errors.New("domain fqdn required")
+	ErrDomainAliasInvalid            = errors.New("domain alias invalid")
+	ErrDomainAliasAlreadyExists      = errors.New("domain alias already exists")
+	ErrDomainQuotaExceeded           = errors.New("domain quota exceeded")
 	ErrDomainOwnerUserIDRequired     = errors.New("domain owner user id required")
+	ErrDomainRevisionMismatch        = errors.New("domain revision mismatch")
+	ErrDomainTransferNotFound        = errors.New("domain transfer not found")
+	ErrDomainTransferAlreadyExists   = errors.New("domain transfer already exists")
+	ErrDomainTransferToOwner         = errors.New("domain transfer to owner")
+	ErrDomainTransferExpired         = errors.New("domain transfer expired")
 	ErrUserDoesNotExist              = errors.New("user does not exist")
 	ErrUserExists                    = errors.New("user exists")
+	ErrDomainRoleInvalid             = errors.New("domain role invalid")
+	ErrOrganizationNotFound          = errors.New("organization not found")
+	ErrOrganizationAlreadyExists     = errors.New("organization already exists")
+	ErrOrganizationNameRequired      = errors.New("organization name required")
+	ErrOrganizationNameInvalid       = errors.New("organization name invalid")
+	ErrOrganizationRoleInvalid       = errors.New("organization role invalid")
+	ErrOrganizationLastOwner         = errors.New("organization last owner")
+	ErrOrganizationHasDomains        = errors.New("organization has domains")
+	ErrPackageDefaultsFieldInvalid   = errors.New("package defaults field invalid")
 	ErrPackageNotFound               = errors.New("package not found")
 	ErrPackageAlreadyExists          = errors.New("package already exists")
 	ErrPackageDomainRequired         = errors.New("package domain required")
@@ -238,5 +625,9 @@ var (
 	ErrPackageRepoRootSchemeInvalid  = errors.New("package repository root scheme invalid")
 	ErrPackageRepoRootHostInvalid    = errors.New("package repository root host invalid")
 	ErrPackageRefChangeRejected      = errors.New("Package Reference Change Rejected")
+	ErrPackageRevisionMismatch       = errors.New("package revision mismatch")
+	ErrPackageQuotaExceeded          = errors.New("package quota exceeded")
+	ErrQuotaInvalid                  = errors.New("quota invalid")
 	ErrChangelogRecordNotFound       = errors.New("changelog record not found")
+	ErrBatchActionInvalid            = errors.New("batch action invalid")
 )